@@ -19,6 +19,7 @@ import (
 	"github.com/gcash/bchwallet/internal/cfgutil"
 	"github.com/gcash/bchwallet/internal/legacy/keystore"
 	"github.com/gcash/bchwallet/netparams"
+	"github.com/gcash/bchwallet/rpc/rpcserver"
 	"github.com/gcash/bchwallet/wallet"
 	"github.com/gcash/neutrino"
 	flags "github.com/jessevdk/go-flags"
@@ -47,26 +48,49 @@ var (
 
 type config struct {
 	// General application behavior
-	ConfigFile    *cfgutil.ExplicitString `short:"C" long:"configfile" description:"Path to configuration file"`
-	ShowVersion   bool                    `short:"V" long:"version" description:"Display version information and exit"`
-	Create        bool                    `long:"create" description:"Create the wallet if it does not exist"`
-	CreateTemp    bool                    `long:"createtemp" description:"Create a temporary simulation wallet (pass=password) in the data directory indicated; must call with --datadir"`
-	AppDataDir    *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
-	TestNet3      bool                    `long:"testnet" description:"Use the test Bitcoin network (version 3) (default mainnet)"`
-	Regtest       bool                    `long:"regtest" description:"Use the regression test network (default mainnet)"`
-	SimNet        bool                    `long:"simnet" description:"Use the simulation test network (default mainnet)"`
-	NoInitialLoad bool                    `long:"noinitialload" description:"Defer wallet creation/opening on startup and enable loading wallets over RPC"`
-	DebugLevel    string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
-	LogDir        string                  `long:"logdir" description:"Directory to log output."`
-	Profile       string                  `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	ConfigFile      *cfgutil.ExplicitString `short:"C" long:"configfile" description:"Path to configuration file"`
+	ShowVersion     bool                    `short:"V" long:"version" description:"Display version information and exit"`
+	Create          bool                    `long:"create" description:"Create the wallet if it does not exist"`
+	CreateTemp      bool                    `long:"createtemp" description:"Create a temporary simulation wallet (pass=password) in the data directory indicated; must call with --datadir"`
+	NoLegacyConvert bool                    `long:"nolegacyconvert" description:"Do not look for or convert a legacy keystore when creating the wallet with --create"`
+	AppDataDir      *cfgutil.ExplicitString `short:"A" long:"appdata" description:"Application data directory for wallet config, databases and logs"`
+	TestNet3        bool                    `long:"testnet" description:"Use the test Bitcoin network (version 3) (default mainnet)"`
+	Regtest         bool                    `long:"regtest" description:"Use the regression test network (default mainnet)"`
+	SimNet          bool                    `long:"simnet" description:"Use the simulation test network (default mainnet)"`
+	NoInitialLoad   bool                    `long:"noinitialload" description:"Defer wallet creation/opening on startup and enable loading wallets over RPC"`
+	DebugLevel      string                  `short:"d" long:"debuglevel" description:"Logging level {trace, debug, info, warn, error, critical}"`
+	LogDir          string                  `long:"logdir" description:"Directory to log output."`
+	Profile         string                  `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
 
 	// Wallet options
 	WalletPass string `long:"walletpass" default-mask:"-" description:"The public wallet password -- Only required if the wallet was created with one"`
+	GapLimit   uint32 `long:"gaplimit" description:"Maximum number of consecutive unused receiving addresses that may be generated for an account (0 for no limit)"`
+
+	GapConfirmations int32 `long:"gapconfirmations" description:"Number of confirmations a deposit needs before its address stops counting toward the gap limit (0 counts unconfirmed deposits)"`
+
+	MaxRollbackDepth int32 `long:"maxrollbackdepth" description:"Deepest chain reorganization, in blocks, to roll back incrementally when syncing; deeper reorgs resync the wallet from its birthday (default and maximum: 10000)"`
+	PruneSpentTxs    bool  `long:"prunespenttxs" description:"Drop the serialized transactions of fully-spent transactions mined deeper than the maximum rollback depth, keeping only their amounts and fees; pruned transactions are fetched from the chain server (requiring its transaction index) when requested"`
+
+	PublishAttempts   uint32        `long:"publishattempts" description:"Number of times a transaction is sent to the chain server when sending fails because of a connection error; rejected transactions are never resent"`
+	PublishRetryDelay time.Duration `long:"publishretrydelay" description:"Delay before resending a transaction that could not be sent to the chain server, doubling with each retry.  Valid time units are {ms, s, m}"`
+
+	MinChange *cfgutil.AmountFlag `long:"minchange" description:"Smallest change output, in BCH, created by the wallet; smaller change is added to the transaction fee"`
+
+	BalanceCheckInterval time.Duration `long:"balancecheckinterval" description:"Periodically verify the wallet balance against the sum of its unspent outputs, logging an error on mismatch (default: disabled).  Valid time units are {s, m, h}"`
+
+	FilterWorkers int `long:"filterworkers" description:"Number of blocks fetched and filtered concurrently when recovering the addresses of a restored wallet (default: number of CPUs)"`
+
+	InternalRecoveryWindow uint32 `long:"internalrecoverywindow" description:"Number of change addresses past the last one found that are searched for when recovering the addresses of a restored wallet (default: 250, the same as receiving addresses)"`
+
+	UnlockPassEnv  string        `long:"unlockpassenv" description:"Unlock the wallet on startup with the private passphrase read from this environment variable -- NOTE: anyone able to read it can spend the wallet's funds"`
+	UnlockPassFile string        `long:"unlockpassfile" description:"Unlock the wallet on startup with the private passphrase read from this file, which must not be accessible by other users -- NOTE: anyone able to read it can spend the wallet's funds"`
+	UnlockTimeout  time.Duration `long:"unlocktimeout" description:"Lock the wallet again this long after it is automatically unlocked (default: stay unlocked).  Valid time units are {s, m, h}"`
 
 	// RPC client options
 	RPCConnect       string                  `short:"c" long:"rpcconnect" description:"Hostname/IP and port of bchd RPC server to connect to (default localhost:8334, testnet: localhost:18334, simnet: localhost:18556)"`
 	CAFile           *cfgutil.ExplicitString `long:"cafile" description:"File containing root certificates to authenticate a TLS connections with bchd"`
 	DisableClientTLS bool                    `long:"noclienttls" description:"Disable TLS for the RPC client -- NOTE: This is only allowed if the RPC client is connecting to localhost"`
+	BroadcastRPC     []string                `long:"broadcastrpc" description:"Hostname/IP and port of an additional bchd RPC server transactions are broadcast to, using the same credentials and certificate as --rpcconnect; may be repeated"`
 	BchdUsername     string                  `long:"bchdusername" description:"Username for bchd authentication"`
 	BchdPassword     string                  `long:"bchdpassword" default-mask:"-" description:"Password for bchd authentication"`
 	Proxy            string                  `long:"proxy" description:"Connect via SOCKS5 proxy (eg. 127.0.0.1:9050)"`
@@ -106,6 +130,7 @@ type config struct {
 	// These options will change (and require changes to config files, etc.)
 	// when the new gRPC server is enabled.
 	ExperimentalRPCListeners []string `long:"experimentalrpclisten" description:"Listen for RPC connections on this interface/port"`
+	RPCAddressFormat         string   `long:"rpcaddressformat" description:"Encoding of addresses in gRPC responses {cashaddr, legacy}; legacy JSON-RPC responses always use cashaddr"`
 
 	// Deprecated options
 	DataDir *cfgutil.ExplicitString `short:"b" long:"datadir" default-mask:"-" description:"DEPRECATED -- use appdata instead"`
@@ -269,6 +294,7 @@ func loadConfig(optionalConfigPath *string) (*config, []string, error) {
 		RPCCert:                cfgutil.NewExplicitString(defaultRPCCertFile),
 		LegacyRPCMaxClients:    defaultRPCMaxClients,
 		LegacyRPCMaxWebsockets: defaultRPCMaxWebsockets,
+		RPCAddressFormat:       rpcserver.AddressFormatCashAddr.String(),
 		DataDir:                cfgutil.NewExplicitString(defaultAppDataDir),
 		UseSPV:                 false,
 		AddPeers:               []string{},
@@ -276,6 +302,9 @@ func loadConfig(optionalConfigPath *string) (*config, []string, error) {
 		MaxPeers:               neutrino.MaxPeers,
 		BanDuration:            neutrino.BanDuration,
 		BanThreshold:           neutrino.BanThreshold,
+		PublishAttempts:        wallet.DefaultPublishAttempts,
+		PublishRetryDelay:      wallet.DefaultPublishRetryDelay,
+		MinChange:              cfgutil.NewAmountFlag(wallet.DefaultMinChangeAmount),
 	}
 
 	// Pre-parse the command line options to see if an alternative config
@@ -385,6 +414,20 @@ func loadConfig(optionalConfigPath *string) (*config, []string, error) {
 		return nil, nil, err
 	}
 
+	// The private passphrase used to unlock the wallet on startup may only
+	// be read from a single source.
+	if cfg.UnlockPassEnv != "" && cfg.UnlockPassFile != "" {
+		str := "%s: The unlockpassenv and unlockpassfile options can't " +
+			"be used together -- choose one"
+		err := fmt.Errorf(str, "loadConfig")
+		fmt.Fprintln(os.Stderr, err)
+		parser.WriteHelp(os.Stderr)
+		return nil, nil, err
+	}
+	if cfg.UnlockPassFile != "" {
+		cfg.UnlockPassFile = cleanAndExpandPath(cfg.UnlockPassFile)
+	}
+
 	// Append the network type to the log directory so it is "namespaced"
 	// per network.
 	cfg.LogDir = cleanAndExpandPath(cfg.LogDir)
@@ -507,6 +550,14 @@ func loadConfig(optionalConfigPath *string) (*config, []string, error) {
 	}
 
 	if cfg.UseSPV {
+		if len(cfg.BroadcastRPC) != 0 {
+			str := "%s: the --broadcastrpc option may not be used " +
+				"with --usespv"
+			err := fmt.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, nil, err
+		}
 		neutrino.MaxPeers = cfg.MaxPeers
 		neutrino.BanDuration = cfg.BanDuration
 		neutrino.BanThreshold = cfg.BanThreshold
@@ -524,19 +575,38 @@ func loadConfig(optionalConfigPath *string) (*config, []string, error) {
 			return nil, nil, err
 		}
 
+		// Add default port to the broadcast endpoints if missing.
+		for i, addr := range cfg.BroadcastRPC {
+			cfg.BroadcastRPC[i], err = cfgutil.NormalizeAddress(addr,
+				activeNet.RPCClientPort)
+			if err != nil {
+				fmt.Fprintf(os.Stderr,
+					"Invalid broadcastrpc network address: %v\n", err)
+				return nil, nil, err
+			}
+		}
+
 		RPCHost, _, err := net.SplitHostPort(cfg.RPCConnect)
 		if err != nil {
 			return nil, nil, err
 		}
 		if cfg.DisableClientTLS {
-			if _, ok := localhostListeners[RPCHost]; !ok {
-				str := "%s: the --noclienttls option may not be used " +
-					"when connecting RPC to non localhost " +
-					"addresses: %s"
-				err := fmt.Errorf(str, funcName, cfg.RPCConnect)
-				fmt.Fprintln(os.Stderr, err)
-				fmt.Fprintln(os.Stderr, usageMessage)
-				return nil, nil, err
+			for _, addr := range append([]string{cfg.RPCConnect},
+				cfg.BroadcastRPC...) {
+
+				host, _, err := net.SplitHostPort(addr)
+				if err != nil {
+					return nil, nil, err
+				}
+				if _, ok := localhostListeners[host]; !ok {
+					str := "%s: the --noclienttls option may not be " +
+						"used when connecting RPC to non localhost " +
+						"addresses: %s"
+					err := fmt.Errorf(str, funcName, addr)
+					fmt.Fprintln(os.Stderr, err)
+					fmt.Fprintln(os.Stderr, usageMessage)
+					return nil, nil, err
+				}
 			}
 		} else {
 			// If CAFile is unset, choose either the copy or local bchd cert.
@@ -619,6 +689,14 @@ func loadConfig(optionalConfigPath *string) (*config, []string, error) {
 		}
 	}
 
+	if _, err := rpcserver.ParseAddressFormat(cfg.RPCAddressFormat); err != nil {
+		str := "%s: invalid --rpcaddressformat: %v"
+		err := fmt.Errorf(str, funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, nil, err
+	}
+
 	// Only allow server TLS to be disabled if the RPC server is bound to
 	// localhost addresses.
 	if cfg.DisableServerTLS {
@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/net/proxy"
 
+	"github.com/gcash/bchd/rpcclient"
 	"github.com/gcash/bchwallet/chain"
 	"github.com/gcash/bchwallet/rpc/legacyrpc"
 	"github.com/gcash/bchwallet/rpc/rpcserver"
@@ -57,6 +58,39 @@ func WalletMain(optionalConfigPath *string) error {
 
 	dbDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
 	loader := wallet.NewLoader(activeNet.Params, dbDir, true, 250)
+	loader.SetMaxRollbackDepth(cfg.MaxRollbackDepth)
+	loader.SetPruneSpentTransactions(cfg.PruneSpentTxs)
+	loader.SetPublishRetry(cfg.PublishAttempts, cfg.PublishRetryDelay)
+	loader.SetMinChangeAmount(cfg.MinChange.Amount)
+	loader.SetBalanceCheckInterval(cfg.BalanceCheckInterval)
+	loader.SetGapConfirmations(cfg.GapConfirmations)
+	loader.SetFilterWorkers(cfg.FilterWorkers)
+	loader.SetInternalRecoveryWindow(cfg.InternalRecoveryWindow)
+	if len(cfg.BroadcastRPC) != 0 {
+		clients, err := startBroadcastRPC(readCAFile())
+		if err != nil {
+			log.Errorf("Unable to create broadcast RPC clients: %v", err)
+			return err
+		}
+		defer func() {
+			for _, c := range clients {
+				c.Shutdown()
+			}
+		}()
+		broadcasters := make([]wallet.TxBroadcaster, len(clients))
+		for i, c := range clients {
+			broadcasters[i] = c
+		}
+		loader.SetBroadcasters(broadcasters)
+	}
+	switch {
+	case cfg.UnlockPassEnv != "":
+		loader.SetAutoUnlock(wallet.EnvPassphrase(cfg.UnlockPassEnv),
+			cfg.UnlockTimeout)
+	case cfg.UnlockPassFile != "":
+		loader.SetAutoUnlock(wallet.FilePassphrase(cfg.UnlockPassFile),
+			cfg.UnlockTimeout)
+	}
 
 	// Create and start HTTP server to serve wallet client connections.
 	// This will be updated with the wallet and chain server RPC client
@@ -96,6 +130,7 @@ func WalletMain(optionalConfigPath *string) error {
 		}
 
 		w.SetProxyDialer(proxyDialer)
+		w.Manager.SetGapLimit(cfg.GapLimit)
 	})
 
 	if !cfg.NoInitialLoad {
@@ -284,6 +319,34 @@ func startChainRPC(certs []byte) (*chain.RPCClient, error) {
 	return rpcc, err
 }
 
+// startBroadcastRPC creates RPC clients of the additional bchd servers
+// transactions are broadcast to.  The clients use HTTP POST requests rather
+// than a websocket connection, since only transactions are sent to them, and
+// authenticate with the same credentials and certificates as the chain RPC
+// client.
+func startBroadcastRPC(certs []byte) ([]*rpcclient.Client, error) {
+	clients := make([]*rpcclient.Client, 0, len(cfg.BroadcastRPC))
+	for _, addr := range cfg.BroadcastRPC {
+		log.Infof("Broadcasting transactions to %v", addr)
+		c, err := rpcclient.New(&rpcclient.ConnConfig{
+			Host:         addr,
+			User:         cfg.BchdUsername,
+			Pass:         cfg.BchdPassword,
+			Certificates: certs,
+			DisableTLS:   cfg.DisableClientTLS,
+			HTTPPostMode: true,
+		}, nil)
+		if err != nil {
+			for _, c := range clients {
+				c.Shutdown()
+			}
+			return nil, err
+		}
+		clients = append(clients, c)
+	}
+	return clients, nil
+}
+
 // buildNeutrinoDNSResolver returns a custom DNS resolver designed for use with
 // neutrino. Because neutrino is primarily going to run on mobile devices we
 // want it to be able to take advantage of orbot's DNS proxy which runs on port
@@ -147,6 +147,8 @@ func startRPCServers(walletLoader *wallet.Loader) (*grpc.Server, *legacyrpc.Serv
 		}
 		server = grpc.NewServer(opts...)
 		rpcserver.RegisterServices(server)
+		addrFormat, _ := rpcserver.ParseAddressFormat(cfg.RPCAddressFormat)
+		rpcserver.SetAddressFormat(addrFormat)
 		rpcserver.StartWalletLoaderService(server, walletLoader, activeNet)
 		for _, lis := range listeners {
 			lis := lis
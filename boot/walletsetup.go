@@ -105,23 +105,27 @@ func convertLegacyKeystore(legacyKeyStore *keystore.Store, w *wallet.Wallet) err
 func createWallet(cfg *config) error {
 	dbDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
 	loader := wallet.NewLoader(activeNet.Params, dbDir, true, 250)
+	loader.SetInternalRecoveryWindow(cfg.InternalRecoveryWindow)
 
 	// When there is a legacy keystore, open it now to ensure any errors
 	// don't end up exiting the process after the user has spent time
-	// entering a bunch of information.
+	// entering a bunch of information.  Looking for a keystore is skipped
+	// entirely when legacy conversion is disabled.
 	netDir := networkDir(cfg.AppDataDir.Value, activeNet.Params)
 	keystorePath := filepath.Join(netDir, keystore.Filename)
 	var legacyKeyStore *keystore.Store
-	_, err := os.Stat(keystorePath)
-	if err != nil && !os.IsNotExist(err) {
-		// A stat error not due to a non-existant file should be
-		// returned to the caller.
-		return err
-	} else if err == nil {
-		// Keystore file exists.
-		legacyKeyStore, err = keystore.OpenDir(netDir)
-		if err != nil {
+	if !cfg.NoLegacyConvert {
+		_, err := os.Stat(keystorePath)
+		if err != nil && !os.IsNotExist(err) {
+			// A stat error not due to a non-existant file should
+			// be returned to the caller.
 			return err
+		} else if err == nil {
+			// Keystore file exists.
+			legacyKeyStore, err = keystore.OpenDir(netDir)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
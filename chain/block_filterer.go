@@ -1,12 +1,15 @@
 package chain
 
 import (
+	"sync"
+
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
 	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/wtxmgr"
 )
 
 // BlockFilterer is used to iteratively scan blocks for a set of addresses of
@@ -101,6 +104,137 @@ func NewBlockFilterer(params *chaincfg.Params,
 	}
 }
 
+// blockFetcher fetches a block of a FilterBlocksRequest to be filtered.  A nil
+// block is returned if the block can be skipped without being filtered, such
+// as when its compact filter does not match the addresses of interest.
+type blockFetcher func(blk *wtxmgr.BlockMeta) (*wire.MsgBlock, error)
+
+// filterBlocks scans the blocks contained in the FilterBlocksRequest for any
+// addresses of interest, returning a FilterBlocksResponse for the first block
+// containing a matching address, or nil if no blocks match.  Up to req.Workers
+// blocks are fetched and filtered concurrently.
+//
+// Since every block is filtered against the same addresses and outpoints, the
+// response is the same as when filtering the blocks sequentially: no block
+// after the first match is ever reported, allowing the caller to record the
+// matches of each block in order and widen its addresses before filtering the
+// blocks that follow.
+func filterBlocks(req *FilterBlocksRequest, params *chaincfg.Params,
+	fetchBlock blockFetcher) (*FilterBlocksResponse, error) {
+
+	workers := req.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(req.Blocks) {
+		workers = len(req.Blocks)
+	}
+
+	type result struct {
+		matched *BlockFilterer
+		err     error
+	}
+
+	var (
+		mu      sync.Mutex
+		results = make([]result, len(req.Blocks))
+		next    int
+
+		// Blocks at or past stop are not filtered.  It is lowered to
+		// just past the first block found to match or fail, and to the
+		// next block to filter when interrupted.
+		stop        = len(req.Blocks)
+		interrupted bool
+	)
+	worker := func() {
+		// A block filterer may be reused until it reports a match.
+		blockFilterer := NewBlockFilterer(params, req)
+		for {
+			mu.Lock()
+			if next >= stop {
+				mu.Unlock()
+				return
+			}
+			select {
+			case <-req.Interrupt:
+				interrupted = true
+				stop = next
+				mu.Unlock()
+				return
+			default:
+			}
+			i := next
+			next++
+			mu.Unlock()
+
+			var res result
+			block, err := fetchBlock(&req.Blocks[i])
+			switch {
+			case err != nil:
+				res.err = err
+			case block != nil && blockFilterer.FilterBlock(block):
+				res.matched = blockFilterer
+				blockFilterer = NewBlockFilterer(params, req)
+			}
+
+			mu.Lock()
+			results[i] = res
+			if (res.err != nil || res.matched != nil) && i+1 < stop {
+				stop = i + 1
+			}
+			mu.Unlock()
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			worker()
+		}()
+	}
+	wg.Wait()
+
+	// Every block before stop has been filtered, so the first match or
+	// error among them is the one a sequential scan would have stopped at.
+	for i := 0; i < stop; i++ {
+		res := &results[i]
+		if res.err != nil {
+			return nil, res.err
+		}
+		if res.matched == nil {
+			continue
+		}
+
+		// If any external or internal addresses were detected in this
+		// block, we return them to the caller so that the rescan
+		// windows can widened with subsequent addresses. The
+		// `BatchIndex` is returned so that the caller can compute the
+		// *next* block from which to begin again.
+		blockFilterer := res.matched
+		resp := &FilterBlocksResponse{
+			BatchIndex:         uint32(i),
+			BlockMeta:          req.Blocks[i],
+			FoundExternalAddrs: blockFilterer.FoundExternal,
+			FoundInternalAddrs: blockFilterer.FoundInternal,
+			FoundOutPoints:     blockFilterer.FoundOutPoints,
+			RelevantTxns:       blockFilterer.RelevantTxns,
+		}
+
+		return resp, nil
+	}
+
+	if interrupted {
+		return &FilterBlocksResponse{
+			BatchIndex: uint32(stop - 1),
+		}, ErrFilterReqInterrupt
+	}
+
+	// No addresses were found for this range.
+	return nil, nil
+}
+
 // checkFilterTx will check if the transaction is relevant and recursively loop through the
 // inputs to double check transactions that are in the block but were already processed.
 // This is necessary if the block is not sorted in topological order.
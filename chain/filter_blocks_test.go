@@ -0,0 +1,241 @@
+package chain
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// testFilterAddr returns a deterministic P2PKH address for the given seed.
+func testFilterAddr(t testing.TB, seed uint32) bchutil.Address {
+	var hash [20]byte
+	binary.BigEndian.PutUint32(hash[:], seed)
+	hash[19] = 0xff
+	addr, err := bchutil.NewAddressPubKeyHash(hash[:],
+		&chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	return addr
+}
+
+// testFilterBlocks creates blocks of transactions paying to addresses that
+// are not of interest, along with a FilterBlocksRequest for them watching
+// numAddrs external addresses.
+func testFilterBlocks(t testing.TB, numBlocks, txsPerBlock,
+	numAddrs int) (map[chainhash.Hash]*wire.MsgBlock, *FilterBlocksRequest) {
+
+	req := &FilterBlocksRequest{
+		ExternalAddrs:    make(map[waddrmgr.ScopedIndex]bchutil.Address),
+		InternalAddrs:    make(map[waddrmgr.ScopedIndex]bchutil.Address),
+		WatchedOutPoints: make(map[wire.OutPoint]bchutil.Address),
+		Interrupt:        make(chan struct{}),
+	}
+	for i := 0; i < numAddrs; i++ {
+		scopedIndex := waddrmgr.ScopedIndex{
+			Scope: waddrmgr.KeyScopeBIP0044,
+			Index: uint32(i),
+		}
+		req.ExternalAddrs[scopedIndex] = testFilterAddr(t, uint32(i))
+	}
+
+	blocks := make(map[chainhash.Hash]*wire.MsgBlock, numBlocks)
+	seed := uint32(1 << 31)
+	for i := 0; i < numBlocks; i++ {
+		block := &wire.MsgBlock{
+			Header: wire.BlockHeader{Nonce: uint32(i)},
+		}
+		for j := 0; j < txsPerBlock; j++ {
+			pkScript, err := txscript.PayToAddrScript(
+				testFilterAddr(t, seed),
+			)
+			if err != nil {
+				t.Fatalf("unable to create pkScript: %v", err)
+			}
+			seed++
+			tx := wire.NewMsgTx(1)
+			tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: seed}, nil))
+			tx.AddTxOut(wire.NewTxOut(1000, pkScript, wire.TokenData{}))
+			block.Transactions = append(block.Transactions, tx)
+		}
+		hash := block.BlockHash()
+		blocks[hash] = block
+		req.Blocks = append(req.Blocks, wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{Hash: hash, Height: int32(i)},
+		})
+	}
+	return blocks, req
+}
+
+// TestFilterBlocksFirstMatch ensures filtering blocks concurrently reports the
+// same first matching block as filtering them sequentially, regardless of the
+// order in which the blocks finish being filtered.
+func TestFilterBlocksFirstMatch(t *testing.T) {
+	blocks, req := testFilterBlocks(t, 200, 3, 10)
+
+	// Pay to watched addresses in two blocks, and spend the output of
+	// the first payment in a block between them.
+	payTo := func(height int, index uint32) *wire.MsgTx {
+		pkScript, err := txscript.PayToAddrScript(
+			testFilterAddr(t, index),
+		)
+		if err != nil {
+			t.Fatalf("unable to create pkScript: %v", err)
+		}
+		block := blocks[req.Blocks[height].Hash]
+		tx := block.Transactions[0]
+		tx.AddTxOut(wire.NewTxOut(5000, pkScript, wire.TokenData{}))
+		return tx
+	}
+	firstTx := payTo(36, 4)
+	payTo(120, 9)
+	spendBlock := blocks[req.Blocks[80].Hash]
+	spendBlock.Transactions[1].AddTxIn(wire.NewTxIn(&wire.OutPoint{
+		Hash:  firstTx.TxHash(),
+		Index: 1,
+	}, nil))
+
+	var fetchErr error
+	errHeight := int32(-1)
+	fetchBlock := func(blk *wtxmgr.BlockMeta) (*wire.MsgBlock, error) {
+		time.Sleep(time.Duration(rand.Intn(200)) * time.Microsecond)
+		if blk.Height == errHeight {
+			return nil, fetchErr
+		}
+		// Blocks with odd heights are skipped, as if their compact
+		// filters did not match.
+		if blk.Height%2 == 1 {
+			return nil, nil
+		}
+		return blocks[blk.Hash], nil
+	}
+
+	for _, workers := range []int{0, 1, 4, 16, 500} {
+		req := *req
+		req.Workers = workers
+
+		resp, err := filterBlocks(&req, &chaincfg.MainNetParams, fetchBlock)
+		if err != nil {
+			t.Fatalf("workers=%d: unable to filter blocks: %v",
+				workers, err)
+		}
+		if resp == nil || resp.BatchIndex != 36 {
+			t.Fatalf("workers=%d: expected match at 36, got %+v",
+				workers, resp)
+		}
+		found := resp.FoundExternalAddrs[waddrmgr.KeyScopeBIP0044]
+		if _, ok := found[4]; !ok || len(found) != 1 {
+			t.Fatalf("workers=%d: expected index 4 found, got %v",
+				workers, found)
+		}
+		if len(resp.RelevantTxns) != 1 ||
+			resp.RelevantTxns[0].TxHash() != firstTx.TxHash() {
+
+			t.Fatalf("workers=%d: unexpected relevant txns",
+				workers)
+		}
+
+		// Once the found outpoint is watched, the block spending it
+		// is the next match, even though a later block matches an
+		// address.
+		req.Blocks = req.Blocks[resp.BatchIndex+1:]
+		req.WatchedOutPoints = resp.FoundOutPoints
+		resp, err = filterBlocks(&req, &chaincfg.MainNetParams, fetchBlock)
+		if err != nil {
+			t.Fatalf("workers=%d: unable to filter blocks: %v",
+				workers, err)
+		}
+		if resp == nil || resp.BlockMeta.Height != 80 {
+			t.Fatalf("workers=%d: expected match at 80, got %+v",
+				workers, resp)
+		}
+
+		// Blocks failing to be fetched after the first match are not
+		// reported, while those before it are.
+		req.Blocks = req.Blocks[resp.BatchIndex+1:]
+		fetchErr = errors.New("fetch failed")
+		errHeight = 150
+		resp, err = filterBlocks(&req, &chaincfg.MainNetParams, fetchBlock)
+		if err != nil || resp == nil || resp.BlockMeta.Height != 120 {
+			t.Fatalf("workers=%d: expected match at 120, got %+v "+
+				"(err %v)", workers, resp, err)
+		}
+		errHeight = 100
+		_, err = filterBlocks(&req, &chaincfg.MainNetParams, fetchBlock)
+		if err != fetchErr {
+			t.Fatalf("workers=%d: got error %v, want %v", workers,
+				err, fetchErr)
+		}
+		errHeight = -1
+
+		// No response is returned when no blocks match.
+		req.Blocks = req.Blocks[resp.BatchIndex+1:]
+		resp, err = filterBlocks(&req, &chaincfg.MainNetParams, fetchBlock)
+		if err != nil || resp != nil {
+			t.Fatalf("workers=%d: expected no match, got %+v "+
+				"(err %v)", workers, resp, err)
+		}
+	}
+}
+
+// TestFilterBlocksInterrupt ensures an interrupted request reports the last
+// block filtered before the interrupt.
+func TestFilterBlocksInterrupt(t *testing.T) {
+	blocks, req := testFilterBlocks(t, 100, 1, 1)
+	fetchBlock := func(blk *wtxmgr.BlockMeta) (*wire.MsgBlock, error) {
+		if blk.Height == 50 {
+			close(req.Interrupt)
+		}
+		return blocks[blk.Hash], nil
+	}
+
+	resp, err := filterBlocks(req, &chaincfg.MainNetParams, fetchBlock)
+	if err != ErrFilterReqInterrupt {
+		t.Fatalf("got error %v, want %v", err, ErrFilterReqInterrupt)
+	}
+	if resp.BatchIndex != 50 {
+		t.Fatalf("expected batch index 50, got %d", resp.BatchIndex)
+	}
+}
+
+// BenchmarkFilterBlocks measures filtering blocks that do not match any of the
+// addresses of interest with an increasing number of workers.
+func BenchmarkFilterBlocks(b *testing.B) {
+	blocks, req := testFilterBlocks(b, 64, 500, 2000)
+	fetchBlock := func(blk *wtxmgr.BlockMeta) (*wire.MsgBlock, error) {
+		return blocks[blk.Hash], nil
+	}
+
+	workerCounts := []int{1, 2, 4}
+	if runtime.NumCPU() > 4 {
+		workerCounts = append(workerCounts, runtime.NumCPU())
+	}
+	for _, workers := range workerCounts {
+		req := *req
+		req.Workers = workers
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				resp, err := filterBlocks(
+					&req, &chaincfg.MainNetParams,
+					fetchBlock,
+				)
+				if err != nil || resp != nil {
+					b.Fatalf("unexpected match %+v (err %v)",
+						resp, err)
+				}
+			}
+		})
+	}
+}
@@ -76,13 +76,16 @@ type (
 	// FilterBlocksRequest specifies a range of blocks and the set of
 	// internal and external addresses of interest, indexed by corresponding
 	// scoped-index of the child address. A global set of watched outpoints
-	// is also included to monitor for spends.
+	// is also included to monitor for spends. Up to Workers blocks are
+	// fetched and filtered concurrently, or one at a time if Workers is
+	// less than two.
 	FilterBlocksRequest struct {
 		Blocks           []wtxmgr.BlockMeta
 		ExternalAddrs    map[waddrmgr.ScopedIndex]bchutil.Address
 		InternalAddrs    map[waddrmgr.ScopedIndex]bchutil.Address
 		WatchedOutPoints map[wire.OutPoint]bchutil.Address
 		Interrupt        chan struct{}
+		Workers          int
 	}
 
 	// FilterBlocksResponse reports the set of all internal and external
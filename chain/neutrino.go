@@ -182,14 +182,13 @@ func (s *NeutrinoClient) SendRawTransaction(tx *wire.MsgTx, allowHighFees bool)
 // addresses of interest. For each requested block, the corresponding compact
 // filter will first be checked for matches, skipping those that do not report
 // anything. If the filter returns a postive match, the full block will be
-// fetched and filtered. This method returns a FilterBlocksReponse for the first
-// block containing a matching address. If no matches are found in the range of
-// blocks requested, the returned response will be nil.
+// fetched and filtered. Up to req.Workers blocks are checked concurrently. This
+// method returns a FilterBlocksReponse for the first block containing a
+// matching address. If no matches are found in the range of blocks requested,
+// the returned response will be nil.
 func (s *NeutrinoClient) FilterBlocks(
 	req *FilterBlocksRequest) (*FilterBlocksResponse, error) {
 
-	blockFilterer := NewBlockFilterer(s.chainParams, req)
-
 	// Construct the watchlist using the addresses and outpoints contained
 	// in the filter blocks request.
 	watchList, err := buildFilterBlocksWatchList(req)
@@ -197,11 +196,11 @@ func (s *NeutrinoClient) FilterBlocks(
 		return nil, err
 	}
 
-	// Iterate over the requested blocks, fetching the compact filter for
-	// each one, and matching it against the watchlist generated above. If
-	// the filter returns a positive match, the full block is then requested
-	// and scanned for addresses using the block filterer.
-	for i, blk := range req.Blocks {
+	// Fetch the compact filter for each requested block, and match it
+	// against the watchlist generated above. If the filter returns a
+	// positive match, the full block is then requested and scanned for
+	// addresses using a block filterer.
+	fetchBlock := func(blk *wtxmgr.BlockMeta) (*wire.MsgBlock, error) {
 		// TODO(wilmer): Investigate why polling it still necessary
 		// here. While testing, I ran into a few instances where the
 		// filter was not retrieved, leading to a panic. This should not
@@ -214,7 +213,7 @@ func (s *NeutrinoClient) FilterBlocks(
 
 		// Skip any empty filters.
 		if filter == nil || filter.N() == 0 {
-			continue
+			return nil, nil
 		}
 
 		key := builder.DeriveKey(&blk.Hash)
@@ -222,7 +221,7 @@ func (s *NeutrinoClient) FilterBlocks(
 		if err != nil {
 			return nil, err
 		} else if !matched {
-			continue
+			return nil, nil
 		}
 
 		log.Infof("Fetching block height=%d hash=%v",
@@ -230,34 +229,10 @@ func (s *NeutrinoClient) FilterBlocks(
 
 		// TODO(conner): can optimize bandwidth by only fetching
 		// stripped blocks
-		rawBlock, err := s.GetBlock(&blk.Hash)
-		if err != nil {
-			return nil, err
-		}
-
-		if !blockFilterer.FilterBlock(rawBlock) {
-			continue
-		}
-
-		// If any external or internal addresses were detected in this
-		// block, we return them to the caller so that the rescan
-		// windows can widened with subsequent addresses. The
-		// `BatchIndex` is returned so that the caller can compute the
-		// *next* block from which to begin again.
-		resp := &FilterBlocksResponse{
-			BatchIndex:         uint32(i),
-			BlockMeta:          blk,
-			FoundExternalAddrs: blockFilterer.FoundExternal,
-			FoundInternalAddrs: blockFilterer.FoundInternal,
-			FoundOutPoints:     blockFilterer.FoundOutPoints,
-			RelevantTxns:       blockFilterer.RelevantTxns,
-		}
-
-		return resp, nil
+		return s.GetBlock(&blk.Hash)
 	}
 
-	// No addresses were found for this range.
-	return nil, nil
+	return filterBlocks(req, s.chainParams, fetchBlock)
 }
 
 // buildFilterBlocksWatchList constructs a watchlist used for matching against a
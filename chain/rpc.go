@@ -195,18 +195,28 @@ func (c *RPCClient) BlockStamp() (*waddrmgr.BlockStamp, error) {
 	}
 }
 
+// GetBlockHeight returns the height for the hash, if known, or returns an
+// error.
+func (c *RPCClient) GetBlockHeight(hash *chainhash.Hash) (int32, error) {
+	header, err := c.GetBlockHeaderVerbose(hash)
+	if err != nil {
+		return 0, err
+	}
+
+	return header.Height, nil
+}
+
 // FilterBlocks scans the blocks contained in the FilterBlocksRequest for any
 // addresses of interest. For each requested block, the corresponding compact
 // filter will first be checked for matches, skipping those that do not report
 // anything. If the filter returns a postive match, the full block will be
-// fetched and filtered. This method returns a FilterBlocksReponse for the first
-// block containing a matching address. If no matches are found in the range of
-// blocks requested, the returned response will be nil.
+// fetched and filtered. Up to req.Workers blocks are checked concurrently. This
+// method returns a FilterBlocksReponse for the first block containing a
+// matching address. If no matches are found in the range of blocks requested,
+// the returned response will be nil.
 func (c *RPCClient) FilterBlocks(
 	req *FilterBlocksRequest) (*FilterBlocksResponse, error) {
 
-	blockFilterer := NewBlockFilterer(c.chainParams, req)
-
 	// Construct the watchlist using the addresses and outpoints contained
 	// in the filter blocks request.
 	watchList, err := buildFilterBlocksWatchList(req)
@@ -214,18 +224,11 @@ func (c *RPCClient) FilterBlocks(
 		return nil, err
 	}
 
-	// Iterate over the requested blocks, fetching the compact filter for
-	// each one, and matching it against the watchlist generated above. If
-	// the filter returns a positive match, the full block is then requested
-	// and scanned for addresses using the block filterer.
-	for i, blk := range req.Blocks {
-		select {
-		case <-req.Interrupt:
-			return &FilterBlocksResponse{
-				BatchIndex: uint32(i - 1),
-			}, ErrFilterReqInterrupt
-		default:
-		}
+	// Fetch the compact filter for each requested block, and match it
+	// against the watchlist generated above. If the filter returns a
+	// positive match, the full block is then requested and scanned for
+	// addresses using a block filterer.
+	fetchBlock := func(blk *wtxmgr.BlockMeta) (*wire.MsgBlock, error) {
 		rawFilter, err := c.GetCFilter(&blk.Hash, wire.GCSFilterRegular)
 		if err != nil {
 			return nil, err
@@ -233,7 +236,7 @@ func (c *RPCClient) FilterBlocks(
 
 		// Ensure the filter is large enough to be deserialized.
 		if len(rawFilter.Data) < 4 {
-			continue
+			return nil, nil
 		}
 
 		filter, err := gcs.FromNBytes(
@@ -245,7 +248,7 @@ func (c *RPCClient) FilterBlocks(
 
 		// Skip any empty filters.
 		if filter.N() == 0 {
-			continue
+			return nil, nil
 		}
 
 		key := builder.DeriveKey(&blk.Hash)
@@ -253,40 +256,16 @@ func (c *RPCClient) FilterBlocks(
 		if err != nil {
 			return nil, err
 		} else if !matched {
-			continue
+			return nil, nil
 		}
 
 		log.Infof("Fetching block height=%d hash=%v",
 			blk.Height, blk.Hash)
 
-		rawBlock, err := c.GetBlock(&blk.Hash)
-		if err != nil {
-			return nil, err
-		}
-
-		if !blockFilterer.FilterBlock(rawBlock) {
-			continue
-		}
-
-		// If any external or internal addresses were detected in this
-		// block, we return them to the caller so that the rescan
-		// windows can widened with subsequent addresses. The
-		// `BatchIndex` is returned so that the caller can compute the
-		// *next* block from which to begin again.
-		resp := &FilterBlocksResponse{
-			BatchIndex:         uint32(i),
-			BlockMeta:          blk,
-			FoundExternalAddrs: blockFilterer.FoundExternal,
-			FoundInternalAddrs: blockFilterer.FoundInternal,
-			FoundOutPoints:     blockFilterer.FoundOutPoints,
-			RelevantTxns:       blockFilterer.RelevantTxns,
-		}
-
-		return resp, nil
+		return c.GetBlock(&blk.Hash)
 	}
 
-	// No addresses were found for this range.
-	return nil, nil
+	return filterBlocks(req, c.chainParams, fetchBlock)
 }
 
 // parseBlock parses a btcws definition of the block a tx is mined it to the
@@ -24,8 +24,9 @@ var datadir = bchutil.AppDataDir("bchwallet", false)
 
 // Flags.
 var opts = struct {
-	Force  bool   `short:"f" description:"Force removal without prompt"`
-	DbPath string `long:"db" description:"Path to wallet database"`
+	Force           bool   `short:"f" description:"Force removal without prompt"`
+	DbPath          string `long:"db" description:"Path to wallet database"`
+	UnconfirmedOnly bool   `long:"unconfirmed-only" description:"Only remove unconfirmed transactions, keeping confirmed history and the synced block"`
 }{
 	Force:  false,
 	DbPath: filepath.Join(datadir, defaultNet, "wallet.db"),
@@ -74,8 +75,12 @@ func mainInt() int {
 		return 1
 	}
 
+	prompt := "Drop all bchwallet transaction history? [y/N] "
+	if opts.UnconfirmedOnly {
+		prompt = "Drop all unconfirmed bchwallet transactions? [y/N] "
+	}
 	for !opts.Force {
-		fmt.Print("Drop all bchwallet transaction history? [y/N] ")
+		fmt.Print(prompt)
 
 		scanner := bufio.NewScanner(bufio.NewReader(os.Stdin))
 		if !scanner.Scan() {
@@ -106,6 +111,10 @@ func mainInt() int {
 	}
 	defer db.Close()
 
+	if opts.UnconfirmedOnly {
+		return dropUnconfirmed(db)
+	}
+
 	fmt.Println("Dropping bchwallet transaction history")
 
 	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
@@ -154,3 +163,62 @@ func mainInt() int {
 
 	return 0
 }
+
+// dropUnconfirmed removes every unconfirmed transaction, and any transactions
+// spending their outputs, from the transaction store.  Confirmed transactions
+// and the block the wallet is synced to are left untouched, so no rescan is
+// needed.
+func dropUnconfirmed(db walletdb.DB) int {
+	fmt.Println("Dropping unconfirmed bchwallet transactions")
+
+	var removed int
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespace)
+		if ns == nil {
+			return walletdb.ErrBucketNotFound
+		}
+
+		// The chain parameters are only used to determine coinbase
+		// maturity, which removing unmined transactions does not
+		// depend on.
+		store, err := wtxmgr.Open(ns, nil)
+		if err != nil {
+			return err
+		}
+		txs, err := store.UnminedTxs(ns)
+		if err != nil {
+			return err
+		}
+		for _, msgTx := range txs {
+			// Transactions spending the outputs of a removed
+			// transaction are removed along with it, so they may
+			// no longer exist by the time they are reached.
+			hash := msgTx.TxHash()
+			details, err := store.UniqueTxDetails(ns, &hash, nil)
+			if err != nil {
+				return err
+			}
+			if details == nil {
+				continue
+			}
+			err = store.RemoveUnminedTx(ns, &details.TxRecord)
+			if err != nil {
+				return err
+			}
+		}
+
+		remaining, err := store.UnminedTxHashes(ns)
+		if err != nil {
+			return err
+		}
+		removed = len(txs) - len(remaining)
+		return nil
+	})
+	if err != nil {
+		fmt.Println("Failed to drop unconfirmed transactions:", err)
+		return 1
+	}
+
+	fmt.Printf("Removed %d unconfirmed transactions\n", removed)
+	return 0
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/walletdb"
+	_ "github.com/gcash/bchwallet/walletdb/bdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+	"github.com/jessevdk/go-flags"
+)
+
+const defaultNet = "mainnet"
+
+var datadir = bchutil.AppDataDir("bchwallet", false)
+
+// Flags.
+var opts = struct {
+	Force            bool   `short:"f" description:"Force pruning without prompt"`
+	DbPath           string `long:"db" description:"Path to wallet database"`
+	PruneBelowHeight int32  `long:"prune-below-height" description:"Prune fully-spent transactions mined below this height (required)"`
+}{
+	Force:  false,
+	DbPath: filepath.Join(datadir, defaultNet, "wallet.db"),
+}
+
+func init() {
+	_, err := flags.Parse(&opts)
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+var (
+	// Namespace keys.
+	wtxmgrNamespace = []byte("wtxmgr")
+)
+
+func yes(s string) bool {
+	switch s {
+	case "y", "Y", "yes", "Yes":
+		return true
+	default:
+		return false
+	}
+}
+
+func no(s string) bool {
+	switch s {
+	case "n", "N", "no", "No":
+		return true
+	default:
+		return false
+	}
+}
+
+func main() {
+	os.Exit(mainInt())
+}
+
+func mainInt() int {
+	if opts.PruneBelowHeight <= 0 {
+		fmt.Println("A positive --prune-below-height is required")
+		return 1
+	}
+
+	fmt.Println("Database path:", opts.DbPath)
+	_, err := os.Stat(opts.DbPath)
+	if os.IsNotExist(err) {
+		fmt.Println("Database file does not exist")
+		return 1
+	}
+
+	// Pruned transactions can not be rolled back, so a reorg deeper than
+	// the prune height requires dropping the transaction history with
+	// dropwtxmgr and rescanning.
+	prompt := fmt.Sprintf("Prune fully-spent bchwallet transactions mined "+
+		"below height %d?  Reorgs below this height will require a "+
+		"rescan. [y/N] ", opts.PruneBelowHeight)
+	for !opts.Force {
+		fmt.Print(prompt)
+
+		scanner := bufio.NewScanner(bufio.NewReader(os.Stdin))
+		if !scanner.Scan() {
+			// Exit on EOF.
+			return 0
+		}
+		err := scanner.Err()
+		if err != nil {
+			fmt.Println()
+			fmt.Println(err)
+			return 1
+		}
+		resp := scanner.Text()
+		if yes(resp) {
+			break
+		}
+		if no(resp) || resp == "" {
+			return 0
+		}
+
+		fmt.Println("Enter yes or no.")
+	}
+
+	db, err := walletdb.Open("bdb", opts.DbPath, true)
+	if err != nil {
+		fmt.Println("Failed to open database:", err)
+		return 1
+	}
+	defer db.Close()
+
+	fmt.Println("Pruning spent bchwallet transactions")
+
+	var pruned int
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespace)
+		if ns == nil {
+			return walletdb.ErrBucketNotFound
+		}
+
+		// The chain parameters are only used to determine coinbase
+		// maturity, which pruning spent transactions does not depend
+		// on.
+		store, err := wtxmgr.Open(ns, nil)
+		if err != nil {
+			return err
+		}
+		pruned, err = store.PruneSpentTransactions(
+			ns, opts.PruneBelowHeight-1,
+		)
+		return err
+	})
+	if err != nil {
+		fmt.Println("Failed to prune spent transactions:", err)
+		return 1
+	}
+
+	fmt.Printf("Pruned %d spent transactions\n", pruned)
+	return 0
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/hdkeychain"
+	"github.com/gcash/bchwallet/netparams"
+	"github.com/jessevdk/go-flags"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Flags.
+type options struct {
+	TestNet3 bool   `long:"testnet" description:"Use the test bitcoin network (version 3)"`
+	SimNet   bool   `long:"simnet" description:"Use the simulation bitcoin network"`
+	RegTest  bool   `long:"regtest" description:"Use the regression test network"`
+	Address  string `short:"a" long:"address" description:"Expected address at the derivation path" required:"true"`
+	Path     string `short:"p" long:"path" description:"Derivation path of the expected address (default: m/44'/<coin type>'/0'/0/0)"`
+}
+
+func main() {
+	os.Exit(mainInt())
+}
+
+func mainInt() int {
+	var opts options
+	if _, err := flags.Parse(&opts); err != nil {
+		return 1
+	}
+
+	numNets := 0
+	activeNet := &netparams.MainNetParams
+	if opts.TestNet3 {
+		numNets++
+		activeNet = &netparams.TestNet3Params
+	}
+	if opts.SimNet {
+		numNets++
+		activeNet = &netparams.SimNetParams
+	}
+	if opts.RegTest {
+		numNets++
+		activeNet = &netparams.RegtestParams
+	}
+	if numNets > 1 {
+		fmt.Fprintln(os.Stderr, "Multiple bitcoin networks may not be "+
+			"used simultaneously")
+		return 1
+	}
+	if opts.Path == "" {
+		opts.Path = defaultPath(activeNet.Params)
+	}
+
+	// The mnemonic is read from stdin rather than a flag so it is never
+	// recorded in the shell history or visible in the process list.
+	stdin := bufio.NewReader(os.Stdin)
+	fmt.Print("Enter mnemonic seed: ")
+	mnemonic, err := readMnemonic(stdin)
+	fmt.Println()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	// The passphrase is read without echo for the same reason.
+	fmt.Print("Enter BIP0039 passphrase (leave empty for none): ")
+	passphrase, err := readPassphrase(stdin, int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	derived, err := verify(mnemonic, passphrase, opts.Path,
+		opts.Address, activeNet.Params)
+	switch {
+	case err == errMismatch:
+		fmt.Printf("FAIL: %s derives %s, not %s\n", opts.Path,
+			derived.EncodeAddress(), opts.Address)
+		return 2
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "Unable to verify seed: %v\n", err)
+		return 1
+	}
+	fmt.Printf("PASS: %s derives %s\n", opts.Path, derived.EncodeAddress())
+	return 0
+}
+
+// errMismatch is returned by verify when the mnemonic is valid but does not
+// derive the expected address.
+var errMismatch = errors.New("derived address does not match")
+
+// defaultPath returns the path of the first external address of the default
+// account, matching the wallet's BIP0044 derivation for the network.
+func defaultPath(params *chaincfg.Params) string {
+	return fmt.Sprintf("m/44'/%d'/0'/0/0", params.HDCoinType)
+}
+
+// readMnemonic reads a single line containing the mnemonic from r.  Words are
+// normalized to single spaces and lower case.
+func readMnemonic(r *bufio.Reader) (string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(strings.Fields(strings.ToLower(line)), " "), nil
+}
+
+// readPassphrase reads the passphrase from the terminal with file descriptor
+// fd without echo.  When fd is not a terminal, such as when input is piped,
+// the passphrase is instead read as the next line of r, which may have
+// already buffered it.  A missing passphrase line is an empty passphrase.
+func readPassphrase(r *bufio.Reader, fd int) (string, error) {
+	if terminal.IsTerminal(fd) {
+		passphrase, err := terminal.ReadPassword(fd)
+		return string(passphrase), err
+	}
+	line, err := readLine(r)
+	if err == io.EOF {
+		return "", nil
+	}
+	return strings.TrimRight(line, "\r\n"), err
+}
+
+// readLine reads a single line from r, which need not be terminated by a
+// newline at the end of the input.
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return line, nil
+}
+
+// parsePath parses a BIP0032 derivation path of the form m/44'/145'/0'/0/0
+// into child indexes.  Hardened indexes may be marked with either ' or h.
+func parsePath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("derivation path %q must begin with m",
+			path)
+	}
+
+	indexes := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") ||
+			strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(index) >= hdkeychain.HardenedKeyStart {
+			return nil, fmt.Errorf("invalid index %q in derivation "+
+				"path %q", part, path)
+		}
+		if hardened {
+			index += hdkeychain.HardenedKeyStart
+		}
+		indexes = append(indexes, uint32(index))
+	}
+	return indexes, nil
+}
+
+// verify derives the pay-to-pubkey-hash address at path from the seed encoded
+// by mnemonic and passphrase and compares it against the expected address,
+// which may be given in either cashaddr or legacy encoding.  The derived
+// address is returned along with errMismatch if it differs from the expected
+// one.
+func verify(mnemonic, passphrase, path, expected string,
+	params *chaincfg.Params) (bchutil.Address, error) {
+
+	expectedAddr, err := bchutil.DecodeAddress(expected, params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %v", expected, err)
+	}
+	if !expectedAddr.IsForNet(params) {
+		return nil, fmt.Errorf("address %q is not for %s", expected,
+			params.Name)
+	}
+	indexes, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %v", err)
+	}
+	key, err := hdkeychain.NewMaster(seed, params)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { key.Zero() }()
+	for _, index := range indexes {
+		child, err := key.Child(index)
+		if err != nil {
+			return nil, err
+		}
+		key.Zero()
+		key = child
+	}
+
+	derived, err := key.Address(params)
+	if err != nil {
+		return nil, err
+	}
+	var isP2PKH bool
+	switch expectedAddr.(type) {
+	case *bchutil.AddressPubKeyHash, *bchutil.LegacyAddressPubKeyHash:
+		isP2PKH = true
+	}
+	if !isP2PKH || !bytes.Equal(derived.ScriptAddress(),
+		expectedAddr.ScriptAddress()) {
+
+		return derived, errMismatch
+	}
+	return derived, nil
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bufio"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchutil/hdkeychain"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon " +
+	"abandon abandon abandon abandon abandon about"
+
+func TestParsePath(t *testing.T) {
+	t.Parallel()
+
+	const h = hdkeychain.HardenedKeyStart
+	tests := []struct {
+		path    string
+		indexes []uint32
+		valid   bool
+	}{
+		{"m", []uint32{}, true},
+		{"m/44'/145'/0'/0/5", []uint32{h + 44, h + 145, h, 0, 5}, true},
+		{"m/44h/1h/2h/1/0", []uint32{h + 44, h + 1, h + 2, 1, 0}, true},
+		{"44'/145'/0'", nil, false},
+		{"m/44'/x", nil, false},
+		{"m/2147483648", nil, false},
+		{"m/-1", nil, false},
+		{"m//0", nil, false},
+	}
+	for _, test := range tests {
+		indexes, err := parsePath(test.path)
+		if (err == nil) != test.valid {
+			t.Errorf("%s: got error %v, want valid=%v", test.path,
+				err, test.valid)
+			continue
+		}
+		if test.valid && !reflect.DeepEqual(indexes, test.indexes) {
+			t.Errorf("%s: got indexes %v, want %v", test.path,
+				indexes, test.indexes)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	mainPath := defaultPath(&chaincfg.MainNetParams)
+	tests := []struct {
+		name       string
+		mnemonic   string
+		passphrase string
+		path       string
+		address    string
+		err        error
+	}{
+		{
+			name:     "cashaddr match",
+			mnemonic: testMnemonic,
+			path:     mainPath,
+			address:  "bitcoincash:qqyx49mu0kkn9ftfj6hje6g2wfer34yfnq5tahq3q6",
+		},
+		{
+			name:     "legacy match",
+			mnemonic: testMnemonic,
+			path:     mainPath,
+			address:  "1mW6fDEMjKrDHvLvoEsaeLxSCzZBf3Bfg",
+		},
+		{
+			name:     "wrong index",
+			mnemonic: testMnemonic,
+			path:     "m/44'/145'/0'/0/1",
+			address:  "bitcoincash:qqyx49mu0kkn9ftfj6hje6g2wfer34yfnq5tahq3q6",
+			err:      errMismatch,
+		},
+		{
+			name:       "wrong passphrase",
+			mnemonic:   testMnemonic,
+			passphrase: "TREZOR",
+			path:       mainPath,
+			address:    "bitcoincash:qqyx49mu0kkn9ftfj6hje6g2wfer34yfnq5tahq3q6",
+			err:        errMismatch,
+		},
+	}
+	for _, test := range tests {
+		_, err := verify(test.mnemonic, test.passphrase, test.path,
+			test.address, &chaincfg.MainNetParams)
+		if err != test.err {
+			t.Errorf("%s: got error %v, want %v", test.name, err,
+				test.err)
+		}
+	}
+
+	// Invalid input is reported as an error rather than a mismatch.
+	invalid := []struct {
+		name     string
+		mnemonic string
+		address  string
+		params   *chaincfg.Params
+	}{
+		{"bad checksum", strings.Replace(testMnemonic, "about",
+			"abandon", 1), "1mW6fDEMjKrDHvLvoEsaeLxSCzZBf3Bfg",
+			&chaincfg.MainNetParams},
+		{"wrong network", testMnemonic,
+			"1mW6fDEMjKrDHvLvoEsaeLxSCzZBf3Bfg",
+			&chaincfg.TestNet3Params},
+	}
+	for _, test := range invalid {
+		_, err := verify(test.mnemonic, "", mainPath, test.address,
+			test.params)
+		if err == nil || err == errMismatch {
+			t.Errorf("%s: got error %v, want invalid input", test.name,
+				err)
+		}
+	}
+}
+
+func TestReadMnemonic(t *testing.T) {
+	t.Parallel()
+
+	input := "  Abandon  abandon\tABOUT \r\nignored\n"
+	mnemonic, err := readMnemonic(bufio.NewReader(strings.NewReader(input)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mnemonic != "abandon abandon about" {
+		t.Fatalf("got mnemonic %q", mnemonic)
+	}
+}
+
+// TestReadPiped ensures the mnemonic and passphrase are both read from piped
+// input sharing a single reader.
+func TestReadPiped(t *testing.T) {
+	t.Parallel()
+
+	// An invalid file descriptor is never a terminal.
+	const fd = -1
+	for _, test := range []struct {
+		input, passphrase string
+	}{
+		{"abandon about\nTREZOR\r\n", "TREZOR"},
+		{"abandon about\n pass phrase", " pass phrase"},
+		{"abandon about\n", ""},
+	} {
+		r := bufio.NewReader(strings.NewReader(test.input))
+		mnemonic, err := readMnemonic(r)
+		if err != nil || mnemonic != "abandon about" {
+			t.Fatalf("got mnemonic %q (%v)", mnemonic, err)
+		}
+		passphrase, err := readPassphrase(r, fd)
+		if err != nil || passphrase != test.passphrase {
+			t.Fatalf("got passphrase %q (%v), want %q", passphrase,
+				err, test.passphrase)
+		}
+	}
+}
@@ -79,7 +79,7 @@ var helpDescsEnUS = map[string]string{
 	"infowalletresult-paytxfee":        "The increment used each time more fee is required for an authored transaction",
 	"infowalletresult-balance":         "The balance of all accounts calculated with one block confirmation",
 	"infowalletresult-walletversion":   "The version of the address manager database",
-	"infowalletresult-unlocked_until":  "Unset",
+	"infowalletresult-unlocked_until":  "The Unix time the wallet will be relocked at, or 0 if locked or unlocked without a timeout",
 	"infowalletresult-keypoolsize":     "Unset",
 	"infowalletresult-keypoololdest":   "Unset",
 
@@ -15,7 +15,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/gcash/bchd/bchec"
 	"github.com/gcash/bchd/btcjson"
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
@@ -277,6 +276,8 @@ func jsonError(err error) *btcjson.RPCError {
 		switch e.ErrorCode {
 		case waddrmgr.ErrWrongPassphrase:
 			code = btcjson.ErrRPCWalletPassphraseIncorrect
+		case waddrmgr.ErrGapLimitExceeded:
+			code = btcjson.ErrRPCWalletKeypoolRanOut
 		}
 	}
 	return &btcjson.RPCError{
@@ -507,9 +508,11 @@ func getInfo(icmd interface{}, w *wallet.Wallet, chainClient *chain.RPCClient) (
 	info.WalletVersion = int32(waddrmgr.LatestMgrVersion)
 	info.Balance = bal.ToBCH()
 	info.PaytxFee = float64(txrules.DefaultRelayFeePerKb)
+	if until := w.UnlockedUntil(); !until.IsZero() {
+		info.UnlockedUntil = until.Unix()
+	}
 	// We don't set the following since they don't make much sense in the
 	// wallet architecture:
-	//  - unlocked_until
 	//  - errors
 
 	return info, nil
@@ -689,9 +692,9 @@ func renameAccount(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 
 // getNewAddress handles a getnewaddress request by returning a new
 // address for an account.  If the account does not exist an appropiate
-// error is returned.
-// TODO: Follow BIP 0044 and warn if number of unused addresses exceeds
-// the gap limit.
+// error is returned, and btcjson.ErrRPCWalletKeypoolRanOut is returned if
+// the new address would exceed the gap limit, counting addresses whose
+// deposits lack the confirmations required to close the gap as unused.
 func getNewAddress(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*btcjson.GetNewAddressCmd)
 
@@ -747,20 +750,13 @@ func getReceivedByAccount(icmd interface{}, w *wallet.Wallet) (interface{}, erro
 		return nil, err
 	}
 
-	// TODO: This is more inefficient that it could be, but the entire
-	// algorithm is already dominated by reading every transaction in the
-	// wallet's history.
-	results, err := w.TotalReceivedForAccounts(
-		waddrmgr.KeyScopeBIP0044, int32(*cmd.MinConf),
+	total, err := w.TotalReceivedForAccount(
+		waddrmgr.KeyScopeBIP0044, account, int32(*cmd.MinConf),
 	)
 	if err != nil {
 		return nil, err
 	}
-	acctIndex := int(account)
-	if account == waddrmgr.ImportedAddrAccount {
-		acctIndex = len(results) - 1
-	}
-	return results[acctIndex].TotalReceived.ToBCH(), nil
+	return total.ToBCH(), nil
 }
 
 // getReceivedByAddress handles a getreceivedbyaddress request by returning
@@ -800,6 +796,13 @@ func getTransaction(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	if details == nil {
 		return nil, &ErrNoTransactionInfo
 	}
+	if details.Pruned {
+		msgTx, err := w.GetRawTransaction(txHash)
+		if err != nil {
+			return nil, err
+		}
+		details.MsgTx = *msgTx
+	}
 
 	syncBlock := w.Manager.SyncedTo()
 
@@ -1150,7 +1153,7 @@ func listReceivedByAddress(icmd interface{}, w *wallet.Wallet) (interface{}, err
 		confirmations := confirms(details[0].Block.Height, syncBlock.Height)
 		for _, tx := range details {
 			for _, cred := range tx.Credits {
-				pkScript := tx.MsgTx.TxOut[cred.Index].PkScript
+				pkScript := tx.CreditPkScript(cred.Index)
 				_, addrs, _, err := txscript.ExtractPkScriptAddrs(
 					pkScript, w.ChainParams())
 				if err != nil {
@@ -1384,7 +1387,7 @@ func sendPairs(w *wallet.Wallet, amounts map[string]bchutil.Amount,
 	if err != nil {
 		return "", err
 	}
-	tx, err := w.SendOutputs(outputs, account, minconf, feeSatPerKb)
+	tx, err := w.SendOutputs(outputs, nil, account, minconf, feeSatPerKb)
 	if err != nil {
 		if err == txrules.ErrAmountNegative {
 			return "", ErrNeedPositiveAmount
@@ -1558,17 +1561,7 @@ func signMessage(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		return nil, err
 	}
 
-	privKey, err := w.PrivKeyForAddress(addr)
-	if err != nil {
-		return nil, err
-	}
-
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Bitcoin Signed Message:\n")
-	wire.WriteVarString(&buf, 0, cmd.Message)
-	messageHash := chainhash.DoubleHashB(buf.Bytes())
-	sigbytes, err := bchec.SignCompact(bchec.S256(), privKey,
-		messageHash, true)
+	sigbytes, err := w.SignMessage(addr, cmd.Message)
 	if err != nil {
 		return nil, err
 	}
@@ -1859,33 +1852,7 @@ func verifyMessage(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 		return nil, err
 	}
 
-	// Validate the signature - this just shows that it was valid at all.
-	// we will compare it with the key next.
-	var buf bytes.Buffer
-	wire.WriteVarString(&buf, 0, "Bitcoin Signed Message:\n")
-	wire.WriteVarString(&buf, 0, cmd.Message)
-	expectedMessageHash := chainhash.DoubleHashB(buf.Bytes())
-	pk, wasCompressed, err := bchec.RecoverCompact(bchec.S256(), sig,
-		expectedMessageHash)
-	if err != nil {
-		return nil, err
-	}
-
-	var serializedPubKey []byte
-	if wasCompressed {
-		serializedPubKey = pk.SerializeCompressed()
-	} else {
-		serializedPubKey = pk.SerializeUncompressed()
-	}
-	// Verify that the signed-by address matches the given address
-	switch checkAddr := addr.(type) {
-	case *bchutil.AddressPubKeyHash: // ok
-		return bytes.Equal(bchutil.Hash160(serializedPubKey), checkAddr.Hash160()[:]), nil
-	case *bchutil.AddressPubKey: // ok
-		return string(serializedPubKey) == checkAddr.String(), nil
-	default:
-		return nil, errors.New("address type not supported")
-	}
+	return wallet.VerifyMessage(addr, cmd.Message, sig)
 }
 
 // walletIsLocked handles the walletislocked extension request by
@@ -1909,12 +1876,14 @@ func walletLock(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 func walletPassphrase(icmd interface{}, w *wallet.Wallet) (interface{}, error) {
 	cmd := icmd.(*btcjson.WalletPassphraseCmd)
 
-	timeout := time.Second * time.Duration(cmd.Timeout)
-	var unlockAfter <-chan time.Time
-	if timeout != 0 {
-		unlockAfter = time.After(timeout)
+	if cmd.Timeout < 0 {
+		return nil, &btcjson.RPCError{
+			Code:    btcjson.ErrRPCInvalidParameter,
+			Message: "timeout cannot be negative",
+		}
 	}
-	err := w.Unlock([]byte(cmd.Passphrase), unlockAfter)
+	timeout := time.Second * time.Duration(cmd.Timeout)
+	err := w.UnlockFor([]byte(cmd.Passphrase), timeout)
 	return nil, err
 }
 
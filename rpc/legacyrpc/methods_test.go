@@ -0,0 +1,21 @@
+package legacyrpc
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestJSONErrorGapLimit ensures addresses refused for exceeding the gap limit
+// are reported as the keypool running out.
+func TestJSONErrorGapLimit(t *testing.T) {
+	err := waddrmgr.ManagerError{
+		ErrorCode:   waddrmgr.ErrGapLimitExceeded,
+		Description: "gap limit exceeded",
+	}
+	if code := jsonError(err).Code; code != btcjson.ErrRPCWalletKeypoolRanOut {
+		t.Fatalf("got error code %v, want %v", code,
+			btcjson.ErrRPCWalletKeypoolRanOut)
+	}
+}
@@ -0,0 +1,120 @@
+package rpcserver
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchutil"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// AddressFormat describes the encoding of addresses in RPC responses.
+type AddressFormat uint32
+
+// These constants define the supported address formats.
+const (
+	// AddressFormatCashAddr encodes addresses using cashaddr.  This is the
+	// default.
+	AddressFormatCashAddr AddressFormat = iota
+
+	// AddressFormatLegacy encodes pay-to-pubkey-hash and pay-to-script-hash
+	// addresses using the legacy base58 encoding.  Addresses without a
+	// legacy encoding are still encoded using cashaddr.
+	AddressFormatLegacy
+)
+
+// AddressFormatMetadataKey is the request metadata key clients may set to
+// "cashaddr" or "legacy" to override the server's address format for a single
+// call or notification stream.
+const AddressFormatMetadataKey = "address-format"
+
+// String returns the name of the address format as accepted by
+// ParseAddressFormat.
+func (f AddressFormat) String() string {
+	switch f {
+	case AddressFormatCashAddr:
+		return "cashaddr"
+	case AddressFormatLegacy:
+		return "legacy"
+	default:
+		return fmt.Sprintf("AddressFormat(%d)", uint32(f))
+	}
+}
+
+// ParseAddressFormat returns the address format with the given name.
+func ParseAddressFormat(name string) (AddressFormat, error) {
+	switch name {
+	case "cashaddr":
+		return AddressFormatCashAddr, nil
+	case "legacy":
+		return AddressFormatLegacy, nil
+	default:
+		return 0, fmt.Errorf("unknown address format %q", name)
+	}
+}
+
+// SetAddressFormat sets the format of addresses in WalletService responses
+// when not overridden by the request.  The legacy JSON-RPC server is not
+// affected.
+func SetAddressFormat(format AddressFormat) {
+	atomic.StoreUint32(&walletService.addressFormat, uint32(format))
+}
+
+// addressEncoder encodes addresses for RPC responses.
+type addressEncoder struct {
+	format AddressFormat
+	params *chaincfg.Params
+}
+
+// addressEncoder returns the encoder for addresses in the response to the
+// request with the given context.  The server's address format is used unless
+// the request metadata overrides it.
+func (s *walletServer) addressEncoder(ctx context.Context) (addressEncoder, error) {
+	enc := addressEncoder{
+		format: AddressFormat(atomic.LoadUint32(&s.addressFormat)),
+		params: s.wallet.ChainParams(),
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return enc, nil
+	}
+	values := md.Get(AddressFormatMetadataKey)
+	if len(values) == 0 {
+		return enc, nil
+	}
+	format, err := ParseAddressFormat(values[len(values)-1])
+	if err != nil {
+		return enc, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	enc.format = format
+	return enc, nil
+}
+
+// encode returns the string encoding of addr in the encoder's format.
+func (e addressEncoder) encode(addr bchutil.Address) string {
+	if e.format != AddressFormatLegacy {
+		return addr.EncodeAddress()
+	}
+
+	var legacy bchutil.Address
+	var err error
+	switch a := addr.(type) {
+	case *bchutil.AddressPubKeyHash:
+		legacy, err = bchutil.NewLegacyAddressPubKeyHash(a.ScriptAddress(), e.params)
+	case *bchutil.AddressPubKey:
+		legacy, err = bchutil.NewLegacyAddressPubKeyHash(
+			bchutil.Hash160(a.ScriptAddress()), e.params)
+	case *bchutil.AddressScriptHash:
+		legacy, err = bchutil.NewLegacyAddressScriptHashFromHash(a.ScriptAddress(), e.params)
+	default:
+		return addr.EncodeAddress()
+	}
+	if err != nil {
+		return addr.EncodeAddress()
+	}
+	return legacy.EncodeAddress()
+}
@@ -0,0 +1,74 @@
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchutil"
+)
+
+// TestAddressEncoder ensures addresses are encoded in the requested format and
+// that addresses without a legacy encoding fall back to cashaddr.
+func TestAddressEncoder(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	key, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	serializedKey := key.PubKey().SerializeCompressed()
+	pkAddr, err := bchutil.NewAddressPubKey(serializedKey, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkhAddr := pkAddr.AddressPubKeyHash()
+	legacyPKH, err := bchutil.NewLegacyAddressPubKeyHash(
+		pkhAddr.ScriptAddress(), params,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shAddr, err := bchutil.NewAddressScriptHash([]byte{0x51}, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacySH, err := bchutil.NewLegacyAddressScriptHash([]byte{0x51}, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sh32Addr, err := bchutil.NewAddressScriptHash32([]byte{0x51}, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		addr   bchutil.Address
+		format AddressFormat
+		want   string
+	}{
+		{"p2pkh cashaddr", pkhAddr, AddressFormatCashAddr, pkhAddr.EncodeAddress()},
+		{"p2pkh legacy", pkhAddr, AddressFormatLegacy, legacyPKH.EncodeAddress()},
+		{"p2pk legacy", pkAddr, AddressFormatLegacy, legacyPKH.EncodeAddress()},
+		{"p2sh legacy", shAddr, AddressFormatLegacy, legacySH.EncodeAddress()},
+		{"legacy input", legacyPKH, AddressFormatCashAddr, legacyPKH.EncodeAddress()},
+		{"p2sh32 legacy", sh32Addr, AddressFormatLegacy, sh32Addr.EncodeAddress()},
+	}
+	for _, test := range tests {
+		enc := addressEncoder{format: test.format, params: params}
+		if got := enc.encode(test.addr); got != test.want {
+			t.Errorf("%s: got %s, want %s", test.name, got, test.want)
+		}
+	}
+
+	for _, format := range []AddressFormat{AddressFormatCashAddr, AddressFormatLegacy} {
+		parsed, err := ParseAddressFormat(format.String())
+		if err != nil || parsed != format {
+			t.Errorf("unable to round trip format %v: %v", format, err)
+		}
+	}
+	if _, err := ParseAddressFormat("base58"); err == nil {
+		t.Errorf("parsed unknown address format")
+	}
+}
@@ -17,7 +17,10 @@ package rpcserver
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -31,6 +34,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 
+	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/rpcclient"
 	"github.com/gcash/bchd/txscript"
@@ -44,14 +48,16 @@ import (
 	pb "github.com/gcash/bchwallet/rpc/walletrpc"
 	"github.com/gcash/bchwallet/waddrmgr"
 	"github.com/gcash/bchwallet/wallet"
+	"github.com/gcash/bchwallet/wallet/txauthor"
+	"github.com/gcash/bchwallet/wallet/txrules"
 	"github.com/gcash/bchwallet/walletdb"
 )
 
 // Public API version constants
 const (
-	semverString = "2.0.1"
+	semverString = "2.39.1"
 	semverMajor  = 2
-	semverMinor  = 0
+	semverMinor  = 39
 	semverPatch  = 1
 )
 
@@ -78,24 +84,57 @@ func errorCode(err error) codes.Code {
 			return codes.InvalidArgument
 		case waddrmgr.ErrAccountNotFound:
 			return codes.NotFound
+		case waddrmgr.ErrScopeNotFound:
+			return codes.NotFound
 		case waddrmgr.ErrInvalidAccount: // reserved account
 			return codes.InvalidArgument
 		case waddrmgr.ErrDuplicateAccount:
 			return codes.AlreadyExists
+		case waddrmgr.ErrGapLimitExceeded:
+			return codes.FailedPrecondition
 		}
 
 		err = e.Err
 	}
 
+	// The chain server could not be reached to publish a transaction,
+	// which may be retried later.
+	if _, ok := err.(*wallet.TransientPublishError); ok {
+		return codes.Unavailable
+	}
+
+	// The wallet was written by a newer version of the software.
+	if errors.Is(err, wallet.ErrWalletVersion) {
+		return codes.FailedPrecondition
+	}
+
 	switch err {
+	case context.Canceled:
+		return codes.Canceled
+	case context.DeadlineExceeded:
+		return codes.DeadlineExceeded
 	case wallet.ErrLoaded:
 		return codes.FailedPrecondition
+	case wallet.ErrTxNotFound:
+		return codes.NotFound
+	case wallet.ErrTxMined:
+		return codes.FailedPrecondition
+	case wallet.ErrDiscoveryInterrupted:
+		return codes.Aborted
+	case wallet.ErrNoBirthdayBlock:
+		return codes.FailedPrecondition
 	case walletdb.ErrDbNotOpen:
 		return codes.Aborted
 	case walletdb.ErrDbExists:
 		return codes.AlreadyExists
 	case walletdb.ErrDbDoesNotExist:
 		return codes.NotFound
+	case walletdb.ErrBucketNotFound:
+		// A bucket the wallet expects is missing, such as in a
+		// partially-initialized database.
+		return codes.FailedPrecondition
+	case walletdb.ErrBucketExists:
+		return codes.AlreadyExists
 	case hdkeychain.ErrInvalidSeedLen:
 		return codes.InvalidArgument
 	default:
@@ -110,8 +149,9 @@ type versionServer struct {
 
 // walletServer provides wallet services for RPC clients.
 type walletServer struct {
-	ready  uint32 // atomic
-	wallet *wallet.Wallet
+	ready         uint32 // atomic
+	addressFormat uint32 // atomic
+	wallet        *wallet.Wallet
 }
 
 // loaderServer provides RPC clients with the ability to load and close wallets,
@@ -195,11 +235,19 @@ func (s *walletServer) Ping(ctx context.Context, req *pb.PingRequest) (*pb.PingR
 func (s *walletServer) Network(ctx context.Context, req *pb.NetworkRequest) (
 	*pb.NetworkResponse, error) {
 
-	if s.wallet.ChainClient() == nil {
-		return nil, translateError(errors.New("chain client to available yet"))
+	syncedTo := s.wallet.Manager.SyncedTo().Height
+
+	// Before the chain client is connected, report the wallet as not
+	// synced rather than failing, so clients can poll during startup.
+	chainClient := s.wallet.ChainClient()
+	if chainClient == nil {
+		return &pb.NetworkResponse{
+			ActiveNetwork: uint32(s.wallet.ChainParams().Net),
+			SyncedTo:      syncedTo,
+		}, nil
 	}
 
-	bestHash, bestHeight, err := s.wallet.ChainClient().GetBestBlock()
+	bestHash, bestHeight, err := chainClient.GetBestBlock()
 	if err != nil {
 		return nil, err
 	}
@@ -208,10 +256,25 @@ func (s *walletServer) Network(ctx context.Context, req *pb.NetworkRequest) (
 		ActiveNetwork: uint32(s.wallet.ChainParams().Net),
 		BestBlock:     bestHash.String(),
 		BestHeight:    bestHeight,
-		SyncedTo:      s.wallet.Manager.SyncedTo().Height,
+		SyncedTo:      syncedTo,
+		Synced:        s.wallet.ChainSynced() && syncedTo >= bestHeight,
+		SyncProgress:  syncProgress(syncedTo, bestHeight),
 	}, nil
 }
 
+// syncProgress returns the fraction, between 0 and 1, of the blocks up to the
+// best height that the wallet is synced to.
+func syncProgress(syncedTo, bestHeight int32) float32 {
+	switch {
+	case syncedTo >= bestHeight:
+		return 1
+	case syncedTo <= 0:
+		return 0
+	default:
+		return float32(syncedTo) / float32(bestHeight)
+	}
+}
+
 func (s *walletServer) AccountNumber(ctx context.Context, req *pb.AccountNumberRequest) (
 	*pb.AccountNumberResponse, error) {
 
@@ -286,13 +349,53 @@ func (s *walletServer) NextAccount(ctx context.Context, req *pb.NextAccountReque
 	return &pb.NextAccountResponse{AccountNumber: account}, nil
 }
 
+// maxDiscoverGapLimit is the maximum number of consecutive unused accounts
+// which may be probed for by a DiscoverAccounts request before it stops.
+const maxDiscoverGapLimit = 100
+
+func (s *walletServer) DiscoverAccounts(ctx context.Context, req *pb.DiscoverAccountsRequest) (
+	*pb.DiscoverAccountsResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	if req.GapLimit == 0 || req.GapLimit > maxDiscoverGapLimit {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"gap limit must be between 1 and %d", maxDiscoverGapLimit)
+	}
+	if s.wallet.ChainClient() == nil {
+		return nil, grpc.Errorf(codes.FailedPrecondition,
+			"wallet is not associated with a consensus server RPC client")
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err := s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	discovered, err := s.wallet.DiscoverAccounts(ctx, req.GapLimit,
+		req.ScanFromGenesis)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.DiscoverAccountsResponse{
+		DiscoveredAccounts: uint32(discovered),
+	}, nil
+}
+
 func (s *walletServer) NextAddress(ctx context.Context, req *pb.NextAddressRequest) (
 	*pb.NextAddressResponse, error) {
 
-	var (
-		addr bchutil.Address
-		err  error
-	)
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var addr bchutil.Address
 	switch req.Kind {
 	case pb.NextAddressRequest_BIP0044_EXTERNAL:
 		addr, err = s.wallet.NewAddress(req.Account, waddrmgr.KeyScopeBIP0044)
@@ -305,18 +408,63 @@ func (s *walletServer) NextAddress(ctx context.Context, req *pb.NextAddressReque
 		return nil, translateError(err)
 	}
 
-	return &pb.NextAddressResponse{Address: addr.EncodeAddress()}, nil
+	return &pb.NextAddressResponse{Address: enc.encode(addr)}, nil
+}
+
+// maxNextAddresses is the maximum number of addresses which may be derived by
+// a single NextAddresses request.
+const maxNextAddresses = 1000
+
+func (s *walletServer) NextAddresses(ctx context.Context, req *pb.NextAddressesRequest) (
+	*pb.NextAddressesResponse, error) {
+
+	if req.Count == 0 || req.Count > maxNextAddresses {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"count must be between 1 and %d", maxNextAddresses)
+	}
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var internal bool
+	switch req.Kind {
+	case pb.NextAddressRequest_BIP0044_EXTERNAL:
+	case pb.NextAddressRequest_BIP0044_INTERNAL:
+		internal = true
+	default:
+		return nil, grpc.Errorf(codes.InvalidArgument, "kind=%v", req.Kind)
+	}
+	addrs, firstIndex, err := s.wallet.NewAddresses(req.Account,
+		waddrmgr.KeyScopeBIP0044, internal, req.Count)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.NextAddressesResponse{
+		Addresses:  make([]string, len(addrs)),
+		FirstIndex: firstIndex,
+	}
+	for i, addr := range addrs {
+		resp.Addresses[i] = enc.encode(addr)
+	}
+	return resp, nil
 }
 
 func (s *walletServer) CurrentAddress(ctx context.Context, req *pb.CurrentAddressRequest) (
 	*pb.CurrentAddressResponse, error) {
 
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	addr, err := s.wallet.CurrentAddress(req.Account, waddrmgr.KeyScopeBIP0044)
 	if err != nil {
 		return nil, translateError(err)
 	}
 
-	return &pb.CurrentAddressResponse{Address: addr.EncodeAddress()}, nil
+	return &pb.CurrentAddressResponse{Address: enc.encode(addr)}, nil
 }
 
 func (s *walletServer) ImportPrivateKey(ctx context.Context, req *pb.ImportPrivateKeyRequest) (
@@ -354,6 +502,214 @@ func (s *walletServer) ImportPrivateKey(ctx context.Context, req *pb.ImportPriva
 	return &pb.ImportPrivateKeyResponse{}, nil
 }
 
+func (s *walletServer) DumpPrivateKey(ctx context.Context, req *pb.DumpPrivateKeyRequest) (
+	*pb.DumpPrivateKeyResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	params := s.wallet.ChainParams()
+	addr, err := bchutil.DecodeAddress(req.Address, params)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"invalid address %q: %v", req.Address, err)
+	}
+	if !addr.IsForNet(params) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not for %s", req.Address, params.Name)
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	wif, err := s.wallet.DumpWIFPrivateKey(addr)
+	switch {
+	case err == nil:
+	case waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound):
+		return nil, grpc.Errorf(codes.NotFound,
+			"address %q is not in the wallet", req.Address)
+	case errors.Is(err, wallet.ErrNotPubKeyAddress):
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not a public key address", req.Address)
+	case waddrmgr.IsError(err, waddrmgr.ErrWatchingOnly):
+		return nil, grpc.Errorf(codes.FailedPrecondition,
+			"private key for address %q is not available", req.Address)
+	default:
+		return nil, translateError(err)
+	}
+
+	return &pb.DumpPrivateKeyResponse{PrivateKeyWif: wif}, nil
+}
+
+func (s *walletServer) SignMessage(ctx context.Context, req *pb.SignMessageRequest) (
+	*pb.SignMessageResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	params := s.wallet.ChainParams()
+	addr, err := bchutil.DecodeAddress(req.Address, params)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"invalid address %q: %v", req.Address, err)
+	}
+	if !addr.IsForNet(params) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not for %s", req.Address, params.Name)
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	sig, err := s.wallet.SignMessage(addr, req.Message)
+	switch {
+	case err == nil:
+	case waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound):
+		return nil, grpc.Errorf(codes.NotFound,
+			"address %q is not in the wallet", req.Address)
+	case errors.Is(err, wallet.ErrNotPubKeyAddress):
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not a public key address", req.Address)
+	case waddrmgr.IsError(err, waddrmgr.ErrWatchingOnly):
+		return nil, grpc.Errorf(codes.FailedPrecondition,
+			"private key for address %q is not available", req.Address)
+	default:
+		return nil, translateError(err)
+	}
+
+	return &pb.SignMessageResponse{
+		Signature: base64.StdEncoding.EncodeToString(sig),
+	}, nil
+}
+
+func (s *walletServer) VerifyMessage(ctx context.Context, req *pb.VerifyMessageRequest) (
+	*pb.VerifyMessageResponse, error) {
+
+	params := s.wallet.ChainParams()
+	addr, err := bchutil.DecodeAddress(req.Address, params)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"invalid address %q: %v", req.Address, err)
+	}
+	if !addr.IsForNet(params) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not for %s", req.Address, params.Name)
+	}
+	sig, err := base64.StdEncoding.DecodeString(req.Signature)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"signature is not base64 encoded: %v", err)
+	}
+
+	valid, err := wallet.VerifyMessage(addr, req.Message, sig)
+	if errors.Is(err, wallet.ErrNotPubKeyAddress) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not a public key address", req.Address)
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.VerifyMessageResponse{Valid: valid}, nil
+}
+
+// multisigScript returns the redeem script of a multisig output requiring
+// required signatures from the hex-encoded public keys.
+func multisigScript(required uint32, publicKeys []string, params *chaincfg.Params) ([]byte, error) {
+	if required == 0 || int(required) > len(publicKeys) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"required signatures %d must be between 1 and the %d public keys",
+			required, len(publicKeys))
+	}
+	keys := make([]*bchutil.AddressPubKey, len(publicKeys))
+	for i, pubKey := range publicKeys {
+		serialized, err := hex.DecodeString(pubKey)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"public key %d: %v", i, err)
+		}
+		keys[i], err = bchutil.NewAddressPubKey(serialized, params)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"public key %d: %v", i, err)
+		}
+	}
+	script, err := txscript.MultiSigScript(keys, int(required))
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if len(script) > txscript.MaxScriptElementSize {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"redeem script size %d exceeds the maximum of %d bytes",
+			len(script), txscript.MaxScriptElementSize)
+	}
+	return script, nil
+}
+
+func (s *walletServer) CreateMultisigAddress(ctx context.Context, req *pb.CreateMultisigAddressRequest) (
+	*pb.CreateMultisigAddressResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	script, err := multisigScript(req.Required, req.PublicKeys, s.wallet.ChainParams())
+	if err != nil {
+		return nil, err
+	}
+
+	lock := make(chan time.Time, 1)
+	defer func() {
+		lock <- time.Time{} // send matters, not the value
+	}()
+	err = s.wallet.Unlock(req.Passphrase, lock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	addr, err := s.wallet.ImportP2SHRedeemScript(script)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.CreateMultisigAddressResponse{
+		Address:      enc.encode(addr),
+		RedeemScript: hex.EncodeToString(script),
+	}, nil
+}
+
+func (s *walletServer) ImportPrunedFunds(ctx context.Context, req *pb.ImportPrunedFundsRequest) (
+	*pb.ImportPrunedFundsResponse, error) {
+
+	blockHash, err := chainhash.NewHash(req.BlockHash)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	err = s.wallet.ImportTransaction(req.Transaction, req.MerkleProof, blockHash)
+	switch err {
+	case nil:
+	case wallet.ErrInvalidMerkleProof, wallet.ErrTxNotRelevant:
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	default:
+		return nil, translateError(err)
+	}
+
+	return &pb.ImportPrunedFundsResponse{}, nil
+}
+
 func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 	*pb.BalanceResponse, error) {
 
@@ -370,6 +726,45 @@ func (s *walletServer) Balance(ctx context.Context, req *pb.BalanceRequest) (
 		Total:          int64(bals.Total),
 		Spendable:      int64(bals.Spendable),
 		ImmatureReward: int64(bals.ImmatureReward),
+		WatchOnly:      int64(bals.WatchOnly),
+		TimeLocked:     int64(bals.TimeLocked),
+	}
+	return resp, nil
+}
+
+func (s *walletServer) AddressBalance(ctx context.Context, req *pb.AddressBalanceRequest) (
+	*pb.AddressBalanceResponse, error) {
+
+	if req.RequiredConfirmations < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"required_confirmations must be non-negative")
+	}
+	addr, err := bchutil.DecodeAddress(req.Address, s.wallet.ChainParams())
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"invalid address %q: %v", req.Address, err)
+	}
+	if !addr.IsForNet(s.wallet.ChainParams()) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not for %s", req.Address,
+			s.wallet.ChainParams().Name)
+	}
+
+	bals, err := s.wallet.AddressBalance(addr, req.RequiredConfirmations)
+	if waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+		return nil, grpc.Errorf(codes.NotFound,
+			"address %q is not known to the wallet", req.Address)
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.AddressBalanceResponse{
+		Total:          int64(bals.Total),
+		Spendable:      int64(bals.Spendable),
+		ImmatureReward: int64(bals.ImmatureReward),
+		WatchOnly:      int64(bals.WatchOnly),
+		TimeLocked:     int64(bals.TimeLocked),
 	}
 	return resp, nil
 }
@@ -399,13 +794,13 @@ func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransact
 		Account:               req.Account,
 		RequiredConfirmations: req.RequiredConfirmations,
 	}
-	unspentOutputs, err := s.wallet.UnspentOutputs(policy)
+	unspentOutputs, totalAmount, err := s.wallet.SelectUnspentOutputs(ctx,
+		policy, bchutil.Amount(req.TargetAmount), req.LockOutputs)
 	if err != nil {
 		return nil, translateError(err)
 	}
 
 	selectedOutputs := make([]*pb.FundTransactionResponse_PreviousOutput, 0, len(unspentOutputs))
-	var totalAmount bchutil.Amount
 	for _, output := range unspentOutputs {
 		selectedOutputs = append(selectedOutputs, &pb.FundTransactionResponse_PreviousOutput{
 			TransactionHash: output.OutPoint.Hash[:],
@@ -415,11 +810,6 @@ func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransact
 			ReceiveTime:     output.ReceiveTime.Unix(),
 			FromCoinbase:    output.OutputKind == wallet.OutputKindCoinbase,
 		})
-		totalAmount += bchutil.Amount(output.Output.Value)
-
-		if req.TargetAmount != 0 && totalAmount > bchutil.Amount(req.TargetAmount) {
-			break
-		}
 	}
 
 	var changeScript []byte
@@ -441,26 +831,175 @@ func (s *walletServer) FundTransaction(ctx context.Context, req *pb.FundTransact
 	}, nil
 }
 
+func (s *walletServer) LockedOutputs(ctx context.Context, req *pb.LockedOutputsRequest) (
+	*pb.LockedOutputsResponse, error) {
+
+	locked, err := s.wallet.LockedOutputs()
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	outputs := make([]*pb.LockedOutputsResponse_Output, len(locked))
+	for i := range locked {
+		op := &locked[i].OutPoint
+		outputs[i] = &pb.LockedOutputsResponse_Output{
+			TransactionHash: op.Hash[:],
+			OutputIndex:     op.Index,
+			Amount:          int64(locked[i].Amount),
+		}
+	}
+	return &pb.LockedOutputsResponse{Outputs: outputs}, nil
+}
+
+func (s *walletServer) UnlockOutput(ctx context.Context, req *pb.UnlockOutputRequest) (
+	*pb.UnlockOutputResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	op := wire.OutPoint{Hash: *txHash, Index: req.OutputIndex}
+	if !s.wallet.LockedOutpoint(op) {
+		return nil, grpc.Errorf(codes.NotFound,
+			"outpoint %v is not locked", op)
+	}
+	s.wallet.UnlockOutpoint(op)
+	return &pb.UnlockOutputResponse{}, nil
+}
+
+// outputScript decodes the address of the output with the given index in a
+// request and returns the script paying to it.  Addresses which do not decode
+// for the network or that no payment script can be created for are rejected
+// with an InvalidArgument error naming the output.
+func outputScript(index int, address string, params *chaincfg.Params) ([]byte, error) {
+	addr, err := bchutil.DecodeAddress(address, params)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"output %d: invalid address %q: %v", index, address, err)
+	}
+	if !addr.IsForNet(params) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"output %d: address %q is not for %s", index, address, params.Name)
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"output %d: unsupported address type %T of %q", index, addr, address)
+	}
+	return script, nil
+}
+
 func (s *walletServer) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (
 	*pb.CreateTransactionResponse, error) {
 
 	fee := bchutil.Amount(req.SatPerKbFee)
+	switch {
+	case req.UseEstimateFee && req.SatPerKbFee != 0:
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"sat_per_kb_fee must be zero when use_estimate_fee is set")
+	case req.UseEstimateFee && req.ConfTarget == 0:
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"conf_target must be positive when use_estimate_fee is set")
+	case !req.UseEstimateFee && req.SatPerKbFee == 0:
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"sat_per_kb_fee must be positive unless use_estimate_fee is set")
+	case !req.UseEstimateFee && req.ConfTarget != 0:
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"conf_target requires use_estimate_fee")
+	}
 	var outputs []*wire.TxOut
-	for _, out := range req.Outputs {
-		addr, err := bchutil.DecodeAddress(out.Address, s.wallet.ChainParams())
+	for i, out := range req.Outputs {
+		script, err := outputScript(i, out.Address, s.wallet.ChainParams())
 		if err != nil {
 			return nil, err
 		}
-		script, err := txscript.PayToAddrScript(addr)
+		outputs = append(outputs, wire.NewTxOut(out.Amount, script, wire.TokenData{}))
+	}
+
+	strategy := wallet.CoinSelectionLargest
+	if req.AvoidAddressMixing {
+		strategy = wallet.CoinSelectionSingleAddress
+	}
+	var changeAddr bchutil.Address
+	if req.ChangeAddress != "" {
+		var err error
+		changeAddr, err = bchutil.DecodeAddress(req.ChangeAddress,
+			s.wallet.ChainParams())
 		if err != nil {
-			return nil, err
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"invalid change address: %v", err)
+		}
+		if !changeAddr.IsForNet(s.wallet.ChainParams()) {
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"change address %q is not for %s",
+				req.ChangeAddress, s.wallet.ChainParams().Name)
 		}
-		outputs = append(outputs, wire.NewTxOut(out.Amount, script, wire.TokenData{}))
 	}
+	var immature *wallet.ImmatureCoinbaseSpend
+	switch {
+	case req.SpendImmatureCoinbases:
+		immature = &wallet.ImmatureCoinbaseSpend{
+			LockTime:        req.LockTime,
+			AcknowledgeRisk: req.AcknowledgeImmatureRisk,
+		}
+	case req.LockTime != 0 || req.AcknowledgeImmatureRisk:
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"lock_time and acknowledge_immature_risk require "+
+				"spend_immature_coinbases")
+	}
+	var selected []wire.OutPoint
+	if len(req.SelectedOutpoints) != 0 {
+		if req.AvoidAddressMixing || changeAddr != nil ||
+			immature != nil || req.RequiredConfirmations != 0 {
 
-	authoredTx, err := s.wallet.CreateUnsignedTx(req.Account, outputs, req.RequiredConfirmations, fee)
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"selected_outpoints can not be combined with "+
+					"avoid_address_mixing, change_address, "+
+					"spend_immature_coinbases or "+
+					"required_confirmations")
+		}
+		for i, op := range req.SelectedOutpoints {
+			hash, err := chainhash.NewHash(op.TransactionHash)
+			if err != nil {
+				return nil, grpc.Errorf(codes.InvalidArgument,
+					"selected outpoint %d: invalid transaction "+
+						"hash: %v", i, err)
+			}
+			selected = append(selected,
+				*wire.NewOutPoint(hash, op.OutputIndex))
+		}
+	}
+	if req.UseEstimateFee {
+		var err error
+		fee, err = s.wallet.EstimateFeeRate(req.ConfTarget)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	}
+	var authoredTx *txauthor.AuthoredTx
+	var err error
+	if selected != nil {
+		authoredTx, err = s.wallet.CreateUnsignedTxWithInputs(
+			req.Account, outputs, selected, fee)
+	} else {
+		authoredTx, err = s.wallet.CreateUnsignedTx(nil, req.Account,
+			outputs, req.RequiredConfirmations, fee,
+			wallet.TxCreateOptions{
+				Strategy:   strategy,
+				ChangeAddr: changeAddr,
+				Immature:   immature,
+			})
+	}
+	if err == wallet.ErrChangeAddressNotOwned ||
+		err == wallet.ErrImmatureSpendNotAcknowledged ||
+		errors.Is(err, wallet.ErrImmatureSpendLockTime) ||
+		errors.Is(err, wallet.ErrInputNotEligible) {
+
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 	var serializedTx bytes.Buffer
 	err = authoredTx.Tx.BchEncode(&serializedTx, wire.ProtocolVersion, wire.BaseEncoding)
@@ -483,37 +1022,83 @@ func (s *walletServer) CreateTransaction(ctx context.Context, req *pb.CreateTran
 		SerializedTransaction: serializedTx.Bytes(),
 		InputValues:           inputValues,
 		Fee:                   totalIn - totalOut,
+		AbsorbedChange:        int64(authoredTx.AbsorbedChange),
+		SatPerKbFee:           uint32(fee),
 	}, nil
 }
 
 func (s *walletServer) SweepAccount(ctx context.Context, req *pb.SweepAccountRequest) (
 	*pb.SweepAccountResponse, error) {
 
+	if req.SweepToAccount && req.SweepToAddress != "" {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"sweep_to_address must be empty when sweeping to an account")
+	}
+	if req.RequiredConfirmations < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"required_confirmations must be non-negative")
+	}
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the source account exists before deriving an address of the
+	// destination account.
+	_, err = s.wallet.AccountName(waddrmgr.KeyScopeBIP0044, req.Account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var addr bchutil.Address
+	if req.SweepToAccount {
+		addr, err = s.wallet.NewChangeAddress(req.DestinationAccount,
+			waddrmgr.KeyScopeBIP0044)
+		if err != nil {
+			return nil, translateError(err)
+		}
+	} else {
+		addr, err = bchutil.DecodeAddress(req.SweepToAddress,
+			s.wallet.ChainParams())
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"invalid address %q: %v", req.SweepToAddress, err)
+		}
+	}
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return nil, err
+	}
+
 	policy := wallet.OutputSelectionPolicy{
 		Account:               req.Account,
-		RequiredConfirmations: 0,
+		RequiredConfirmations: req.RequiredConfirmations,
 	}
-	unspentOutputs, err := s.wallet.UnspentOutputs(policy)
+	unspentOutputs, err := s.wallet.UnspentOutputs(ctx, policy)
 	if err != nil {
 		return nil, translateError(err)
 	}
 
+	// Coinbase outputs can not be spent until they reach maturity, so
+	// leave immature ones out of the sweep.
+	syncHeight := s.wallet.Manager.SyncedTo().Height
+	maturity := int32(s.wallet.ChainParams().CoinbaseMaturity)
+
 	totalIn := int64(0)
 	var inputs []*wire.TxIn
 	var inputValues []int64
 	for _, u := range unspentOutputs {
+		if u.OutputKind == wallet.OutputKindCoinbase &&
+			!confirmed(maturity, u.ContainingBlock.Height, syncHeight) {
+			continue
+		}
 		totalIn += u.Output.Value
 		inputValues = append(inputValues, u.Output.Value)
 		inputs = append(inputs, wire.NewTxIn(&u.OutPoint, nil))
 	}
-
-	addr, err := bchutil.DecodeAddress(req.SweepToAddress, s.wallet.ChainParams())
-	if err != nil {
-		return nil, err
-	}
-	script, err := txscript.PayToAddrScript(addr)
-	if err != nil {
-		return nil, err
+	if len(inputs) == 0 {
+		return nil, grpc.Errorf(codes.FailedPrecondition,
+			"account %d has no outputs eligible to sweep", req.Account)
 	}
 
 	// Set the value to zero as a placeholder while we calculate the estimate size
@@ -526,6 +1111,18 @@ func (s *walletServer) SweepAccount(ctx context.Context, req *pb.SweepAccountReq
 
 	out.Value = totalIn - int64(fee)
 
+	// Refuse to build a transaction whose only output would be rejected
+	// as dust, which includes the fee exceeding the swept amount.
+	dustThreshold := txrules.GetDustThreshold(len(script),
+		txrules.DefaultRelayFeePerKb)
+	if bchutil.Amount(out.Value) < dustThreshold {
+		return nil, grpc.Errorf(codes.FailedPrecondition,
+			"swept amount of %v after a fee of %v from %v of inputs "+
+				"is below the dust threshold of %v",
+			bchutil.Amount(out.Value), bchutil.Amount(fee),
+			bchutil.Amount(totalIn), dustThreshold)
+	}
+
 	tx := &wire.MsgTx{
 		Version:  wire.TxVersion,
 		TxIn:     inputs,
@@ -544,15 +1141,16 @@ func (s *walletServer) SweepAccount(ctx context.Context, req *pb.SweepAccountReq
 		InputValues:           inputValues,
 		TotalAmount:           out.Value,
 		Fee:                   int64(fee),
+		SweepToAddress:        enc.encode(addr),
 	}, nil
 }
 
-func marshalGetTransactionsResult(wresp *wallet.GetTransactionsResult) (
-	*pb.GetTransactionsResponse, error) {
+func marshalGetTransactionsResult(wresp *wallet.GetTransactionsResult,
+	enc addressEncoder) (*pb.GetTransactionsResponse, error) {
 
 	resp := &pb.GetTransactionsResponse{
-		MinedTransactions:   marshalBlocks(wresp.MinedTransactions),
-		UnminedTransactions: marshalTransactionDetails(wresp.UnminedTransactions),
+		MinedTransactions:   marshalBlocks(wresp.MinedTransactions, enc),
+		UnminedTransactions: marshalTransactionDetails(wresp.UnminedTransactions, enc),
 	}
 	return resp, nil
 }
@@ -563,6 +1161,11 @@ func marshalGetTransactionsResult(wresp *wallet.GetTransactionsResult) (
 func (s *walletServer) GetTransactions(ctx context.Context, req *pb.GetTransactionsRequest) (
 	resp *pb.GetTransactionsResponse, err error) {
 
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	var startBlock, endBlock *wallet.BlockIdentifier
 	if req.StartingBlockHash != nil && req.StartingBlockHeight != 0 {
 		return nil, errors.New(
@@ -590,27 +1193,274 @@ func (s *walletServer) GetTransactions(ctx context.Context, req *pb.GetTransacti
 		endBlock = wallet.NewBlockIdentifierFromHeight(req.EndingBlockHeight)
 	}
 
-	var minRecentTxs int
-	if req.MinimumRecentTransactions != 0 {
-		if endBlock != nil {
-			return nil, grpc.Errorf(codes.InvalidArgument,
-				"ending block and minimum number of recent transactions "+
-					"may not be specified simultaneously")
+	var minRecentTxs int
+	if req.MinimumRecentTransactions != 0 {
+		if endBlock != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"ending block and minimum number of recent transactions "+
+					"may not be specified simultaneously")
+		}
+		minRecentTxs = int(req.MinimumRecentTransactions)
+		if minRecentTxs < 0 {
+			return nil, grpc.Errorf(codes.InvalidArgument,
+				"minimum number of recent transactions may not be negative")
+		}
+	}
+
+	_ = minRecentTxs
+
+	gtr, err := s.wallet.GetTransactions(ctx, startBlock, endBlock)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return marshalGetTransactionsResult(gtr, enc)
+}
+
+func (s *walletServer) TotalFeesPaid(ctx context.Context, req *pb.TotalFeesPaidRequest) (
+	*pb.TotalFeesPaidResponse, error) {
+
+	var startBlock, endBlock *wallet.BlockIdentifier
+	if req.StartingBlockHash != nil && req.StartingBlockHeight != 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"starting block hash and height may not be specified simultaneously")
+	} else if req.StartingBlockHash != nil {
+		startBlockHash, err := chainhash.NewHash(req.StartingBlockHash)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		startBlock = wallet.NewBlockIdentifierFromHash(startBlockHash)
+	} else if req.StartingBlockHeight != 0 {
+		startBlock = wallet.NewBlockIdentifierFromHeight(req.StartingBlockHeight)
+	}
+
+	if req.EndingBlockHash != nil && req.EndingBlockHeight != 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"ending block hash and height may not be specified simultaneously")
+	} else if req.EndingBlockHash != nil {
+		endBlockHash, err := chainhash.NewHash(req.EndingBlockHash)
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+		}
+		endBlock = wallet.NewBlockIdentifierFromHash(endBlockHash)
+	} else if req.EndingBlockHeight != 0 {
+		endBlock = wallet.NewBlockIdentifierFromHeight(req.EndingBlockHeight)
+	}
+
+	fees, err := s.wallet.TotalFeesPaid(startBlock, endBlock)
+	if err == wallet.ErrInvalidBlockRange {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return &pb.TotalFeesPaidResponse{TotalFees: int64(fees)}, nil
+}
+
+func (s *walletServer) MempoolStatus(ctx context.Context, req *pb.MempoolStatusRequest) (
+	*pb.MempoolStatusResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	status, err := s.wallet.MempoolStatus(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	resp := &pb.MempoolStatusResponse{
+		InMempool: status.InMempool,
+		Confirmed: status.Confirmed,
+	}
+	if status.InMempool {
+		resp.Fee = int64(status.Fee)
+		resp.Size = status.Size
+		resp.Time = status.Time.Unix()
+		resp.Height = status.Height
+		resp.AncestorCount = status.AncestorCount
+		resp.DescendantCount = status.DescendantCount
+	}
+	return resp, nil
+}
+
+func (s *walletServer) AddressUsage(ctx context.Context, req *pb.AddressUsageRequest) (
+	*pb.AddressUsageResponse, error) {
+
+	usage, err := s.wallet.AddressUsage(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	accounts := make([]*pb.AddressUsageResponse_Account, len(usage))
+	for i := range usage {
+		u := &usage[i]
+		accounts[i] = &pb.AddressUsageResponse_Account{
+			AccountNumber:     u.AccountNumber,
+			AccountName:       u.AccountName,
+			ExternalKeyCount:  u.ExternalKeyCount,
+			InternalKeyCount:  u.InternalKeyCount,
+			ExternalUsedCount: u.ExternalUsedCount,
+			InternalUsedCount: u.InternalUsedCount,
 		}
-		minRecentTxs = int(req.MinimumRecentTransactions)
-		if minRecentTxs < 0 {
-			return nil, grpc.Errorf(codes.InvalidArgument,
-				"minimum number of recent transactions may not be negative")
+	}
+	return &pb.AddressUsageResponse{
+		Accounts: accounts,
+		GapLimit: s.wallet.Manager.GapLimit(),
+	}, nil
+}
+
+func (s *walletServer) TotalReceivedByAddress(ctx context.Context, req *pb.TotalReceivedByAddressRequest) (
+	*pb.TotalReceivedByAddressResponse, error) {
+
+	if req.RequiredConfirmations < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"required_confirmations must be non-negative")
+	}
+	addr, err := bchutil.DecodeAddress(req.Address, s.wallet.ChainParams())
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"invalid address %q: %v", req.Address, err)
+	}
+	if !addr.IsForNet(s.wallet.ChainParams()) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not for %s", req.Address,
+			s.wallet.ChainParams().Name)
+	}
+
+	total, err := s.wallet.TotalReceivedForAddr(addr, req.RequiredConfirmations)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.TotalReceivedByAddressResponse{TotalReceived: int64(total)}, nil
+}
+
+func (s *walletServer) TotalReceivedByAccount(ctx context.Context, req *pb.TotalReceivedByAccountRequest) (
+	*pb.TotalReceivedByAccountResponse, error) {
+
+	if req.RequiredConfirmations < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"required_confirmations must be non-negative")
+	}
+
+	total, err := s.wallet.TotalReceivedForAccount(waddrmgr.KeyScopeBIP0044,
+		req.Account, req.RequiredConfirmations)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.TotalReceivedByAccountResponse{TotalReceived: int64(total)}, nil
+}
+
+func (s *walletServer) ImmatureCoinbaseOutputs(ctx context.Context, req *pb.ImmatureCoinbaseOutputsRequest) (
+	*pb.ImmatureCoinbaseOutputsResponse, error) {
+
+	immature, err := s.wallet.ImmatureCoinbaseOutputs(req.Account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	outputs := make([]*pb.ImmatureCoinbaseOutputsResponse_Output, len(immature))
+	for i := range immature {
+		output := &immature[i]
+		outputs[i] = &pb.ImmatureCoinbaseOutputsResponse_Output{
+			TransactionHash:   output.OutPoint.Hash[:],
+			OutputIndex:       output.OutPoint.Index,
+			Amount:            int64(output.Amount),
+			Height:            output.Height,
+			MaturityHeight:    output.MaturityHeight,
+			BlocksUntilMature: output.BlocksUntilMature,
 		}
 	}
+	return &pb.ImmatureCoinbaseOutputsResponse{Outputs: outputs}, nil
+}
 
-	_ = minRecentTxs
+func (s *walletServer) UnlockState(ctx context.Context, req *pb.UnlockStateRequest) (
+	*pb.UnlockStateResponse, error) {
+
+	resp := &pb.UnlockStateResponse{Locked: s.wallet.Locked()}
+	if until := s.wallet.UnlockedUntil(); !until.IsZero() {
+		resp.UnlockedUntil = until.Unix()
+	}
+	return resp, nil
+}
+
+func (s *walletServer) WalletInfo(ctx context.Context, req *pb.WalletInfoRequest) (
+	*pb.WalletInfoResponse, error) {
+
+	info, err := s.wallet.Info()
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.WalletInfoResponse{
+		Version:              info.Version,
+		PublicEncrypted:      info.PublicEncrypted,
+		Locked:               info.Locked,
+		WatchingOnly:         info.WatchingOnly,
+		AccountCount:         info.AccountCount,
+		OutputCount:          uint32(info.OutputCount),
+		UnspentOutputCount:   uint32(info.UnspentOutputCount),
+		SyncedToHeight:       info.SyncedTo.Height,
+		SyncedToHash:         info.SyncedTo.Hash[:],
+		Birthday:             info.Birthday.Unix(),
+		ChainClientConnected: info.ChainClientConnected,
+	}
+	if info.BirthdayBlock != nil {
+		resp.BirthdayBlockHeight = info.BirthdayBlock.Height
+		resp.BirthdayBlockHash = info.BirthdayBlock.Hash[:]
+	}
+	return resp, nil
+}
+
+func (s *walletServer) GetAccountAddresses(ctx context.Context, req *pb.GetAccountAddressesRequest) (
+	*pb.GetAccountAddressesResponse, error) {
+
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := waddrmgr.KeyScope{Purpose: req.Purpose, Coin: req.CoinType}
+	if req.Purpose == 0 && req.CoinType == 0 {
+		scope = waddrmgr.KeyScopeBIP0044
+	}
+	addrs, err := s.wallet.AccountAddressDetails(scope, req.Account)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp := &pb.GetAccountAddressesResponse{
+		Addresses: make([]*pb.GetAccountAddressesResponse_Address, len(addrs)),
+	}
+	for i := range addrs {
+		a := &addrs[i]
+		resp.Addresses[i] = &pb.GetAccountAddressesResponse_Address{
+			Address:  enc.encode(a.Address),
+			Internal: a.Internal,
+			Index:    a.Index,
+			Imported: a.Imported,
+			Used:     a.Used,
+		}
+	}
+	return resp, nil
+}
+
+func (s *walletServer) GetAccountExtendedPubKey(ctx context.Context, req *pb.GetAccountExtendedPubKeyRequest) (
+	*pb.GetAccountExtendedPubKeyResponse, error) {
 
-	gtr, err := s.wallet.GetTransactions(startBlock, endBlock, ctx.Done())
+	scope := waddrmgr.KeyScope{Purpose: req.Purpose, Coin: req.CoinType}
+	if req.Purpose == 0 && req.CoinType == 0 {
+		scope = waddrmgr.KeyScopeBIP0044
+	}
+	key, err := s.wallet.AccountExtendedPubKey(scope, req.Account)
+	if waddrmgr.IsError(err, waddrmgr.ErrAccountNumTooHigh) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"account %d has no extended public key", req.Account)
+	}
 	if err != nil {
 		return nil, translateError(err)
 	}
-	return marshalGetTransactionsResult(gtr)
+	return &pb.GetAccountExtendedPubKeyResponse{
+		ExtendedPubKey: key.String(),
+	}, nil
 }
 
 func (s *walletServer) ChangePassphrase(ctx context.Context, req *pb.ChangePassphraseRequest) (
@@ -636,6 +1486,30 @@ func (s *walletServer) ChangePassphrase(ctx context.Context, req *pb.ChangePassp
 	return &pb.ChangePassphraseResponse{}, nil
 }
 
+func (s *walletServer) UnlockWallet(ctx context.Context, req *pb.UnlockWalletRequest) (
+	*pb.UnlockWalletResponse, error) {
+
+	defer zero.Bytes(req.Passphrase)
+
+	if req.TimeoutSeconds < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"timeout_seconds may not be negative")
+	}
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	err := s.wallet.UnlockFor(req.Passphrase, timeout)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.UnlockWalletResponse{}, nil
+}
+
+func (s *walletServer) LockWallet(ctx context.Context, req *pb.LockWalletRequest) (
+	*pb.LockWalletResponse, error) {
+
+	s.wallet.Lock()
+	return &pb.LockWalletResponse{}, nil
+}
+
 // BUGS:
 // - InputIndexes request field is ignored.
 func (s *walletServer) SignTransaction(ctx context.Context, req *pb.SignTransactionRequest) (
@@ -682,13 +1556,6 @@ func (s *walletServer) SignTransaction(ctx context.Context, req *pb.SignTransact
 	return resp, nil
 }
 
-// BUGS:
-//   - The transaction is not inspected to be relevant before publishing using
-//     sendrawtransaction, so connection errors to bchd could result in the tx
-//     never being added to the wallet database.
-//   - Once the above bug is fixed, wallet will require a way to purge invalid
-//     transactions from the database when they are rejected by the network, other
-//     than double spending them.
 func (s *walletServer) PublishTransaction(ctx context.Context, req *pb.PublishTransactionRequest) (
 	*pb.PublishTransactionResponse, error) {
 
@@ -707,10 +1574,120 @@ func (s *walletServer) PublishTransaction(ctx context.Context, req *pb.PublishTr
 	return &pb.PublishTransactionResponse{Hash: txid[:]}, nil
 }
 
+func (s *walletServer) RemoveTransaction(ctx context.Context, req *pb.RemoveTransactionRequest) (
+	*pb.RemoveTransactionResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	err = s.wallet.RemoveUnminedTransaction(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.RemoveTransactionResponse{}, nil
+}
+
+func (s *walletServer) SetTransactionLabel(ctx context.Context, req *pb.SetTransactionLabelRequest) (
+	*pb.SetTransactionLabelResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	err = s.wallet.PutTxLabel(txHash, req.Label)
+	if err == wallet.ErrLabelTooLong {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.SetTransactionLabelResponse{}, nil
+}
+
+func (s *walletServer) GetTransactionLabel(ctx context.Context, req *pb.GetTransactionLabelRequest) (
+	*pb.GetTransactionLabelResponse, error) {
+
+	txHash, err := chainhash.NewHash(req.TransactionHash)
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	label, err := s.wallet.FetchTxLabel(txHash)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.GetTransactionLabelResponse{Label: label}, nil
+}
+
+func (s *walletServer) SetAddressLabel(ctx context.Context, req *pb.SetAddressLabelRequest) (
+	*pb.SetAddressLabelResponse, error) {
+
+	addr, err := bchutil.DecodeAddress(req.Address, s.wallet.ChainParams())
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"invalid address %q: %v", req.Address, err)
+	}
+	if !addr.IsForNet(s.wallet.ChainParams()) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not for %s", req.Address,
+			s.wallet.ChainParams().Name)
+	}
+
+	err = s.wallet.PutAddressLabel(addr, req.Label)
+	if err == wallet.ErrLabelTooLong {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+	if waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+		return nil, grpc.Errorf(codes.NotFound,
+			"address %q is not known to the wallet", req.Address)
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.SetAddressLabelResponse{}, nil
+}
+
+func (s *walletServer) GetAddressLabel(ctx context.Context, req *pb.GetAddressLabelRequest) (
+	*pb.GetAddressLabelResponse, error) {
+
+	addr, err := bchutil.DecodeAddress(req.Address, s.wallet.ChainParams())
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"invalid address %q: %v", req.Address, err)
+	}
+	if !addr.IsForNet(s.wallet.ChainParams()) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"address %q is not for %s", req.Address,
+			s.wallet.ChainParams().Name)
+	}
+
+	label, err := s.wallet.FetchAddressLabel(addr)
+	if err != nil {
+		return nil, translateError(err)
+	}
+	return &pb.GetAddressLabelResponse{Label: label}, nil
+}
+
 func (s *walletServer) Rescan(ctx context.Context, req *pb.RescanRequest) (
 	*pb.RescanResponse, error) {
 
-	job, err := s.wallet.NewRescanJob()
+	var job *wallet.RescanJob
+	var err error
+	switch {
+	case req.BeginHeight < 0:
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"begin_height must be non-negative")
+	case req.BeginHeight == 0:
+		job, err = s.wallet.NewRescanJob()
+	default:
+		job, err = s.wallet.NewRescanJobFromHeight(req.BeginHeight)
+	}
+	if errors.Is(err, wallet.ErrRescanHeight) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -721,6 +1698,11 @@ func (s *walletServer) Rescan(ctx context.Context, req *pb.RescanRequest) (
 func (s *walletServer) DownloadPaymentRequest(ctx context.Context, req *pb.DownloadPaymentRequestRequest) (
 	*pb.DownloadPaymentRequestResponse, error) {
 
+	enc, err := s.addressEncoder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	client := pymtproto.NewPaymentProtocolClient(s.wallet.ChainParams(), s.wallet.GetProxyDialer())
 	pr, err := client.DownloadBip0070PaymentRequest(req.Uri)
 	if err != nil {
@@ -736,7 +1718,7 @@ func (s *walletServer) DownloadPaymentRequest(ctx context.Context, req *pb.Downl
 	for _, out := range pr.Outputs {
 		output := &pb.DownloadPaymentRequestResponse_Output{
 			Amount:  int64(out.Amount.ToUnit(bchutil.AmountSatoshi)),
-			Address: out.Address.String(),
+			Address: enc.encode(out.Address),
 		}
 		resp.Outputs = append(resp.Outputs, output)
 	}
@@ -784,12 +1766,104 @@ func (s *walletServer) PostPayment(ctx context.Context, req *pb.PostPaymentReque
 func (s *walletServer) ValidateAddress(ctx context.Context, req *pb.ValidateAddressRequest) (
 	*pb.ValidateAddressResponse, error) {
 
-	valid := false
-	_, err := bchutil.DecodeAddress(req.Address, s.wallet.ChainParams())
-	if err == nil {
-		valid = true
+	resp := &pb.ValidateAddressResponse{}
+	params := s.wallet.ChainParams()
+	addr, err := bchutil.DecodeAddress(req.Address, params)
+	if err != nil || !addr.IsForNet(params) {
+		return resp, nil
+	}
+	resp.Valid = true
+	resp.AddressType = addressType(addr)
+	switch addr.(type) {
+	case *bchutil.AddressScriptHash, *bchutil.AddressScriptHash32:
+		resp.IsScript = true
+	}
+
+	// Ownership details are only reported for addresses of the wallet.
+	ma, err := s.wallet.AddressInfo(addr)
+	if waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+		return resp, nil
+	}
+	if err != nil {
+		return nil, translateError(err)
+	}
+	resp.IsMine = true
+	resp.IsChange = ma.Internal()
+	resp.Account = ma.Account()
+	if pka, ok := ma.(waddrmgr.ManagedPubKeyAddress); ok {
+		if scope, path, ok := pka.DerivationInfo(); ok {
+			resp.DerivationPath = &pb.ValidateAddressResponse_DerivationPath{
+				Purpose:  scope.Purpose,
+				CoinType: scope.Coin,
+				Account:  path.Account,
+				Branch:   path.Branch,
+				Index:    path.Index,
+			}
+		}
+	}
+	return resp, nil
+}
+
+// maxValidateAddresses is the maximum number of addresses which may be
+// validated by a single ValidateAddresses request.
+const maxValidateAddresses = 1000
+
+func (s *walletServer) ValidateAddresses(ctx context.Context, req *pb.ValidateAddressesRequest) (
+	*pb.ValidateAddressesResponse, error) {
+
+	if len(req.Addresses) > maxValidateAddresses {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"too many addresses (%d, maximum is %d)", len(req.Addresses),
+			maxValidateAddresses)
+	}
+
+	results := make([]*pb.ValidateAddressesResponse_Result, len(req.Addresses))
+	for i, address := range req.Addresses {
+		result, err := s.validateAddress(address)
+		if err != nil {
+			return nil, translateError(err)
+		}
+		results[i] = result
+	}
+	return &pb.ValidateAddressesResponse{Results: results}, nil
+}
+
+// validateAddress checks whether address is a valid encoding of an address
+// for the wallet's network and, if it is, reports its type and whether it is
+// owned by the wallet.
+func (s *walletServer) validateAddress(address string) (
+	*pb.ValidateAddressesResponse_Result, error) {
+
+	result := &pb.ValidateAddressesResponse_Result{Address: address}
+	params := s.wallet.ChainParams()
+	addr, err := bchutil.DecodeAddress(address, params)
+	if err != nil || !addr.IsForNet(params) {
+		return result, nil
+	}
+	isMine, err := s.wallet.HaveAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	result.Valid = true
+	result.AddressType = addressType(addr)
+	result.IsMine = isMine
+	return result, nil
+}
+
+// addressType returns the name of the output script type paying to addr.
+func addressType(addr bchutil.Address) string {
+	switch addr.(type) {
+	case *bchutil.AddressPubKeyHash:
+		return "P2PKH"
+	case *bchutil.AddressScriptHash:
+		return "P2SH"
+	case *bchutil.AddressScriptHash32:
+		return "P2SH32"
+	case *bchutil.AddressPubKey:
+		return "P2PK"
+	default:
+		return "unknown"
 	}
-	return &pb.ValidateAddressResponse{Valid: valid}, nil
 }
 
 func marshalTransactionInputs(v []wallet.TransactionSummaryInput) []*pb.TransactionDetails_Input {
@@ -805,13 +1879,15 @@ func marshalTransactionInputs(v []wallet.TransactionSummaryInput) []*pb.Transact
 	return inputs
 }
 
-func marshalTransactionOutputs(v []wallet.TransactionSummaryOutput) []*pb.TransactionDetails_Output {
+func marshalTransactionOutputs(v []wallet.TransactionSummaryOutput,
+	enc addressEncoder) []*pb.TransactionDetails_Output {
+
 	outputs := make([]*pb.TransactionDetails_Output, len(v))
 	for i := range v {
 		output := &v[i]
 		var address string
 		if output.Address != nil {
-			address = output.Address.String()
+			address = enc.encode(output.Address)
 		}
 		outputs[i] = &pb.TransactionDetails_Output{
 			Index:    output.Index,
@@ -824,7 +1900,9 @@ func marshalTransactionOutputs(v []wallet.TransactionSummaryOutput) []*pb.Transa
 	return outputs
 }
 
-func marshalTransactionDetails(v []wallet.TransactionSummary) []*pb.TransactionDetails {
+func marshalTransactionDetails(v []wallet.TransactionSummary,
+	enc addressEncoder) []*pb.TransactionDetails {
+
 	txs := make([]*pb.TransactionDetails, len(v))
 	for i := range v {
 		tx := &v[i]
@@ -832,15 +1910,16 @@ func marshalTransactionDetails(v []wallet.TransactionSummary) []*pb.TransactionD
 			Hash:        tx.Hash[:],
 			Transaction: tx.Transaction,
 			Debits:      marshalTransactionInputs(tx.MyInputs),
-			Credits:     marshalTransactionOutputs(tx.MyOutputs),
+			Credits:     marshalTransactionOutputs(tx.MyOutputs, enc),
 			Fee:         int64(tx.Fee),
 			Timestamp:   tx.Timestamp,
+			Label:       tx.Label,
 		}
 	}
 	return txs
 }
 
-func marshalBlocks(v []wallet.Block) []*pb.BlockDetails {
+func marshalBlocks(v []wallet.Block, enc addressEncoder) []*pb.BlockDetails {
 	blocks := make([]*pb.BlockDetails, len(v))
 	for i := range v {
 		block := &v[i]
@@ -848,7 +1927,7 @@ func marshalBlocks(v []wallet.Block) []*pb.BlockDetails {
 			Hash:         block.Hash[:],
 			Height:       block.Height,
 			Timestamp:    block.Timestamp,
-			Transactions: marshalTransactionDetails(block.Transactions),
+			Transactions: marshalTransactionDetails(block.Transactions, enc),
 		}
 	}
 	return blocks
@@ -877,6 +1956,11 @@ func marshalAccountBalances(v []wallet.AccountBalance) []*pb.AccountBalance {
 func (s *walletServer) TransactionNotifications(req *pb.TransactionNotificationsRequest,
 	svr pb.WalletService_TransactionNotificationsServer) error {
 
+	enc, err := s.addressEncoder(svr.Context())
+	if err != nil {
+		return err
+	}
+
 	n := s.wallet.NtfnServer.TransactionNotifications()
 	defer n.Done()
 
@@ -885,9 +1969,9 @@ func (s *walletServer) TransactionNotifications(req *pb.TransactionNotifications
 		select {
 		case v := <-n.C:
 			resp := pb.TransactionNotificationsResponse{
-				AttachedBlocks:           marshalBlocks(v.AttachedBlocks),
+				AttachedBlocks:           marshalBlocks(v.AttachedBlocks, enc),
 				DetachedBlocks:           marshalHashes(v.DetachedBlocks),
-				UnminedTransactions:      marshalTransactionDetails(v.UnminedTransactions),
+				UnminedTransactions:      marshalTransactionDetails(v.UnminedTransactions, enc),
 				UnminedTransactionHashes: marshalHashes(v.UnminedTransactionHashes),
 			}
 			err := svr.Send(&resp)
@@ -996,6 +2080,75 @@ func (s *walletServer) RescanNotifications(req *pb.RescanNotificationsRequest,
 	}
 }
 
+func (s *walletServer) AddressPaymentNotifications(req *pb.AddressPaymentNotificationsRequest,
+	svr pb.WalletService_AddressPaymentNotificationsServer) error {
+
+	if len(req.Addresses) == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "no addresses to watch")
+	}
+	if req.TargetConfirmations < 0 {
+		return grpc.Errorf(codes.InvalidArgument,
+			"target_confirmations must be non-negative")
+	}
+	enc, err := s.addressEncoder(svr.Context())
+	if err != nil {
+		return err
+	}
+
+	params := s.wallet.ChainParams()
+	addrs := make([]bchutil.Address, 0, len(req.Addresses))
+	for _, address := range req.Addresses {
+		addr, err := bchutil.DecodeAddress(address, params)
+		if err != nil {
+			return grpc.Errorf(codes.InvalidArgument,
+				"invalid address %q: %v", address, err)
+		}
+		if !addr.IsForNet(params) {
+			return grpc.Errorf(codes.InvalidArgument,
+				"address %q is not for %s", address, params.Name)
+		}
+		isMine, err := s.wallet.HaveAddress(addr)
+		if err != nil {
+			return translateError(err)
+		}
+		if !isMine {
+			return grpc.Errorf(codes.NotFound,
+				"address %q is not in the wallet", address)
+		}
+		addrs = append(addrs, addr)
+	}
+	filter, err := wallet.NewAddressPaymentFilter(addrs,
+		req.TargetConfirmations)
+	if err != nil {
+		return grpc.Errorf(codes.InvalidArgument, "%s", err.Error())
+	}
+
+	n := s.wallet.NtfnServer.TransactionNotifications()
+	defer n.Done()
+
+	ctxDone := svr.Context().Done()
+	for {
+		select {
+		case v := <-n.C:
+			for _, p := range filter.Filter(v) {
+				resp := pb.AddressPaymentNotificationsResponse{
+					Address:         enc.encode(p.Address),
+					TransactionHash: p.Hash[:],
+					Amount:          int64(p.Amount),
+					Confirmations:   p.Confirmations,
+				}
+				err := svr.Send(&resp)
+				if err != nil {
+					return translateError(err)
+				}
+			}
+
+		case <-ctxDone:
+			return nil
+		}
+	}
+}
+
 // StartWalletLoaderService creates an implementation of the WalletLoaderService
 // and registers it with the gRPC server.
 func StartWalletLoaderService(server *grpc.Server, loader *wallet.Loader, activeNet *netparams.Params) {
@@ -1013,15 +2166,29 @@ func (s *loaderServer) checkReady() bool {
 func (s *loaderServer) CreateWallet(ctx context.Context, req *pb.CreateWalletRequest) (
 	*pb.CreateWalletResponse, error) {
 
-	seed := bip39.NewSeed(req.MnemonicSeed, "")
-
+	var seed []byte
 	defer func() {
 		zero.Bytes(req.PrivatePassphrase)
 		zero.Bytes(seed)
 		req.WalletBirthday = 0
 		req.MnemonicSeed = ""
+		req.Bip39Passphrase = ""
 	}()
 
+	// A mistyped mnemonic would otherwise create a new, empty wallet
+	// rather than restoring the intended one.
+	words := len(strings.Fields(req.MnemonicSeed))
+	if req.WordCount != 0 && words != int(req.WordCount) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"mnemonic has %d words, expected %d", words, req.WordCount)
+	}
+	if !bip39.IsMnemonicValid(req.MnemonicSeed) {
+		return nil, grpc.Errorf(codes.InvalidArgument,
+			"mnemonic is not a valid BIP0039 mnemonic: check for "+
+				"misspelled, missing or reordered words")
+	}
+	seed = bip39.NewSeed(req.MnemonicSeed, req.Bip39Passphrase)
+
 	// Use an insecure public passphrase when the request's is empty.
 	pubPassphrase := req.PublicPassphrase
 	if len(pubPassphrase) == 0 {
@@ -0,0 +1,609 @@
+package rpcserver
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+	pb "github.com/gcash/bchwallet/rpc/walletrpc"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/wallet"
+	"github.com/gcash/bchwallet/walletdb"
+	_ "github.com/gcash/bchwallet/walletdb/bdb"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// TestOutputScript ensures output addresses which cannot be paid to are
+// rejected with an InvalidArgument error.
+func TestOutputScript(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	addr, err := bchutil.NewAddressScriptHash([]byte{0x51}, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, err := outputScript(0, addr.EncodeAddress(), params)
+	if err != nil {
+		t.Fatalf("outputScript: %v", err)
+	}
+	if string(script) != string(want) {
+		t.Fatalf("got script %x, want %x", script, want)
+	}
+
+	testNetAddr, err := bchutil.NewAddressScriptHash([]byte{0x51},
+		&chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid := []string{"", "bitcoincash:qqqqqqqq", testNetAddr.EncodeAddress()}
+	for i, address := range invalid {
+		_, err := outputScript(i, address, params)
+		if grpc.Code(err) != codes.InvalidArgument {
+			t.Errorf("address %q: got error %v, want InvalidArgument",
+				address, err)
+		}
+	}
+}
+
+// TestErrorCode ensures wallet and database errors are mapped to actionable
+// gRPC codes.
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		err  error
+		want codes.Code
+	}{
+		{walletdb.ErrDbNotOpen, codes.Aborted},
+		{walletdb.ErrDbExists, codes.AlreadyExists},
+		{walletdb.ErrDbDoesNotExist, codes.NotFound},
+		{walletdb.ErrBucketNotFound, codes.FailedPrecondition},
+		{walletdb.ErrBucketExists, codes.AlreadyExists},
+		{wallet.ErrDiscoveryInterrupted, codes.Aborted},
+		{waddrmgr.ManagerError{
+			ErrorCode: waddrmgr.ErrDatabase,
+			Err:       walletdb.ErrBucketNotFound,
+		}, codes.FailedPrecondition},
+		{fmt.Errorf("%w: database version 2", wallet.ErrWalletVersion),
+			codes.FailedPrecondition},
+		{errors.New("unexpected"), codes.Unknown},
+	}
+	for _, test := range tests {
+		if got := errorCode(test.err); got != test.want {
+			t.Errorf("errorCode(%v) = %v, want %v", test.err, got,
+				test.want)
+		}
+	}
+}
+
+// TestValidateAddressesLimit ensures requests validating more than the
+// maximum number of addresses are rejected.
+func TestValidateAddressesLimit(t *testing.T) {
+	s := &walletServer{}
+	req := &pb.ValidateAddressesRequest{
+		Addresses: make([]string, maxValidateAddresses+1),
+	}
+	_, err := s.ValidateAddresses(context.Background(), req)
+	if grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got error %v, want InvalidArgument", err)
+	}
+}
+
+// TestNextAddressesLimit ensures requests for no addresses or more than the
+// maximum number of addresses are rejected.
+func TestNextAddressesLimit(t *testing.T) {
+	s := &walletServer{}
+	for _, count := range []uint32{0, maxNextAddresses + 1} {
+		req := &pb.NextAddressesRequest{Count: count}
+		_, err := s.NextAddresses(context.Background(), req)
+		if grpc.Code(err) != codes.InvalidArgument {
+			t.Fatalf("count %d: got error %v, want InvalidArgument",
+				count, err)
+		}
+	}
+}
+
+// TestDiscoverAccountsGapLimit ensures requests with a gap limit of zero or
+// more than the maximum are rejected.
+func TestDiscoverAccountsGapLimit(t *testing.T) {
+	s := &walletServer{}
+	for _, gapLimit := range []uint32{0, maxDiscoverGapLimit + 1} {
+		req := &pb.DiscoverAccountsRequest{GapLimit: gapLimit}
+		_, err := s.DiscoverAccounts(context.Background(), req)
+		if grpc.Code(err) != codes.InvalidArgument {
+			t.Fatalf("gap limit %d: got error %v, want "+
+				"InvalidArgument", gapLimit, err)
+		}
+	}
+}
+
+// testWalletServer creates a server for a new testnet wallet with the private
+// passphrase "world".
+func testWalletServer(t *testing.T) (*walletServer, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "rpcserver_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	loader := wallet.NewLoader(&chaincfg.TestNet3Params, dir, true, 250)
+	w, err := loader.CreateNewWallet([]byte("hello"), []byte("world"), nil,
+		time.Now())
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	return &walletServer{wallet: w}, func() {
+		loader.UnloadWallet()
+		os.RemoveAll(dir)
+	}
+}
+
+// testAccountAddress returns the address of account 0 with the given branch
+// and index.
+func testAccountAddress(t *testing.T, w *wallet.Wallet, internal bool,
+	index uint32) bchutil.Address {
+
+	t.Helper()
+
+	addrs, err := w.AccountAddressDetails(waddrmgr.KeyScopeBIP0044, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range addrs {
+		if addrs[i].Internal == internal && addrs[i].Index == index {
+			return addrs[i].Address
+		}
+	}
+	t.Fatalf("wallet has no address with index %d", index)
+	return nil
+}
+
+// TestValidateAddress ensures ownership details are reported for the
+// addresses of the wallet, and only validity and type for other addresses.
+func TestValidateAddress(t *testing.T) {
+	s, cleanup := testWalletServer(t)
+	defer cleanup()
+	params := s.wallet.ChainParams()
+
+	change := testAccountAddress(t, s.wallet, true, 1)
+	resp, err := s.ValidateAddress(context.Background(),
+		&pb.ValidateAddressRequest{Address: change.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := resp.DerivationPath
+	if !resp.Valid || !resp.IsMine || !resp.IsChange || resp.IsScript ||
+		resp.AddressType != "P2PKH" || resp.Account != 0 || path == nil ||
+		path.Purpose != 44 || path.CoinType != params.HDCoinType ||
+		path.Account != 0 || path.Branch != 1 || path.Index != 1 {
+
+		t.Fatalf("unexpected result for change address: %v", resp)
+	}
+
+	foreign, err := bchutil.NewAddressScriptHashFromHash(make([]byte, 20),
+		params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = s.ValidateAddress(context.Background(),
+		&pb.ValidateAddressRequest{Address: foreign.String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Valid || resp.IsMine || !resp.IsScript ||
+		resp.AddressType != "P2SH" || resp.DerivationPath != nil {
+
+		t.Fatalf("unexpected result for foreign address: %v", resp)
+	}
+
+	resp, err = s.ValidateAddress(context.Background(),
+		&pb.ValidateAddressRequest{Address: "invalid"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Valid {
+		t.Fatalf("invalid address reported valid: %v", resp)
+	}
+}
+
+// paymentNotificationsStream is a mock stream of address payment
+// notifications serving only its context.
+type paymentNotificationsStream struct {
+	pb.WalletService_AddressPaymentNotificationsServer
+	ctx context.Context
+}
+
+func (s *paymentNotificationsStream) Context() context.Context {
+	return s.ctx
+}
+
+// TestAddressPaymentNotificationsOwnership ensures payment notifications are
+// only streamed for addresses of the wallet.
+func TestAddressPaymentNotificationsOwnership(t *testing.T) {
+	s, cleanup := testWalletServer(t)
+	defer cleanup()
+	params := s.wallet.ChainParams()
+
+	foreign, err := bchutil.NewAddressPubKeyHash(make([]byte, 20), params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	owned := testAccountAddress(t, s.wallet, false, 0)
+
+	// The stream ends immediately once its addresses are accepted.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	svr := &paymentNotificationsStream{ctx: ctx}
+
+	err = s.AddressPaymentNotifications(&pb.AddressPaymentNotificationsRequest{
+		Addresses: []string{owned.String(), foreign.String()},
+	}, svr)
+	if grpc.Code(err) != codes.NotFound {
+		t.Fatalf("got error %v, want NotFound", err)
+	}
+
+	err = s.AddressPaymentNotifications(&pb.AddressPaymentNotificationsRequest{
+		Addresses: []string{owned.String()},
+	}, svr)
+	if err != nil {
+		t.Fatalf("unable to watch wallet address: %v", err)
+	}
+}
+
+// TestSignMessage ensures a message signed by a wallet address verifies
+// against that address only.
+func TestSignMessage(t *testing.T) {
+	s, cleanup := testWalletServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	addr := testAccountAddress(t, s.wallet, false, 0).String()
+	other := testAccountAddress(t, s.wallet, false, 1).String()
+	const message = "hello world"
+
+	_, err := s.SignMessage(ctx, &pb.SignMessageRequest{
+		Address:    addr,
+		Message:    message,
+		Passphrase: []byte("wrong"),
+	})
+	if grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got error %v, want InvalidArgument", err)
+	}
+	signed, err := s.SignMessage(ctx, &pb.SignMessageRequest{
+		Address:    addr,
+		Message:    message,
+		Passphrase: []byte("world"),
+	})
+	if err != nil {
+		t.Fatalf("unable to sign message: %v", err)
+	}
+
+	tests := []struct {
+		addr    string
+		message string
+		valid   bool
+	}{
+		{addr, message, true},
+		{addr, "goodbye world", false},
+		{other, message, false},
+	}
+	for _, test := range tests {
+		resp, err := s.VerifyMessage(ctx, &pb.VerifyMessageRequest{
+			Address:   test.addr,
+			Message:   test.message,
+			Signature: signed.Signature,
+		})
+		if err != nil {
+			t.Fatalf("unable to verify message: %v", err)
+		}
+		if resp.Valid != test.valid {
+			t.Fatalf("%s signing %q: got valid %v, want %v",
+				test.addr, test.message, resp.Valid, test.valid)
+		}
+	}
+
+	_, err = s.VerifyMessage(ctx, &pb.VerifyMessageRequest{
+		Address:   addr,
+		Message:   message,
+		Signature: "not base64!",
+	})
+	if grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got error %v, want InvalidArgument", err)
+	}
+}
+
+// TestCreateWalletBip39Passphrase ensures a BIP0039 passphrase given at
+// wallet creation changes the seed the wallet keys are derived from.
+func TestCreateWalletBip39Passphrase(t *testing.T) {
+	entropy, err := bip39.NewEntropy(128)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mnemonic, err := bip39.NewMnemonic(entropy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	firstAddress := func(passphrase string) string {
+		t.Helper()
+
+		dir, err := ioutil.TempDir("", "rpcserver_test")
+		if err != nil {
+			t.Fatalf("Failed to create db dir: %v", err)
+		}
+		defer os.RemoveAll(dir)
+
+		s := &loaderServer{
+			loader: wallet.NewLoader(&chaincfg.TestNet3Params, dir,
+				true, 250),
+		}
+		_, err = s.CreateWallet(context.Background(),
+			&pb.CreateWalletRequest{
+				PrivatePassphrase: []byte("world"),
+				MnemonicSeed:      mnemonic,
+				Bip39Passphrase:   passphrase,
+			})
+		if err != nil {
+			t.Fatalf("unable to create wallet: %v", err)
+		}
+		defer s.loader.UnloadWallet()
+
+		w, ok := s.loader.LoadedWallet()
+		if !ok {
+			t.Fatal("wallet was not loaded")
+		}
+		return testAccountAddress(t, w, false, 0).String()
+	}
+
+	withoutPassphrase := firstAddress("")
+	if withoutPassphrase != firstAddress("") {
+		t.Fatal("same mnemonic derived different addresses")
+	}
+	if withoutPassphrase == firstAddress("passphrase") {
+		t.Fatal("BIP0039 passphrase did not change the derived address")
+	}
+}
+
+// TestCreateWalletMnemonic ensures invalid mnemonics and mnemonics with an
+// unexpected number of words are rejected before a wallet is created.
+func TestCreateWalletMnemonic(t *testing.T) {
+	const valid = "abandon abandon abandon abandon abandon abandon " +
+		"abandon abandon abandon abandon abandon about"
+
+	s := &loaderServer{}
+	reqs := []*pb.CreateWalletRequest{
+		{MnemonicSeed: ""},
+		{MnemonicSeed: strings.Repeat("abandon ", 12)},
+		{MnemonicSeed: strings.Replace(valid, "about", "abuot", 1)},
+		{MnemonicSeed: valid, WordCount: 24},
+	}
+	for _, req := range reqs {
+		// The request's mnemonic is cleared by the call.
+		mnemonic := req.MnemonicSeed
+		_, err := s.CreateWallet(context.Background(), req)
+		if grpc.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%q: got error %v, want InvalidArgument",
+				mnemonic, err)
+		}
+	}
+}
+
+// TestCreateTransactionFeeRate ensures requests must give either a fee rate or
+// request an estimated one, but not both.
+func TestCreateTransactionFeeRate(t *testing.T) {
+	s := &walletServer{}
+	reqs := []*pb.CreateTransactionRequest{
+		{},
+		{ConfTarget: 6},
+		{SatPerKbFee: 1000, ConfTarget: 6},
+		{UseEstimateFee: true},
+		{UseEstimateFee: true, ConfTarget: 6, SatPerKbFee: 1000},
+	}
+	for _, req := range reqs {
+		_, err := s.CreateTransaction(context.Background(), req)
+		if grpc.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%+v: got error %v, want InvalidArgument", req,
+				err)
+		}
+	}
+}
+
+// TestCreateTransactionSelectedOutpoints ensures selected outpoints with
+// invalid hashes, or combined with options that select inputs or pay change
+// differently, are rejected.
+func TestCreateTransactionSelectedOutpoints(t *testing.T) {
+	s := &walletServer{}
+	valid := []*pb.CreateTransactionRequest_OutPoint{
+		{TransactionHash: make([]byte, 32)},
+	}
+	reqs := []*pb.CreateTransactionRequest{
+		{SatPerKbFee: 1000, SelectedOutpoints: valid,
+			AvoidAddressMixing: true},
+		{SatPerKbFee: 1000, SelectedOutpoints: valid,
+			RequiredConfirmations: 1},
+		{SatPerKbFee: 1000, SelectedOutpoints: valid,
+			SpendImmatureCoinbases: true},
+		{SatPerKbFee: 1000, SelectedOutpoints: []*pb.CreateTransactionRequest_OutPoint{
+			{TransactionHash: []byte{1}},
+		}},
+	}
+	for _, req := range reqs {
+		_, err := s.CreateTransaction(context.Background(), req)
+		if grpc.Code(err) != codes.InvalidArgument {
+			t.Fatalf("%+v: got error %v, want InvalidArgument", req,
+				err)
+		}
+	}
+}
+
+// TestAddressType ensures each address type is reported with the name of the
+// output script paying it.
+func TestAddressType(t *testing.T) {
+	params := &chaincfg.MainNetParams
+	hash := make([]byte, 20)
+
+	pkh, err := bchutil.NewAddressPubKeyHash(hash, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sh, err := bchutil.NewAddressScriptHashFromHash(hash, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		addr bchutil.Address
+		want string
+	}{
+		{pkh, "P2PKH"},
+		{sh, "P2SH"},
+	}
+	for _, test := range tests {
+		if got := addressType(test.addr); got != test.want {
+			t.Errorf("addressType(%v) = %s, want %s", test.addr, got,
+				test.want)
+		}
+	}
+}
+
+// TestMultisigScript ensures multisig redeem scripts are built from valid
+// public keys and that invalid requests are rejected with an InvalidArgument
+// error.
+func TestMultisigScript(t *testing.T) {
+	params := &chaincfg.MainNetParams
+
+	var keys []*bchutil.AddressPubKey
+	var hexKeys []string
+	for i := 0; i < 16; i++ {
+		priv, err := bchec.NewPrivateKey(bchec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		serialized := priv.PubKey().SerializeCompressed()
+		key, err := bchutil.NewAddressPubKey(serialized, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key)
+		hexKeys = append(hexKeys, hex.EncodeToString(serialized))
+	}
+
+	script, err := multisigScript(2, hexKeys[:3], params)
+	if err != nil {
+		t.Fatalf("multisigScript: %v", err)
+	}
+	want, err := txscript.MultiSigScript(keys[:3], 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(script) != string(want) {
+		t.Fatalf("got script %x, want %x", script, want)
+	}
+
+	tests := []struct {
+		required uint32
+		keys     []string
+	}{
+		{0, hexKeys[:3]},
+		{4, hexKeys[:3]},
+		{1, nil},
+		{1, []string{hexKeys[0], "zz"}},
+		{1, []string{hexKeys[0], hexKeys[1][2:]}},
+		// Sixteen compressed keys exceed the maximum redeem script size.
+		{1, hexKeys},
+	}
+	for i, test := range tests {
+		_, err := multisigScript(test.required, test.keys, params)
+		if grpc.Code(err) != codes.InvalidArgument {
+			t.Errorf("test %d: got error %v, want InvalidArgument", i,
+				err)
+		}
+	}
+}
+
+// TestSyncProgress ensures the sync progress reported by the Network method is
+// the fraction of the best height synced to, bounded to between zero and one.
+func TestSyncProgress(t *testing.T) {
+	tests := []struct {
+		syncedTo, bestHeight int32
+		want                 float32
+	}{
+		{syncedTo: 0, bestHeight: 0, want: 1},
+		{syncedTo: 0, bestHeight: 1000, want: 0},
+		{syncedTo: -1, bestHeight: 1000, want: 0},
+		{syncedTo: 250, bestHeight: 1000, want: 0.25},
+		{syncedTo: 1000, bestHeight: 1000, want: 1},
+		{syncedTo: 1001, bestHeight: 1000, want: 1},
+	}
+	for _, test := range tests {
+		got := syncProgress(test.syncedTo, test.bestHeight)
+		if got != test.want {
+			t.Errorf("syncProgress(%d, %d) = %v, want %v",
+				test.syncedTo, test.bestHeight, got, test.want)
+		}
+	}
+}
+
+// TestSweepAccountRequiredConfirmations ensures sweeps requiring a negative
+// number of confirmations are rejected.
+func TestSweepAccountRequiredConfirmations(t *testing.T) {
+	s := &walletServer{}
+	req := &pb.SweepAccountRequest{RequiredConfirmations: -1}
+	_, err := s.SweepAccount(context.Background(), req)
+	if grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got error %v, want InvalidArgument", err)
+	}
+}
+
+// TestRescanBeginHeight ensures rescans may not begin at a negative height.
+func TestRescanBeginHeight(t *testing.T) {
+	s := &walletServer{}
+	req := &pb.RescanRequest{BeginHeight: -1}
+	_, err := s.Rescan(context.Background(), req)
+	if grpc.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got error %v, want InvalidArgument", err)
+	}
+}
+
+// TestWalletInfo ensures the summary of a new wallet reports it locked,
+// encrypted with its public passphrase and without a chain client.
+func TestWalletInfo(t *testing.T) {
+	s, cleanup := testWalletServer(t)
+	defer cleanup()
+
+	resp, err := s.WalletInfo(context.Background(), &pb.WalletInfoRequest{})
+	if err != nil {
+		t.Fatalf("unable to get wallet info: %v", err)
+	}
+	if !resp.Locked || !resp.PublicEncrypted || resp.WatchingOnly {
+		t.Fatalf("unexpected wallet state %+v", resp)
+	}
+	if resp.ChainClientConnected {
+		t.Fatal("wallet without a chain client reported connected")
+	}
+	if resp.AccountCount == 0 {
+		t.Fatal("wallet reported no accounts")
+	}
+	if resp.OutputCount != 0 || resp.UnspentOutputCount != 0 {
+		t.Fatalf("got %d outputs, %d unspent, want none",
+			resp.OutputCount, resp.UnspentOutputCount)
+	}
+	if len(resp.SyncedToHash) != chainhash.HashSize {
+		t.Fatalf("got synced to hash of %d bytes", len(resp.SyncedToHash))
+	}
+}
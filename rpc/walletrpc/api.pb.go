@@ -46,7 +46,7 @@ func (x NextAddressRequest_Kind) String() string {
 }
 
 func (NextAddressRequest_Kind) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17, 0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19, 0}
 }
 
 type ChangePassphraseRequest_Key int32
@@ -71,7 +71,7 @@ func (x ChangePassphraseRequest_Key) String() string {
 }
 
 func (ChangePassphraseRequest_Key) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27, 0}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55, 0}
 }
 
 type VersionRequest struct {
@@ -191,6 +191,7 @@ type TransactionDetails struct {
 	Credits              []*TransactionDetails_Output `protobuf:"bytes,4,rep,name=credits,proto3" json:"credits,omitempty"`
 	Fee                  int64                        `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
 	Timestamp            int64                        `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Label                string                       `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -263,6 +264,13 @@ func (m *TransactionDetails) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *TransactionDetails) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type TransactionDetails_Input struct {
 	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	PreviousAccount      uint32   `protobuf:"varint,2,opt,name=previous_account,json=previousAccount,proto3" json:"previous_account,omitempty"`
@@ -597,6 +605,8 @@ type NetworkResponse struct {
 	BestBlock            string   `protobuf:"bytes,2,opt,name=best_block,json=bestBlock,proto3" json:"best_block,omitempty"`
 	BestHeight           int32    `protobuf:"varint,3,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
 	SyncedTo             int32    `protobuf:"varint,4,opt,name=synced_to,json=syncedTo,proto3" json:"synced_to,omitempty"`
+	Synced               bool     `protobuf:"varint,5,opt,name=synced,proto3" json:"synced,omitempty"`
+	SyncProgress         float32  `protobuf:"fixed32,6,opt,name=sync_progress,json=syncProgress,proto3" json:"sync_progress,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -655,6 +665,20 @@ func (m *NetworkResponse) GetSyncedTo() int32 {
 	return 0
 }
 
+func (m *NetworkResponse) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
+}
+
+func (m *NetworkResponse) GetSyncProgress() float32 {
+	if m != nil {
+		return m.SyncProgress
+	}
+	return 0
+}
+
 type AccountNumberRequest struct {
 	AccountName          string   `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1062,6 +1086,100 @@ func (m *NextAccountResponse) GetAccountNumber() uint32 {
 	return 0
 }
 
+type DiscoverAccountsRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	GapLimit             uint32   `protobuf:"varint,2,opt,name=gap_limit,json=gapLimit,proto3" json:"gap_limit,omitempty"`
+	ScanFromGenesis      bool     `protobuf:"varint,3,opt,name=scan_from_genesis,json=scanFromGenesis,proto3" json:"scan_from_genesis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoverAccountsRequest) Reset()         { *m = DiscoverAccountsRequest{} }
+func (m *DiscoverAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*DiscoverAccountsRequest) ProtoMessage()    {}
+func (*DiscoverAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+}
+
+func (m *DiscoverAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoverAccountsRequest.Unmarshal(m, b)
+}
+func (m *DiscoverAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoverAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *DiscoverAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoverAccountsRequest.Merge(m, src)
+}
+func (m *DiscoverAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_DiscoverAccountsRequest.Size(m)
+}
+func (m *DiscoverAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoverAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoverAccountsRequest proto.InternalMessageInfo
+
+func (m *DiscoverAccountsRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *DiscoverAccountsRequest) GetGapLimit() uint32 {
+	if m != nil {
+		return m.GapLimit
+	}
+	return 0
+}
+
+func (m *DiscoverAccountsRequest) GetScanFromGenesis() bool {
+	if m != nil {
+		return m.ScanFromGenesis
+	}
+	return false
+}
+
+type DiscoverAccountsResponse struct {
+	DiscoveredAccounts   uint32   `protobuf:"varint,1,opt,name=discovered_accounts,json=discoveredAccounts,proto3" json:"discovered_accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiscoverAccountsResponse) Reset()         { *m = DiscoverAccountsResponse{} }
+func (m *DiscoverAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiscoverAccountsResponse) ProtoMessage()    {}
+func (*DiscoverAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+}
+
+func (m *DiscoverAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiscoverAccountsResponse.Unmarshal(m, b)
+}
+func (m *DiscoverAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiscoverAccountsResponse.Marshal(b, m, deterministic)
+}
+func (m *DiscoverAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoverAccountsResponse.Merge(m, src)
+}
+func (m *DiscoverAccountsResponse) XXX_Size() int {
+	return xxx_messageInfo_DiscoverAccountsResponse.Size(m)
+}
+func (m *DiscoverAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoverAccountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoverAccountsResponse proto.InternalMessageInfo
+
+func (m *DiscoverAccountsResponse) GetDiscoveredAccounts() uint32 {
+	if m != nil {
+		return m.DiscoveredAccounts
+	}
+	return 0
+}
+
 type NextAddressRequest struct {
 	Account              uint32                  `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
 	Kind                 NextAddressRequest_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=walletrpc.NextAddressRequest_Kind" json:"kind,omitempty"`
@@ -1074,7 +1192,7 @@ func (m *NextAddressRequest) Reset()         { *m = NextAddressRequest{} }
 func (m *NextAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*NextAddressRequest) ProtoMessage()    {}
 func (*NextAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{17}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
 }
 
 func (m *NextAddressRequest) XXX_Unmarshal(b []byte) error {
@@ -1120,7 +1238,7 @@ func (m *NextAddressResponse) Reset()         { *m = NextAddressResponse{} }
 func (m *NextAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*NextAddressResponse) ProtoMessage()    {}
 func (*NextAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{18}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
 }
 
 func (m *NextAddressResponse) XXX_Unmarshal(b []byte) error {
@@ -1148,6 +1266,108 @@ func (m *NextAddressResponse) GetAddress() string {
 	return ""
 }
 
+type NextAddressesRequest struct {
+	Account              uint32                  `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Kind                 NextAddressRequest_Kind `protobuf:"varint,2,opt,name=kind,enum=walletrpc.NextAddressRequest_Kind,proto3" json:"kind,omitempty"`
+	Count                uint32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *NextAddressesRequest) Reset()         { *m = NextAddressesRequest{} }
+func (m *NextAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*NextAddressesRequest) ProtoMessage()    {}
+func (*NextAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+}
+
+func (m *NextAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextAddressesRequest.Unmarshal(m, b)
+}
+func (m *NextAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextAddressesRequest.Marshal(b, m, deterministic)
+}
+func (m *NextAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextAddressesRequest.Merge(m, src)
+}
+func (m *NextAddressesRequest) XXX_Size() int {
+	return xxx_messageInfo_NextAddressesRequest.Size(m)
+}
+func (m *NextAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextAddressesRequest proto.InternalMessageInfo
+
+func (m *NextAddressesRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *NextAddressesRequest) GetKind() NextAddressRequest_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return NextAddressRequest_BIP0044_EXTERNAL
+}
+
+func (m *NextAddressesRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type NextAddressesResponse struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	FirstIndex           uint32   `protobuf:"varint,2,opt,name=first_index,json=firstIndex,proto3" json:"first_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NextAddressesResponse) Reset()         { *m = NextAddressesResponse{} }
+func (m *NextAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*NextAddressesResponse) ProtoMessage()    {}
+func (*NextAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+}
+
+func (m *NextAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextAddressesResponse.Unmarshal(m, b)
+}
+func (m *NextAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextAddressesResponse.Marshal(b, m, deterministic)
+}
+func (m *NextAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextAddressesResponse.Merge(m, src)
+}
+func (m *NextAddressesResponse) XXX_Size() int {
+	return xxx_messageInfo_NextAddressesResponse.Size(m)
+}
+func (m *NextAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextAddressesResponse proto.InternalMessageInfo
+
+func (m *NextAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *NextAddressesResponse) GetFirstIndex() uint32 {
+	if m != nil {
+		return m.FirstIndex
+	}
+	return 0
+}
+
 type ImportPrivateKeyRequest struct {
 	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 	Account              uint32   `protobuf:"varint,2,opt,name=account,proto3" json:"account,omitempty"`
@@ -1162,7 +1382,7 @@ func (m *ImportPrivateKeyRequest) Reset()         { *m = ImportPrivateKeyRequest
 func (m *ImportPrivateKeyRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportPrivateKeyRequest) ProtoMessage()    {}
 func (*ImportPrivateKeyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{19}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
 }
 
 func (m *ImportPrivateKeyRequest) XXX_Unmarshal(b []byte) error {
@@ -1221,7 +1441,7 @@ func (m *ImportPrivateKeyResponse) Reset()         { *m = ImportPrivateKeyRespon
 func (m *ImportPrivateKeyResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportPrivateKeyResponse) ProtoMessage()    {}
 func (*ImportPrivateKeyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{20}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
 }
 
 func (m *ImportPrivateKeyResponse) XXX_Unmarshal(b []byte) error {
@@ -1242,3702 +1462,7962 @@ func (m *ImportPrivateKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ImportPrivateKeyResponse proto.InternalMessageInfo
 
-type BalanceRequest struct {
-	AccountNumber         uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	RequiredConfirmations int32    `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+type DumpPrivateKeyRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Passphrase           []byte   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
-func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*BalanceRequest) ProtoMessage()    {}
-func (*BalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{21}
+func (m *DumpPrivateKeyRequest) Reset()         { *m = DumpPrivateKeyRequest{} }
+func (m *DumpPrivateKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*DumpPrivateKeyRequest) ProtoMessage()    {}
+func (*DumpPrivateKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
 }
 
-func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BalanceRequest.Unmarshal(m, b)
+func (m *DumpPrivateKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DumpPrivateKeyRequest.Unmarshal(m, b)
 }
-func (m *BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BalanceRequest.Marshal(b, m, deterministic)
+func (m *DumpPrivateKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DumpPrivateKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *BalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BalanceRequest.Merge(m, src)
+func (m *DumpPrivateKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpPrivateKeyRequest.Merge(m, src)
 }
-func (m *BalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_BalanceRequest.Size(m)
+func (m *DumpPrivateKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_DumpPrivateKeyRequest.Size(m)
 }
-func (m *BalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_BalanceRequest.DiscardUnknown(m)
+func (m *DumpPrivateKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpPrivateKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_DumpPrivateKeyRequest proto.InternalMessageInfo
 
-func (m *BalanceRequest) GetAccountNumber() uint32 {
+func (m *DumpPrivateKeyRequest) GetAddress() string {
 	if m != nil {
-		return m.AccountNumber
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *BalanceRequest) GetRequiredConfirmations() int32 {
+func (m *DumpPrivateKeyRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.RequiredConfirmations
+		return m.Passphrase
 	}
-	return 0
+	return nil
 }
 
-type BalanceResponse struct {
-	Total                int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Spendable            int64    `protobuf:"varint,2,opt,name=spendable,proto3" json:"spendable,omitempty"`
-	ImmatureReward       int64    `protobuf:"varint,3,opt,name=immature_reward,json=immatureReward,proto3" json:"immature_reward,omitempty"`
+type DumpPrivateKeyResponse struct {
+	PrivateKeyWif        string   `protobuf:"bytes,1,opt,name=private_key_wif,json=privateKeyWif,proto3" json:"private_key_wif,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
-func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*BalanceResponse) ProtoMessage()    {}
-func (*BalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{22}
+func (m *DumpPrivateKeyResponse) Reset()         { *m = DumpPrivateKeyResponse{} }
+func (m *DumpPrivateKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*DumpPrivateKeyResponse) ProtoMessage()    {}
+func (*DumpPrivateKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
 }
 
-func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BalanceResponse.Unmarshal(m, b)
+func (m *DumpPrivateKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DumpPrivateKeyResponse.Unmarshal(m, b)
 }
-func (m *BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BalanceResponse.Marshal(b, m, deterministic)
+func (m *DumpPrivateKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DumpPrivateKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *BalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BalanceResponse.Merge(m, src)
+func (m *DumpPrivateKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DumpPrivateKeyResponse.Merge(m, src)
 }
-func (m *BalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_BalanceResponse.Size(m)
+func (m *DumpPrivateKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_DumpPrivateKeyResponse.Size(m)
 }
-func (m *BalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BalanceResponse.DiscardUnknown(m)
+func (m *DumpPrivateKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DumpPrivateKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_DumpPrivateKeyResponse proto.InternalMessageInfo
 
-func (m *BalanceResponse) GetTotal() int64 {
+func (m *DumpPrivateKeyResponse) GetPrivateKeyWif() string {
 	if m != nil {
-		return m.Total
+		return m.PrivateKeyWif
 	}
-	return 0
+	return ""
 }
 
-func (m *BalanceResponse) GetSpendable() int64 {
+type SignMessageRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Passphrase           []byte   `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
+func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*SignMessageRequest) ProtoMessage()    {}
+func (*SignMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+
+func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
+}
+func (m *SignMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageRequest.Marshal(b, m, deterministic)
+}
+func (m *SignMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageRequest.Merge(m, src)
+}
+func (m *SignMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_SignMessageRequest.Size(m)
+}
+func (m *SignMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageRequest proto.InternalMessageInfo
+
+func (m *SignMessageRequest) GetAddress() string {
 	if m != nil {
-		return m.Spendable
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-func (m *BalanceResponse) GetImmatureReward() int64 {
+func (m *SignMessageRequest) GetMessage() string {
 	if m != nil {
-		return m.ImmatureReward
+		return m.Message
 	}
-	return 0
+	return ""
 }
 
-type CurrentAddressRequest struct {
-	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+func (m *SignMessageRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+type SignMessageResponse struct {
+	Signature            string   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CurrentAddressRequest) Reset()         { *m = CurrentAddressRequest{} }
-func (m *CurrentAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*CurrentAddressRequest) ProtoMessage()    {}
-func (*CurrentAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{23}
+func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
+func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*SignMessageResponse) ProtoMessage()    {}
+func (*SignMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 
-func (m *CurrentAddressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CurrentAddressRequest.Unmarshal(m, b)
+func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
 }
-func (m *CurrentAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CurrentAddressRequest.Marshal(b, m, deterministic)
+func (m *SignMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageResponse.Marshal(b, m, deterministic)
 }
-func (m *CurrentAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrentAddressRequest.Merge(m, src)
+func (m *SignMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageResponse.Merge(m, src)
 }
-func (m *CurrentAddressRequest) XXX_Size() int {
-	return xxx_messageInfo_CurrentAddressRequest.Size(m)
+func (m *SignMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_SignMessageResponse.Size(m)
 }
-func (m *CurrentAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrentAddressRequest.DiscardUnknown(m)
+func (m *SignMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CurrentAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_SignMessageResponse proto.InternalMessageInfo
 
-func (m *CurrentAddressRequest) GetAccount() uint32 {
+func (m *SignMessageResponse) GetSignature() string {
 	if m != nil {
-		return m.Account
+		return m.Signature
 	}
-	return 0
+	return ""
 }
 
-type CurrentAddressResponse struct {
+type VerifyMessageRequest struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature            string   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CurrentAddressResponse) Reset()         { *m = CurrentAddressResponse{} }
-func (m *CurrentAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*CurrentAddressResponse) ProtoMessage()    {}
-func (*CurrentAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{24}
+func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
+func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageRequest) ProtoMessage()    {}
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 
-func (m *CurrentAddressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CurrentAddressResponse.Unmarshal(m, b)
+func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
 }
-func (m *CurrentAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CurrentAddressResponse.Marshal(b, m, deterministic)
+func (m *VerifyMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageRequest.Marshal(b, m, deterministic)
 }
-func (m *CurrentAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CurrentAddressResponse.Merge(m, src)
+func (m *VerifyMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageRequest.Merge(m, src)
 }
-func (m *CurrentAddressResponse) XXX_Size() int {
-	return xxx_messageInfo_CurrentAddressResponse.Size(m)
+func (m *VerifyMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageRequest.Size(m)
 }
-func (m *CurrentAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CurrentAddressResponse.DiscardUnknown(m)
+func (m *VerifyMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CurrentAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_VerifyMessageRequest proto.InternalMessageInfo
 
-func (m *CurrentAddressResponse) GetAddress() string {
+func (m *VerifyMessageRequest) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-type GetTransactionsRequest struct {
-	// Optionally specify the starting block from which to begin including all transactions.
-	// Either the starting block hash or height may be specified, but not both.
-	// If a block height is specified and is negative, the absolute value becomes the number of
-	// last blocks to include.  That is, given a current chain height of 1000 and a starting block
-	// height of -3, transaction notifications will be created for blocks 998, 999, and 1000.
-	// If both options are excluded, transaction results are created for transactions since the
-	// genesis block.
-	StartingBlockHash   []byte `protobuf:"bytes,1,opt,name=starting_block_hash,json=startingBlockHash,proto3" json:"starting_block_hash,omitempty"`
-	StartingBlockHeight int32  `protobuf:"zigzag32,2,opt,name=starting_block_height,json=startingBlockHeight,proto3" json:"starting_block_height,omitempty"`
-	// Optionally specify the last block that transaction results may appear in.
-	// Either the ending block hash or height may be specified, but not both.
-	// If both are excluded, transaction results are created for all transactions
-	// through the best block, and include all unmined transactions.
-	EndingBlockHash   []byte `protobuf:"bytes,3,opt,name=ending_block_hash,json=endingBlockHash,proto3" json:"ending_block_hash,omitempty"`
-	EndingBlockHeight int32  `protobuf:"varint,4,opt,name=ending_block_height,json=endingBlockHeight,proto3" json:"ending_block_height,omitempty"`
-	// Include at least this many of the newest transactions if they exist.
-	// Cannot be used when the ending block hash is specified.
-	//
-	// TODO: remove until spec adds it back in some way.
-	MinimumRecentTransactions int32    `protobuf:"varint,5,opt,name=minimum_recent_transactions,json=minimumRecentTransactions,proto3" json:"minimum_recent_transactions,omitempty"`
-	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
-	XXX_unrecognized          []byte   `json:"-"`
-	XXX_sizecache             int32    `json:"-"`
+func (m *VerifyMessageRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{} }
-func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTransactionsRequest) ProtoMessage()    {}
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{25}
+func (m *VerifyMessageRequest) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
 }
 
-func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
+type VerifyMessageResponse struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTransactionsRequest.Marshal(b, m, deterministic)
+
+func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
+func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageResponse) ProtoMessage()    {}
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
-func (m *GetTransactionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTransactionsRequest.Merge(m, src)
+
+func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
 }
-func (m *GetTransactionsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTransactionsRequest.Size(m)
+func (m *VerifyMessageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageResponse.Marshal(b, m, deterministic)
 }
-func (m *GetTransactionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTransactionsRequest.DiscardUnknown(m)
+func (m *VerifyMessageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageResponse.Merge(m, src)
+}
+func (m *VerifyMessageResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageResponse.Size(m)
+}
+func (m *VerifyMessageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTransactionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_VerifyMessageResponse proto.InternalMessageInfo
 
-func (m *GetTransactionsRequest) GetStartingBlockHash() []byte {
+func (m *VerifyMessageResponse) GetValid() bool {
 	if m != nil {
-		return m.StartingBlockHash
+		return m.Valid
 	}
-	return nil
+	return false
 }
 
-func (m *GetTransactionsRequest) GetStartingBlockHeight() int32 {
-	if m != nil {
-		return m.StartingBlockHeight
-	}
-	return 0
+type CreateMultisigAddressRequest struct {
+	Required             uint32   `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	PublicKeys           []string `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Passphrase           []byte   `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTransactionsRequest) GetEndingBlockHash() []byte {
+func (m *CreateMultisigAddressRequest) Reset()         { *m = CreateMultisigAddressRequest{} }
+func (m *CreateMultisigAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateMultisigAddressRequest) ProtoMessage()    {}
+func (*CreateMultisigAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+}
+
+func (m *CreateMultisigAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMultisigAddressRequest.Unmarshal(m, b)
+}
+func (m *CreateMultisigAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMultisigAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateMultisigAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMultisigAddressRequest.Merge(m, src)
+}
+func (m *CreateMultisigAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateMultisigAddressRequest.Size(m)
+}
+func (m *CreateMultisigAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMultisigAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateMultisigAddressRequest proto.InternalMessageInfo
+
+func (m *CreateMultisigAddressRequest) GetRequired() uint32 {
 	if m != nil {
-		return m.EndingBlockHash
+		return m.Required
 	}
-	return nil
+	return 0
 }
 
-func (m *GetTransactionsRequest) GetEndingBlockHeight() int32 {
+func (m *CreateMultisigAddressRequest) GetPublicKeys() []string {
 	if m != nil {
-		return m.EndingBlockHeight
+		return m.PublicKeys
 	}
-	return 0
+	return nil
 }
 
-func (m *GetTransactionsRequest) GetMinimumRecentTransactions() int32 {
+func (m *CreateMultisigAddressRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.MinimumRecentTransactions
+		return m.Passphrase
 	}
-	return 0
+	return nil
 }
 
-type GetTransactionsResponse struct {
-	MinedTransactions    []*BlockDetails       `protobuf:"bytes,1,rep,name=mined_transactions,json=minedTransactions,proto3" json:"mined_transactions,omitempty"`
-	UnminedTransactions  []*TransactionDetails `protobuf:"bytes,2,rep,name=unmined_transactions,json=unminedTransactions,proto3" json:"unmined_transactions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type CreateMultisigAddressResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RedeemScript         string   `protobuf:"bytes,2,opt,name=redeem_script,json=redeemScript,proto3" json:"redeem_script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse{} }
-func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTransactionsResponse) ProtoMessage()    {}
-func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{26}
+func (m *CreateMultisigAddressResponse) Reset()         { *m = CreateMultisigAddressResponse{} }
+func (m *CreateMultisigAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateMultisigAddressResponse) ProtoMessage()    {}
+func (*CreateMultisigAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
 }
 
-func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTransactionsResponse.Unmarshal(m, b)
+func (m *CreateMultisigAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateMultisigAddressResponse.Unmarshal(m, b)
 }
-func (m *GetTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTransactionsResponse.Marshal(b, m, deterministic)
+func (m *CreateMultisigAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateMultisigAddressResponse.Marshal(b, m, deterministic)
 }
-func (m *GetTransactionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTransactionsResponse.Merge(m, src)
+func (m *CreateMultisigAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateMultisigAddressResponse.Merge(m, src)
 }
-func (m *GetTransactionsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTransactionsResponse.Size(m)
+func (m *CreateMultisigAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateMultisigAddressResponse.Size(m)
 }
-func (m *GetTransactionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTransactionsResponse.DiscardUnknown(m)
+func (m *CreateMultisigAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateMultisigAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTransactionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateMultisigAddressResponse proto.InternalMessageInfo
 
-func (m *GetTransactionsResponse) GetMinedTransactions() []*BlockDetails {
+func (m *CreateMultisigAddressResponse) GetAddress() string {
 	if m != nil {
-		return m.MinedTransactions
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *GetTransactionsResponse) GetUnminedTransactions() []*TransactionDetails {
+func (m *CreateMultisigAddressResponse) GetRedeemScript() string {
 	if m != nil {
-		return m.UnminedTransactions
+		return m.RedeemScript
 	}
-	return nil
+	return ""
 }
 
-type ChangePassphraseRequest struct {
-	Key                  ChangePassphraseRequest_Key `protobuf:"varint,1,opt,name=key,proto3,enum=walletrpc.ChangePassphraseRequest_Key" json:"key,omitempty"`
-	OldPassphrase        []byte                      `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
-	NewPassphrase        []byte                      `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+type ImportPrunedFundsRequest struct {
+	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	MerkleProof          []byte   `protobuf:"bytes,2,opt,name=merkle_proof,json=merkleProof,proto3" json:"merkle_proof,omitempty"`
+	BlockHash            []byte   `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangePassphraseRequest) Reset()         { *m = ChangePassphraseRequest{} }
-func (m *ChangePassphraseRequest) String() string { return proto.CompactTextString(m) }
-func (*ChangePassphraseRequest) ProtoMessage()    {}
-func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+func (m *ImportPrunedFundsRequest) Reset()         { *m = ImportPrunedFundsRequest{} }
+func (m *ImportPrunedFundsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportPrunedFundsRequest) ProtoMessage()    {}
+func (*ImportPrunedFundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
 }
 
-func (m *ChangePassphraseRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePassphraseRequest.Unmarshal(m, b)
+func (m *ImportPrunedFundsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPrunedFundsRequest.Unmarshal(m, b)
 }
-func (m *ChangePassphraseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePassphraseRequest.Marshal(b, m, deterministic)
+func (m *ImportPrunedFundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPrunedFundsRequest.Marshal(b, m, deterministic)
 }
-func (m *ChangePassphraseRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePassphraseRequest.Merge(m, src)
+func (m *ImportPrunedFundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPrunedFundsRequest.Merge(m, src)
 }
-func (m *ChangePassphraseRequest) XXX_Size() int {
-	return xxx_messageInfo_ChangePassphraseRequest.Size(m)
+func (m *ImportPrunedFundsRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportPrunedFundsRequest.Size(m)
 }
-func (m *ChangePassphraseRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePassphraseRequest.DiscardUnknown(m)
+func (m *ImportPrunedFundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPrunedFundsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePassphraseRequest proto.InternalMessageInfo
+var xxx_messageInfo_ImportPrunedFundsRequest proto.InternalMessageInfo
 
-func (m *ChangePassphraseRequest) GetKey() ChangePassphraseRequest_Key {
+func (m *ImportPrunedFundsRequest) GetTransaction() []byte {
 	if m != nil {
-		return m.Key
+		return m.Transaction
 	}
-	return ChangePassphraseRequest_PRIVATE
+	return nil
 }
 
-func (m *ChangePassphraseRequest) GetOldPassphrase() []byte {
+func (m *ImportPrunedFundsRequest) GetMerkleProof() []byte {
 	if m != nil {
-		return m.OldPassphrase
+		return m.MerkleProof
 	}
 	return nil
 }
 
-func (m *ChangePassphraseRequest) GetNewPassphrase() []byte {
+func (m *ImportPrunedFundsRequest) GetBlockHash() []byte {
 	if m != nil {
-		return m.NewPassphrase
+		return m.BlockHash
 	}
 	return nil
 }
 
-type ChangePassphraseResponse struct {
+type ImportPrunedFundsResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChangePassphraseResponse) Reset()         { *m = ChangePassphraseResponse{} }
-func (m *ChangePassphraseResponse) String() string { return proto.CompactTextString(m) }
-func (*ChangePassphraseResponse) ProtoMessage()    {}
-func (*ChangePassphraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+func (m *ImportPrunedFundsResponse) Reset()         { *m = ImportPrunedFundsResponse{} }
+func (m *ImportPrunedFundsResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportPrunedFundsResponse) ProtoMessage()    {}
+func (*ImportPrunedFundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
 }
 
-func (m *ChangePassphraseResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChangePassphraseResponse.Unmarshal(m, b)
+func (m *ImportPrunedFundsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportPrunedFundsResponse.Unmarshal(m, b)
 }
-func (m *ChangePassphraseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChangePassphraseResponse.Marshal(b, m, deterministic)
+func (m *ImportPrunedFundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportPrunedFundsResponse.Marshal(b, m, deterministic)
 }
-func (m *ChangePassphraseResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePassphraseResponse.Merge(m, src)
+func (m *ImportPrunedFundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPrunedFundsResponse.Merge(m, src)
 }
-func (m *ChangePassphraseResponse) XXX_Size() int {
-	return xxx_messageInfo_ChangePassphraseResponse.Size(m)
+func (m *ImportPrunedFundsResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportPrunedFundsResponse.Size(m)
 }
-func (m *ChangePassphraseResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePassphraseResponse.DiscardUnknown(m)
+func (m *ImportPrunedFundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPrunedFundsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePassphraseResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImportPrunedFundsResponse proto.InternalMessageInfo
 
-type FundTransactionRequest struct {
-	Account                  uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
-	TargetAmount             int64    `protobuf:"varint,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
-	RequiredConfirmations    int32    `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
-	IncludeImmatureCoinbases bool     `protobuf:"varint,4,opt,name=include_immature_coinbases,json=includeImmatureCoinbases,proto3" json:"include_immature_coinbases,omitempty"`
-	IncludeChangeScript      bool     `protobuf:"varint,5,opt,name=include_change_script,json=includeChangeScript,proto3" json:"include_change_script,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+type BalanceRequest struct {
+	AccountNumber         uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	RequiredConfirmations int32    `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *FundTransactionRequest) Reset()         { *m = FundTransactionRequest{} }
-func (m *FundTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*FundTransactionRequest) ProtoMessage()    {}
-func (*FundTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+func (m *BalanceRequest) Reset()         { *m = BalanceRequest{} }
+func (m *BalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*BalanceRequest) ProtoMessage()    {}
+func (*BalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
 }
 
-func (m *FundTransactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FundTransactionRequest.Unmarshal(m, b)
+func (m *BalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceRequest.Unmarshal(m, b)
 }
-func (m *FundTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FundTransactionRequest.Marshal(b, m, deterministic)
+func (m *BalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceRequest.Marshal(b, m, deterministic)
 }
-func (m *FundTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTransactionRequest.Merge(m, src)
+func (m *BalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceRequest.Merge(m, src)
 }
-func (m *FundTransactionRequest) XXX_Size() int {
-	return xxx_messageInfo_FundTransactionRequest.Size(m)
+func (m *BalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_BalanceRequest.Size(m)
 }
-func (m *FundTransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTransactionRequest.DiscardUnknown(m)
+func (m *BalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTransactionRequest proto.InternalMessageInfo
-
-func (m *FundTransactionRequest) GetAccount() uint32 {
-	if m != nil {
-		return m.Account
-	}
-	return 0
-}
+var xxx_messageInfo_BalanceRequest proto.InternalMessageInfo
 
-func (m *FundTransactionRequest) GetTargetAmount() int64 {
+func (m *BalanceRequest) GetAccountNumber() uint32 {
 	if m != nil {
-		return m.TargetAmount
+		return m.AccountNumber
 	}
 	return 0
 }
 
-func (m *FundTransactionRequest) GetRequiredConfirmations() int32 {
+func (m *BalanceRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
 		return m.RequiredConfirmations
 	}
 	return 0
 }
 
-func (m *FundTransactionRequest) GetIncludeImmatureCoinbases() bool {
+type BalanceResponse struct {
+	Total                int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Spendable            int64    `protobuf:"varint,2,opt,name=spendable,proto3" json:"spendable,omitempty"`
+	ImmatureReward       int64    `protobuf:"varint,3,opt,name=immature_reward,json=immatureReward,proto3" json:"immature_reward,omitempty"`
+	WatchOnly            int64    `protobuf:"varint,4,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
+	TimeLocked           int64    `protobuf:"varint,5,opt,name=time_locked,json=timeLocked,proto3" json:"time_locked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BalanceResponse) Reset()         { *m = BalanceResponse{} }
+func (m *BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*BalanceResponse) ProtoMessage()    {}
+func (*BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+}
+
+func (m *BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BalanceResponse.Unmarshal(m, b)
+}
+func (m *BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceResponse.Merge(m, src)
+}
+func (m *BalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_BalanceResponse.Size(m)
+}
+func (m *BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceResponse proto.InternalMessageInfo
+
+func (m *BalanceResponse) GetTotal() int64 {
 	if m != nil {
-		return m.IncludeImmatureCoinbases
+		return m.Total
 	}
-	return false
+	return 0
 }
 
-func (m *FundTransactionRequest) GetIncludeChangeScript() bool {
+func (m *BalanceResponse) GetSpendable() int64 {
 	if m != nil {
-		return m.IncludeChangeScript
+		return m.Spendable
 	}
-	return false
+	return 0
 }
 
-type FundTransactionResponse struct {
-	SelectedOutputs      []*FundTransactionResponse_PreviousOutput `protobuf:"bytes,1,rep,name=selected_outputs,json=selectedOutputs,proto3" json:"selected_outputs,omitempty"`
-	TotalAmount          int64                                     `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	ChangePkScript       []byte                                    `protobuf:"bytes,3,opt,name=change_pk_script,json=changePkScript,proto3" json:"change_pk_script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
-	XXX_unrecognized     []byte                                    `json:"-"`
-	XXX_sizecache        int32                                     `json:"-"`
+func (m *BalanceResponse) GetImmatureReward() int64 {
+	if m != nil {
+		return m.ImmatureReward
+	}
+	return 0
 }
 
-func (m *FundTransactionResponse) Reset()         { *m = FundTransactionResponse{} }
-func (m *FundTransactionResponse) String() string { return proto.CompactTextString(m) }
-func (*FundTransactionResponse) ProtoMessage()    {}
-func (*FundTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
+func (m *BalanceResponse) GetWatchOnly() int64 {
+	if m != nil {
+		return m.WatchOnly
+	}
+	return 0
 }
 
-func (m *FundTransactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FundTransactionResponse.Unmarshal(m, b)
+func (m *BalanceResponse) GetTimeLocked() int64 {
+	if m != nil {
+		return m.TimeLocked
+	}
+	return 0
 }
-func (m *FundTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FundTransactionResponse.Marshal(b, m, deterministic)
+
+type AddressBalanceRequest struct {
+	Address               string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RequiredConfirmations int32    `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
-func (m *FundTransactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTransactionResponse.Merge(m, src)
+
+func (m *AddressBalanceRequest) Reset()         { *m = AddressBalanceRequest{} }
+func (m *AddressBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*AddressBalanceRequest) ProtoMessage()    {}
+func (*AddressBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
 }
-func (m *FundTransactionResponse) XXX_Size() int {
-	return xxx_messageInfo_FundTransactionResponse.Size(m)
+
+func (m *AddressBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressBalanceRequest.Unmarshal(m, b)
 }
-func (m *FundTransactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTransactionResponse.DiscardUnknown(m)
+func (m *AddressBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *AddressBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBalanceRequest.Merge(m, src)
+}
+func (m *AddressBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_AddressBalanceRequest.Size(m)
+}
+func (m *AddressBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTransactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddressBalanceRequest proto.InternalMessageInfo
 
-func (m *FundTransactionResponse) GetSelectedOutputs() []*FundTransactionResponse_PreviousOutput {
+func (m *AddressBalanceRequest) GetAddress() string {
 	if m != nil {
-		return m.SelectedOutputs
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *FundTransactionResponse) GetTotalAmount() int64 {
+func (m *AddressBalanceRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
-		return m.TotalAmount
+		return m.RequiredConfirmations
 	}
 	return 0
 }
 
-func (m *FundTransactionResponse) GetChangePkScript() []byte {
-	if m != nil {
-		return m.ChangePkScript
-	}
-	return nil
-}
-
-type FundTransactionResponse_PreviousOutput struct {
-	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
-	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	PkScript             []byte   `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
-	ReceiveTime          int64    `protobuf:"varint,5,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"`
-	FromCoinbase         bool     `protobuf:"varint,6,opt,name=from_coinbase,json=fromCoinbase,proto3" json:"from_coinbase,omitempty"`
+type AddressBalanceResponse struct {
+	Total                int64    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Spendable            int64    `protobuf:"varint,2,opt,name=spendable,proto3" json:"spendable,omitempty"`
+	ImmatureReward       int64    `protobuf:"varint,3,opt,name=immature_reward,json=immatureReward,proto3" json:"immature_reward,omitempty"`
+	WatchOnly            int64    `protobuf:"varint,4,opt,name=watch_only,json=watchOnly,proto3" json:"watch_only,omitempty"`
+	TimeLocked           int64    `protobuf:"varint,5,opt,name=time_locked,json=timeLocked,proto3" json:"time_locked,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FundTransactionResponse_PreviousOutput) Reset() {
-	*m = FundTransactionResponse_PreviousOutput{}
-}
-func (m *FundTransactionResponse_PreviousOutput) String() string { return proto.CompactTextString(m) }
-func (*FundTransactionResponse_PreviousOutput) ProtoMessage()    {}
-func (*FundTransactionResponse_PreviousOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{30, 0}
+func (m *AddressBalanceResponse) Reset()         { *m = AddressBalanceResponse{} }
+func (m *AddressBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*AddressBalanceResponse) ProtoMessage()    {}
+func (*AddressBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
 }
 
-func (m *FundTransactionResponse_PreviousOutput) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Unmarshal(m, b)
+func (m *AddressBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressBalanceResponse.Unmarshal(m, b)
 }
-func (m *FundTransactionResponse_PreviousOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Marshal(b, m, deterministic)
+func (m *AddressBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressBalanceResponse.Marshal(b, m, deterministic)
 }
-func (m *FundTransactionResponse_PreviousOutput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FundTransactionResponse_PreviousOutput.Merge(m, src)
+func (m *AddressBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBalanceResponse.Merge(m, src)
 }
-func (m *FundTransactionResponse_PreviousOutput) XXX_Size() int {
-	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Size(m)
+func (m *AddressBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_AddressBalanceResponse.Size(m)
 }
-func (m *FundTransactionResponse_PreviousOutput) XXX_DiscardUnknown() {
-	xxx_messageInfo_FundTransactionResponse_PreviousOutput.DiscardUnknown(m)
+func (m *AddressBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBalanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FundTransactionResponse_PreviousOutput proto.InternalMessageInfo
-
-func (m *FundTransactionResponse_PreviousOutput) GetTransactionHash() []byte {
-	if m != nil {
-		return m.TransactionHash
-	}
-	return nil
-}
+var xxx_messageInfo_AddressBalanceResponse proto.InternalMessageInfo
 
-func (m *FundTransactionResponse_PreviousOutput) GetOutputIndex() uint32 {
+func (m *AddressBalanceResponse) GetTotal() int64 {
 	if m != nil {
-		return m.OutputIndex
+		return m.Total
 	}
 	return 0
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetAmount() int64 {
+func (m *AddressBalanceResponse) GetSpendable() int64 {
 	if m != nil {
-		return m.Amount
+		return m.Spendable
 	}
 	return 0
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetPkScript() []byte {
+func (m *AddressBalanceResponse) GetImmatureReward() int64 {
 	if m != nil {
-		return m.PkScript
+		return m.ImmatureReward
 	}
-	return nil
+	return 0
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetReceiveTime() int64 {
+func (m *AddressBalanceResponse) GetWatchOnly() int64 {
 	if m != nil {
-		return m.ReceiveTime
+		return m.WatchOnly
 	}
 	return 0
 }
 
-func (m *FundTransactionResponse_PreviousOutput) GetFromCoinbase() bool {
+func (m *AddressBalanceResponse) GetTimeLocked() int64 {
 	if m != nil {
-		return m.FromCoinbase
+		return m.TimeLocked
 	}
-	return false
+	return 0
 }
 
-type CreateTransactionRequest struct {
-	Account               uint32                             `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
-	Outputs               []*CreateTransactionRequest_Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	RequiredConfirmations int32                              `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
-	SatPerKbFee           uint32                             `protobuf:"varint,4,opt,name=sat_per_kb_fee,json=satPerKbFee,proto3" json:"sat_per_kb_fee,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}                           `json:"-"`
-	XXX_unrecognized      []byte                             `json:"-"`
-	XXX_sizecache         int32                              `json:"-"`
+type CurrentAddressRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTransactionRequest) Reset()         { *m = CreateTransactionRequest{} }
-func (m *CreateTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateTransactionRequest) ProtoMessage()    {}
-func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{31}
+func (m *CurrentAddressRequest) Reset()         { *m = CurrentAddressRequest{} }
+func (m *CurrentAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*CurrentAddressRequest) ProtoMessage()    {}
+func (*CurrentAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
 }
 
-func (m *CreateTransactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTransactionRequest.Unmarshal(m, b)
+func (m *CurrentAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrentAddressRequest.Unmarshal(m, b)
 }
-func (m *CreateTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTransactionRequest.Marshal(b, m, deterministic)
+func (m *CurrentAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrentAddressRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTransactionRequest.Merge(m, src)
+func (m *CurrentAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentAddressRequest.Merge(m, src)
 }
-func (m *CreateTransactionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateTransactionRequest.Size(m)
+func (m *CurrentAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_CurrentAddressRequest.Size(m)
 }
-func (m *CreateTransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTransactionRequest.DiscardUnknown(m)
+func (m *CurrentAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentAddressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTransactionRequest proto.InternalMessageInfo
+var xxx_messageInfo_CurrentAddressRequest proto.InternalMessageInfo
 
-func (m *CreateTransactionRequest) GetAccount() uint32 {
+func (m *CurrentAddressRequest) GetAccount() uint32 {
 	if m != nil {
 		return m.Account
 	}
 	return 0
 }
 
-func (m *CreateTransactionRequest) GetOutputs() []*CreateTransactionRequest_Output {
-	if m != nil {
-		return m.Outputs
-	}
-	return nil
-}
-
-func (m *CreateTransactionRequest) GetRequiredConfirmations() int32 {
-	if m != nil {
-		return m.RequiredConfirmations
-	}
-	return 0
-}
-
-func (m *CreateTransactionRequest) GetSatPerKbFee() uint32 {
-	if m != nil {
-		return m.SatPerKbFee
-	}
-	return 0
-}
-
-type CreateTransactionRequest_Output struct {
+type CurrentAddressResponse struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTransactionRequest_Output) Reset()         { *m = CreateTransactionRequest_Output{} }
-func (m *CreateTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
-func (*CreateTransactionRequest_Output) ProtoMessage()    {}
-func (*CreateTransactionRequest_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{31, 0}
+func (m *CurrentAddressResponse) Reset()         { *m = CurrentAddressResponse{} }
+func (m *CurrentAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*CurrentAddressResponse) ProtoMessage()    {}
+func (*CurrentAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
 }
 
-func (m *CreateTransactionRequest_Output) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTransactionRequest_Output.Unmarshal(m, b)
+func (m *CurrentAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrentAddressResponse.Unmarshal(m, b)
 }
-func (m *CreateTransactionRequest_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTransactionRequest_Output.Marshal(b, m, deterministic)
+func (m *CurrentAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrentAddressResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateTransactionRequest_Output) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTransactionRequest_Output.Merge(m, src)
+func (m *CurrentAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentAddressResponse.Merge(m, src)
 }
-func (m *CreateTransactionRequest_Output) XXX_Size() int {
-	return xxx_messageInfo_CreateTransactionRequest_Output.Size(m)
+func (m *CurrentAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_CurrentAddressResponse.Size(m)
 }
-func (m *CreateTransactionRequest_Output) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTransactionRequest_Output.DiscardUnknown(m)
+func (m *CurrentAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTransactionRequest_Output proto.InternalMessageInfo
+var xxx_messageInfo_CurrentAddressResponse proto.InternalMessageInfo
 
-func (m *CreateTransactionRequest_Output) GetAddress() string {
+func (m *CurrentAddressResponse) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *CreateTransactionRequest_Output) GetAmount() int64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-type CreateTransactionResponse struct {
-	SerializedTransaction []byte   `protobuf:"bytes,1,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
-	InputValues           []int64  `protobuf:"varint,2,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
-	Fee                   int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+type GetTransactionsRequest struct {
+	// Optionally specify the starting block from which to begin including all transactions.
+	// Either the starting block hash or height may be specified, but not both.
+	// If a block height is specified and is negative, the absolute value becomes the number of
+	// last blocks to include.  That is, given a current chain height of 1000 and a starting block
+	// height of -3, transaction notifications will be created for blocks 998, 999, and 1000.
+	// If both options are excluded, transaction results are created for transactions since the
+	// genesis block.
+	StartingBlockHash   []byte `protobuf:"bytes,1,opt,name=starting_block_hash,json=startingBlockHash,proto3" json:"starting_block_hash,omitempty"`
+	StartingBlockHeight int32  `protobuf:"zigzag32,2,opt,name=starting_block_height,json=startingBlockHeight,proto3" json:"starting_block_height,omitempty"`
+	// Optionally specify the last block that transaction results may appear in.
+	// Either the ending block hash or height may be specified, but not both.
+	// If both are excluded, transaction results are created for all transactions
+	// through the best block, and include all unmined transactions.
+	EndingBlockHash   []byte `protobuf:"bytes,3,opt,name=ending_block_hash,json=endingBlockHash,proto3" json:"ending_block_hash,omitempty"`
+	EndingBlockHeight int32  `protobuf:"varint,4,opt,name=ending_block_height,json=endingBlockHeight,proto3" json:"ending_block_height,omitempty"`
+	// Include at least this many of the newest transactions if they exist.
+	// Cannot be used when the ending block hash is specified.
+	//
+	// TODO: remove until spec adds it back in some way.
+	MinimumRecentTransactions int32    `protobuf:"varint,5,opt,name=minimum_recent_transactions,json=minimumRecentTransactions,proto3" json:"minimum_recent_transactions,omitempty"`
+	XXX_NoUnkeyedLiteral      struct{} `json:"-"`
+	XXX_unrecognized          []byte   `json:"-"`
+	XXX_sizecache             int32    `json:"-"`
 }
 
-func (m *CreateTransactionResponse) Reset()         { *m = CreateTransactionResponse{} }
-func (m *CreateTransactionResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateTransactionResponse) ProtoMessage()    {}
-func (*CreateTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{32}
+func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{} }
+func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionsRequest) ProtoMessage()    {}
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
 }
 
-func (m *CreateTransactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTransactionResponse.Unmarshal(m, b)
+func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
 }
-func (m *CreateTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTransactionResponse.Marshal(b, m, deterministic)
+func (m *GetTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionsRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateTransactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTransactionResponse.Merge(m, src)
+func (m *GetTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionsRequest.Merge(m, src)
 }
-func (m *CreateTransactionResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateTransactionResponse.Size(m)
+func (m *GetTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionsRequest.Size(m)
 }
-func (m *CreateTransactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTransactionResponse.DiscardUnknown(m)
+func (m *GetTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTransactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTransactionsRequest proto.InternalMessageInfo
 
-func (m *CreateTransactionResponse) GetSerializedTransaction() []byte {
+func (m *GetTransactionsRequest) GetStartingBlockHash() []byte {
 	if m != nil {
-		return m.SerializedTransaction
+		return m.StartingBlockHash
 	}
 	return nil
 }
 
-func (m *CreateTransactionResponse) GetInputValues() []int64 {
+func (m *GetTransactionsRequest) GetStartingBlockHeight() int32 {
 	if m != nil {
-		return m.InputValues
+		return m.StartingBlockHeight
+	}
+	return 0
+}
+
+func (m *GetTransactionsRequest) GetEndingBlockHash() []byte {
+	if m != nil {
+		return m.EndingBlockHash
 	}
 	return nil
 }
 
-func (m *CreateTransactionResponse) GetFee() int64 {
+func (m *GetTransactionsRequest) GetEndingBlockHeight() int32 {
 	if m != nil {
-		return m.Fee
+		return m.EndingBlockHeight
 	}
 	return 0
 }
 
-type SweepAccountRequest struct {
-	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
-	SweepToAddress       string   `protobuf:"bytes,2,opt,name=sweep_to_address,json=sweepToAddress,proto3" json:"sweep_to_address,omitempty"`
-	SatPerKbFee          uint32   `protobuf:"varint,3,opt,name=sat_per_kb_fee,json=satPerKbFee,proto3" json:"sat_per_kb_fee,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetTransactionsRequest) GetMinimumRecentTransactions() int32 {
+	if m != nil {
+		return m.MinimumRecentTransactions
+	}
+	return 0
 }
 
-func (m *SweepAccountRequest) Reset()         { *m = SweepAccountRequest{} }
-func (m *SweepAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*SweepAccountRequest) ProtoMessage()    {}
-func (*SweepAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{33}
+type GetTransactionsResponse struct {
+	MinedTransactions    []*BlockDetails       `protobuf:"bytes,1,rep,name=mined_transactions,json=minedTransactions,proto3" json:"mined_transactions,omitempty"`
+	UnminedTransactions  []*TransactionDetails `protobuf:"bytes,2,rep,name=unmined_transactions,json=unminedTransactions,proto3" json:"unmined_transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *SweepAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SweepAccountRequest.Unmarshal(m, b)
+func (m *GetTransactionsResponse) Reset()         { *m = GetTransactionsResponse{} }
+func (m *GetTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionsResponse) ProtoMessage()    {}
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
 }
-func (m *SweepAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SweepAccountRequest.Marshal(b, m, deterministic)
+
+func (m *GetTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionsResponse.Unmarshal(m, b)
 }
-func (m *SweepAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SweepAccountRequest.Merge(m, src)
+func (m *GetTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionsResponse.Marshal(b, m, deterministic)
 }
-func (m *SweepAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_SweepAccountRequest.Size(m)
+func (m *GetTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionsResponse.Merge(m, src)
 }
-func (m *SweepAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SweepAccountRequest.DiscardUnknown(m)
+func (m *GetTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionsResponse.Size(m)
 }
-
-var xxx_messageInfo_SweepAccountRequest proto.InternalMessageInfo
-
-func (m *SweepAccountRequest) GetAccount() uint32 {
-	if m != nil {
-		return m.Account
-	}
-	return 0
+func (m *GetTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionsResponse.DiscardUnknown(m)
 }
 
-func (m *SweepAccountRequest) GetSweepToAddress() string {
+var xxx_messageInfo_GetTransactionsResponse proto.InternalMessageInfo
+
+func (m *GetTransactionsResponse) GetMinedTransactions() []*BlockDetails {
 	if m != nil {
-		return m.SweepToAddress
+		return m.MinedTransactions
 	}
-	return ""
+	return nil
 }
 
-func (m *SweepAccountRequest) GetSatPerKbFee() uint32 {
+func (m *GetTransactionsResponse) GetUnminedTransactions() []*TransactionDetails {
 	if m != nil {
-		return m.SatPerKbFee
+		return m.UnminedTransactions
 	}
-	return 0
+	return nil
 }
 
-type SweepAccountResponse struct {
-	SerializedTransaction []byte   `protobuf:"bytes,1,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
-	InputValues           []int64  `protobuf:"varint,2,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
-	TotalAmount           int64    `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	Fee                   int64    `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
-	XXX_unrecognized      []byte   `json:"-"`
-	XXX_sizecache         int32    `json:"-"`
+type TotalFeesPaidRequest struct {
+	StartingBlockHash    []byte   `protobuf:"bytes,1,opt,name=starting_block_hash,json=startingBlockHash,proto3" json:"starting_block_hash,omitempty"`
+	StartingBlockHeight  int32    `protobuf:"varint,2,opt,name=starting_block_height,json=startingBlockHeight,proto3" json:"starting_block_height,omitempty"`
+	EndingBlockHash      []byte   `protobuf:"bytes,3,opt,name=ending_block_hash,json=endingBlockHash,proto3" json:"ending_block_hash,omitempty"`
+	EndingBlockHeight    int32    `protobuf:"varint,4,opt,name=ending_block_height,json=endingBlockHeight,proto3" json:"ending_block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SweepAccountResponse) Reset()         { *m = SweepAccountResponse{} }
-func (m *SweepAccountResponse) String() string { return proto.CompactTextString(m) }
-func (*SweepAccountResponse) ProtoMessage()    {}
-func (*SweepAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{34}
+func (m *TotalFeesPaidRequest) Reset()         { *m = TotalFeesPaidRequest{} }
+func (m *TotalFeesPaidRequest) String() string { return proto.CompactTextString(m) }
+func (*TotalFeesPaidRequest) ProtoMessage()    {}
+func (*TotalFeesPaidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
 }
 
-func (m *SweepAccountResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SweepAccountResponse.Unmarshal(m, b)
+func (m *TotalFeesPaidRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalFeesPaidRequest.Unmarshal(m, b)
 }
-func (m *SweepAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SweepAccountResponse.Marshal(b, m, deterministic)
+func (m *TotalFeesPaidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalFeesPaidRequest.Marshal(b, m, deterministic)
 }
-func (m *SweepAccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SweepAccountResponse.Merge(m, src)
+func (m *TotalFeesPaidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalFeesPaidRequest.Merge(m, src)
 }
-func (m *SweepAccountResponse) XXX_Size() int {
-	return xxx_messageInfo_SweepAccountResponse.Size(m)
+func (m *TotalFeesPaidRequest) XXX_Size() int {
+	return xxx_messageInfo_TotalFeesPaidRequest.Size(m)
 }
-func (m *SweepAccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SweepAccountResponse.DiscardUnknown(m)
+func (m *TotalFeesPaidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalFeesPaidRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SweepAccountResponse proto.InternalMessageInfo
+var xxx_messageInfo_TotalFeesPaidRequest proto.InternalMessageInfo
 
-func (m *SweepAccountResponse) GetSerializedTransaction() []byte {
+func (m *TotalFeesPaidRequest) GetStartingBlockHash() []byte {
 	if m != nil {
-		return m.SerializedTransaction
+		return m.StartingBlockHash
 	}
 	return nil
 }
 
-func (m *SweepAccountResponse) GetInputValues() []int64 {
+func (m *TotalFeesPaidRequest) GetStartingBlockHeight() int32 {
 	if m != nil {
-		return m.InputValues
+		return m.StartingBlockHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *SweepAccountResponse) GetTotalAmount() int64 {
+func (m *TotalFeesPaidRequest) GetEndingBlockHash() []byte {
 	if m != nil {
-		return m.TotalAmount
+		return m.EndingBlockHash
 	}
-	return 0
+	return nil
 }
 
-func (m *SweepAccountResponse) GetFee() int64 {
+func (m *TotalFeesPaidRequest) GetEndingBlockHeight() int32 {
 	if m != nil {
-		return m.Fee
+		return m.EndingBlockHeight
 	}
 	return 0
 }
 
-type SignTransactionRequest struct {
-	Passphrase            []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	SerializedTransaction []byte `protobuf:"bytes,2,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
-	// If no indexes are specified, signatures scripts will be added for
-	// every input. If any input indexes are specified, only those inputs
-	// will be signed.  Rather than returning an incompletely signed
-	// transaction if any of the inputs to be signed can not be, the RPC
-	// immediately errors.
-	InputIndexes []uint32 `protobuf:"varint,3,rep,packed,name=input_indexes,json=inputIndexes,proto3" json:"input_indexes,omitempty"`
-	// Values must be provided for each input in order to sign using
-	// the bitcoin cash signing algorithm.
-	InputValues          []int64  `protobuf:"varint,4,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
+type TotalFeesPaidResponse struct {
+	TotalFees            int64    `protobuf:"varint,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignTransactionRequest) Reset()         { *m = SignTransactionRequest{} }
-func (m *SignTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*SignTransactionRequest) ProtoMessage()    {}
-func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{35}
+func (m *TotalFeesPaidResponse) Reset()         { *m = TotalFeesPaidResponse{} }
+func (m *TotalFeesPaidResponse) String() string { return proto.CompactTextString(m) }
+func (*TotalFeesPaidResponse) ProtoMessage()    {}
+func (*TotalFeesPaidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
 }
 
-func (m *SignTransactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignTransactionRequest.Unmarshal(m, b)
+func (m *TotalFeesPaidResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalFeesPaidResponse.Unmarshal(m, b)
 }
-func (m *SignTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignTransactionRequest.Marshal(b, m, deterministic)
+func (m *TotalFeesPaidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalFeesPaidResponse.Marshal(b, m, deterministic)
 }
-func (m *SignTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignTransactionRequest.Merge(m, src)
+func (m *TotalFeesPaidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalFeesPaidResponse.Merge(m, src)
 }
-func (m *SignTransactionRequest) XXX_Size() int {
-	return xxx_messageInfo_SignTransactionRequest.Size(m)
+func (m *TotalFeesPaidResponse) XXX_Size() int {
+	return xxx_messageInfo_TotalFeesPaidResponse.Size(m)
 }
-func (m *SignTransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignTransactionRequest.DiscardUnknown(m)
+func (m *TotalFeesPaidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalFeesPaidResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignTransactionRequest proto.InternalMessageInfo
+var xxx_messageInfo_TotalFeesPaidResponse proto.InternalMessageInfo
 
-func (m *SignTransactionRequest) GetPassphrase() []byte {
+func (m *TotalFeesPaidResponse) GetTotalFees() int64 {
 	if m != nil {
-		return m.Passphrase
+		return m.TotalFees
 	}
-	return nil
+	return 0
 }
 
-func (m *SignTransactionRequest) GetSerializedTransaction() []byte {
-	if m != nil {
-		return m.SerializedTransaction
-	}
-	return nil
+type MempoolStatusRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignTransactionRequest) GetInputIndexes() []uint32 {
+func (m *MempoolStatusRequest) Reset()         { *m = MempoolStatusRequest{} }
+func (m *MempoolStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*MempoolStatusRequest) ProtoMessage()    {}
+func (*MempoolStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+}
+
+func (m *MempoolStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolStatusRequest.Unmarshal(m, b)
+}
+func (m *MempoolStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *MempoolStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolStatusRequest.Merge(m, src)
+}
+func (m *MempoolStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_MempoolStatusRequest.Size(m)
+}
+func (m *MempoolStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolStatusRequest proto.InternalMessageInfo
+
+func (m *MempoolStatusRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.InputIndexes
+		return m.TransactionHash
 	}
 	return nil
 }
 
-func (m *SignTransactionRequest) GetInputValues() []int64 {
-	if m != nil {
-		return m.InputValues
-	}
-	return nil
-}
-
-type SignTransactionResponse struct {
-	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	UnsignedInputIndexes []uint32 `protobuf:"varint,2,rep,packed,name=unsigned_input_indexes,json=unsignedInputIndexes,proto3" json:"unsigned_input_indexes,omitempty"`
+type MempoolStatusResponse struct {
+	InMempool            bool     `protobuf:"varint,1,opt,name=in_mempool,json=inMempool,proto3" json:"in_mempool,omitempty"`
+	Fee                  int64    `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	Size                 int32    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Time                 int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Height               int32    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	AncestorCount        int64    `protobuf:"varint,6,opt,name=ancestor_count,json=ancestorCount,proto3" json:"ancestor_count,omitempty"`
+	DescendantCount      int64    `protobuf:"varint,7,opt,name=descendant_count,json=descendantCount,proto3" json:"descendant_count,omitempty"`
+	Confirmed            bool     `protobuf:"varint,8,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SignTransactionResponse) Reset()         { *m = SignTransactionResponse{} }
-func (m *SignTransactionResponse) String() string { return proto.CompactTextString(m) }
-func (*SignTransactionResponse) ProtoMessage()    {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{36}
+func (m *MempoolStatusResponse) Reset()         { *m = MempoolStatusResponse{} }
+func (m *MempoolStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MempoolStatusResponse) ProtoMessage()    {}
+func (*MempoolStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
 }
 
-func (m *SignTransactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SignTransactionResponse.Unmarshal(m, b)
+func (m *MempoolStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolStatusResponse.Unmarshal(m, b)
 }
-func (m *SignTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SignTransactionResponse.Marshal(b, m, deterministic)
+func (m *MempoolStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolStatusResponse.Marshal(b, m, deterministic)
 }
-func (m *SignTransactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SignTransactionResponse.Merge(m, src)
+func (m *MempoolStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolStatusResponse.Merge(m, src)
 }
-func (m *SignTransactionResponse) XXX_Size() int {
-	return xxx_messageInfo_SignTransactionResponse.Size(m)
+func (m *MempoolStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_MempoolStatusResponse.Size(m)
 }
-func (m *SignTransactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SignTransactionResponse.DiscardUnknown(m)
+func (m *MempoolStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SignTransactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MempoolStatusResponse proto.InternalMessageInfo
 
-func (m *SignTransactionResponse) GetTransaction() []byte {
+func (m *MempoolStatusResponse) GetInMempool() bool {
 	if m != nil {
-		return m.Transaction
+		return m.InMempool
 	}
-	return nil
+	return false
 }
 
-func (m *SignTransactionResponse) GetUnsignedInputIndexes() []uint32 {
+func (m *MempoolStatusResponse) GetFee() int64 {
 	if m != nil {
-		return m.UnsignedInputIndexes
+		return m.Fee
 	}
-	return nil
+	return 0
 }
 
-type PublishTransactionRequest struct {
-	SignedTransaction    []byte   `protobuf:"bytes,1,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MempoolStatusResponse) GetSize() int32 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
 }
 
-func (m *PublishTransactionRequest) Reset()         { *m = PublishTransactionRequest{} }
-func (m *PublishTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*PublishTransactionRequest) ProtoMessage()    {}
-func (*PublishTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{37}
+func (m *MempoolStatusResponse) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
-func (m *PublishTransactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PublishTransactionRequest.Unmarshal(m, b)
-}
-func (m *PublishTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PublishTransactionRequest.Marshal(b, m, deterministic)
-}
-func (m *PublishTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PublishTransactionRequest.Merge(m, src)
-}
-func (m *PublishTransactionRequest) XXX_Size() int {
-	return xxx_messageInfo_PublishTransactionRequest.Size(m)
+func (m *MempoolStatusResponse) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
-func (m *PublishTransactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PublishTransactionRequest.DiscardUnknown(m)
+
+func (m *MempoolStatusResponse) GetAncestorCount() int64 {
+	if m != nil {
+		return m.AncestorCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_PublishTransactionRequest proto.InternalMessageInfo
+func (m *MempoolStatusResponse) GetDescendantCount() int64 {
+	if m != nil {
+		return m.DescendantCount
+	}
+	return 0
+}
 
-func (m *PublishTransactionRequest) GetSignedTransaction() []byte {
+func (m *MempoolStatusResponse) GetConfirmed() bool {
 	if m != nil {
-		return m.SignedTransaction
+		return m.Confirmed
 	}
-	return nil
+	return false
 }
 
-type PublishTransactionResponse struct {
-	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+type AddressUsageRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PublishTransactionResponse) Reset()         { *m = PublishTransactionResponse{} }
-func (m *PublishTransactionResponse) String() string { return proto.CompactTextString(m) }
-func (*PublishTransactionResponse) ProtoMessage()    {}
-func (*PublishTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{38}
+func (m *AddressUsageRequest) Reset()         { *m = AddressUsageRequest{} }
+func (m *AddressUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*AddressUsageRequest) ProtoMessage()    {}
+func (*AddressUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
 }
 
-func (m *PublishTransactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PublishTransactionResponse.Unmarshal(m, b)
+func (m *AddressUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressUsageRequest.Unmarshal(m, b)
 }
-func (m *PublishTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PublishTransactionResponse.Marshal(b, m, deterministic)
+func (m *AddressUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressUsageRequest.Marshal(b, m, deterministic)
 }
-func (m *PublishTransactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PublishTransactionResponse.Merge(m, src)
+func (m *AddressUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressUsageRequest.Merge(m, src)
 }
-func (m *PublishTransactionResponse) XXX_Size() int {
-	return xxx_messageInfo_PublishTransactionResponse.Size(m)
+func (m *AddressUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_AddressUsageRequest.Size(m)
 }
-func (m *PublishTransactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PublishTransactionResponse.DiscardUnknown(m)
+func (m *AddressUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressUsageRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PublishTransactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddressUsageRequest proto.InternalMessageInfo
 
-func (m *PublishTransactionResponse) GetHash() []byte {
-	if m != nil {
-		return m.Hash
-	}
-	return nil
+type AddressUsageResponse struct {
+	Accounts             []*AddressUsageResponse_Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	GapLimit             uint32                          `protobuf:"varint,2,opt,name=gap_limit,json=gapLimit,proto3" json:"gap_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-type RescanRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AddressUsageResponse) Reset()         { *m = AddressUsageResponse{} }
+func (m *AddressUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*AddressUsageResponse) ProtoMessage()    {}
+func (*AddressUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
 }
 
-func (m *RescanRequest) Reset()         { *m = RescanRequest{} }
-func (m *RescanRequest) String() string { return proto.CompactTextString(m) }
-func (*RescanRequest) ProtoMessage()    {}
-func (*RescanRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{39}
+func (m *AddressUsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressUsageResponse.Unmarshal(m, b)
 }
-
-func (m *RescanRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RescanRequest.Unmarshal(m, b)
+func (m *AddressUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressUsageResponse.Marshal(b, m, deterministic)
 }
-func (m *RescanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RescanRequest.Marshal(b, m, deterministic)
+func (m *AddressUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressUsageResponse.Merge(m, src)
 }
-func (m *RescanRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RescanRequest.Merge(m, src)
+func (m *AddressUsageResponse) XXX_Size() int {
+	return xxx_messageInfo_AddressUsageResponse.Size(m)
 }
-func (m *RescanRequest) XXX_Size() int {
-	return xxx_messageInfo_RescanRequest.Size(m)
+func (m *AddressUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressUsageResponse.DiscardUnknown(m)
 }
-func (m *RescanRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RescanRequest.DiscardUnknown(m)
+
+var xxx_messageInfo_AddressUsageResponse proto.InternalMessageInfo
+
+func (m *AddressUsageResponse) GetAccounts() []*AddressUsageResponse_Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
 }
 
-var xxx_messageInfo_RescanRequest proto.InternalMessageInfo
+func (m *AddressUsageResponse) GetGapLimit() uint32 {
+	if m != nil {
+		return m.GapLimit
+	}
+	return 0
+}
 
-type RescanResponse struct {
+type AddressUsageResponse_Account struct {
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	ExternalKeyCount     uint32   `protobuf:"varint,3,opt,name=external_key_count,json=externalKeyCount,proto3" json:"external_key_count,omitempty"`
+	InternalKeyCount     uint32   `protobuf:"varint,4,opt,name=internal_key_count,json=internalKeyCount,proto3" json:"internal_key_count,omitempty"`
+	ExternalUsedCount    uint32   `protobuf:"varint,5,opt,name=external_used_count,json=externalUsedCount,proto3" json:"external_used_count,omitempty"`
+	InternalUsedCount    uint32   `protobuf:"varint,6,opt,name=internal_used_count,json=internalUsedCount,proto3" json:"internal_used_count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RescanResponse) Reset()         { *m = RescanResponse{} }
-func (m *RescanResponse) String() string { return proto.CompactTextString(m) }
-func (*RescanResponse) ProtoMessage()    {}
-func (*RescanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{40}
+func (m *AddressUsageResponse_Account) Reset()         { *m = AddressUsageResponse_Account{} }
+func (m *AddressUsageResponse_Account) String() string { return proto.CompactTextString(m) }
+func (*AddressUsageResponse_Account) ProtoMessage()    {}
+func (*AddressUsageResponse_Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{48, 0}
 }
 
-func (m *RescanResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RescanResponse.Unmarshal(m, b)
+func (m *AddressUsageResponse_Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressUsageResponse_Account.Unmarshal(m, b)
 }
-func (m *RescanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RescanResponse.Marshal(b, m, deterministic)
+func (m *AddressUsageResponse_Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressUsageResponse_Account.Marshal(b, m, deterministic)
 }
-func (m *RescanResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RescanResponse.Merge(m, src)
+func (m *AddressUsageResponse_Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressUsageResponse_Account.Merge(m, src)
 }
-func (m *RescanResponse) XXX_Size() int {
-	return xxx_messageInfo_RescanResponse.Size(m)
+func (m *AddressUsageResponse_Account) XXX_Size() int {
+	return xxx_messageInfo_AddressUsageResponse_Account.Size(m)
 }
-func (m *RescanResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RescanResponse.DiscardUnknown(m)
+func (m *AddressUsageResponse_Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressUsageResponse_Account.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RescanResponse proto.InternalMessageInfo
+var xxx_messageInfo_AddressUsageResponse_Account proto.InternalMessageInfo
 
-type TransactionNotificationsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *AddressUsageResponse_Account) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
 }
 
-func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNotificationsRequest{} }
-func (m *TransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*TransactionNotificationsRequest) ProtoMessage()    {}
-func (*TransactionNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{41}
+func (m *AddressUsageResponse_Account) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
 }
 
-func (m *TransactionNotificationsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionNotificationsRequest.Unmarshal(m, b)
-}
-func (m *TransactionNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionNotificationsRequest.Marshal(b, m, deterministic)
-}
-func (m *TransactionNotificationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionNotificationsRequest.Merge(m, src)
+func (m *AddressUsageResponse_Account) GetExternalKeyCount() uint32 {
+	if m != nil {
+		return m.ExternalKeyCount
+	}
+	return 0
 }
-func (m *TransactionNotificationsRequest) XXX_Size() int {
-	return xxx_messageInfo_TransactionNotificationsRequest.Size(m)
+
+func (m *AddressUsageResponse_Account) GetInternalKeyCount() uint32 {
+	if m != nil {
+		return m.InternalKeyCount
+	}
+	return 0
 }
-func (m *TransactionNotificationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionNotificationsRequest.DiscardUnknown(m)
+
+func (m *AddressUsageResponse_Account) GetExternalUsedCount() uint32 {
+	if m != nil {
+		return m.ExternalUsedCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_TransactionNotificationsRequest proto.InternalMessageInfo
+func (m *AddressUsageResponse_Account) GetInternalUsedCount() uint32 {
+	if m != nil {
+		return m.InternalUsedCount
+	}
+	return 0
+}
 
-type TransactionNotificationsResponse struct {
-	// Sorted by increasing height.  This is a repeated field so many new blocks
-	// in a new best chain can be notified at once during a reorganize.
-	AttachedBlocks []*BlockDetails `protobuf:"bytes,1,rep,name=attached_blocks,json=attachedBlocks,proto3" json:"attached_blocks,omitempty"`
-	// If there was a chain reorganize, there may have been blocks with wallet
-	// transactions that are no longer in the best chain.  These are those
-	// block's hashes.
-	DetachedBlocks [][]byte `protobuf:"bytes,2,rep,name=detached_blocks,json=detachedBlocks,proto3" json:"detached_blocks,omitempty"`
-	// Any new unmined transactions are included here.  These unmined transactions
-	// refer to the current best chain, so transactions from detached blocks may
-	// be moved to mempool and included here if they are not mined or double spent
-	// in the new chain.  Additonally, if no new blocks were attached but a relevant
-	// unmined transaction is seen by the wallet, it will be reported here.
-	UnminedTransactions []*TransactionDetails `protobuf:"bytes,3,rep,name=unmined_transactions,json=unminedTransactions,proto3" json:"unmined_transactions,omitempty"`
-	// Instead of notifying all of the removed unmined transactions,
-	// just send all of the current hashes.
-	UnminedTransactionHashes [][]byte `protobuf:"bytes,4,rep,name=unmined_transaction_hashes,json=unminedTransactionHashes,proto3" json:"unmined_transaction_hashes,omitempty"`
-	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
-	XXX_unrecognized         []byte   `json:"-"`
-	XXX_sizecache            int32    `json:"-"`
+type TotalReceivedByAddressRequest struct {
+	Address               string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RequiredConfirmations int32    `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *TransactionNotificationsResponse) Reset()         { *m = TransactionNotificationsResponse{} }
-func (m *TransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*TransactionNotificationsResponse) ProtoMessage()    {}
-func (*TransactionNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{42}
+func (m *TotalReceivedByAddressRequest) Reset()         { *m = TotalReceivedByAddressRequest{} }
+func (m *TotalReceivedByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*TotalReceivedByAddressRequest) ProtoMessage()    {}
+func (*TotalReceivedByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
 }
 
-func (m *TransactionNotificationsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionNotificationsResponse.Unmarshal(m, b)
+func (m *TotalReceivedByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalReceivedByAddressRequest.Unmarshal(m, b)
 }
-func (m *TransactionNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionNotificationsResponse.Marshal(b, m, deterministic)
+func (m *TotalReceivedByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalReceivedByAddressRequest.Marshal(b, m, deterministic)
 }
-func (m *TransactionNotificationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionNotificationsResponse.Merge(m, src)
+func (m *TotalReceivedByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalReceivedByAddressRequest.Merge(m, src)
 }
-func (m *TransactionNotificationsResponse) XXX_Size() int {
-	return xxx_messageInfo_TransactionNotificationsResponse.Size(m)
+func (m *TotalReceivedByAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_TotalReceivedByAddressRequest.Size(m)
 }
-func (m *TransactionNotificationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionNotificationsResponse.DiscardUnknown(m)
+func (m *TotalReceivedByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalReceivedByAddressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TransactionNotificationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_TotalReceivedByAddressRequest proto.InternalMessageInfo
 
-func (m *TransactionNotificationsResponse) GetAttachedBlocks() []*BlockDetails {
+func (m *TotalReceivedByAddressRequest) GetAddress() string {
 	if m != nil {
-		return m.AttachedBlocks
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-func (m *TransactionNotificationsResponse) GetDetachedBlocks() [][]byte {
+func (m *TotalReceivedByAddressRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
-		return m.DetachedBlocks
+		return m.RequiredConfirmations
 	}
-	return nil
+	return 0
 }
 
-func (m *TransactionNotificationsResponse) GetUnminedTransactions() []*TransactionDetails {
-	if m != nil {
-		return m.UnminedTransactions
-	}
-	return nil
-}
-
-func (m *TransactionNotificationsResponse) GetUnminedTransactionHashes() [][]byte {
-	if m != nil {
-		return m.UnminedTransactionHashes
-	}
-	return nil
-}
-
-type SpentnessNotificationsRequest struct {
-	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
-	NoNotifyUnspent      bool     `protobuf:"varint,2,opt,name=no_notify_unspent,json=noNotifyUnspent,proto3" json:"no_notify_unspent,omitempty"`
-	NoNotifySpent        bool     `protobuf:"varint,3,opt,name=no_notify_spent,json=noNotifySpent,proto3" json:"no_notify_spent,omitempty"`
+type TotalReceivedByAddressResponse struct {
+	TotalReceived        int64    `protobuf:"varint,1,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SpentnessNotificationsRequest) Reset()         { *m = SpentnessNotificationsRequest{} }
-func (m *SpentnessNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*SpentnessNotificationsRequest) ProtoMessage()    {}
-func (*SpentnessNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{43}
+func (m *TotalReceivedByAddressResponse) Reset()         { *m = TotalReceivedByAddressResponse{} }
+func (m *TotalReceivedByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*TotalReceivedByAddressResponse) ProtoMessage()    {}
+func (*TotalReceivedByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
 }
 
-func (m *SpentnessNotificationsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SpentnessNotificationsRequest.Unmarshal(m, b)
+func (m *TotalReceivedByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalReceivedByAddressResponse.Unmarshal(m, b)
 }
-func (m *SpentnessNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SpentnessNotificationsRequest.Marshal(b, m, deterministic)
+func (m *TotalReceivedByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalReceivedByAddressResponse.Marshal(b, m, deterministic)
 }
-func (m *SpentnessNotificationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpentnessNotificationsRequest.Merge(m, src)
+func (m *TotalReceivedByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalReceivedByAddressResponse.Merge(m, src)
 }
-func (m *SpentnessNotificationsRequest) XXX_Size() int {
-	return xxx_messageInfo_SpentnessNotificationsRequest.Size(m)
+func (m *TotalReceivedByAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_TotalReceivedByAddressResponse.Size(m)
 }
-func (m *SpentnessNotificationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpentnessNotificationsRequest.DiscardUnknown(m)
+func (m *TotalReceivedByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalReceivedByAddressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpentnessNotificationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_TotalReceivedByAddressResponse proto.InternalMessageInfo
 
-func (m *SpentnessNotificationsRequest) GetAccount() uint32 {
+func (m *TotalReceivedByAddressResponse) GetTotalReceived() int64 {
 	if m != nil {
-		return m.Account
+		return m.TotalReceived
 	}
 	return 0
 }
 
-func (m *SpentnessNotificationsRequest) GetNoNotifyUnspent() bool {
-	if m != nil {
-		return m.NoNotifyUnspent
-	}
-	return false
-}
-
-func (m *SpentnessNotificationsRequest) GetNoNotifySpent() bool {
-	if m != nil {
-		return m.NoNotifySpent
-	}
-	return false
-}
-
-type SpentnessNotificationsResponse struct {
-	TransactionHash      []byte                                  `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	OutputIndex          uint32                                  `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
-	Spender              *SpentnessNotificationsResponse_Spender `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
-	XXX_unrecognized     []byte                                  `json:"-"`
-	XXX_sizecache        int32                                   `json:"-"`
+type TotalReceivedByAccountRequest struct {
+	Account               uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	RequiredConfirmations int32    `protobuf:"varint,2,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *SpentnessNotificationsResponse) Reset()         { *m = SpentnessNotificationsResponse{} }
-func (m *SpentnessNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*SpentnessNotificationsResponse) ProtoMessage()    {}
-func (*SpentnessNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{44}
+func (m *TotalReceivedByAccountRequest) Reset()         { *m = TotalReceivedByAccountRequest{} }
+func (m *TotalReceivedByAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*TotalReceivedByAccountRequest) ProtoMessage()    {}
+func (*TotalReceivedByAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
 }
 
-func (m *SpentnessNotificationsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SpentnessNotificationsResponse.Unmarshal(m, b)
+func (m *TotalReceivedByAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalReceivedByAccountRequest.Unmarshal(m, b)
 }
-func (m *SpentnessNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SpentnessNotificationsResponse.Marshal(b, m, deterministic)
+func (m *TotalReceivedByAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalReceivedByAccountRequest.Marshal(b, m, deterministic)
 }
-func (m *SpentnessNotificationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpentnessNotificationsResponse.Merge(m, src)
+func (m *TotalReceivedByAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalReceivedByAccountRequest.Merge(m, src)
 }
-func (m *SpentnessNotificationsResponse) XXX_Size() int {
-	return xxx_messageInfo_SpentnessNotificationsResponse.Size(m)
+func (m *TotalReceivedByAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_TotalReceivedByAccountRequest.Size(m)
 }
-func (m *SpentnessNotificationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpentnessNotificationsResponse.DiscardUnknown(m)
+func (m *TotalReceivedByAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalReceivedByAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpentnessNotificationsResponse proto.InternalMessageInfo
-
-func (m *SpentnessNotificationsResponse) GetTransactionHash() []byte {
-	if m != nil {
-		return m.TransactionHash
-	}
-	return nil
-}
+var xxx_messageInfo_TotalReceivedByAccountRequest proto.InternalMessageInfo
 
-func (m *SpentnessNotificationsResponse) GetOutputIndex() uint32 {
+func (m *TotalReceivedByAccountRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.OutputIndex
+		return m.Account
 	}
 	return 0
 }
 
-func (m *SpentnessNotificationsResponse) GetSpender() *SpentnessNotificationsResponse_Spender {
+func (m *TotalReceivedByAccountRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
-		return m.Spender
+		return m.RequiredConfirmations
 	}
-	return nil
+	return 0
 }
 
-type SpentnessNotificationsResponse_Spender struct {
-	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
-	InputIndex           uint32   `protobuf:"varint,2,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+type TotalReceivedByAccountResponse struct {
+	TotalReceived        int64    `protobuf:"varint,1,opt,name=total_received,json=totalReceived,proto3" json:"total_received,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SpentnessNotificationsResponse_Spender) Reset() {
-	*m = SpentnessNotificationsResponse_Spender{}
-}
-func (m *SpentnessNotificationsResponse_Spender) String() string { return proto.CompactTextString(m) }
-func (*SpentnessNotificationsResponse_Spender) ProtoMessage()    {}
-func (*SpentnessNotificationsResponse_Spender) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{44, 0}
+func (m *TotalReceivedByAccountResponse) Reset()         { *m = TotalReceivedByAccountResponse{} }
+func (m *TotalReceivedByAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*TotalReceivedByAccountResponse) ProtoMessage()    {}
+func (*TotalReceivedByAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
 }
 
-func (m *SpentnessNotificationsResponse_Spender) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Unmarshal(m, b)
+func (m *TotalReceivedByAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalReceivedByAccountResponse.Unmarshal(m, b)
 }
-func (m *SpentnessNotificationsResponse_Spender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Marshal(b, m, deterministic)
+func (m *TotalReceivedByAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalReceivedByAccountResponse.Marshal(b, m, deterministic)
 }
-func (m *SpentnessNotificationsResponse_Spender) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpentnessNotificationsResponse_Spender.Merge(m, src)
+func (m *TotalReceivedByAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalReceivedByAccountResponse.Merge(m, src)
 }
-func (m *SpentnessNotificationsResponse_Spender) XXX_Size() int {
-	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Size(m)
+func (m *TotalReceivedByAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_TotalReceivedByAccountResponse.Size(m)
 }
-func (m *SpentnessNotificationsResponse_Spender) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpentnessNotificationsResponse_Spender.DiscardUnknown(m)
+func (m *TotalReceivedByAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalReceivedByAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpentnessNotificationsResponse_Spender proto.InternalMessageInfo
+var xxx_messageInfo_TotalReceivedByAccountResponse proto.InternalMessageInfo
 
-func (m *SpentnessNotificationsResponse_Spender) GetTransactionHash() []byte {
+func (m *TotalReceivedByAccountResponse) GetTotalReceived() int64 {
 	if m != nil {
-		return m.TransactionHash
+		return m.TotalReceived
 	}
-	return nil
+	return 0
 }
 
-func (m *SpentnessNotificationsResponse_Spender) GetInputIndex() uint32 {
+type ImmatureCoinbaseOutputsRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImmatureCoinbaseOutputsRequest) Reset()         { *m = ImmatureCoinbaseOutputsRequest{} }
+func (m *ImmatureCoinbaseOutputsRequest) String() string { return proto.CompactTextString(m) }
+func (*ImmatureCoinbaseOutputsRequest) ProtoMessage()    {}
+func (*ImmatureCoinbaseOutputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+}
+
+func (m *ImmatureCoinbaseOutputsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsRequest.Unmarshal(m, b)
+}
+func (m *ImmatureCoinbaseOutputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsRequest.Marshal(b, m, deterministic)
+}
+func (m *ImmatureCoinbaseOutputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImmatureCoinbaseOutputsRequest.Merge(m, src)
+}
+func (m *ImmatureCoinbaseOutputsRequest) XXX_Size() int {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsRequest.Size(m)
+}
+func (m *ImmatureCoinbaseOutputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImmatureCoinbaseOutputsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImmatureCoinbaseOutputsRequest proto.InternalMessageInfo
+
+func (m *ImmatureCoinbaseOutputsRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.InputIndex
+		return m.Account
 	}
 	return 0
 }
 
-type AccountNotificationsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ImmatureCoinbaseOutputsResponse struct {
+	Outputs              []*ImmatureCoinbaseOutputsResponse_Output `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func (m *AccountNotificationsRequest) Reset()         { *m = AccountNotificationsRequest{} }
-func (m *AccountNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*AccountNotificationsRequest) ProtoMessage()    {}
-func (*AccountNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{45}
+func (m *ImmatureCoinbaseOutputsResponse) Reset()         { *m = ImmatureCoinbaseOutputsResponse{} }
+func (m *ImmatureCoinbaseOutputsResponse) String() string { return proto.CompactTextString(m) }
+func (*ImmatureCoinbaseOutputsResponse) ProtoMessage()    {}
+func (*ImmatureCoinbaseOutputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
 }
 
-func (m *AccountNotificationsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountNotificationsRequest.Unmarshal(m, b)
+func (m *ImmatureCoinbaseOutputsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsResponse.Unmarshal(m, b)
 }
-func (m *AccountNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountNotificationsRequest.Marshal(b, m, deterministic)
+func (m *ImmatureCoinbaseOutputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsResponse.Marshal(b, m, deterministic)
 }
-func (m *AccountNotificationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountNotificationsRequest.Merge(m, src)
+func (m *ImmatureCoinbaseOutputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImmatureCoinbaseOutputsResponse.Merge(m, src)
 }
-func (m *AccountNotificationsRequest) XXX_Size() int {
-	return xxx_messageInfo_AccountNotificationsRequest.Size(m)
+func (m *ImmatureCoinbaseOutputsResponse) XXX_Size() int {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsResponse.Size(m)
 }
-func (m *AccountNotificationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountNotificationsRequest.DiscardUnknown(m)
+func (m *ImmatureCoinbaseOutputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImmatureCoinbaseOutputsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountNotificationsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ImmatureCoinbaseOutputsResponse proto.InternalMessageInfo
 
-type AccountNotificationsResponse struct {
-	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	ExternalKeyCount     uint32   `protobuf:"varint,3,opt,name=external_key_count,json=externalKeyCount,proto3" json:"external_key_count,omitempty"`
-	InternalKeyCount     uint32   `protobuf:"varint,4,opt,name=internal_key_count,json=internalKeyCount,proto3" json:"internal_key_count,omitempty"`
-	ImportedKeyCount     uint32   `protobuf:"varint,5,opt,name=imported_key_count,json=importedKeyCount,proto3" json:"imported_key_count,omitempty"`
+func (m *ImmatureCoinbaseOutputsResponse) GetOutputs() []*ImmatureCoinbaseOutputsResponse_Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+type ImmatureCoinbaseOutputsResponse_Output struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Height               int32    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	MaturityHeight       int32    `protobuf:"varint,5,opt,name=maturity_height,json=maturityHeight,proto3" json:"maturity_height,omitempty"`
+	BlocksUntilMature    int32    `protobuf:"varint,6,opt,name=blocks_until_mature,json=blocksUntilMature,proto3" json:"blocks_until_mature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountNotificationsResponse) Reset()         { *m = AccountNotificationsResponse{} }
-func (m *AccountNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*AccountNotificationsResponse) ProtoMessage()    {}
-func (*AccountNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{46}
+func (m *ImmatureCoinbaseOutputsResponse_Output) Reset() {
+	*m = ImmatureCoinbaseOutputsResponse_Output{}
+}
+func (m *ImmatureCoinbaseOutputsResponse_Output) String() string { return proto.CompactTextString(m) }
+func (*ImmatureCoinbaseOutputsResponse_Output) ProtoMessage()    {}
+func (*ImmatureCoinbaseOutputsResponse_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{54, 0}
 }
 
-func (m *AccountNotificationsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AccountNotificationsResponse.Unmarshal(m, b)
+func (m *ImmatureCoinbaseOutputsResponse_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsResponse_Output.Unmarshal(m, b)
 }
-func (m *AccountNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AccountNotificationsResponse.Marshal(b, m, deterministic)
+func (m *ImmatureCoinbaseOutputsResponse_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsResponse_Output.Marshal(b, m, deterministic)
 }
-func (m *AccountNotificationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountNotificationsResponse.Merge(m, src)
+func (m *ImmatureCoinbaseOutputsResponse_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImmatureCoinbaseOutputsResponse_Output.Merge(m, src)
 }
-func (m *AccountNotificationsResponse) XXX_Size() int {
-	return xxx_messageInfo_AccountNotificationsResponse.Size(m)
+func (m *ImmatureCoinbaseOutputsResponse_Output) XXX_Size() int {
+	return xxx_messageInfo_ImmatureCoinbaseOutputsResponse_Output.Size(m)
 }
-func (m *AccountNotificationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountNotificationsResponse.DiscardUnknown(m)
+func (m *ImmatureCoinbaseOutputsResponse_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImmatureCoinbaseOutputsResponse_Output.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountNotificationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ImmatureCoinbaseOutputsResponse_Output proto.InternalMessageInfo
 
-func (m *AccountNotificationsResponse) GetAccountNumber() uint32 {
+func (m *ImmatureCoinbaseOutputsResponse_Output) GetTransactionHash() []byte {
 	if m != nil {
-		return m.AccountNumber
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *ImmatureCoinbaseOutputsResponse_Output) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
 	}
 	return 0
 }
 
-func (m *AccountNotificationsResponse) GetAccountName() string {
+func (m *ImmatureCoinbaseOutputsResponse_Output) GetAmount() int64 {
 	if m != nil {
-		return m.AccountName
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *AccountNotificationsResponse) GetExternalKeyCount() uint32 {
+func (m *ImmatureCoinbaseOutputsResponse_Output) GetHeight() int32 {
 	if m != nil {
-		return m.ExternalKeyCount
+		return m.Height
 	}
 	return 0
 }
 
-func (m *AccountNotificationsResponse) GetInternalKeyCount() uint32 {
+func (m *ImmatureCoinbaseOutputsResponse_Output) GetMaturityHeight() int32 {
 	if m != nil {
-		return m.InternalKeyCount
+		return m.MaturityHeight
 	}
 	return 0
 }
 
-func (m *AccountNotificationsResponse) GetImportedKeyCount() uint32 {
+func (m *ImmatureCoinbaseOutputsResponse_Output) GetBlocksUntilMature() int32 {
 	if m != nil {
-		return m.ImportedKeyCount
+		return m.BlocksUntilMature
 	}
 	return 0
 }
 
-type RescanNotificationsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ChangePassphraseRequest struct {
+	Key                  ChangePassphraseRequest_Key `protobuf:"varint,1,opt,name=key,proto3,enum=walletrpc.ChangePassphraseRequest_Key" json:"key,omitempty"`
+	OldPassphrase        []byte                      `protobuf:"bytes,2,opt,name=old_passphrase,json=oldPassphrase,proto3" json:"old_passphrase,omitempty"`
+	NewPassphrase        []byte                      `protobuf:"bytes,3,opt,name=new_passphrase,json=newPassphrase,proto3" json:"new_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (m *RescanNotificationsRequest) Reset()         { *m = RescanNotificationsRequest{} }
-func (m *RescanNotificationsRequest) String() string { return proto.CompactTextString(m) }
-func (*RescanNotificationsRequest) ProtoMessage()    {}
-func (*RescanNotificationsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{47}
+func (m *ChangePassphraseRequest) Reset()         { *m = ChangePassphraseRequest{} }
+func (m *ChangePassphraseRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePassphraseRequest) ProtoMessage()    {}
+func (*ChangePassphraseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
 }
 
-func (m *RescanNotificationsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RescanNotificationsRequest.Unmarshal(m, b)
+func (m *ChangePassphraseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePassphraseRequest.Unmarshal(m, b)
 }
-func (m *RescanNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RescanNotificationsRequest.Marshal(b, m, deterministic)
+func (m *ChangePassphraseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePassphraseRequest.Marshal(b, m, deterministic)
 }
-func (m *RescanNotificationsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RescanNotificationsRequest.Merge(m, src)
+func (m *ChangePassphraseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePassphraseRequest.Merge(m, src)
 }
-func (m *RescanNotificationsRequest) XXX_Size() int {
-	return xxx_messageInfo_RescanNotificationsRequest.Size(m)
+func (m *ChangePassphraseRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePassphraseRequest.Size(m)
 }
-func (m *RescanNotificationsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RescanNotificationsRequest.DiscardUnknown(m)
+func (m *ChangePassphraseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePassphraseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RescanNotificationsRequest proto.InternalMessageInfo
-
-type RescanNotificationsResponse struct {
-	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Height               int32    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Finished             bool     `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *RescanNotificationsResponse) Reset()         { *m = RescanNotificationsResponse{} }
-func (m *RescanNotificationsResponse) String() string { return proto.CompactTextString(m) }
-func (*RescanNotificationsResponse) ProtoMessage()    {}
-func (*RescanNotificationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{48}
-}
-
-func (m *RescanNotificationsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RescanNotificationsResponse.Unmarshal(m, b)
-}
-func (m *RescanNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RescanNotificationsResponse.Marshal(b, m, deterministic)
-}
-func (m *RescanNotificationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RescanNotificationsResponse.Merge(m, src)
-}
-func (m *RescanNotificationsResponse) XXX_Size() int {
-	return xxx_messageInfo_RescanNotificationsResponse.Size(m)
-}
-func (m *RescanNotificationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RescanNotificationsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RescanNotificationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChangePassphraseRequest proto.InternalMessageInfo
 
-func (m *RescanNotificationsResponse) GetHash() []byte {
+func (m *ChangePassphraseRequest) GetKey() ChangePassphraseRequest_Key {
 	if m != nil {
-		return m.Hash
+		return m.Key
 	}
-	return nil
+	return ChangePassphraseRequest_PRIVATE
 }
 
-func (m *RescanNotificationsResponse) GetHeight() int32 {
+func (m *ChangePassphraseRequest) GetOldPassphrase() []byte {
 	if m != nil {
-		return m.Height
+		return m.OldPassphrase
 	}
-	return 0
+	return nil
 }
 
-func (m *RescanNotificationsResponse) GetFinished() bool {
+func (m *ChangePassphraseRequest) GetNewPassphrase() []byte {
 	if m != nil {
-		return m.Finished
+		return m.NewPassphrase
 	}
-	return false
+	return nil
 }
 
-type CreateWalletRequest struct {
-	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
-	PrivatePassphrase    []byte   `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
-	MnemonicSeed         string   `protobuf:"bytes,3,opt,name=mnemonic_seed,json=mnemonicSeed,proto3" json:"mnemonic_seed,omitempty"`
-	WalletBirthday       int64    `protobuf:"varint,4,opt,name=wallet_birthday,json=walletBirthday,proto3" json:"wallet_birthday,omitempty"`
+type ChangePassphraseResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateWalletRequest) Reset()         { *m = CreateWalletRequest{} }
-func (m *CreateWalletRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateWalletRequest) ProtoMessage()    {}
-func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{49}
+func (m *ChangePassphraseResponse) Reset()         { *m = ChangePassphraseResponse{} }
+func (m *ChangePassphraseResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePassphraseResponse) ProtoMessage()    {}
+func (*ChangePassphraseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
 }
 
-func (m *CreateWalletRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateWalletRequest.Unmarshal(m, b)
+func (m *ChangePassphraseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePassphraseResponse.Unmarshal(m, b)
 }
-func (m *CreateWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateWalletRequest.Marshal(b, m, deterministic)
+func (m *ChangePassphraseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePassphraseResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateWalletRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateWalletRequest.Merge(m, src)
+func (m *ChangePassphraseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePassphraseResponse.Merge(m, src)
 }
-func (m *CreateWalletRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateWalletRequest.Size(m)
+func (m *ChangePassphraseResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePassphraseResponse.Size(m)
 }
-func (m *CreateWalletRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateWalletRequest.DiscardUnknown(m)
+func (m *ChangePassphraseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePassphraseResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateWalletRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChangePassphraseResponse proto.InternalMessageInfo
 
-func (m *CreateWalletRequest) GetPublicPassphrase() []byte {
-	if m != nil {
-		return m.PublicPassphrase
-	}
-	return nil
+type UnlockWalletRequest struct {
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	TimeoutSeconds       int64    `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateWalletRequest) GetPrivatePassphrase() []byte {
-	if m != nil {
-		return m.PrivatePassphrase
-	}
-	return nil
+func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
+func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockWalletRequest) ProtoMessage()    {}
+func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
 }
 
-func (m *CreateWalletRequest) GetMnemonicSeed() string {
+func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
+}
+func (m *UnlockWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlockWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockWalletRequest.Merge(m, src)
+}
+func (m *UnlockWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockWalletRequest.Size(m)
+}
+func (m *UnlockWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockWalletRequest proto.InternalMessageInfo
+
+func (m *UnlockWalletRequest) GetPassphrase() []byte {
 	if m != nil {
-		return m.MnemonicSeed
+		return m.Passphrase
 	}
-	return ""
+	return nil
 }
 
-func (m *CreateWalletRequest) GetWalletBirthday() int64 {
+func (m *UnlockWalletRequest) GetTimeoutSeconds() int64 {
 	if m != nil {
-		return m.WalletBirthday
+		return m.TimeoutSeconds
 	}
 	return 0
 }
 
-type CreateWalletResponse struct {
+type UnlockWalletResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateWalletResponse) Reset()         { *m = CreateWalletResponse{} }
-func (m *CreateWalletResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateWalletResponse) ProtoMessage()    {}
-func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{50}
+func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
+func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockWalletResponse) ProtoMessage()    {}
+func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
 }
 
-func (m *CreateWalletResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateWalletResponse.Unmarshal(m, b)
+func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
 }
-func (m *CreateWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateWalletResponse.Marshal(b, m, deterministic)
+func (m *UnlockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockWalletResponse.Marshal(b, m, deterministic)
 }
-func (m *CreateWalletResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateWalletResponse.Merge(m, src)
+func (m *UnlockWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockWalletResponse.Merge(m, src)
 }
-func (m *CreateWalletResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateWalletResponse.Size(m)
+func (m *UnlockWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockWalletResponse.Size(m)
 }
-func (m *CreateWalletResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateWalletResponse.DiscardUnknown(m)
+func (m *UnlockWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockWalletResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateWalletResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnlockWalletResponse proto.InternalMessageInfo
 
-type OpenWalletRequest struct {
-	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+type LockWalletRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *OpenWalletRequest) Reset()         { *m = OpenWalletRequest{} }
-func (m *OpenWalletRequest) String() string { return proto.CompactTextString(m) }
-func (*OpenWalletRequest) ProtoMessage()    {}
-func (*OpenWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{51}
+func (m *LockWalletRequest) Reset()         { *m = LockWalletRequest{} }
+func (m *LockWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*LockWalletRequest) ProtoMessage()    {}
+func (*LockWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
 }
 
-func (m *OpenWalletRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OpenWalletRequest.Unmarshal(m, b)
+func (m *LockWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockWalletRequest.Unmarshal(m, b)
 }
-func (m *OpenWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OpenWalletRequest.Marshal(b, m, deterministic)
+func (m *LockWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockWalletRequest.Marshal(b, m, deterministic)
 }
-func (m *OpenWalletRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OpenWalletRequest.Merge(m, src)
+func (m *LockWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockWalletRequest.Merge(m, src)
 }
-func (m *OpenWalletRequest) XXX_Size() int {
-	return xxx_messageInfo_OpenWalletRequest.Size(m)
+func (m *LockWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_LockWalletRequest.Size(m)
 }
-func (m *OpenWalletRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_OpenWalletRequest.DiscardUnknown(m)
+func (m *LockWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockWalletRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OpenWalletRequest proto.InternalMessageInfo
-
-func (m *OpenWalletRequest) GetPublicPassphrase() []byte {
-	if m != nil {
-		return m.PublicPassphrase
-	}
-	return nil
-}
+var xxx_messageInfo_LockWalletRequest proto.InternalMessageInfo
 
-type OpenWalletResponse struct {
+type LockWalletResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *OpenWalletResponse) Reset()         { *m = OpenWalletResponse{} }
-func (m *OpenWalletResponse) String() string { return proto.CompactTextString(m) }
-func (*OpenWalletResponse) ProtoMessage()    {}
-func (*OpenWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{52}
+func (m *LockWalletResponse) Reset()         { *m = LockWalletResponse{} }
+func (m *LockWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*LockWalletResponse) ProtoMessage()    {}
+func (*LockWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
 }
 
-func (m *OpenWalletResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OpenWalletResponse.Unmarshal(m, b)
+func (m *LockWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockWalletResponse.Unmarshal(m, b)
 }
-func (m *OpenWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OpenWalletResponse.Marshal(b, m, deterministic)
+func (m *LockWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockWalletResponse.Marshal(b, m, deterministic)
 }
-func (m *OpenWalletResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OpenWalletResponse.Merge(m, src)
+func (m *LockWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockWalletResponse.Merge(m, src)
 }
-func (m *OpenWalletResponse) XXX_Size() int {
-	return xxx_messageInfo_OpenWalletResponse.Size(m)
+func (m *LockWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_LockWalletResponse.Size(m)
 }
-func (m *OpenWalletResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_OpenWalletResponse.DiscardUnknown(m)
+func (m *LockWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockWalletResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OpenWalletResponse proto.InternalMessageInfo
+var xxx_messageInfo_LockWalletResponse proto.InternalMessageInfo
 
-type CloseWalletRequest struct {
+type UnlockStateRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseWalletRequest) Reset()         { *m = CloseWalletRequest{} }
-func (m *CloseWalletRequest) String() string { return proto.CompactTextString(m) }
-func (*CloseWalletRequest) ProtoMessage()    {}
-func (*CloseWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{53}
+func (m *UnlockStateRequest) Reset()         { *m = UnlockStateRequest{} }
+func (m *UnlockStateRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockStateRequest) ProtoMessage()    {}
+func (*UnlockStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
 }
 
-func (m *CloseWalletRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseWalletRequest.Unmarshal(m, b)
+func (m *UnlockStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockStateRequest.Unmarshal(m, b)
 }
-func (m *CloseWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseWalletRequest.Marshal(b, m, deterministic)
+func (m *UnlockStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockStateRequest.Marshal(b, m, deterministic)
 }
-func (m *CloseWalletRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseWalletRequest.Merge(m, src)
+func (m *UnlockStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockStateRequest.Merge(m, src)
 }
-func (m *CloseWalletRequest) XXX_Size() int {
-	return xxx_messageInfo_CloseWalletRequest.Size(m)
+func (m *UnlockStateRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockStateRequest.Size(m)
 }
-func (m *CloseWalletRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseWalletRequest.DiscardUnknown(m)
+func (m *UnlockStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseWalletRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnlockStateRequest proto.InternalMessageInfo
 
-type CloseWalletResponse struct {
+type UnlockStateResponse struct {
+	Locked               bool     `protobuf:"varint,1,opt,name=locked,proto3" json:"locked,omitempty"`
+	UnlockedUntil        int64    `protobuf:"varint,2,opt,name=unlocked_until,json=unlockedUntil,proto3" json:"unlocked_until,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CloseWalletResponse) Reset()         { *m = CloseWalletResponse{} }
-func (m *CloseWalletResponse) String() string { return proto.CompactTextString(m) }
-func (*CloseWalletResponse) ProtoMessage()    {}
-func (*CloseWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{54}
+func (m *UnlockStateResponse) Reset()         { *m = UnlockStateResponse{} }
+func (m *UnlockStateResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockStateResponse) ProtoMessage()    {}
+func (*UnlockStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
 }
 
-func (m *CloseWalletResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CloseWalletResponse.Unmarshal(m, b)
+func (m *UnlockStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockStateResponse.Unmarshal(m, b)
 }
-func (m *CloseWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CloseWalletResponse.Marshal(b, m, deterministic)
+func (m *UnlockStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockStateResponse.Marshal(b, m, deterministic)
 }
-func (m *CloseWalletResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CloseWalletResponse.Merge(m, src)
+func (m *UnlockStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockStateResponse.Merge(m, src)
 }
-func (m *CloseWalletResponse) XXX_Size() int {
-	return xxx_messageInfo_CloseWalletResponse.Size(m)
+func (m *UnlockStateResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockStateResponse.Size(m)
 }
-func (m *CloseWalletResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CloseWalletResponse.DiscardUnknown(m)
+func (m *UnlockStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CloseWalletResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnlockStateResponse proto.InternalMessageInfo
 
-type WalletExistsRequest struct {
+func (m *UnlockStateResponse) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
+}
+
+func (m *UnlockStateResponse) GetUnlockedUntil() int64 {
+	if m != nil {
+		return m.UnlockedUntil
+	}
+	return 0
+}
+
+type WalletInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WalletExistsRequest) Reset()         { *m = WalletExistsRequest{} }
-func (m *WalletExistsRequest) String() string { return proto.CompactTextString(m) }
-func (*WalletExistsRequest) ProtoMessage()    {}
-func (*WalletExistsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{55}
+func (m *WalletInfoRequest) Reset()         { *m = WalletInfoRequest{} }
+func (m *WalletInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletInfoRequest) ProtoMessage()    {}
+func (*WalletInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
 }
 
-func (m *WalletExistsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletExistsRequest.Unmarshal(m, b)
+func (m *WalletInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletInfoRequest.Unmarshal(m, b)
 }
-func (m *WalletExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletExistsRequest.Marshal(b, m, deterministic)
+func (m *WalletInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletInfoRequest.Marshal(b, m, deterministic)
 }
-func (m *WalletExistsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletExistsRequest.Merge(m, src)
+func (m *WalletInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletInfoRequest.Merge(m, src)
 }
-func (m *WalletExistsRequest) XXX_Size() int {
-	return xxx_messageInfo_WalletExistsRequest.Size(m)
+func (m *WalletInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletInfoRequest.Size(m)
 }
-func (m *WalletExistsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletExistsRequest.DiscardUnknown(m)
+func (m *WalletInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalletExistsRequest proto.InternalMessageInfo
+var xxx_messageInfo_WalletInfoRequest proto.InternalMessageInfo
 
-type WalletExistsResponse struct {
-	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+type WalletInfoResponse struct {
+	Version              uint32   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	PublicEncrypted      bool     `protobuf:"varint,2,opt,name=public_encrypted,json=publicEncrypted,proto3" json:"public_encrypted,omitempty"`
+	Locked               bool     `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	WatchingOnly         bool     `protobuf:"varint,4,opt,name=watching_only,json=watchingOnly,proto3" json:"watching_only,omitempty"`
+	AccountCount         uint32   `protobuf:"varint,5,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
+	OutputCount          uint32   `protobuf:"varint,6,opt,name=output_count,json=outputCount,proto3" json:"output_count,omitempty"`
+	UnspentOutputCount   uint32   `protobuf:"varint,7,opt,name=unspent_output_count,json=unspentOutputCount,proto3" json:"unspent_output_count,omitempty"`
+	SyncedToHeight       int32    `protobuf:"varint,8,opt,name=synced_to_height,json=syncedToHeight,proto3" json:"synced_to_height,omitempty"`
+	SyncedToHash         []byte   `protobuf:"bytes,9,opt,name=synced_to_hash,json=syncedToHash,proto3" json:"synced_to_hash,omitempty"`
+	Birthday             int64    `protobuf:"varint,10,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	BirthdayBlockHeight  int32    `protobuf:"varint,11,opt,name=birthday_block_height,json=birthdayBlockHeight,proto3" json:"birthday_block_height,omitempty"`
+	BirthdayBlockHash    []byte   `protobuf:"bytes,12,opt,name=birthday_block_hash,json=birthdayBlockHash,proto3" json:"birthday_block_hash,omitempty"`
+	ChainClientConnected bool     `protobuf:"varint,13,opt,name=chain_client_connected,json=chainClientConnected,proto3" json:"chain_client_connected,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WalletExistsResponse) Reset()         { *m = WalletExistsResponse{} }
-func (m *WalletExistsResponse) String() string { return proto.CompactTextString(m) }
-func (*WalletExistsResponse) ProtoMessage()    {}
-func (*WalletExistsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{56}
+func (m *WalletInfoResponse) Reset()         { *m = WalletInfoResponse{} }
+func (m *WalletInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletInfoResponse) ProtoMessage()    {}
+func (*WalletInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
 }
 
-func (m *WalletExistsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletExistsResponse.Unmarshal(m, b)
+func (m *WalletInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletInfoResponse.Unmarshal(m, b)
 }
-func (m *WalletExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletExistsResponse.Marshal(b, m, deterministic)
+func (m *WalletInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletInfoResponse.Marshal(b, m, deterministic)
 }
-func (m *WalletExistsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletExistsResponse.Merge(m, src)
+func (m *WalletInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletInfoResponse.Merge(m, src)
 }
-func (m *WalletExistsResponse) XXX_Size() int {
-	return xxx_messageInfo_WalletExistsResponse.Size(m)
+func (m *WalletInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletInfoResponse.Size(m)
 }
-func (m *WalletExistsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletExistsResponse.DiscardUnknown(m)
+func (m *WalletInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalletExistsResponse proto.InternalMessageInfo
+var xxx_messageInfo_WalletInfoResponse proto.InternalMessageInfo
 
-func (m *WalletExistsResponse) GetExists() bool {
+func (m *WalletInfoResponse) GetVersion() uint32 {
 	if m != nil {
-		return m.Exists
+		return m.Version
 	}
-	return false
+	return 0
 }
 
-type StartConsensusRpcRequest struct {
-	NetworkAddress       string   `protobuf:"bytes,1,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
-	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password             []byte   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	Certificate          []byte   `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *WalletInfoResponse) GetPublicEncrypted() bool {
+	if m != nil {
+		return m.PublicEncrypted
+	}
+	return false
 }
 
-func (m *StartConsensusRpcRequest) Reset()         { *m = StartConsensusRpcRequest{} }
-func (m *StartConsensusRpcRequest) String() string { return proto.CompactTextString(m) }
-func (*StartConsensusRpcRequest) ProtoMessage()    {}
-func (*StartConsensusRpcRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{57}
+func (m *WalletInfoResponse) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
 }
 
-func (m *StartConsensusRpcRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StartConsensusRpcRequest.Unmarshal(m, b)
+func (m *WalletInfoResponse) GetWatchingOnly() bool {
+	if m != nil {
+		return m.WatchingOnly
+	}
+	return false
 }
-func (m *StartConsensusRpcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StartConsensusRpcRequest.Marshal(b, m, deterministic)
+
+func (m *WalletInfoResponse) GetAccountCount() uint32 {
+	if m != nil {
+		return m.AccountCount
+	}
+	return 0
 }
-func (m *StartConsensusRpcRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StartConsensusRpcRequest.Merge(m, src)
+
+func (m *WalletInfoResponse) GetOutputCount() uint32 {
+	if m != nil {
+		return m.OutputCount
+	}
+	return 0
 }
-func (m *StartConsensusRpcRequest) XXX_Size() int {
-	return xxx_messageInfo_StartConsensusRpcRequest.Size(m)
+
+func (m *WalletInfoResponse) GetUnspentOutputCount() uint32 {
+	if m != nil {
+		return m.UnspentOutputCount
+	}
+	return 0
 }
-func (m *StartConsensusRpcRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StartConsensusRpcRequest.DiscardUnknown(m)
+
+func (m *WalletInfoResponse) GetSyncedToHeight() int32 {
+	if m != nil {
+		return m.SyncedToHeight
+	}
+	return 0
 }
 
-var xxx_messageInfo_StartConsensusRpcRequest proto.InternalMessageInfo
+func (m *WalletInfoResponse) GetSyncedToHash() []byte {
+	if m != nil {
+		return m.SyncedToHash
+	}
+	return nil
+}
 
-func (m *StartConsensusRpcRequest) GetNetworkAddress() string {
+func (m *WalletInfoResponse) GetBirthday() int64 {
 	if m != nil {
-		return m.NetworkAddress
+		return m.Birthday
 	}
-	return ""
+	return 0
 }
 
-func (m *StartConsensusRpcRequest) GetUsername() string {
+func (m *WalletInfoResponse) GetBirthdayBlockHeight() int32 {
 	if m != nil {
-		return m.Username
+		return m.BirthdayBlockHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *StartConsensusRpcRequest) GetPassword() []byte {
+func (m *WalletInfoResponse) GetBirthdayBlockHash() []byte {
 	if m != nil {
-		return m.Password
+		return m.BirthdayBlockHash
 	}
 	return nil
 }
 
-func (m *StartConsensusRpcRequest) GetCertificate() []byte {
+func (m *WalletInfoResponse) GetChainClientConnected() bool {
 	if m != nil {
-		return m.Certificate
+		return m.ChainClientConnected
 	}
-	return nil
+	return false
 }
 
-type StartConsensusRpcResponse struct {
+type GetAccountAddressesRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Purpose              uint32   `protobuf:"varint,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	CoinType             uint32   `protobuf:"varint,3,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StartConsensusRpcResponse) Reset()         { *m = StartConsensusRpcResponse{} }
-func (m *StartConsensusRpcResponse) String() string { return proto.CompactTextString(m) }
-func (*StartConsensusRpcResponse) ProtoMessage()    {}
-func (*StartConsensusRpcResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{58}
+func (m *GetAccountAddressesRequest) Reset()         { *m = GetAccountAddressesRequest{} }
+func (m *GetAccountAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountAddressesRequest) ProtoMessage()    {}
+func (*GetAccountAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
 }
 
-func (m *StartConsensusRpcResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StartConsensusRpcResponse.Unmarshal(m, b)
+func (m *GetAccountAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountAddressesRequest.Unmarshal(m, b)
 }
-func (m *StartConsensusRpcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StartConsensusRpcResponse.Marshal(b, m, deterministic)
+func (m *GetAccountAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountAddressesRequest.Marshal(b, m, deterministic)
 }
-func (m *StartConsensusRpcResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StartConsensusRpcResponse.Merge(m, src)
+func (m *GetAccountAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountAddressesRequest.Merge(m, src)
 }
-func (m *StartConsensusRpcResponse) XXX_Size() int {
-	return xxx_messageInfo_StartConsensusRpcResponse.Size(m)
+func (m *GetAccountAddressesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountAddressesRequest.Size(m)
 }
-func (m *StartConsensusRpcResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StartConsensusRpcResponse.DiscardUnknown(m)
+func (m *GetAccountAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountAddressesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StartConsensusRpcResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAccountAddressesRequest proto.InternalMessageInfo
 
-type ValidateAddressRequest struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetAccountAddressesRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
 }
 
-func (m *ValidateAddressRequest) Reset()         { *m = ValidateAddressRequest{} }
-func (m *ValidateAddressRequest) String() string { return proto.CompactTextString(m) }
-func (*ValidateAddressRequest) ProtoMessage()    {}
-func (*ValidateAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{59}
+func (m *GetAccountAddressesRequest) GetPurpose() uint32 {
+	if m != nil {
+		return m.Purpose
+	}
+	return 0
 }
 
-func (m *ValidateAddressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateAddressRequest.Unmarshal(m, b)
+func (m *GetAccountAddressesRequest) GetCoinType() uint32 {
+	if m != nil {
+		return m.CoinType
+	}
+	return 0
 }
-func (m *ValidateAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateAddressRequest.Marshal(b, m, deterministic)
+
+type GetAccountAddressesResponse struct {
+	Addresses            []*GetAccountAddressesResponse_Address `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
-func (m *ValidateAddressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateAddressRequest.Merge(m, src)
+
+func (m *GetAccountAddressesResponse) Reset()         { *m = GetAccountAddressesResponse{} }
+func (m *GetAccountAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountAddressesResponse) ProtoMessage()    {}
+func (*GetAccountAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
 }
-func (m *ValidateAddressRequest) XXX_Size() int {
-	return xxx_messageInfo_ValidateAddressRequest.Size(m)
+
+func (m *GetAccountAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountAddressesResponse.Unmarshal(m, b)
 }
-func (m *ValidateAddressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateAddressRequest.DiscardUnknown(m)
+func (m *GetAccountAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountAddressesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountAddressesResponse.Merge(m, src)
+}
+func (m *GetAccountAddressesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountAddressesResponse.Size(m)
+}
+func (m *GetAccountAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountAddressesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateAddressRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetAccountAddressesResponse proto.InternalMessageInfo
 
-func (m *ValidateAddressRequest) GetAddress() string {
+func (m *GetAccountAddressesResponse) GetAddresses() []*GetAccountAddressesResponse_Address {
 	if m != nil {
-		return m.Address
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-type ValidateAddressResponse struct {
-	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+type GetAccountAddressesResponse_Address struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Internal             bool     `protobuf:"varint,2,opt,name=internal,proto3" json:"internal,omitempty"`
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Imported             bool     `protobuf:"varint,4,opt,name=imported,proto3" json:"imported,omitempty"`
+	Used                 bool     `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ValidateAddressResponse) Reset()         { *m = ValidateAddressResponse{} }
-func (m *ValidateAddressResponse) String() string { return proto.CompactTextString(m) }
-func (*ValidateAddressResponse) ProtoMessage()    {}
-func (*ValidateAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{60}
+func (m *GetAccountAddressesResponse_Address) Reset()         { *m = GetAccountAddressesResponse_Address{} }
+func (m *GetAccountAddressesResponse_Address) String() string { return proto.CompactTextString(m) }
+func (*GetAccountAddressesResponse_Address) ProtoMessage()    {}
+func (*GetAccountAddressesResponse_Address) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{66, 0}
 }
 
-func (m *ValidateAddressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ValidateAddressResponse.Unmarshal(m, b)
+func (m *GetAccountAddressesResponse_Address) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountAddressesResponse_Address.Unmarshal(m, b)
 }
-func (m *ValidateAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ValidateAddressResponse.Marshal(b, m, deterministic)
+func (m *GetAccountAddressesResponse_Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountAddressesResponse_Address.Marshal(b, m, deterministic)
 }
-func (m *ValidateAddressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ValidateAddressResponse.Merge(m, src)
+func (m *GetAccountAddressesResponse_Address) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountAddressesResponse_Address.Merge(m, src)
 }
-func (m *ValidateAddressResponse) XXX_Size() int {
-	return xxx_messageInfo_ValidateAddressResponse.Size(m)
+func (m *GetAccountAddressesResponse_Address) XXX_Size() int {
+	return xxx_messageInfo_GetAccountAddressesResponse_Address.Size(m)
 }
-func (m *ValidateAddressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ValidateAddressResponse.DiscardUnknown(m)
+func (m *GetAccountAddressesResponse_Address) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountAddressesResponse_Address.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ValidateAddressResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAccountAddressesResponse_Address proto.InternalMessageInfo
 
-func (m *ValidateAddressResponse) GetValid() bool {
+func (m *GetAccountAddressesResponse_Address) GetAddress() string {
 	if m != nil {
-		return m.Valid
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAccountAddressesResponse_Address) GetInternal() bool {
+	if m != nil {
+		return m.Internal
 	}
 	return false
 }
 
-type GenerateMnemonicSeedRequest struct {
-	BitSize              uint32   `protobuf:"varint,1,opt,name=bit_size,json=bitSize,proto3" json:"bit_size,omitempty"`
+func (m *GetAccountAddressesResponse_Address) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *GetAccountAddressesResponse_Address) GetImported() bool {
+	if m != nil {
+		return m.Imported
+	}
+	return false
+}
+
+func (m *GetAccountAddressesResponse_Address) GetUsed() bool {
+	if m != nil {
+		return m.Used
+	}
+	return false
+}
+
+type GetAccountExtendedPubKeyRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Purpose              uint32   `protobuf:"varint,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	CoinType             uint32   `protobuf:"varint,3,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GenerateMnemonicSeedRequest) Reset()         { *m = GenerateMnemonicSeedRequest{} }
-func (m *GenerateMnemonicSeedRequest) String() string { return proto.CompactTextString(m) }
-func (*GenerateMnemonicSeedRequest) ProtoMessage()    {}
-func (*GenerateMnemonicSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{61}
+func (m *GetAccountExtendedPubKeyRequest) Reset()         { *m = GetAccountExtendedPubKeyRequest{} }
+func (m *GetAccountExtendedPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountExtendedPubKeyRequest) ProtoMessage()    {}
+func (*GetAccountExtendedPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{67}
 }
 
-func (m *GenerateMnemonicSeedRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GenerateMnemonicSeedRequest.Unmarshal(m, b)
+func (m *GetAccountExtendedPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountExtendedPubKeyRequest.Unmarshal(m, b)
 }
-func (m *GenerateMnemonicSeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GenerateMnemonicSeedRequest.Marshal(b, m, deterministic)
+func (m *GetAccountExtendedPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountExtendedPubKeyRequest.Marshal(b, m, deterministic)
 }
-func (m *GenerateMnemonicSeedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenerateMnemonicSeedRequest.Merge(m, src)
+func (m *GetAccountExtendedPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountExtendedPubKeyRequest.Merge(m, src)
 }
-func (m *GenerateMnemonicSeedRequest) XXX_Size() int {
-	return xxx_messageInfo_GenerateMnemonicSeedRequest.Size(m)
+func (m *GetAccountExtendedPubKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountExtendedPubKeyRequest.Size(m)
 }
-func (m *GenerateMnemonicSeedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenerateMnemonicSeedRequest.DiscardUnknown(m)
+func (m *GetAccountExtendedPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountExtendedPubKeyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenerateMnemonicSeedRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetAccountExtendedPubKeyRequest proto.InternalMessageInfo
 
-func (m *GenerateMnemonicSeedRequest) GetBitSize() uint32 {
+func (m *GetAccountExtendedPubKeyRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.BitSize
+		return m.Account
 	}
 	return 0
 }
 
-type GenerateMnemonicSeedResponse struct {
-	Mnemonic             string   `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+func (m *GetAccountExtendedPubKeyRequest) GetPurpose() uint32 {
+	if m != nil {
+		return m.Purpose
+	}
+	return 0
+}
+
+func (m *GetAccountExtendedPubKeyRequest) GetCoinType() uint32 {
+	if m != nil {
+		return m.CoinType
+	}
+	return 0
+}
+
+type GetAccountExtendedPubKeyResponse struct {
+	ExtendedPubKey       string   `protobuf:"bytes,1,opt,name=extended_pub_key,json=extendedPubKey,proto3" json:"extended_pub_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GenerateMnemonicSeedResponse) Reset()         { *m = GenerateMnemonicSeedResponse{} }
-func (m *GenerateMnemonicSeedResponse) String() string { return proto.CompactTextString(m) }
-func (*GenerateMnemonicSeedResponse) ProtoMessage()    {}
-func (*GenerateMnemonicSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{62}
+func (m *GetAccountExtendedPubKeyResponse) Reset()         { *m = GetAccountExtendedPubKeyResponse{} }
+func (m *GetAccountExtendedPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountExtendedPubKeyResponse) ProtoMessage()    {}
+func (*GetAccountExtendedPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{68}
 }
 
-func (m *GenerateMnemonicSeedResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GenerateMnemonicSeedResponse.Unmarshal(m, b)
+func (m *GetAccountExtendedPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountExtendedPubKeyResponse.Unmarshal(m, b)
 }
-func (m *GenerateMnemonicSeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GenerateMnemonicSeedResponse.Marshal(b, m, deterministic)
+func (m *GetAccountExtendedPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountExtendedPubKeyResponse.Marshal(b, m, deterministic)
 }
-func (m *GenerateMnemonicSeedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GenerateMnemonicSeedResponse.Merge(m, src)
+func (m *GetAccountExtendedPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountExtendedPubKeyResponse.Merge(m, src)
 }
-func (m *GenerateMnemonicSeedResponse) XXX_Size() int {
-	return xxx_messageInfo_GenerateMnemonicSeedResponse.Size(m)
+func (m *GetAccountExtendedPubKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountExtendedPubKeyResponse.Size(m)
 }
-func (m *GenerateMnemonicSeedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GenerateMnemonicSeedResponse.DiscardUnknown(m)
+func (m *GetAccountExtendedPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountExtendedPubKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GenerateMnemonicSeedResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAccountExtendedPubKeyResponse proto.InternalMessageInfo
 
-func (m *GenerateMnemonicSeedResponse) GetMnemonic() string {
+func (m *GetAccountExtendedPubKeyResponse) GetExtendedPubKey() string {
 	if m != nil {
-		return m.Mnemonic
+		return m.ExtendedPubKey
 	}
 	return ""
 }
 
-type DownloadPaymentRequestRequest struct {
-	Uri                  string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FundTransactionRequest struct {
+	Account                  uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	TargetAmount             int64    `protobuf:"varint,2,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	RequiredConfirmations    int32    `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	IncludeImmatureCoinbases bool     `protobuf:"varint,4,opt,name=include_immature_coinbases,json=includeImmatureCoinbases,proto3" json:"include_immature_coinbases,omitempty"`
+	IncludeChangeScript      bool     `protobuf:"varint,5,opt,name=include_change_script,json=includeChangeScript,proto3" json:"include_change_script,omitempty"`
+	LockOutputs              bool     `protobuf:"varint,6,opt,name=lock_outputs,json=lockOutputs,proto3" json:"lock_outputs,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
-func (m *DownloadPaymentRequestRequest) Reset()         { *m = DownloadPaymentRequestRequest{} }
-func (m *DownloadPaymentRequestRequest) String() string { return proto.CompactTextString(m) }
-func (*DownloadPaymentRequestRequest) ProtoMessage()    {}
-func (*DownloadPaymentRequestRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{63}
-}
-
-func (m *DownloadPaymentRequestRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownloadPaymentRequestRequest.Unmarshal(m, b)
-}
-func (m *DownloadPaymentRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownloadPaymentRequestRequest.Marshal(b, m, deterministic)
-}
-func (m *DownloadPaymentRequestRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownloadPaymentRequestRequest.Merge(m, src)
-}
-func (m *DownloadPaymentRequestRequest) XXX_Size() int {
-	return xxx_messageInfo_DownloadPaymentRequestRequest.Size(m)
-}
-func (m *DownloadPaymentRequestRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownloadPaymentRequestRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DownloadPaymentRequestRequest proto.InternalMessageInfo
-
-func (m *DownloadPaymentRequestRequest) GetUri() string {
-	if m != nil {
-		return m.Uri
-	}
-	return ""
-}
-
-type DownloadPaymentRequestResponse struct {
-	PayToName            string                                   `protobuf:"bytes,1,opt,name=pay_to_name,json=payToName,proto3" json:"pay_to_name,omitempty"`
-	Outputs              []*DownloadPaymentRequestResponse_Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
-	Expires              int64                                    `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
-	Memo                 string                                   `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
-	PaymentUrl           string                                   `protobuf:"bytes,5,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	MerchantData         []byte                                   `protobuf:"bytes,6,opt,name=merchant_data,json=merchantData,proto3" json:"merchant_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
-	XXX_unrecognized     []byte                                   `json:"-"`
-	XXX_sizecache        int32                                    `json:"-"`
-}
-
-func (m *DownloadPaymentRequestResponse) Reset()         { *m = DownloadPaymentRequestResponse{} }
-func (m *DownloadPaymentRequestResponse) String() string { return proto.CompactTextString(m) }
-func (*DownloadPaymentRequestResponse) ProtoMessage()    {}
-func (*DownloadPaymentRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{64}
+func (m *FundTransactionRequest) Reset()         { *m = FundTransactionRequest{} }
+func (m *FundTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionRequest) ProtoMessage()    {}
+func (*FundTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{69}
 }
 
-func (m *DownloadPaymentRequestResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownloadPaymentRequestResponse.Unmarshal(m, b)
+func (m *FundTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundTransactionRequest.Unmarshal(m, b)
 }
-func (m *DownloadPaymentRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownloadPaymentRequestResponse.Marshal(b, m, deterministic)
+func (m *FundTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundTransactionRequest.Marshal(b, m, deterministic)
 }
-func (m *DownloadPaymentRequestResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownloadPaymentRequestResponse.Merge(m, src)
+func (m *FundTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionRequest.Merge(m, src)
 }
-func (m *DownloadPaymentRequestResponse) XXX_Size() int {
-	return xxx_messageInfo_DownloadPaymentRequestResponse.Size(m)
+func (m *FundTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_FundTransactionRequest.Size(m)
 }
-func (m *DownloadPaymentRequestResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownloadPaymentRequestResponse.DiscardUnknown(m)
+func (m *FundTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DownloadPaymentRequestResponse proto.InternalMessageInfo
+var xxx_messageInfo_FundTransactionRequest proto.InternalMessageInfo
 
-func (m *DownloadPaymentRequestResponse) GetPayToName() string {
+func (m *FundTransactionRequest) GetAccount() uint32 {
 	if m != nil {
-		return m.PayToName
+		return m.Account
 	}
-	return ""
+	return 0
 }
 
-func (m *DownloadPaymentRequestResponse) GetOutputs() []*DownloadPaymentRequestResponse_Output {
+func (m *FundTransactionRequest) GetTargetAmount() int64 {
 	if m != nil {
-		return m.Outputs
+		return m.TargetAmount
 	}
-	return nil
+	return 0
 }
 
-func (m *DownloadPaymentRequestResponse) GetExpires() int64 {
+func (m *FundTransactionRequest) GetRequiredConfirmations() int32 {
 	if m != nil {
-		return m.Expires
+		return m.RequiredConfirmations
 	}
 	return 0
 }
 
-func (m *DownloadPaymentRequestResponse) GetMemo() string {
+func (m *FundTransactionRequest) GetIncludeImmatureCoinbases() bool {
 	if m != nil {
-		return m.Memo
+		return m.IncludeImmatureCoinbases
 	}
-	return ""
+	return false
 }
 
-func (m *DownloadPaymentRequestResponse) GetPaymentUrl() string {
+func (m *FundTransactionRequest) GetIncludeChangeScript() bool {
 	if m != nil {
-		return m.PaymentUrl
+		return m.IncludeChangeScript
 	}
-	return ""
+	return false
 }
 
-func (m *DownloadPaymentRequestResponse) GetMerchantData() []byte {
+func (m *FundTransactionRequest) GetLockOutputs() bool {
 	if m != nil {
-		return m.MerchantData
+		return m.LockOutputs
 	}
-	return nil
+	return false
 }
 
-type DownloadPaymentRequestResponse_Output struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FundTransactionResponse struct {
+	SelectedOutputs      []*FundTransactionResponse_PreviousOutput `protobuf:"bytes,1,rep,name=selected_outputs,json=selectedOutputs,proto3" json:"selected_outputs,omitempty"`
+	TotalAmount          int64                                     `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	ChangePkScript       []byte                                    `protobuf:"bytes,3,opt,name=change_pk_script,json=changePkScript,proto3" json:"change_pk_script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
-func (m *DownloadPaymentRequestResponse_Output) Reset()         { *m = DownloadPaymentRequestResponse_Output{} }
-func (m *DownloadPaymentRequestResponse_Output) String() string { return proto.CompactTextString(m) }
-func (*DownloadPaymentRequestResponse_Output) ProtoMessage()    {}
-func (*DownloadPaymentRequestResponse_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{64, 0}
+func (m *FundTransactionResponse) Reset()         { *m = FundTransactionResponse{} }
+func (m *FundTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionResponse) ProtoMessage()    {}
+func (*FundTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70}
 }
 
-func (m *DownloadPaymentRequestResponse_Output) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DownloadPaymentRequestResponse_Output.Unmarshal(m, b)
+func (m *FundTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundTransactionResponse.Unmarshal(m, b)
 }
-func (m *DownloadPaymentRequestResponse_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DownloadPaymentRequestResponse_Output.Marshal(b, m, deterministic)
+func (m *FundTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundTransactionResponse.Marshal(b, m, deterministic)
 }
-func (m *DownloadPaymentRequestResponse_Output) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DownloadPaymentRequestResponse_Output.Merge(m, src)
+func (m *FundTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionResponse.Merge(m, src)
 }
-func (m *DownloadPaymentRequestResponse_Output) XXX_Size() int {
-	return xxx_messageInfo_DownloadPaymentRequestResponse_Output.Size(m)
+func (m *FundTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_FundTransactionResponse.Size(m)
 }
-func (m *DownloadPaymentRequestResponse_Output) XXX_DiscardUnknown() {
-	xxx_messageInfo_DownloadPaymentRequestResponse_Output.DiscardUnknown(m)
+func (m *FundTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DownloadPaymentRequestResponse_Output proto.InternalMessageInfo
+var xxx_messageInfo_FundTransactionResponse proto.InternalMessageInfo
 
-func (m *DownloadPaymentRequestResponse_Output) GetAddress() string {
+func (m *FundTransactionResponse) GetSelectedOutputs() []*FundTransactionResponse_PreviousOutput {
 	if m != nil {
-		return m.Address
+		return m.SelectedOutputs
 	}
-	return ""
+	return nil
 }
 
-func (m *DownloadPaymentRequestResponse_Output) GetAmount() int64 {
+func (m *FundTransactionResponse) GetTotalAmount() int64 {
 	if m != nil {
-		return m.Amount
+		return m.TotalAmount
 	}
 	return 0
 }
 
-type PostPaymentRequest struct {
-	PaymentUrl           string                     `protobuf:"bytes,1,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	MerchantData         []byte                     `protobuf:"bytes,2,opt,name=merchant_data,json=merchantData,proto3" json:"merchant_data,omitempty"`
-	Transactions         [][]byte                   `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	RefundOutput         *PostPaymentRequest_Output `protobuf:"bytes,4,opt,name=refund_output,json=refundOutput,proto3" json:"refund_output,omitempty"`
-	Memo                 string                     `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *FundTransactionResponse) GetChangePkScript() []byte {
+	if m != nil {
+		return m.ChangePkScript
+	}
+	return nil
 }
 
-func (m *PostPaymentRequest) Reset()         { *m = PostPaymentRequest{} }
-func (m *PostPaymentRequest) String() string { return proto.CompactTextString(m) }
-func (*PostPaymentRequest) ProtoMessage()    {}
-func (*PostPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{65}
+type FundTransactionResponse_PreviousOutput struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	PkScript             []byte   `protobuf:"bytes,4,opt,name=pk_script,json=pkScript,proto3" json:"pk_script,omitempty"`
+	ReceiveTime          int64    `protobuf:"varint,5,opt,name=receive_time,json=receiveTime,proto3" json:"receive_time,omitempty"`
+	FromCoinbase         bool     `protobuf:"varint,6,opt,name=from_coinbase,json=fromCoinbase,proto3" json:"from_coinbase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostPaymentRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PostPaymentRequest.Unmarshal(m, b)
+func (m *FundTransactionResponse_PreviousOutput) Reset() {
+	*m = FundTransactionResponse_PreviousOutput{}
 }
-func (m *PostPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PostPaymentRequest.Marshal(b, m, deterministic)
+func (m *FundTransactionResponse_PreviousOutput) String() string { return proto.CompactTextString(m) }
+func (*FundTransactionResponse_PreviousOutput) ProtoMessage()    {}
+func (*FundTransactionResponse_PreviousOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{70, 0}
 }
-func (m *PostPaymentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostPaymentRequest.Merge(m, src)
+
+func (m *FundTransactionResponse_PreviousOutput) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Unmarshal(m, b)
 }
-func (m *PostPaymentRequest) XXX_Size() int {
-	return xxx_messageInfo_PostPaymentRequest.Size(m)
+func (m *FundTransactionResponse_PreviousOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Marshal(b, m, deterministic)
 }
-func (m *PostPaymentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostPaymentRequest.DiscardUnknown(m)
+func (m *FundTransactionResponse_PreviousOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundTransactionResponse_PreviousOutput.Merge(m, src)
+}
+func (m *FundTransactionResponse_PreviousOutput) XXX_Size() int {
+	return xxx_messageInfo_FundTransactionResponse_PreviousOutput.Size(m)
+}
+func (m *FundTransactionResponse_PreviousOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundTransactionResponse_PreviousOutput.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostPaymentRequest proto.InternalMessageInfo
+var xxx_messageInfo_FundTransactionResponse_PreviousOutput proto.InternalMessageInfo
 
-func (m *PostPaymentRequest) GetPaymentUrl() string {
+func (m *FundTransactionResponse_PreviousOutput) GetTransactionHash() []byte {
 	if m != nil {
-		return m.PaymentUrl
+		return m.TransactionHash
 	}
-	return ""
+	return nil
 }
 
-func (m *PostPaymentRequest) GetMerchantData() []byte {
+func (m *FundTransactionResponse_PreviousOutput) GetOutputIndex() uint32 {
 	if m != nil {
-		return m.MerchantData
+		return m.OutputIndex
 	}
-	return nil
+	return 0
 }
 
-func (m *PostPaymentRequest) GetTransactions() [][]byte {
+func (m *FundTransactionResponse_PreviousOutput) GetAmount() int64 {
 	if m != nil {
-		return m.Transactions
+		return m.Amount
 	}
-	return nil
+	return 0
 }
 
-func (m *PostPaymentRequest) GetRefundOutput() *PostPaymentRequest_Output {
+func (m *FundTransactionResponse_PreviousOutput) GetPkScript() []byte {
 	if m != nil {
-		return m.RefundOutput
+		return m.PkScript
 	}
 	return nil
 }
 
-func (m *PostPaymentRequest) GetMemo() string {
+func (m *FundTransactionResponse_PreviousOutput) GetReceiveTime() int64 {
 	if m != nil {
-		return m.Memo
+		return m.ReceiveTime
 	}
-	return ""
+	return 0
 }
 
-type PostPaymentRequest_Output struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *FundTransactionResponse_PreviousOutput) GetFromCoinbase() bool {
+	if m != nil {
+		return m.FromCoinbase
+	}
+	return false
+}
+
+type LockedOutputsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostPaymentRequest_Output) Reset()         { *m = PostPaymentRequest_Output{} }
-func (m *PostPaymentRequest_Output) String() string { return proto.CompactTextString(m) }
-func (*PostPaymentRequest_Output) ProtoMessage()    {}
-func (*PostPaymentRequest_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{65, 0}
+func (m *LockedOutputsRequest) Reset()         { *m = LockedOutputsRequest{} }
+func (m *LockedOutputsRequest) String() string { return proto.CompactTextString(m) }
+func (*LockedOutputsRequest) ProtoMessage()    {}
+func (*LockedOutputsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{71}
 }
 
-func (m *PostPaymentRequest_Output) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PostPaymentRequest_Output.Unmarshal(m, b)
+func (m *LockedOutputsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockedOutputsRequest.Unmarshal(m, b)
 }
-func (m *PostPaymentRequest_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PostPaymentRequest_Output.Marshal(b, m, deterministic)
+func (m *LockedOutputsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockedOutputsRequest.Marshal(b, m, deterministic)
 }
-func (m *PostPaymentRequest_Output) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostPaymentRequest_Output.Merge(m, src)
+func (m *LockedOutputsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedOutputsRequest.Merge(m, src)
 }
-func (m *PostPaymentRequest_Output) XXX_Size() int {
-	return xxx_messageInfo_PostPaymentRequest_Output.Size(m)
+func (m *LockedOutputsRequest) XXX_Size() int {
+	return xxx_messageInfo_LockedOutputsRequest.Size(m)
 }
-func (m *PostPaymentRequest_Output) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostPaymentRequest_Output.DiscardUnknown(m)
+func (m *LockedOutputsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedOutputsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostPaymentRequest_Output proto.InternalMessageInfo
+var xxx_messageInfo_LockedOutputsRequest proto.InternalMessageInfo
 
-func (m *PostPaymentRequest_Output) GetAddress() string {
+type LockedOutputsResponse struct {
+	Outputs              []*LockedOutputsResponse_Output `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *LockedOutputsResponse) Reset()         { *m = LockedOutputsResponse{} }
+func (m *LockedOutputsResponse) String() string { return proto.CompactTextString(m) }
+func (*LockedOutputsResponse) ProtoMessage()    {}
+func (*LockedOutputsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72}
+}
+
+func (m *LockedOutputsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockedOutputsResponse.Unmarshal(m, b)
+}
+func (m *LockedOutputsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockedOutputsResponse.Marshal(b, m, deterministic)
+}
+func (m *LockedOutputsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedOutputsResponse.Merge(m, src)
+}
+func (m *LockedOutputsResponse) XXX_Size() int {
+	return xxx_messageInfo_LockedOutputsResponse.Size(m)
+}
+func (m *LockedOutputsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedOutputsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedOutputsResponse proto.InternalMessageInfo
+
+func (m *LockedOutputsResponse) GetOutputs() []*LockedOutputsResponse_Output {
 	if m != nil {
-		return m.Address
+		return m.Outputs
 	}
-	return ""
+	return nil
 }
 
-func (m *PostPaymentRequest_Output) GetAmount() int64 {
+type LockedOutputsResponse_Output struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockedOutputsResponse_Output) Reset()         { *m = LockedOutputsResponse_Output{} }
+func (m *LockedOutputsResponse_Output) String() string { return proto.CompactTextString(m) }
+func (*LockedOutputsResponse_Output) ProtoMessage()    {}
+func (*LockedOutputsResponse_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{72, 0}
+}
+
+func (m *LockedOutputsResponse_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockedOutputsResponse_Output.Unmarshal(m, b)
+}
+func (m *LockedOutputsResponse_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockedOutputsResponse_Output.Marshal(b, m, deterministic)
+}
+func (m *LockedOutputsResponse_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedOutputsResponse_Output.Merge(m, src)
+}
+func (m *LockedOutputsResponse_Output) XXX_Size() int {
+	return xxx_messageInfo_LockedOutputsResponse_Output.Size(m)
+}
+func (m *LockedOutputsResponse_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedOutputsResponse_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedOutputsResponse_Output proto.InternalMessageInfo
+
+func (m *LockedOutputsResponse_Output) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *LockedOutputsResponse_Output) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *LockedOutputsResponse_Output) GetAmount() int64 {
 	if m != nil {
 		return m.Amount
 	}
 	return 0
 }
 
-type PostPaymentResponse struct {
-	Memo                 string   `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+type UnlockOutputRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PostPaymentResponse) Reset()         { *m = PostPaymentResponse{} }
-func (m *PostPaymentResponse) String() string { return proto.CompactTextString(m) }
-func (*PostPaymentResponse) ProtoMessage()    {}
-func (*PostPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{66}
+func (m *UnlockOutputRequest) Reset()         { *m = UnlockOutputRequest{} }
+func (m *UnlockOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockOutputRequest) ProtoMessage()    {}
+func (*UnlockOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{73}
 }
 
-func (m *PostPaymentResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PostPaymentResponse.Unmarshal(m, b)
+func (m *UnlockOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockOutputRequest.Unmarshal(m, b)
 }
-func (m *PostPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PostPaymentResponse.Marshal(b, m, deterministic)
+func (m *UnlockOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockOutputRequest.Marshal(b, m, deterministic)
 }
-func (m *PostPaymentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PostPaymentResponse.Merge(m, src)
+func (m *UnlockOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockOutputRequest.Merge(m, src)
 }
-func (m *PostPaymentResponse) XXX_Size() int {
-	return xxx_messageInfo_PostPaymentResponse.Size(m)
+func (m *UnlockOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockOutputRequest.Size(m)
 }
-func (m *PostPaymentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PostPaymentResponse.DiscardUnknown(m)
+func (m *UnlockOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockOutputRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PostPaymentResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnlockOutputRequest proto.InternalMessageInfo
 
-func (m *PostPaymentResponse) GetMemo() string {
+func (m *UnlockOutputRequest) GetTransactionHash() []byte {
 	if m != nil {
-		return m.Memo
+		return m.TransactionHash
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
-	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
-	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
-	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
-	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
-	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
-	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
-	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
-	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
-	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
-	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
-	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
-	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
-	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
-	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
-	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
-	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
-	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
-	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
-	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
-	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
-	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
-	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
-	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
-	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
-	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
-	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
-	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
-	proto.RegisterType((*CurrentAddressRequest)(nil), "walletrpc.CurrentAddressRequest")
-	proto.RegisterType((*CurrentAddressResponse)(nil), "walletrpc.CurrentAddressResponse")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
-	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
-	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
-	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
-	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
-	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
-	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
-	proto.RegisterType((*CreateTransactionRequest)(nil), "walletrpc.CreateTransactionRequest")
-	proto.RegisterType((*CreateTransactionRequest_Output)(nil), "walletrpc.CreateTransactionRequest.Output")
-	proto.RegisterType((*CreateTransactionResponse)(nil), "walletrpc.CreateTransactionResponse")
-	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
-	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
-	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
-	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
-	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
-	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
-	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
-	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
-	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
-	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
-	proto.RegisterType((*SpentnessNotificationsRequest)(nil), "walletrpc.SpentnessNotificationsRequest")
-	proto.RegisterType((*SpentnessNotificationsResponse)(nil), "walletrpc.SpentnessNotificationsResponse")
-	proto.RegisterType((*SpentnessNotificationsResponse_Spender)(nil), "walletrpc.SpentnessNotificationsResponse.Spender")
-	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
-	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
-	proto.RegisterType((*RescanNotificationsRequest)(nil), "walletrpc.RescanNotificationsRequest")
-	proto.RegisterType((*RescanNotificationsResponse)(nil), "walletrpc.RescanNotificationsResponse")
-	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
-	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
-	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
-	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
-	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
-	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
-	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
-	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
-	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
-	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
-	proto.RegisterType((*ValidateAddressRequest)(nil), "walletrpc.ValidateAddressRequest")
-	proto.RegisterType((*ValidateAddressResponse)(nil), "walletrpc.ValidateAddressResponse")
-	proto.RegisterType((*GenerateMnemonicSeedRequest)(nil), "walletrpc.GenerateMnemonicSeedRequest")
-	proto.RegisterType((*GenerateMnemonicSeedResponse)(nil), "walletrpc.GenerateMnemonicSeedResponse")
-	proto.RegisterType((*DownloadPaymentRequestRequest)(nil), "walletrpc.DownloadPaymentRequestRequest")
-	proto.RegisterType((*DownloadPaymentRequestResponse)(nil), "walletrpc.DownloadPaymentRequestResponse")
-	proto.RegisterType((*DownloadPaymentRequestResponse_Output)(nil), "walletrpc.DownloadPaymentRequestResponse.Output")
-	proto.RegisterType((*PostPaymentRequest)(nil), "walletrpc.PostPaymentRequest")
-	proto.RegisterType((*PostPaymentRequest_Output)(nil), "walletrpc.PostPaymentRequest.Output")
-	proto.RegisterType((*PostPaymentResponse)(nil), "walletrpc.PostPaymentResponse")
+func (m *UnlockOutputRequest) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+type UnlockOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 3162 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0xcb, 0x72, 0x1b, 0xc7,
-	0xb5, 0x1e, 0x0c, 0x1f, 0xe0, 0xc1, 0x93, 0x4d, 0x10, 0x04, 0x47, 0xa2, 0x1e, 0x43, 0x5b, 0x96,
-	0x65, 0x9b, 0xa6, 0x79, 0xed, 0x7b, 0x7d, 0x7d, 0x7d, 0x1d, 0x4b, 0x94, 0x6c, 0xd3, 0x92, 0x29,
-	0xd4, 0x90, 0x92, 0x55, 0xe5, 0x54, 0xa6, 0x06, 0x98, 0x26, 0x39, 0x21, 0x30, 0x03, 0xcd, 0x0c,
-	0x48, 0xd1, 0x8b, 0x54, 0x2a, 0x8b, 0x64, 0x95, 0x4d, 0x52, 0xa9, 0x8a, 0x93, 0xf2, 0x26, 0x55,
-	0xf9, 0x82, 0x2c, 0x92, 0x45, 0xaa, 0x52, 0xfe, 0x8e, 0xfc, 0x45, 0xf2, 0x03, 0xa9, 0x7e, 0x61,
-	0xba, 0xe7, 0x01, 0x92, 0x76, 0xb2, 0xc3, 0x9c, 0x3e, 0xe7, 0xf4, 0xe9, 0xd3, 0xe7, 0xdd, 0x80,
-	0x05, 0x67, 0xe4, 0x6d, 0x8c, 0xc2, 0x20, 0x0e, 0xd0, 0xc2, 0xa9, 0x33, 0x18, 0xe0, 0x38, 0x1c,
-	0xf5, 0xcd, 0x26, 0xd4, 0x9f, 0xe2, 0x30, 0xf2, 0x02, 0xdf, 0xc2, 0xcf, 0xc7, 0x38, 0x8a, 0xcd,
-	0x6f, 0x35, 0x68, 0x4c, 0x40, 0xd1, 0x28, 0xf0, 0x23, 0x8c, 0x5e, 0x81, 0xfa, 0x09, 0x03, 0xd9,
-	0x51, 0x1c, 0x7a, 0xfe, 0x61, 0x47, 0xbb, 0xa1, 0xdd, 0x5e, 0xb0, 0x6a, 0x1c, 0xba, 0x47, 0x81,
-	0xa8, 0x05, 0xb3, 0x43, 0xe7, 0xc7, 0x41, 0xd8, 0x29, 0xdd, 0xd0, 0x6e, 0xd7, 0x2c, 0xf6, 0x41,
-	0xa1, 0x9e, 0x1f, 0x84, 0x1d, 0x9d, 0x43, 0xc9, 0x07, 0x81, 0x8e, 0x9c, 0xb8, 0x7f, 0xd4, 0x99,
-	0x61, 0x50, 0xfa, 0x81, 0xae, 0x01, 0x8c, 0x42, 0x1c, 0xe2, 0x01, 0x76, 0x22, 0xdc, 0x99, 0xa5,
-	0x9b, 0x48, 0x10, 0x22, 0x48, 0x6f, 0xec, 0x0d, 0x5c, 0x7b, 0x88, 0x63, 0xc7, 0x75, 0x62, 0xa7,
-	0x33, 0xc7, 0x04, 0xa1, 0xd0, 0xcf, 0x39, 0xd0, 0xfc, 0xa7, 0x0e, 0x68, 0x3f, 0x74, 0xfc, 0xc8,
-	0xe9, 0xc7, 0x5e, 0xe0, 0xdf, 0xc7, 0xb1, 0xe3, 0x0d, 0x22, 0x84, 0x60, 0xe6, 0xc8, 0x89, 0x8e,
-	0xa8, 0xf0, 0x55, 0x8b, 0xfe, 0x46, 0x37, 0xa0, 0x12, 0x27, 0x98, 0x54, 0xf2, 0xaa, 0x25, 0x83,
-	0xd0, 0xff, 0xc1, 0x9c, 0x8b, 0x7b, 0x5e, 0x1c, 0x75, 0xf4, 0x1b, 0xfa, 0xed, 0xca, 0xd6, 0xfa,
-	0xc6, 0x44, 0x7d, 0x1b, 0xd9, 0x4d, 0x36, 0x76, 0xfc, 0xd1, 0x38, 0xb6, 0x38, 0x09, 0xfa, 0x10,
-	0xe6, 0xfb, 0x21, 0x76, 0x09, 0xf5, 0x0c, 0xa5, 0x7e, 0x79, 0x3a, 0xf5, 0xe3, 0x71, 0x4c, 0xc8,
-	0x05, 0x11, 0x6a, 0x82, 0x7e, 0x80, 0x99, 0x26, 0x74, 0x8b, 0xfc, 0x44, 0x57, 0x61, 0x21, 0xf6,
-	0x86, 0x38, 0x8a, 0x9d, 0xe1, 0x88, 0x9e, 0x5e, 0xb7, 0x12, 0x80, 0xf1, 0x1c, 0x66, 0xa9, 0x00,
-	0x44, 0xbf, 0x9e, 0xef, 0xe2, 0x17, 0xf4, 0xb0, 0x35, 0x8b, 0x7d, 0xa0, 0xd7, 0xa0, 0x39, 0x0a,
-	0xf1, 0x89, 0x17, 0x8c, 0x23, 0xdb, 0xe9, 0xf7, 0x83, 0xb1, 0x1f, 0xf3, 0xcb, 0x6a, 0x08, 0xf8,
-	0x5d, 0x06, 0x46, 0xaf, 0x42, 0x23, 0x41, 0x1d, 0x52, 0x4c, 0x9d, 0xee, 0x56, 0x9f, 0x60, 0x52,
-	0xa8, 0xf1, 0x73, 0x0d, 0xe6, 0x98, 0xd8, 0x05, 0x9b, 0x76, 0x60, 0x5e, 0xdd, 0x4b, 0x7c, 0x22,
-	0x03, 0xca, 0x9e, 0x1f, 0xe3, 0xd0, 0x77, 0x06, 0x94, 0x79, 0xd9, 0x9a, 0x7c, 0x53, 0x2a, 0xd7,
-	0x0d, 0x71, 0x14, 0x51, 0x13, 0x59, 0xb0, 0xc4, 0x27, 0x6a, 0xc3, 0x1c, 0x17, 0x88, 0xa9, 0x85,
-	0x7f, 0x99, 0xbf, 0xd7, 0xa0, 0x7a, 0x6f, 0x10, 0xf4, 0x8f, 0xa7, 0xdd, 0x77, 0x1b, 0xe6, 0x8e,
-	0xb0, 0x77, 0x78, 0xc4, 0x64, 0x99, 0xb5, 0xf8, 0x97, 0xaa, 0x56, 0x3d, 0xa5, 0x56, 0x74, 0x17,
-	0xaa, 0x92, 0x49, 0x88, 0xbb, 0x5c, 0x9b, 0x7a, 0x97, 0x96, 0x42, 0x62, 0x3e, 0x86, 0x3a, 0x57,
-	0xed, 0x3d, 0x67, 0xe0, 0xf8, 0x7d, 0x2c, 0xeb, 0x45, 0x53, 0xf5, 0xb2, 0x0e, 0xb5, 0x38, 0x88,
-	0x9d, 0x81, 0xdd, 0x63, 0xa8, 0x54, 0x56, 0xdd, 0xaa, 0x52, 0x20, 0x27, 0x37, 0x6b, 0x50, 0xe9,
-	0x7a, 0xfe, 0xa1, 0xf0, 0xdb, 0x3a, 0x54, 0xd9, 0x27, 0xf3, 0x59, 0xe2, 0xd9, 0xbb, 0x38, 0x3e,
-	0x0d, 0xc2, 0x63, 0x81, 0xf1, 0x1b, 0x0d, 0x1a, 0x13, 0x50, 0xe2, 0xd9, 0x44, 0xc0, 0x13, 0x6c,
-	0xfb, 0x6c, 0x85, 0x8b, 0x52, 0x63, 0x50, 0x8e, 0x8e, 0xd6, 0x00, 0x7a, 0x38, 0x8a, 0xed, 0x1e,
-	0x51, 0x2f, 0x95, 0x66, 0xc1, 0x5a, 0x20, 0x10, 0xaa, 0x6f, 0x74, 0x1d, 0x2a, 0x74, 0x99, 0x6b,
-	0x56, 0xa7, 0x9a, 0xa5, 0x14, 0x9f, 0x32, 0xed, 0x5e, 0x81, 0x85, 0xe8, 0xcc, 0xef, 0x63, 0xd7,
-	0x8e, 0x03, 0x7a, 0x9d, 0xb3, 0x56, 0x99, 0x01, 0xf6, 0x03, 0xf3, 0x7f, 0xa1, 0xc5, 0x35, 0xb3,
-	0x3b, 0x1e, 0xf6, 0x70, 0xc8, 0xe5, 0x45, 0x37, 0xa1, 0xca, 0x15, 0x62, 0xfb, 0xce, 0x10, 0xf3,
-	0x98, 0x53, 0xe1, 0xb0, 0x5d, 0x67, 0x88, 0xcd, 0x0f, 0x61, 0x39, 0x45, 0x2a, 0x9f, 0x8b, 0xd3,
-	0xd2, 0x95, 0xe4, 0x5c, 0x12, 0xba, 0xb9, 0x08, 0x0d, 0x4e, 0x1f, 0x09, 0x2d, 0xfd, 0x45, 0x87,
-	0x66, 0x02, 0xe3, 0xec, 0x7e, 0x00, 0x65, 0x4e, 0x18, 0x75, 0xb4, 0x4c, 0x14, 0x48, 0xa3, 0x0b,
-	0x80, 0x35, 0x21, 0x42, 0x6f, 0x00, 0xea, 0x8f, 0xc3, 0x10, 0xfb, 0x5c, 0x87, 0x36, 0x35, 0x4c,
-	0x16, 0x6d, 0x9a, 0x7c, 0x85, 0xea, 0xf2, 0x53, 0x62, 0xa4, 0x9b, 0xd0, 0x4a, 0x61, 0xcb, 0x8a,
-	0x45, 0x0a, 0x3e, 0x5d, 0x31, 0x7e, 0x56, 0x82, 0x79, 0xe1, 0xb9, 0x17, 0x3b, 0x7b, 0x46, 0xbd,
-	0xa5, 0x8c, 0x7a, 0xb3, 0x76, 0xa8, 0x67, 0xed, 0x90, 0x1c, 0x0d, 0xbf, 0x60, 0x4e, 0x6b, 0x1f,
-	0xe3, 0x33, 0x9b, 0x59, 0x34, 0x0b, 0xeb, 0x4d, 0xb1, 0xf2, 0x10, 0x9f, 0x6d, 0x53, 0xe1, 0xde,
-	0x00, 0x24, 0x5c, 0x5c, 0xc2, 0x9e, 0x65, 0xd8, 0x62, 0x45, 0xc1, 0x1e, 0x8e, 0x82, 0x30, 0xc6,
-	0xae, 0x84, 0x3d, 0xc7, 0xb1, 0xf9, 0x8a, 0xc0, 0x36, 0x9f, 0x41, 0xcb, 0xc2, 0xe4, 0x2c, 0x42,
-	0xff, 0xdc, 0x90, 0x2e, 0xa8, 0x90, 0x55, 0x28, 0xfb, 0xf8, 0x54, 0x56, 0xc6, 0xbc, 0x8f, 0x4f,
-	0xa9, 0x9d, 0xad, 0xc0, 0x72, 0x8a, 0x33, 0xf7, 0xb2, 0x2f, 0x00, 0xed, 0xe2, 0x17, 0x71, 0x6a,
-	0x43, 0x92, 0xc6, 0x9c, 0x28, 0x1a, 0x1d, 0x85, 0x24, 0x8d, 0xb1, 0xf0, 0x23, 0x41, 0x2e, 0xa0,
-	0x7a, 0xf3, 0x03, 0x58, 0x52, 0x18, 0x5f, 0xce, 0xae, 0x7f, 0xa7, 0x71, 0xb9, 0x58, 0xc8, 0x14,
-	0x72, 0x15, 0x47, 0x9c, 0xff, 0x86, 0x99, 0x63, 0xcf, 0x77, 0xa9, 0x24, 0xf5, 0x2d, 0x53, 0x32,
-	0xee, 0x2c, 0x9b, 0x8d, 0x87, 0x9e, 0xef, 0x5a, 0x14, 0xdf, 0xdc, 0x82, 0x19, 0xf2, 0x85, 0x5a,
-	0xd0, 0xbc, 0xb7, 0xd3, 0xdd, 0xdc, 0x7c, 0xe7, 0x1d, 0xfb, 0xc1, 0xb3, 0xfd, 0x07, 0xd6, 0xee,
-	0xdd, 0x47, 0xcd, 0x97, 0x64, 0xe8, 0xce, 0x2e, 0x87, 0x6a, 0xe6, 0x5b, 0xfc, 0x68, 0x82, 0x29,
-	0x3f, 0x9a, 0x14, 0xf0, 0x35, 0x25, 0xe0, 0x9b, 0xbf, 0xd6, 0x60, 0x65, 0x87, 0x5e, 0x76, 0x37,
-	0xf4, 0x4e, 0x9c, 0x18, 0x3f, 0xc4, 0x67, 0x17, 0x55, 0x75, 0x71, 0xf2, 0xb9, 0x45, 0x12, 0x1c,
-	0x65, 0x47, 0x4d, 0xeb, 0xd4, 0x3b, 0xa0, 0xe6, 0xbd, 0x60, 0xd5, 0x46, 0x93, 0x5d, 0xbe, 0xf0,
-	0x0e, 0x48, 0xc6, 0x08, 0x71, 0xd4, 0x77, 0x7c, 0x6a, 0xd3, 0x65, 0x8b, 0x7f, 0x99, 0x06, 0x74,
-	0xb2, 0x42, 0x71, 0xb3, 0xf0, 0xa1, 0xce, 0xdd, 0xe3, 0x92, 0x36, 0xf8, 0x2e, 0xb4, 0x43, 0xfc,
-	0x7c, 0xec, 0x85, 0xd8, 0xb5, 0xfb, 0x81, 0x7f, 0xe0, 0x85, 0x43, 0x87, 0xa5, 0x1c, 0x96, 0xae,
-	0x96, 0xc5, 0xea, 0xb6, 0xbc, 0x68, 0xfa, 0xd0, 0x98, 0xec, 0xc7, 0xd5, 0xd9, 0x82, 0x59, 0xea,
-	0xa6, 0x74, 0x1f, 0xdd, 0x62, 0x1f, 0x24, 0xcd, 0x45, 0x23, 0xec, 0xbb, 0x4e, 0x6f, 0x20, 0xb2,
-	0x4a, 0x02, 0x20, 0x39, 0xdf, 0x1b, 0x0e, 0x9d, 0x78, 0x1c, 0x62, 0x3b, 0xc4, 0xa7, 0x4e, 0xe8,
-	0x8a, 0x9c, 0x2f, 0xc0, 0x16, 0x85, 0x9a, 0x6f, 0xc3, 0xf2, 0x36, 0x0b, 0x42, 0x17, 0xb5, 0x30,
-	0x73, 0x0b, 0xda, 0x69, 0x92, 0x73, 0x2f, 0xfe, 0xb7, 0x25, 0x68, 0x7f, 0x82, 0x63, 0x29, 0xb7,
-	0x4e, 0x36, 0xda, 0x80, 0xa5, 0x28, 0x76, 0xc2, 0xd8, 0xf3, 0x0f, 0xe5, 0x88, 0xca, 0x0c, 0x60,
-	0x51, 0x2c, 0x25, 0x21, 0x75, 0x0b, 0x96, 0xd3, 0xf8, 0x49, 0x19, 0xb0, 0x68, 0x2d, 0xa9, 0x14,
-	0x2c, 0x6b, 0xdd, 0x81, 0x45, 0xec, 0xbb, 0xa9, 0x1d, 0x74, 0xba, 0x43, 0x83, 0x2d, 0x24, 0xfc,
-	0x37, 0x60, 0x49, 0xc5, 0x65, 0xdc, 0x59, 0xae, 0x5b, 0x94, 0xb1, 0x19, 0xef, 0x0f, 0xe1, 0xca,
-	0xd0, 0xf3, 0xbd, 0xe1, 0x78, 0x68, 0x87, 0xb8, 0x4f, 0x22, 0xbd, 0x52, 0x60, 0xcc, 0x52, 0xba,
-	0x55, 0x8e, 0x62, 0x51, 0x0c, 0x59, 0x0d, 0xe6, 0x9f, 0x34, 0x58, 0xc9, 0xa8, 0x86, 0x2b, 0xf4,
-	0x63, 0x40, 0x43, 0xcf, 0x27, 0xc9, 0x56, 0x66, 0xc9, 0xf2, 0xd6, 0x8a, 0xe4, 0xda, 0x72, 0xb1,
-	0x64, 0x2d, 0x52, 0x12, 0x99, 0x1f, 0xea, 0x42, 0x6b, 0xec, 0xe7, 0x70, 0x2a, 0x5d, 0xa4, 0xfa,
-	0x59, 0xe2, 0xa4, 0x8a, 0xd4, 0xdf, 0x6a, 0xb0, 0xb2, 0x7d, 0xe4, 0xf8, 0x87, 0xb8, 0x3b, 0x71,
-	0x51, 0x71, 0xa3, 0xef, 0x81, 0x7e, 0x8c, 0xcf, 0xe8, 0x0d, 0xd6, 0xb7, 0x6e, 0x49, 0xcc, 0x0b,
-	0x08, 0x36, 0x88, 0xc3, 0x11, 0x12, 0xe2, 0x5b, 0xc1, 0xc0, 0xb5, 0xa5, 0x38, 0xc0, 0x12, 0x6b,
-	0x2d, 0x18, 0xb8, 0x09, 0x19, 0x41, 0x23, 0xf1, 0x5d, 0x42, 0x63, 0x77, 0x59, 0xf3, 0xf1, 0x69,
-	0x82, 0x66, 0x5e, 0x03, 0xfd, 0x21, 0x3e, 0x43, 0x15, 0x98, 0xef, 0x5a, 0x3b, 0x4f, 0xef, 0xee,
-	0x3f, 0x68, 0xbe, 0x84, 0x00, 0xe6, 0xba, 0x4f, 0xee, 0x3d, 0xda, 0xd9, 0x6e, 0x6a, 0xc4, 0xef,
-	0xb3, 0x12, 0x71, 0xbf, 0xff, 0x69, 0x09, 0xda, 0x1f, 0x8f, 0x7d, 0xf9, 0xd0, 0xe7, 0xc7, 0x5e,
-	0x92, 0x65, 0x9d, 0xf0, 0x10, 0xc7, 0xa2, 0xce, 0x16, 0xd5, 0x1e, 0x05, 0xb2, 0x2a, 0x7b, 0x4a,
-	0x60, 0xd0, 0xa7, 0x04, 0x06, 0xf4, 0x01, 0x18, 0x9e, 0xdf, 0x1f, 0x8c, 0x5d, 0x6c, 0x4f, 0x3c,
-	0xbb, 0x1f, 0x78, 0x7e, 0xcf, 0x89, 0x70, 0xc4, 0x03, 0x5a, 0x87, 0x63, 0xec, 0x70, 0x84, 0x6d,
-	0xb1, 0x4e, 0x9c, 0x46, 0x50, 0xf7, 0xe9, 0x91, 0xed, 0xa8, 0x1f, 0x7a, 0x23, 0x96, 0xaf, 0xcb,
-	0xd6, 0x12, 0x5f, 0x64, 0xea, 0xd8, 0xa3, 0x4b, 0xe6, 0x1f, 0x74, 0x58, 0xc9, 0xa8, 0x80, 0x1b,
-	0xe6, 0x0f, 0xa1, 0x19, 0xe1, 0x01, 0xee, 0x93, 0x74, 0x1e, 0xd0, 0x96, 0x41, 0x98, 0xe5, 0xdb,
-	0xd2, 0x7d, 0x17, 0x50, 0x6f, 0x74, 0x79, 0xdf, 0xc1, 0x7b, 0xa4, 0x86, 0x60, 0xc5, 0xbe, 0x23,
-	0x92, 0x55, 0x59, 0xb5, 0xa2, 0xa8, 0xb1, 0x42, 0x61, 0x5c, 0x8b, 0xb7, 0xa1, 0xc9, 0x0f, 0x32,
-	0x3a, 0x16, 0x67, 0x61, 0x46, 0x50, 0x67, 0xf0, 0xee, 0x31, 0x3b, 0x86, 0xf1, 0x77, 0x0d, 0xea,
-	0xea, 0x86, 0xa4, 0x79, 0x92, 0xdc, 0x40, 0x8e, 0x37, 0x0d, 0x09, 0x4e, 0xa3, 0xc1, 0x4d, 0xa8,
-	0xb2, 0xf3, 0xd9, 0xac, 0x1f, 0x62, 0xa9, 0xa7, 0xc2, 0x60, 0x3b, 0xb4, 0x2b, 0x4a, 0xba, 0x18,
-	0x5d, 0xee, 0x62, 0x48, 0xa9, 0x9c, 0xc8, 0x36, 0x43, 0xd9, 0x97, 0x47, 0x5c, 0x2a, 0xc2, 0x97,
-	0x44, 0x0b, 0x52, 0xaf, 0x93, 0xe6, 0x84, 0x37, 0x40, 0x15, 0x0e, 0xdb, 0xf7, 0x58, 0xcd, 0x76,
-	0x10, 0x06, 0xc3, 0xc9, 0x2d, 0xd3, 0x6a, 0xa9, 0x6c, 0x55, 0x09, 0x50, 0xdc, 0xac, 0xf9, 0xab,
-	0x12, 0x74, 0xb6, 0x43, 0xec, 0xc4, 0xf8, 0x52, 0x96, 0x7a, 0x1f, 0xe6, 0xc5, 0xb5, 0xb1, 0x18,
-	0x70, 0x47, 0x76, 0xd3, 0x02, 0x7e, 0x93, 0x9e, 0x96, 0x93, 0x7e, 0x57, 0x53, 0x5e, 0x87, 0x7a,
-	0xe4, 0xc4, 0xf6, 0x08, 0x87, 0xf6, 0x71, 0xcf, 0x26, 0x5d, 0x31, 0xab, 0x31, 0x2b, 0x91, 0x13,
-	0x77, 0x71, 0xf8, 0xb0, 0xf7, 0x31, 0xc6, 0xc6, 0xfb, 0x93, 0x5e, 0xb4, 0x30, 0xab, 0x48, 0x9a,
-	0x2f, 0x29, 0xfd, 0xe3, 0x2f, 0x34, 0x58, 0xcd, 0x39, 0x04, 0xb7, 0xdd, 0x77, 0xa1, 0x1d, 0xe1,
-	0xd0, 0x73, 0x06, 0xde, 0x57, 0x6a, 0x3c, 0xe4, 0x36, 0xb0, 0x9c, 0xac, 0x4a, 0xe4, 0xe4, 0xc6,
-	0x3c, 0xd2, 0x90, 0xdb, 0x27, 0xce, 0x60, 0x8c, 0x99, 0xde, 0x74, 0xab, 0x42, 0x61, 0x4f, 0x29,
-	0x48, 0xf4, 0xf8, 0xfa, 0xa4, 0xc7, 0x37, 0x7f, 0x02, 0x4b, 0x7b, 0xa7, 0x18, 0x8f, 0x52, 0x65,
-	0x65, 0xf1, 0xc5, 0xdc, 0x86, 0x66, 0x44, 0x08, 0xec, 0x38, 0xb0, 0xc5, 0xa9, 0x59, 0x51, 0x59,
-	0xa7, 0xf0, 0xfd, 0x80, 0x27, 0xdd, 0x1c, 0x2d, 0xea, 0x19, 0x2d, 0x9a, 0x7f, 0xd4, 0xa0, 0xa5,
-	0x0a, 0xf0, 0x1f, 0x57, 0x42, 0xda, 0x79, 0xf5, 0xac, 0xf3, 0x72, 0x3d, 0xcd, 0x24, 0x7a, 0xfa,
-	0xb3, 0x06, 0xed, 0x3d, 0xef, 0xd0, 0xcf, 0x31, 0xe2, 0xf3, 0xea, 0xc2, 0xe2, 0x93, 0x94, 0xa6,
-	0x9d, 0x64, 0x1d, 0x6a, 0xec, 0x24, 0xd4, 0xaf, 0x31, 0x9b, 0x09, 0xd5, 0x2c, 0x76, 0xbc, 0x1d,
-	0x06, 0xcb, 0x1c, 0x77, 0x26, 0x73, 0x5c, 0xf3, 0x39, 0xac, 0x64, 0x04, 0xe7, 0x3a, 0x4e, 0x4d,
-	0xa4, 0xb4, 0xec, 0x44, 0xea, 0x1d, 0x68, 0x8f, 0xfd, 0xc8, 0x3b, 0x24, 0x89, 0x59, 0x95, 0xa6,
-	0x44, 0xa5, 0x69, 0x89, 0xd5, 0x1d, 0x49, 0x2a, 0xf3, 0x33, 0x58, 0xed, 0x8e, 0x7b, 0x03, 0x2f,
-	0x3a, 0xca, 0x51, 0xd7, 0x9b, 0x80, 0x38, 0xc3, 0xec, 0xde, 0x8b, 0x6c, 0x45, 0xa2, 0x32, 0x37,
-	0xc1, 0xc8, 0xe3, 0xc5, 0x4f, 0x90, 0x33, 0x77, 0x31, 0x1b, 0x50, 0xb3, 0x68, 0xdd, 0x2c, 0xfa,
-	0xec, 0x26, 0xd4, 0x05, 0x80, 0x27, 0xcf, 0x9b, 0x70, 0x5d, 0xe2, 0xb6, 0x1b, 0xc4, 0xde, 0x81,
-	0xd7, 0x77, 0xe4, 0xaa, 0xcf, 0xfc, 0xa6, 0x04, 0x37, 0x8a, 0x71, 0xf8, 0xf6, 0x1f, 0x41, 0xc3,
-	0x89, 0x63, 0xa7, 0x7f, 0x84, 0x5d, 0x56, 0x8c, 0x9d, 0x5b, 0xfb, 0xd4, 0x05, 0x3e, 0x85, 0x46,
-	0xa4, 0x0e, 0x76, 0xb1, 0xca, 0x81, 0x68, 0xb6, 0x6a, 0xd5, 0x05, 0x98, 0x23, 0x16, 0x55, 0x48,
-	0xfa, 0x77, 0xad, 0x90, 0x48, 0xc2, 0xce, 0xe1, 0x48, 0x93, 0x0d, 0xb7, 0xa4, 0xaa, 0xd5, 0xc9,
-	0x12, 0x7e, 0x4a, 0xd7, 0xcd, 0x5f, 0x6a, 0xb0, 0xb6, 0x37, 0xc2, 0x7e, 0xec, 0xe3, 0x28, 0xca,
-	0xd3, 0xe0, 0x94, 0x18, 0x72, 0x07, 0x16, 0xfd, 0xc0, 0xf6, 0x09, 0xd1, 0x99, 0x3d, 0xf6, 0x49,
-	0x57, 0xc0, 0x22, 0x64, 0xd9, 0x6a, 0xf8, 0x01, 0x65, 0x76, 0xf6, 0x84, 0x81, 0x49, 0xef, 0x94,
-	0xe0, 0x32, 0x4c, 0x36, 0xbf, 0xab, 0x09, 0x4c, 0x2a, 0x05, 0xc9, 0x33, 0xd7, 0x8a, 0xe4, 0xe1,
-	0xb7, 0xf5, 0xef, 0xcd, 0xaa, 0x0f, 0x61, 0x9e, 0xb6, 0x33, 0x98, 0x8d, 0x9b, 0xd5, 0xc2, 0x62,
-	0xba, 0x24, 0x74, 0xd9, 0xc5, 0xa1, 0x25, 0x38, 0x18, 0x4f, 0x60, 0x9e, 0xc3, 0x2e, 0x23, 0xe5,
-	0x75, 0xa8, 0x48, 0x4e, 0xc9, 0x85, 0x84, 0x24, 0x40, 0x98, 0x6b, 0x70, 0x45, 0x0c, 0xad, 0xf2,
-	0x6c, 0xfc, 0x1f, 0x1a, 0x5c, 0xcd, 0x5f, 0xbf, 0xd4, 0x0c, 0xe0, 0x22, 0xf3, 0x9d, 0xfc, 0xd1,
-	0x8d, 0x7e, 0xa9, 0xd1, 0xcd, 0xcc, 0xa5, 0x46, 0x37, 0xb3, 0x05, 0xa3, 0x9b, 0xab, 0x60, 0xb0,
-	0x68, 0x90, 0xab, 0x12, 0x0c, 0x57, 0x72, 0x57, 0x8b, 0xe3, 0x4d, 0xe1, 0x9c, 0xd7, 0x80, 0xf2,
-	0x81, 0xe7, 0x7b, 0xd1, 0x11, 0x76, 0xc5, 0xc8, 0x59, 0x7c, 0x9b, 0x7f, 0xd3, 0x60, 0x89, 0x15,
-	0x00, 0x5f, 0x50, 0x9b, 0x11, 0x3e, 0xf3, 0x3a, 0x2c, 0x8e, 0x48, 0xb4, 0xeb, 0xdb, 0x99, 0x94,
-	0xd2, 0x64, 0x0b, 0x52, 0x97, 0xf1, 0x26, 0x20, 0x31, 0x56, 0xc8, 0x34, 0x24, 0x8b, 0x7c, 0x45,
-	0x42, 0x5f, 0x87, 0xda, 0xd0, 0xc7, 0xc3, 0xc0, 0xf7, 0xfa, 0x76, 0x84, 0xb9, 0x50, 0x0b, 0x56,
-	0x55, 0x00, 0xf7, 0x30, 0x76, 0x49, 0x3c, 0x62, 0x56, 0x6c, 0xf7, 0xbc, 0x30, 0x3e, 0x72, 0x9d,
-	0x33, 0x9e, 0x05, 0xeb, 0x0c, 0x7c, 0x8f, 0x43, 0xcd, 0x36, 0xb4, 0xd4, 0x03, 0xf0, 0xd0, 0xfa,
-	0x11, 0x2c, 0x3e, 0x1e, 0x61, 0xff, 0xbb, 0x1f, 0xcb, 0x6c, 0x01, 0x92, 0x39, 0x70, 0xbe, 0x2d,
-	0x40, 0xdb, 0x83, 0x20, 0x52, 0xf5, 0x65, 0x2e, 0xc3, 0x92, 0x02, 0xe5, 0xc8, 0xcb, 0xb0, 0xc4,
-	0x20, 0x0f, 0x5e, 0x78, 0x51, 0x32, 0x70, 0xdd, 0x80, 0x96, 0x0a, 0xe6, 0xb7, 0xda, 0x86, 0x39,
-	0x4c, 0x21, 0x54, 0xa6, 0xb2, 0xc5, 0xbf, 0xcc, 0x6f, 0x34, 0xe8, 0xec, 0x91, 0x6e, 0x7d, 0x9b,
-	0xa0, 0xf9, 0xd1, 0x38, 0xb2, 0x46, 0x7d, 0x71, 0xa6, 0x57, 0xa1, 0xc1, 0x07, 0xd9, 0xb6, 0x5a,
-	0xfd, 0xd5, 0x39, 0x58, 0xd4, 0x41, 0x06, 0x94, 0xc7, 0x11, 0xb1, 0xd8, 0x89, 0x67, 0x4c, 0xbe,
-	0xc9, 0x1a, 0xd1, 0xc8, 0x69, 0xc0, 0xe7, 0x1f, 0xa4, 0x02, 0xe7, 0xdf, 0x24, 0x3b, 0xf7, 0x71,
-	0xc8, 0xad, 0x10, 0xf3, 0x02, 0x5d, 0x06, 0x99, 0x57, 0x60, 0x35, 0x47, 0x3c, 0xae, 0x83, 0x2d,
-	0x68, 0x3f, 0x75, 0x06, 0x9e, 0xeb, 0xc4, 0x38, 0x67, 0x72, 0x92, 0x3f, 0x05, 0x79, 0x0b, 0x56,
-	0x32, 0x34, 0xc9, 0x90, 0xe7, 0x84, 0x2c, 0x71, 0x15, 0xb1, 0x0f, 0xf3, 0x3d, 0xb8, 0xf2, 0x09,
-	0xf6, 0x71, 0xe8, 0xc4, 0xf8, 0x73, 0xc9, 0x8c, 0xc4, 0x4e, 0xab, 0x50, 0xee, 0x79, 0xb1, 0x1d,
-	0x79, 0x5f, 0x61, 0x91, 0x03, 0x7a, 0x5e, 0xbc, 0xe7, 0x7d, 0x85, 0xcd, 0xf7, 0xe1, 0x6a, 0x3e,
-	0x25, 0xdf, 0xcf, 0x80, 0xb2, 0x30, 0x4c, 0x2e, 0xe5, 0xe4, 0xdb, 0x7c, 0x1b, 0xd6, 0xee, 0x07,
-	0xa7, 0xfe, 0x20, 0x70, 0xdc, 0xae, 0x73, 0x36, 0xc4, 0x93, 0xba, 0x55, 0xec, 0xdb, 0x04, 0x7d,
-	0x1c, 0x7a, 0x9c, 0x8e, 0xfc, 0x34, 0xff, 0x5a, 0x82, 0x6b, 0x45, 0x34, 0x7c, 0xc7, 0x6b, 0x50,
-	0x19, 0x39, 0x67, 0xa4, 0xae, 0x95, 0xde, 0x00, 0x16, 0x46, 0xce, 0xd9, 0x7e, 0x40, 0x43, 0xd8,
-	0x67, 0xe9, 0x96, 0x64, 0x53, 0x0a, 0xf8, 0xd3, 0x79, 0x67, 0x1a, 0x93, 0x0e, 0xcc, 0xe3, 0x17,
-	0x23, 0x2f, 0xc4, 0x11, 0x2f, 0x3f, 0xc5, 0x27, 0x89, 0x30, 0x43, 0x3c, 0x0c, 0xf8, 0x4b, 0x14,
-	0xfd, 0x4d, 0xe2, 0xfc, 0x88, 0xf1, 0xb5, 0xc7, 0xe1, 0x60, 0xf2, 0x58, 0xc9, 0x40, 0x4f, 0xc2,
-	0x01, 0x75, 0x6d, 0x1c, 0x92, 0xbe, 0x32, 0xb6, 0x27, 0x6f, 0x95, 0x55, 0xab, 0x2a, 0x80, 0xf7,
-	0x9d, 0xd8, 0xf9, 0x5e, 0x0d, 0xcb, 0xd7, 0x25, 0x40, 0xdd, 0x20, 0x8a, 0xd5, 0xe3, 0xa5, 0x05,
-	0xd3, 0xce, 0x17, 0xac, 0x94, 0x15, 0x0c, 0x99, 0xa9, 0x27, 0x2f, 0x9d, 0x96, 0x1e, 0x0a, 0x0c,
-	0xed, 0x40, 0x2d, 0xc4, 0x07, 0x63, 0x5f, 0x74, 0xf3, 0x54, 0x3f, 0xea, 0x1b, 0x67, 0x56, 0x3e,
-	0xa1, 0xf6, 0x2a, 0x23, 0xe5, 0xa7, 0x17, 0x1a, 0x9e, 0x4d, 0x34, 0xfc, 0xbd, 0x74, 0xf3, 0x1a,
-	0x2c, 0x29, 0x5b, 0x27, 0xa9, 0x82, 0x6e, 0xa3, 0x25, 0xdb, 0x6c, 0x59, 0x93, 0x37, 0xf0, 0x3d,
-	0x1c, 0x9e, 0x78, 0x7d, 0x52, 0x41, 0xce, 0x73, 0x08, 0x5a, 0x95, 0xce, 0xa2, 0xbe, 0x94, 0x1b,
-	0x46, 0xde, 0x12, 0xdb, 0x67, 0xeb, 0xeb, 0x45, 0xa8, 0xb1, 0xa8, 0x26, 0x78, 0xfe, 0x0f, 0xcc,
-	0x74, 0x3d, 0xff, 0x10, 0xb5, 0x65, 0xe5, 0x24, 0xef, 0x77, 0xc6, 0x4a, 0x06, 0x3e, 0x29, 0x67,
-	0xe7, 0xc5, 0x33, 0xdc, 0xaa, 0x32, 0x97, 0x97, 0x1f, 0xf7, 0x14, 0x61, 0xd2, 0x8f, 0x7c, 0x16,
-	0xd4, 0x94, 0x57, 0x32, 0x74, 0x3d, 0xfb, 0x78, 0xa5, 0x3c, 0xbd, 0x19, 0x37, 0x8a, 0x11, 0x38,
-	0xcf, 0x6d, 0x28, 0x8b, 0x67, 0x2f, 0x64, 0xe4, 0xbe, 0x85, 0x31, 0x4e, 0x57, 0xa6, 0xbc, 0x93,
-	0x91, 0xa3, 0x89, 0x57, 0x24, 0xf9, 0x68, 0xea, 0xe8, 0x5c, 0x39, 0x5a, 0x7a, 0xca, 0xfd, 0x04,
-	0xea, 0xea, 0x54, 0x19, 0xc9, 0xa2, 0xe7, 0xce, 0xa8, 0x8d, 0x9b, 0x53, 0x30, 0x38, 0xdb, 0x67,
-	0xd0, 0x48, 0x0d, 0x57, 0x91, 0x4c, 0x95, 0x3f, 0x93, 0x36, 0xcc, 0x69, 0x28, 0x9c, 0xf3, 0x18,
-	0x3a, 0x45, 0x0d, 0x0c, 0xba, 0x93, 0xdf, 0x2f, 0xe4, 0x95, 0x44, 0xc6, 0xeb, 0x17, 0xc2, 0x65,
-	0x9b, 0x6e, 0x6a, 0x28, 0x80, 0x76, 0x7e, 0xf5, 0x8b, 0x6e, 0x5f, 0xa0, 0x40, 0x66, 0x5b, 0xbe,
-	0x76, 0xe1, 0x52, 0x7a, 0x53, 0x43, 0x5e, 0xf2, 0xa8, 0xab, 0x6c, 0x77, 0x2b, 0xc7, 0xb2, 0xf2,
-	0x36, 0x7b, 0xf5, 0x5c, 0xbc, 0xc9, 0x56, 0x07, 0xb0, 0x94, 0x53, 0x1d, 0xa2, 0x57, 0x24, 0x0e,
-	0xc5, 0xb5, 0xa5, 0x71, 0xeb, 0x3c, 0xb4, 0xc9, 0x3e, 0x5f, 0x42, 0x33, 0x3d, 0xf8, 0x45, 0xe6,
-	0xf9, 0x73, 0x6a, 0x63, 0x7d, 0x2a, 0x4e, 0xe2, 0xa3, 0xca, 0x0b, 0xa3, 0xe2, 0xa3, 0x79, 0xaf,
-	0x9a, 0x8a, 0x8f, 0xe6, 0x3e, 0x4e, 0xa2, 0x47, 0x50, 0x91, 0xde, 0x10, 0xd1, 0x5a, 0xfa, 0x55,
-	0x4f, 0xe5, 0x77, 0xad, 0x68, 0x39, 0xc5, 0x8d, 0xfb, 0xd9, 0xda, 0xd4, 0x37, 0xc2, 0x2c, 0xb7,
-	0x94, 0x87, 0x7d, 0x09, 0xcd, 0xf4, 0xeb, 0x99, 0xa2, 0xcc, 0x82, 0xf7, 0x3e, 0x45, 0x99, 0x45,
-	0xcf, 0x6f, 0xc4, 0x7d, 0x53, 0x43, 0x64, 0xc5, 0x7d, 0xf3, 0x27, 0xf4, 0x8a, 0xfb, 0x16, 0x4d,
-	0xb0, 0x7f, 0x04, 0x8b, 0x99, 0x11, 0x21, 0x5a, 0xbf, 0xc0, 0x14, 0xd4, 0x78, 0x79, 0x3a, 0x12,
-	0xe7, 0xff, 0x18, 0xaa, 0xf2, 0xe0, 0x0d, 0xc9, 0x6a, 0xcc, 0x19, 0x09, 0x1a, 0xd7, 0x0b, 0xd7,
-	0x13, 0x55, 0xa4, 0x06, 0x4d, 0x8a, 0x2a, 0xf2, 0xa7, 0x67, 0x8a, 0x2a, 0x8a, 0xe6, 0x54, 0x0e,
-	0xa0, 0xec, 0x0c, 0x08, 0x29, 0xb9, 0xbf, 0x68, 0xdc, 0x64, 0xbc, 0x72, 0x0e, 0x16, 0xdf, 0xe2,
-	0xff, 0x61, 0x8e, 0xb9, 0x24, 0xea, 0x64, 0xbc, 0x54, 0xb0, 0x5a, 0xcd, 0x59, 0xe1, 0xe4, 0x43,
-	0x68, 0xe7, 0x57, 0x80, 0x4a, 0xd0, 0x9b, 0x5a, 0xb4, 0x2a, 0x41, 0xef, 0x9c, 0x52, 0xf5, 0x11,
-	0x54, 0xa4, 0x92, 0x43, 0x71, 0x90, 0x6c, 0x15, 0xa4, 0x38, 0x48, 0x5e, 0xa5, 0xf2, 0x0c, 0x1a,
-	0xa9, 0xaa, 0x5f, 0xb9, 0xb8, 0xfc, 0x2e, 0x42, 0xb9, 0xb8, 0x82, 0xa6, 0x61, 0xeb, 0xeb, 0x19,
-	0xd1, 0x88, 0x3d, 0x0a, 0x1c, 0x17, 0x87, 0xa2, 0x42, 0x79, 0x0c, 0x55, 0xb9, 0x11, 0x53, 0x6c,
-	0x2f, 0xa7, 0x71, 0x53, 0x6c, 0x2f, 0xb7, 0x83, 0x7b, 0x0c, 0x55, 0xb9, 0x1b, 0x55, 0x18, 0xe6,
-	0xf4, 0xd9, 0x0a, 0xc3, 0xbc, 0x36, 0x16, 0xed, 0x00, 0x24, 0x4d, 0x28, 0xba, 0x2a, 0xa1, 0x67,
-	0xba, 0x5b, 0x63, 0xad, 0x60, 0x35, 0xb9, 0x2c, 0xa9, 0x47, 0x55, 0x2e, 0x2b, 0xdb, 0xd1, 0x2a,
-	0x97, 0x95, 0xd3, 0xda, 0x92, 0xb0, 0x90, 0xea, 0xf9, 0xba, 0xdb, 0x4a, 0x58, 0x28, 0x6a, 0x58,
-	0x95, 0xb0, 0x50, 0xd8, 0x36, 0xa2, 0x43, 0x68, 0xe5, 0xf5, 0x65, 0x4a, 0x36, 0x9d, 0xd2, 0xf2,
-	0x29, 0xd9, 0x74, 0x5a, 0x83, 0xd7, 0x9b, 0xa3, 0xff, 0x10, 0xfd, 0xaf, 0x7f, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0xac, 0x3c, 0xe7, 0xd5, 0x2e, 0x2a, 0x00, 0x00,
+func (m *UnlockOutputResponse) Reset()         { *m = UnlockOutputResponse{} }
+func (m *UnlockOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockOutputResponse) ProtoMessage()    {}
+func (*UnlockOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{74}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *UnlockOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockOutputResponse.Unmarshal(m, b)
+}
+func (m *UnlockOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockOutputResponse.Marshal(b, m, deterministic)
+}
+func (m *UnlockOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockOutputResponse.Merge(m, src)
+}
+func (m *UnlockOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockOutputResponse.Size(m)
+}
+func (m *UnlockOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockOutputResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_UnlockOutputResponse proto.InternalMessageInfo
 
-// VersionServiceClient is the client API for VersionService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type VersionServiceClient interface {
-	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+type CreateTransactionRequest struct {
+	Account                 uint32                               `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	Outputs                 []*CreateTransactionRequest_Output   `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	RequiredConfirmations   int32                                `protobuf:"varint,3,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	SatPerKbFee             uint32                               `protobuf:"varint,4,opt,name=sat_per_kb_fee,json=satPerKbFee,proto3" json:"sat_per_kb_fee,omitempty"`
+	AvoidAddressMixing      bool                                 `protobuf:"varint,5,opt,name=avoid_address_mixing,json=avoidAddressMixing,proto3" json:"avoid_address_mixing,omitempty"`
+	ChangeAddress           string                               `protobuf:"bytes,6,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+	SpendImmatureCoinbases  bool                                 `protobuf:"varint,7,opt,name=spend_immature_coinbases,json=spendImmatureCoinbases,proto3" json:"spend_immature_coinbases,omitempty"`
+	LockTime                uint32                               `protobuf:"varint,8,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	AcknowledgeImmatureRisk bool                                 `protobuf:"varint,9,opt,name=acknowledge_immature_risk,json=acknowledgeImmatureRisk,proto3" json:"acknowledge_immature_risk,omitempty"`
+	UseEstimateFee          bool                                 `protobuf:"varint,10,opt,name=use_estimate_fee,json=useEstimateFee,proto3" json:"use_estimate_fee,omitempty"`
+	ConfTarget              uint32                               `protobuf:"varint,11,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	SelectedOutpoints       []*CreateTransactionRequest_OutPoint `protobuf:"bytes,12,rep,name=selected_outpoints,json=selectedOutpoints,proto3" json:"selected_outpoints,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                             `json:"-"`
+	XXX_unrecognized        []byte                               `json:"-"`
+	XXX_sizecache           int32                                `json:"-"`
 }
 
-type versionServiceClient struct {
-	cc *grpc.ClientConn
+func (m *CreateTransactionRequest) Reset()         { *m = CreateTransactionRequest{} }
+func (m *CreateTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTransactionRequest) ProtoMessage()    {}
+func (*CreateTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75}
 }
 
-func NewVersionServiceClient(cc *grpc.ClientConn) VersionServiceClient {
-	return &versionServiceClient{cc}
+func (m *CreateTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTransactionRequest.Unmarshal(m, b)
+}
+func (m *CreateTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTransactionRequest.Merge(m, src)
+}
+func (m *CreateTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTransactionRequest.Size(m)
+}
+func (m *CreateTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTransactionRequest.DiscardUnknown(m)
 }
 
-func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
-	out := new(VersionResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateTransactionRequest proto.InternalMessageInfo
+
+func (m *CreateTransactionRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return 0
 }
 
-// VersionServiceServer is the server API for VersionService service.
-type VersionServiceServer interface {
-	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+func (m *CreateTransactionRequest) GetOutputs() []*CreateTransactionRequest_Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
 }
 
-// UnimplementedVersionServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedVersionServiceServer struct {
+func (m *CreateTransactionRequest) GetRequiredConfirmations() int32 {
+	if m != nil {
+		return m.RequiredConfirmations
+	}
+	return 0
 }
 
-func (*UnimplementedVersionServiceServer) Version(ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+func (m *CreateTransactionRequest) GetSatPerKbFee() uint32 {
+	if m != nil {
+		return m.SatPerKbFee
+	}
+	return 0
 }
 
-func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
-	s.RegisterService(&_VersionService_serviceDesc, srv)
+func (m *CreateTransactionRequest) GetAvoidAddressMixing() bool {
+	if m != nil {
+		return m.AvoidAddressMixing
+	}
+	return false
 }
 
-func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *CreateTransactionRequest) GetChangeAddress() string {
+	if m != nil {
+		return m.ChangeAddress
 	}
-	if interceptor == nil {
-		return srv.(VersionServiceServer).Version(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/walletrpc.VersionService/Version",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VersionServiceServer).Version(ctx, req.(*VersionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _VersionService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "walletrpc.VersionService",
-	HandlerType: (*VersionServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Version",
-			Handler:    _VersionService_Version_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api.proto",
+	return ""
 }
 
-// WalletServiceClient is the client API for WalletService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type WalletServiceClient interface {
-	// Queries
-	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
-	Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error)
-	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
-	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
-	CurrentAddress(ctx context.Context, in *CurrentAddressRequest, opts ...grpc.CallOption) (*CurrentAddressResponse, error)
-	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
-	// Notifications
-	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
-	SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error)
-	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
-	RescanNotifications(ctx context.Context, in *RescanNotificationsRequest, opts ...grpc.CallOption) (WalletService_RescanNotificationsClient, error)
-	// Control
-	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
-	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
-	NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error)
-	NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error)
-	ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error)
-	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error)
-	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
-	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
-	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
-	PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error)
-	Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error)
-	// Payment Requests
-	DownloadPaymentRequest(ctx context.Context, in *DownloadPaymentRequestRequest, opts ...grpc.CallOption) (*DownloadPaymentRequestResponse, error)
-	PostPayment(ctx context.Context, in *PostPaymentRequest, opts ...grpc.CallOption) (*PostPaymentResponse, error)
-	// Utilities
-	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
+func (m *CreateTransactionRequest) GetSpendImmatureCoinbases() bool {
+	if m != nil {
+		return m.SpendImmatureCoinbases
+	}
+	return false
 }
 
-type walletServiceClient struct {
-	cc *grpc.ClientConn
+func (m *CreateTransactionRequest) GetLockTime() uint32 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
 }
 
-func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
-	return &walletServiceClient{cc}
+func (m *CreateTransactionRequest) GetAcknowledgeImmatureRisk() bool {
+	if m != nil {
+		return m.AcknowledgeImmatureRisk
+	}
+	return false
 }
 
-func (c *walletServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
-	out := new(PingResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Ping", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateTransactionRequest) GetUseEstimateFee() bool {
+	if m != nil {
+		return m.UseEstimateFee
 	}
-	return out, nil
+	return false
 }
 
-func (c *walletServiceClient) Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error) {
-	out := new(NetworkResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Network", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateTransactionRequest) GetConfTarget() uint32 {
+	if m != nil {
+		return m.ConfTarget
 	}
-	return out, nil
+	return 0
 }
 
-func (c *walletServiceClient) AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error) {
-	out := new(AccountNumberResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AccountNumber", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CreateTransactionRequest) GetSelectedOutpoints() []*CreateTransactionRequest_OutPoint {
+	if m != nil {
+		return m.SelectedOutpoints
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
-	out := new(AccountsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Accounts", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CreateTransactionRequest_Output struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
-	out := new(BalanceResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Balance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CreateTransactionRequest_Output) Reset()         { *m = CreateTransactionRequest_Output{} }
+func (m *CreateTransactionRequest_Output) String() string { return proto.CompactTextString(m) }
+func (*CreateTransactionRequest_Output) ProtoMessage()    {}
+func (*CreateTransactionRequest_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75, 0}
 }
 
-func (c *walletServiceClient) CurrentAddress(ctx context.Context, in *CurrentAddressRequest, opts ...grpc.CallOption) (*CurrentAddressResponse, error) {
-	out := new(CurrentAddressResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CurrentAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *CreateTransactionRequest_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTransactionRequest_Output.Unmarshal(m, b)
+}
+func (m *CreateTransactionRequest_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTransactionRequest_Output.Marshal(b, m, deterministic)
+}
+func (m *CreateTransactionRequest_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTransactionRequest_Output.Merge(m, src)
+}
+func (m *CreateTransactionRequest_Output) XXX_Size() int {
+	return xxx_messageInfo_CreateTransactionRequest_Output.Size(m)
+}
+func (m *CreateTransactionRequest_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTransactionRequest_Output.DiscardUnknown(m)
 }
 
-func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
-	out := new(GetTransactionsResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetTransactions", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CreateTransactionRequest_Output proto.InternalMessageInfo
+
+func (m *CreateTransactionRequest_Output) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[0], "/walletrpc.WalletService/TransactionNotifications", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &walletServiceTransactionNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *CreateTransactionRequest_Output) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
 	}
-	return x, nil
+	return 0
 }
 
-type WalletService_TransactionNotificationsClient interface {
-	Recv() (*TransactionNotificationsResponse, error)
-	grpc.ClientStream
+type CreateTransactionRequest_OutPoint struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32   `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type walletServiceTransactionNotificationsClient struct {
-	grpc.ClientStream
+func (m *CreateTransactionRequest_OutPoint) Reset()         { *m = CreateTransactionRequest_OutPoint{} }
+func (m *CreateTransactionRequest_OutPoint) String() string { return proto.CompactTextString(m) }
+func (*CreateTransactionRequest_OutPoint) ProtoMessage()    {}
+func (*CreateTransactionRequest_OutPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{75, 1}
 }
 
-func (x *walletServiceTransactionNotificationsClient) Recv() (*TransactionNotificationsResponse, error) {
-	m := new(TransactionNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *CreateTransactionRequest_OutPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTransactionRequest_OutPoint.Unmarshal(m, b)
 }
-
-func (c *walletServiceClient) SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[1], "/walletrpc.WalletService/SpentnessNotifications", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &walletServiceSpentnessNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+func (m *CreateTransactionRequest_OutPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTransactionRequest_OutPoint.Marshal(b, m, deterministic)
 }
-
-type WalletService_SpentnessNotificationsClient interface {
-	Recv() (*SpentnessNotificationsResponse, error)
-	grpc.ClientStream
+func (m *CreateTransactionRequest_OutPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTransactionRequest_OutPoint.Merge(m, src)
 }
-
-type walletServiceSpentnessNotificationsClient struct {
-	grpc.ClientStream
+func (m *CreateTransactionRequest_OutPoint) XXX_Size() int {
+	return xxx_messageInfo_CreateTransactionRequest_OutPoint.Size(m)
+}
+func (m *CreateTransactionRequest_OutPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTransactionRequest_OutPoint.DiscardUnknown(m)
 }
 
-func (x *walletServiceSpentnessNotificationsClient) Recv() (*SpentnessNotificationsResponse, error) {
-	m := new(SpentnessNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_CreateTransactionRequest_OutPoint proto.InternalMessageInfo
+
+func (m *CreateTransactionRequest_OutPoint) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
 	}
-	return m, nil
+	return nil
 }
 
-func (c *walletServiceClient) AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[2], "/walletrpc.WalletService/AccountNotifications", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &walletServiceAccountNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *CreateTransactionRequest_OutPoint) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
 	}
-	return x, nil
+	return 0
 }
 
-type WalletService_AccountNotificationsClient interface {
-	Recv() (*AccountNotificationsResponse, error)
-	grpc.ClientStream
+type CreateTransactionResponse struct {
+	SerializedTransaction []byte   `protobuf:"bytes,1,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
+	InputValues           []int64  `protobuf:"varint,2,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
+	Fee                   int64    `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	AbsorbedChange        int64    `protobuf:"varint,4,opt,name=absorbed_change,json=absorbedChange,proto3" json:"absorbed_change,omitempty"`
+	SatPerKbFee           uint32   `protobuf:"varint,5,opt,name=sat_per_kb_fee,json=satPerKbFee,proto3" json:"sat_per_kb_fee,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-type walletServiceAccountNotificationsClient struct {
-	grpc.ClientStream
+func (m *CreateTransactionResponse) Reset()         { *m = CreateTransactionResponse{} }
+func (m *CreateTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTransactionResponse) ProtoMessage()    {}
+func (*CreateTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{76}
 }
 
-func (x *walletServiceAccountNotificationsClient) Recv() (*AccountNotificationsResponse, error) {
-	m := new(AccountNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *CreateTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTransactionResponse.Unmarshal(m, b)
 }
-
-func (c *walletServiceClient) RescanNotifications(ctx context.Context, in *RescanNotificationsRequest, opts ...grpc.CallOption) (WalletService_RescanNotificationsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[3], "/walletrpc.WalletService/RescanNotifications", opts...)
+func (m *CreateTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTransactionResponse.Merge(m, src)
+}
+func (m *CreateTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTransactionResponse.Size(m)
+}
+func (m *CreateTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTransactionResponse proto.InternalMessageInfo
+
+func (m *CreateTransactionResponse) GetSerializedTransaction() []byte {
+	if m != nil {
+		return m.SerializedTransaction
+	}
+	return nil
+}
+
+func (m *CreateTransactionResponse) GetInputValues() []int64 {
+	if m != nil {
+		return m.InputValues
+	}
+	return nil
+}
+
+func (m *CreateTransactionResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *CreateTransactionResponse) GetAbsorbedChange() int64 {
+	if m != nil {
+		return m.AbsorbedChange
+	}
+	return 0
+}
+
+func (m *CreateTransactionResponse) GetSatPerKbFee() uint32 {
+	if m != nil {
+		return m.SatPerKbFee
+	}
+	return 0
+}
+
+type SweepAccountRequest struct {
+	Account               uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	SweepToAddress        string   `protobuf:"bytes,2,opt,name=sweep_to_address,json=sweepToAddress,proto3" json:"sweep_to_address,omitempty"`
+	SatPerKbFee           uint32   `protobuf:"varint,3,opt,name=sat_per_kb_fee,json=satPerKbFee,proto3" json:"sat_per_kb_fee,omitempty"`
+	SweepToAccount        bool     `protobuf:"varint,4,opt,name=sweep_to_account,json=sweepToAccount,proto3" json:"sweep_to_account,omitempty"`
+	DestinationAccount    uint32   `protobuf:"varint,5,opt,name=destination_account,json=destinationAccount,proto3" json:"destination_account,omitempty"`
+	RequiredConfirmations int32    `protobuf:"varint,6,opt,name=required_confirmations,json=requiredConfirmations,proto3" json:"required_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *SweepAccountRequest) Reset()         { *m = SweepAccountRequest{} }
+func (m *SweepAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*SweepAccountRequest) ProtoMessage()    {}
+func (*SweepAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{77}
+}
+
+func (m *SweepAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SweepAccountRequest.Unmarshal(m, b)
+}
+func (m *SweepAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SweepAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *SweepAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepAccountRequest.Merge(m, src)
+}
+func (m *SweepAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_SweepAccountRequest.Size(m)
+}
+func (m *SweepAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepAccountRequest proto.InternalMessageInfo
+
+func (m *SweepAccountRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *SweepAccountRequest) GetSweepToAddress() string {
+	if m != nil {
+		return m.SweepToAddress
+	}
+	return ""
+}
+
+func (m *SweepAccountRequest) GetSatPerKbFee() uint32 {
+	if m != nil {
+		return m.SatPerKbFee
+	}
+	return 0
+}
+
+func (m *SweepAccountRequest) GetSweepToAccount() bool {
+	if m != nil {
+		return m.SweepToAccount
+	}
+	return false
+}
+
+func (m *SweepAccountRequest) GetDestinationAccount() uint32 {
+	if m != nil {
+		return m.DestinationAccount
+	}
+	return 0
+}
+
+func (m *SweepAccountRequest) GetRequiredConfirmations() int32 {
+	if m != nil {
+		return m.RequiredConfirmations
+	}
+	return 0
+}
+
+type SweepAccountResponse struct {
+	SerializedTransaction []byte   `protobuf:"bytes,1,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
+	InputValues           []int64  `protobuf:"varint,2,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
+	TotalAmount           int64    `protobuf:"varint,3,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	Fee                   int64    `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	SweepToAddress        string   `protobuf:"bytes,5,opt,name=sweep_to_address,json=sweepToAddress,proto3" json:"sweep_to_address,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *SweepAccountResponse) Reset()         { *m = SweepAccountResponse{} }
+func (m *SweepAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*SweepAccountResponse) ProtoMessage()    {}
+func (*SweepAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{78}
+}
+
+func (m *SweepAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SweepAccountResponse.Unmarshal(m, b)
+}
+func (m *SweepAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SweepAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *SweepAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepAccountResponse.Merge(m, src)
+}
+func (m *SweepAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_SweepAccountResponse.Size(m)
+}
+func (m *SweepAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepAccountResponse proto.InternalMessageInfo
+
+func (m *SweepAccountResponse) GetSerializedTransaction() []byte {
+	if m != nil {
+		return m.SerializedTransaction
+	}
+	return nil
+}
+
+func (m *SweepAccountResponse) GetInputValues() []int64 {
+	if m != nil {
+		return m.InputValues
+	}
+	return nil
+}
+
+func (m *SweepAccountResponse) GetTotalAmount() int64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *SweepAccountResponse) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *SweepAccountResponse) GetSweepToAddress() string {
+	if m != nil {
+		return m.SweepToAddress
+	}
+	return ""
+}
+
+type SignTransactionRequest struct {
+	Passphrase            []byte `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	SerializedTransaction []byte `protobuf:"bytes,2,opt,name=serialized_transaction,json=serializedTransaction,proto3" json:"serialized_transaction,omitempty"`
+	// If no indexes are specified, signatures scripts will be added for
+	// every input. If any input indexes are specified, only those inputs
+	// will be signed.  Rather than returning an incompletely signed
+	// transaction if any of the inputs to be signed can not be, the RPC
+	// immediately errors.
+	InputIndexes []uint32 `protobuf:"varint,3,rep,packed,name=input_indexes,json=inputIndexes,proto3" json:"input_indexes,omitempty"`
+	// Values must be provided for each input in order to sign using
+	// the bitcoin cash signing algorithm.
+	InputValues          []int64  `protobuf:"varint,4,rep,packed,name=input_values,json=inputValues,proto3" json:"input_values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignTransactionRequest) Reset()         { *m = SignTransactionRequest{} }
+func (m *SignTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignTransactionRequest) ProtoMessage()    {}
+func (*SignTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{79}
+}
+
+func (m *SignTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTransactionRequest.Unmarshal(m, b)
+}
+func (m *SignTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *SignTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTransactionRequest.Merge(m, src)
+}
+func (m *SignTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_SignTransactionRequest.Size(m)
+}
+func (m *SignTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTransactionRequest proto.InternalMessageInfo
+
+func (m *SignTransactionRequest) GetPassphrase() []byte {
+	if m != nil {
+		return m.Passphrase
+	}
+	return nil
+}
+
+func (m *SignTransactionRequest) GetSerializedTransaction() []byte {
+	if m != nil {
+		return m.SerializedTransaction
+	}
+	return nil
+}
+
+func (m *SignTransactionRequest) GetInputIndexes() []uint32 {
+	if m != nil {
+		return m.InputIndexes
+	}
+	return nil
+}
+
+func (m *SignTransactionRequest) GetInputValues() []int64 {
+	if m != nil {
+		return m.InputValues
+	}
+	return nil
+}
+
+type SignTransactionResponse struct {
+	Transaction          []byte   `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	UnsignedInputIndexes []uint32 `protobuf:"varint,2,rep,packed,name=unsigned_input_indexes,json=unsignedInputIndexes,proto3" json:"unsigned_input_indexes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignTransactionResponse) Reset()         { *m = SignTransactionResponse{} }
+func (m *SignTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignTransactionResponse) ProtoMessage()    {}
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{80}
+}
+
+func (m *SignTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTransactionResponse.Unmarshal(m, b)
+}
+func (m *SignTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *SignTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTransactionResponse.Merge(m, src)
+}
+func (m *SignTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SignTransactionResponse.Size(m)
+}
+func (m *SignTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTransactionResponse proto.InternalMessageInfo
+
+func (m *SignTransactionResponse) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *SignTransactionResponse) GetUnsignedInputIndexes() []uint32 {
+	if m != nil {
+		return m.UnsignedInputIndexes
+	}
+	return nil
+}
+
+type PublishTransactionRequest struct {
+	SignedTransaction    []byte   `protobuf:"bytes,1,opt,name=signed_transaction,json=signedTransaction,proto3" json:"signed_transaction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishTransactionRequest) Reset()         { *m = PublishTransactionRequest{} }
+func (m *PublishTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishTransactionRequest) ProtoMessage()    {}
+func (*PublishTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{81}
+}
+
+func (m *PublishTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishTransactionRequest.Unmarshal(m, b)
+}
+func (m *PublishTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *PublishTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishTransactionRequest.Merge(m, src)
+}
+func (m *PublishTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_PublishTransactionRequest.Size(m)
+}
+func (m *PublishTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishTransactionRequest proto.InternalMessageInfo
+
+func (m *PublishTransactionRequest) GetSignedTransaction() []byte {
+	if m != nil {
+		return m.SignedTransaction
+	}
+	return nil
+}
+
+type PublishTransactionResponse struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PublishTransactionResponse) Reset()         { *m = PublishTransactionResponse{} }
+func (m *PublishTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishTransactionResponse) ProtoMessage()    {}
+func (*PublishTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{82}
+}
+
+func (m *PublishTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PublishTransactionResponse.Unmarshal(m, b)
+}
+func (m *PublishTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PublishTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *PublishTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishTransactionResponse.Merge(m, src)
+}
+func (m *PublishTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_PublishTransactionResponse.Size(m)
+}
+func (m *PublishTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishTransactionResponse proto.InternalMessageInfo
+
+func (m *PublishTransactionResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+type RemoveTransactionRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveTransactionRequest) Reset()         { *m = RemoveTransactionRequest{} }
+func (m *RemoveTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTransactionRequest) ProtoMessage()    {}
+func (*RemoveTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{83}
+}
+
+func (m *RemoveTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTransactionRequest.Unmarshal(m, b)
+}
+func (m *RemoveTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTransactionRequest.Merge(m, src)
+}
+func (m *RemoveTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveTransactionRequest.Size(m)
+}
+func (m *RemoveTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTransactionRequest proto.InternalMessageInfo
+
+func (m *RemoveTransactionRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+type RemoveTransactionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveTransactionResponse) Reset()         { *m = RemoveTransactionResponse{} }
+func (m *RemoveTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveTransactionResponse) ProtoMessage()    {}
+func (*RemoveTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{84}
+}
+
+func (m *RemoveTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTransactionResponse.Unmarshal(m, b)
+}
+func (m *RemoveTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTransactionResponse.Merge(m, src)
+}
+func (m *RemoveTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveTransactionResponse.Size(m)
+}
+func (m *RemoveTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveTransactionResponse proto.InternalMessageInfo
+
+type SetTransactionLabelRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTransactionLabelRequest) Reset()         { *m = SetTransactionLabelRequest{} }
+func (m *SetTransactionLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTransactionLabelRequest) ProtoMessage()    {}
+func (*SetTransactionLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{85}
+}
+
+func (m *SetTransactionLabelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTransactionLabelRequest.Unmarshal(m, b)
+}
+func (m *SetTransactionLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTransactionLabelRequest.Marshal(b, m, deterministic)
+}
+func (m *SetTransactionLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTransactionLabelRequest.Merge(m, src)
+}
+func (m *SetTransactionLabelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTransactionLabelRequest.Size(m)
+}
+func (m *SetTransactionLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTransactionLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTransactionLabelRequest proto.InternalMessageInfo
+
+func (m *SetTransactionLabelRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *SetTransactionLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetTransactionLabelResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTransactionLabelResponse) Reset()         { *m = SetTransactionLabelResponse{} }
+func (m *SetTransactionLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetTransactionLabelResponse) ProtoMessage()    {}
+func (*SetTransactionLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{86}
+}
+
+func (m *SetTransactionLabelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTransactionLabelResponse.Unmarshal(m, b)
+}
+func (m *SetTransactionLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTransactionLabelResponse.Marshal(b, m, deterministic)
+}
+func (m *SetTransactionLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTransactionLabelResponse.Merge(m, src)
+}
+func (m *SetTransactionLabelResponse) XXX_Size() int {
+	return xxx_messageInfo_SetTransactionLabelResponse.Size(m)
+}
+func (m *SetTransactionLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTransactionLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTransactionLabelResponse proto.InternalMessageInfo
+
+type GetTransactionLabelRequest struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransactionLabelRequest) Reset()         { *m = GetTransactionLabelRequest{} }
+func (m *GetTransactionLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionLabelRequest) ProtoMessage()    {}
+func (*GetTransactionLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{87}
+}
+
+func (m *GetTransactionLabelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionLabelRequest.Unmarshal(m, b)
+}
+func (m *GetTransactionLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionLabelRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionLabelRequest.Merge(m, src)
+}
+func (m *GetTransactionLabelRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionLabelRequest.Size(m)
+}
+func (m *GetTransactionLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionLabelRequest proto.InternalMessageInfo
+
+func (m *GetTransactionLabelRequest) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+type GetTransactionLabelResponse struct {
+	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransactionLabelResponse) Reset()         { *m = GetTransactionLabelResponse{} }
+func (m *GetTransactionLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionLabelResponse) ProtoMessage()    {}
+func (*GetTransactionLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{88}
+}
+
+func (m *GetTransactionLabelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransactionLabelResponse.Unmarshal(m, b)
+}
+func (m *GetTransactionLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransactionLabelResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransactionLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionLabelResponse.Merge(m, src)
+}
+func (m *GetTransactionLabelResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransactionLabelResponse.Size(m)
+}
+func (m *GetTransactionLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionLabelResponse proto.InternalMessageInfo
+
+func (m *GetTransactionLabelResponse) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetAddressLabelRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAddressLabelRequest) Reset()         { *m = SetAddressLabelRequest{} }
+func (m *SetAddressLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetAddressLabelRequest) ProtoMessage()    {}
+func (*SetAddressLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{89}
+}
+
+func (m *SetAddressLabelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAddressLabelRequest.Unmarshal(m, b)
+}
+func (m *SetAddressLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAddressLabelRequest.Marshal(b, m, deterministic)
+}
+func (m *SetAddressLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAddressLabelRequest.Merge(m, src)
+}
+func (m *SetAddressLabelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetAddressLabelRequest.Size(m)
+}
+func (m *SetAddressLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAddressLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAddressLabelRequest proto.InternalMessageInfo
+
+func (m *SetAddressLabelRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SetAddressLabelRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type SetAddressLabelResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetAddressLabelResponse) Reset()         { *m = SetAddressLabelResponse{} }
+func (m *SetAddressLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*SetAddressLabelResponse) ProtoMessage()    {}
+func (*SetAddressLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{90}
+}
+
+func (m *SetAddressLabelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetAddressLabelResponse.Unmarshal(m, b)
+}
+func (m *SetAddressLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetAddressLabelResponse.Marshal(b, m, deterministic)
+}
+func (m *SetAddressLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetAddressLabelResponse.Merge(m, src)
+}
+func (m *SetAddressLabelResponse) XXX_Size() int {
+	return xxx_messageInfo_SetAddressLabelResponse.Size(m)
+}
+func (m *SetAddressLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetAddressLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetAddressLabelResponse proto.InternalMessageInfo
+
+type GetAddressLabelRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAddressLabelRequest) Reset()         { *m = GetAddressLabelRequest{} }
+func (m *GetAddressLabelRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAddressLabelRequest) ProtoMessage()    {}
+func (*GetAddressLabelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{91}
+}
+
+func (m *GetAddressLabelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAddressLabelRequest.Unmarshal(m, b)
+}
+func (m *GetAddressLabelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAddressLabelRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAddressLabelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAddressLabelRequest.Merge(m, src)
+}
+func (m *GetAddressLabelRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAddressLabelRequest.Size(m)
+}
+func (m *GetAddressLabelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAddressLabelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAddressLabelRequest proto.InternalMessageInfo
+
+func (m *GetAddressLabelRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type GetAddressLabelResponse struct {
+	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAddressLabelResponse) Reset()         { *m = GetAddressLabelResponse{} }
+func (m *GetAddressLabelResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAddressLabelResponse) ProtoMessage()    {}
+func (*GetAddressLabelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{92}
+}
+
+func (m *GetAddressLabelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAddressLabelResponse.Unmarshal(m, b)
+}
+func (m *GetAddressLabelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAddressLabelResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAddressLabelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAddressLabelResponse.Merge(m, src)
+}
+func (m *GetAddressLabelResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAddressLabelResponse.Size(m)
+}
+func (m *GetAddressLabelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAddressLabelResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAddressLabelResponse proto.InternalMessageInfo
+
+func (m *GetAddressLabelResponse) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type RescanRequest struct {
+	BeginHeight          int32    `protobuf:"varint,1,opt,name=begin_height,json=beginHeight,proto3" json:"begin_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescanRequest) Reset()         { *m = RescanRequest{} }
+func (m *RescanRequest) String() string { return proto.CompactTextString(m) }
+func (*RescanRequest) ProtoMessage()    {}
+func (*RescanRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{93}
+}
+
+func (m *RescanRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RescanRequest.Unmarshal(m, b)
+}
+func (m *RescanRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RescanRequest.Marshal(b, m, deterministic)
+}
+func (m *RescanRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescanRequest.Merge(m, src)
+}
+func (m *RescanRequest) XXX_Size() int {
+	return xxx_messageInfo_RescanRequest.Size(m)
+}
+func (m *RescanRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescanRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescanRequest proto.InternalMessageInfo
+
+func (m *RescanRequest) GetBeginHeight() int32 {
+	if m != nil {
+		return m.BeginHeight
+	}
+	return 0
+}
+
+type RescanResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescanResponse) Reset()         { *m = RescanResponse{} }
+func (m *RescanResponse) String() string { return proto.CompactTextString(m) }
+func (*RescanResponse) ProtoMessage()    {}
+func (*RescanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{94}
+}
+
+func (m *RescanResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RescanResponse.Unmarshal(m, b)
+}
+func (m *RescanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RescanResponse.Marshal(b, m, deterministic)
+}
+func (m *RescanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescanResponse.Merge(m, src)
+}
+func (m *RescanResponse) XXX_Size() int {
+	return xxx_messageInfo_RescanResponse.Size(m)
+}
+func (m *RescanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescanResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescanResponse proto.InternalMessageInfo
+
+type TransactionNotificationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionNotificationsRequest) Reset()         { *m = TransactionNotificationsRequest{} }
+func (m *TransactionNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*TransactionNotificationsRequest) ProtoMessage()    {}
+func (*TransactionNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{95}
+}
+
+func (m *TransactionNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionNotificationsRequest.Unmarshal(m, b)
+}
+func (m *TransactionNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *TransactionNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionNotificationsRequest.Merge(m, src)
+}
+func (m *TransactionNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_TransactionNotificationsRequest.Size(m)
+}
+func (m *TransactionNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionNotificationsRequest proto.InternalMessageInfo
+
+type TransactionNotificationsResponse struct {
+	// Sorted by increasing height.  This is a repeated field so many new blocks
+	// in a new best chain can be notified at once during a reorganize.
+	AttachedBlocks []*BlockDetails `protobuf:"bytes,1,rep,name=attached_blocks,json=attachedBlocks,proto3" json:"attached_blocks,omitempty"`
+	// If there was a chain reorganize, there may have been blocks with wallet
+	// transactions that are no longer in the best chain.  These are those
+	// block's hashes.
+	DetachedBlocks [][]byte `protobuf:"bytes,2,rep,name=detached_blocks,json=detachedBlocks,proto3" json:"detached_blocks,omitempty"`
+	// Any new unmined transactions are included here.  These unmined transactions
+	// refer to the current best chain, so transactions from detached blocks may
+	// be moved to mempool and included here if they are not mined or double spent
+	// in the new chain.  Additonally, if no new blocks were attached but a relevant
+	// unmined transaction is seen by the wallet, it will be reported here.
+	UnminedTransactions []*TransactionDetails `protobuf:"bytes,3,rep,name=unmined_transactions,json=unminedTransactions,proto3" json:"unmined_transactions,omitempty"`
+	// Instead of notifying all of the removed unmined transactions,
+	// just send all of the current hashes.
+	UnminedTransactionHashes [][]byte `protobuf:"bytes,4,rep,name=unmined_transaction_hashes,json=unminedTransactionHashes,proto3" json:"unmined_transaction_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
+}
+
+func (m *TransactionNotificationsResponse) Reset()         { *m = TransactionNotificationsResponse{} }
+func (m *TransactionNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*TransactionNotificationsResponse) ProtoMessage()    {}
+func (*TransactionNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{96}
+}
+
+func (m *TransactionNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionNotificationsResponse.Unmarshal(m, b)
+}
+func (m *TransactionNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *TransactionNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionNotificationsResponse.Merge(m, src)
+}
+func (m *TransactionNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_TransactionNotificationsResponse.Size(m)
+}
+func (m *TransactionNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionNotificationsResponse proto.InternalMessageInfo
+
+func (m *TransactionNotificationsResponse) GetAttachedBlocks() []*BlockDetails {
+	if m != nil {
+		return m.AttachedBlocks
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetDetachedBlocks() [][]byte {
+	if m != nil {
+		return m.DetachedBlocks
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetUnminedTransactions() []*TransactionDetails {
+	if m != nil {
+		return m.UnminedTransactions
+	}
+	return nil
+}
+
+func (m *TransactionNotificationsResponse) GetUnminedTransactionHashes() [][]byte {
+	if m != nil {
+		return m.UnminedTransactionHashes
+	}
+	return nil
+}
+
+type SpentnessNotificationsRequest struct {
+	Account              uint32   `protobuf:"varint,1,opt,name=account,proto3" json:"account,omitempty"`
+	NoNotifyUnspent      bool     `protobuf:"varint,2,opt,name=no_notify_unspent,json=noNotifyUnspent,proto3" json:"no_notify_unspent,omitempty"`
+	NoNotifySpent        bool     `protobuf:"varint,3,opt,name=no_notify_spent,json=noNotifySpent,proto3" json:"no_notify_spent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpentnessNotificationsRequest) Reset()         { *m = SpentnessNotificationsRequest{} }
+func (m *SpentnessNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*SpentnessNotificationsRequest) ProtoMessage()    {}
+func (*SpentnessNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{97}
+}
+
+func (m *SpentnessNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpentnessNotificationsRequest.Unmarshal(m, b)
+}
+func (m *SpentnessNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpentnessNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *SpentnessNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentnessNotificationsRequest.Merge(m, src)
+}
+func (m *SpentnessNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_SpentnessNotificationsRequest.Size(m)
+}
+func (m *SpentnessNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentnessNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentnessNotificationsRequest proto.InternalMessageInfo
+
+func (m *SpentnessNotificationsRequest) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *SpentnessNotificationsRequest) GetNoNotifyUnspent() bool {
+	if m != nil {
+		return m.NoNotifyUnspent
+	}
+	return false
+}
+
+func (m *SpentnessNotificationsRequest) GetNoNotifySpent() bool {
+	if m != nil {
+		return m.NoNotifySpent
+	}
+	return false
+}
+
+type SpentnessNotificationsResponse struct {
+	TransactionHash      []byte                                  `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	OutputIndex          uint32                                  `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	Spender              *SpentnessNotificationsResponse_Spender `protobuf:"bytes,3,opt,name=spender,proto3" json:"spender,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *SpentnessNotificationsResponse) Reset()         { *m = SpentnessNotificationsResponse{} }
+func (m *SpentnessNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*SpentnessNotificationsResponse) ProtoMessage()    {}
+func (*SpentnessNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98}
+}
+
+func (m *SpentnessNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpentnessNotificationsResponse.Unmarshal(m, b)
+}
+func (m *SpentnessNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpentnessNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *SpentnessNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentnessNotificationsResponse.Merge(m, src)
+}
+func (m *SpentnessNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_SpentnessNotificationsResponse.Size(m)
+}
+func (m *SpentnessNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentnessNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentnessNotificationsResponse proto.InternalMessageInfo
+
+func (m *SpentnessNotificationsResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *SpentnessNotificationsResponse) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
+func (m *SpentnessNotificationsResponse) GetSpender() *SpentnessNotificationsResponse_Spender {
+	if m != nil {
+		return m.Spender
+	}
+	return nil
+}
+
+type SpentnessNotificationsResponse_Spender struct {
+	TransactionHash      []byte   `protobuf:"bytes,1,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	InputIndex           uint32   `protobuf:"varint,2,opt,name=input_index,json=inputIndex,proto3" json:"input_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpentnessNotificationsResponse_Spender) Reset() {
+	*m = SpentnessNotificationsResponse_Spender{}
+}
+func (m *SpentnessNotificationsResponse_Spender) String() string { return proto.CompactTextString(m) }
+func (*SpentnessNotificationsResponse_Spender) ProtoMessage()    {}
+func (*SpentnessNotificationsResponse_Spender) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{98, 0}
+}
+
+func (m *SpentnessNotificationsResponse_Spender) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Unmarshal(m, b)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Marshal(b, m, deterministic)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpentnessNotificationsResponse_Spender.Merge(m, src)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_Size() int {
+	return xxx_messageInfo_SpentnessNotificationsResponse_Spender.Size(m)
+}
+func (m *SpentnessNotificationsResponse_Spender) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpentnessNotificationsResponse_Spender.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpentnessNotificationsResponse_Spender proto.InternalMessageInfo
+
+func (m *SpentnessNotificationsResponse_Spender) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *SpentnessNotificationsResponse_Spender) GetInputIndex() uint32 {
+	if m != nil {
+		return m.InputIndex
+	}
+	return 0
+}
+
+type AccountNotificationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountNotificationsRequest) Reset()         { *m = AccountNotificationsRequest{} }
+func (m *AccountNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountNotificationsRequest) ProtoMessage()    {}
+func (*AccountNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{99}
+}
+
+func (m *AccountNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountNotificationsRequest.Unmarshal(m, b)
+}
+func (m *AccountNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *AccountNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountNotificationsRequest.Merge(m, src)
+}
+func (m *AccountNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_AccountNotificationsRequest.Size(m)
+}
+func (m *AccountNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountNotificationsRequest proto.InternalMessageInfo
+
+type AccountNotificationsResponse struct {
+	AccountNumber        uint32   `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName          string   `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	ExternalKeyCount     uint32   `protobuf:"varint,3,opt,name=external_key_count,json=externalKeyCount,proto3" json:"external_key_count,omitempty"`
+	InternalKeyCount     uint32   `protobuf:"varint,4,opt,name=internal_key_count,json=internalKeyCount,proto3" json:"internal_key_count,omitempty"`
+	ImportedKeyCount     uint32   `protobuf:"varint,5,opt,name=imported_key_count,json=importedKeyCount,proto3" json:"imported_key_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountNotificationsResponse) Reset()         { *m = AccountNotificationsResponse{} }
+func (m *AccountNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountNotificationsResponse) ProtoMessage()    {}
+func (*AccountNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{100}
+}
+
+func (m *AccountNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountNotificationsResponse.Unmarshal(m, b)
+}
+func (m *AccountNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *AccountNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountNotificationsResponse.Merge(m, src)
+}
+func (m *AccountNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_AccountNotificationsResponse.Size(m)
+}
+func (m *AccountNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountNotificationsResponse proto.InternalMessageInfo
+
+func (m *AccountNotificationsResponse) GetAccountNumber() uint32 {
+	if m != nil {
+		return m.AccountNumber
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetAccountName() string {
+	if m != nil {
+		return m.AccountName
+	}
+	return ""
+}
+
+func (m *AccountNotificationsResponse) GetExternalKeyCount() uint32 {
+	if m != nil {
+		return m.ExternalKeyCount
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetInternalKeyCount() uint32 {
+	if m != nil {
+		return m.InternalKeyCount
+	}
+	return 0
+}
+
+func (m *AccountNotificationsResponse) GetImportedKeyCount() uint32 {
+	if m != nil {
+		return m.ImportedKeyCount
+	}
+	return 0
+}
+
+type RescanNotificationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescanNotificationsRequest) Reset()         { *m = RescanNotificationsRequest{} }
+func (m *RescanNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*RescanNotificationsRequest) ProtoMessage()    {}
+func (*RescanNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{101}
+}
+
+func (m *RescanNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RescanNotificationsRequest.Unmarshal(m, b)
+}
+func (m *RescanNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RescanNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *RescanNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescanNotificationsRequest.Merge(m, src)
+}
+func (m *RescanNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_RescanNotificationsRequest.Size(m)
+}
+func (m *RescanNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescanNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescanNotificationsRequest proto.InternalMessageInfo
+
+type RescanNotificationsResponse struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height               int32    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Finished             bool     `protobuf:"varint,3,opt,name=finished,proto3" json:"finished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RescanNotificationsResponse) Reset()         { *m = RescanNotificationsResponse{} }
+func (m *RescanNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*RescanNotificationsResponse) ProtoMessage()    {}
+func (*RescanNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{102}
+}
+
+func (m *RescanNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RescanNotificationsResponse.Unmarshal(m, b)
+}
+func (m *RescanNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RescanNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *RescanNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RescanNotificationsResponse.Merge(m, src)
+}
+func (m *RescanNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_RescanNotificationsResponse.Size(m)
+}
+func (m *RescanNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RescanNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RescanNotificationsResponse proto.InternalMessageInfo
+
+func (m *RescanNotificationsResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *RescanNotificationsResponse) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RescanNotificationsResponse) GetFinished() bool {
+	if m != nil {
+		return m.Finished
+	}
+	return false
+}
+
+type AddressPaymentNotificationsRequest struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	TargetConfirmations  int32    `protobuf:"varint,2,opt,name=target_confirmations,json=targetConfirmations,proto3" json:"target_confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressPaymentNotificationsRequest) Reset()         { *m = AddressPaymentNotificationsRequest{} }
+func (m *AddressPaymentNotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddressPaymentNotificationsRequest) ProtoMessage()    {}
+func (*AddressPaymentNotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{103}
+}
+
+func (m *AddressPaymentNotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressPaymentNotificationsRequest.Unmarshal(m, b)
+}
+func (m *AddressPaymentNotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressPaymentNotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddressPaymentNotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressPaymentNotificationsRequest.Merge(m, src)
+}
+func (m *AddressPaymentNotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddressPaymentNotificationsRequest.Size(m)
+}
+func (m *AddressPaymentNotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressPaymentNotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressPaymentNotificationsRequest proto.InternalMessageInfo
+
+func (m *AddressPaymentNotificationsRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *AddressPaymentNotificationsRequest) GetTargetConfirmations() int32 {
+	if m != nil {
+		return m.TargetConfirmations
+	}
+	return 0
+}
+
+type AddressPaymentNotificationsResponse struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TransactionHash      []byte   `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Confirmations        int32    `protobuf:"varint,4,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressPaymentNotificationsResponse) Reset()         { *m = AddressPaymentNotificationsResponse{} }
+func (m *AddressPaymentNotificationsResponse) String() string { return proto.CompactTextString(m) }
+func (*AddressPaymentNotificationsResponse) ProtoMessage()    {}
+func (*AddressPaymentNotificationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{104}
+}
+
+func (m *AddressPaymentNotificationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressPaymentNotificationsResponse.Unmarshal(m, b)
+}
+func (m *AddressPaymentNotificationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressPaymentNotificationsResponse.Marshal(b, m, deterministic)
+}
+func (m *AddressPaymentNotificationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressPaymentNotificationsResponse.Merge(m, src)
+}
+func (m *AddressPaymentNotificationsResponse) XXX_Size() int {
+	return xxx_messageInfo_AddressPaymentNotificationsResponse.Size(m)
+}
+func (m *AddressPaymentNotificationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressPaymentNotificationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressPaymentNotificationsResponse proto.InternalMessageInfo
+
+func (m *AddressPaymentNotificationsResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddressPaymentNotificationsResponse) GetTransactionHash() []byte {
+	if m != nil {
+		return m.TransactionHash
+	}
+	return nil
+}
+
+func (m *AddressPaymentNotificationsResponse) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *AddressPaymentNotificationsResponse) GetConfirmations() int32 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type CreateWalletRequest struct {
+	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+	PrivatePassphrase    []byte   `protobuf:"bytes,2,opt,name=private_passphrase,json=privatePassphrase,proto3" json:"private_passphrase,omitempty"`
+	MnemonicSeed         string   `protobuf:"bytes,3,opt,name=mnemonic_seed,json=mnemonicSeed,proto3" json:"mnemonic_seed,omitempty"`
+	WalletBirthday       int64    `protobuf:"varint,4,opt,name=wallet_birthday,json=walletBirthday,proto3" json:"wallet_birthday,omitempty"`
+	Bip39Passphrase      string   `protobuf:"bytes,5,opt,name=bip39_passphrase,json=bip39Passphrase,proto3" json:"bip39_passphrase,omitempty"`
+	WordCount            uint32   `protobuf:"varint,6,opt,name=word_count,json=wordCount,proto3" json:"word_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWalletRequest) Reset()         { *m = CreateWalletRequest{} }
+func (m *CreateWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateWalletRequest) ProtoMessage()    {}
+func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{105}
+}
+
+func (m *CreateWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWalletRequest.Unmarshal(m, b)
+}
+func (m *CreateWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWalletRequest.Merge(m, src)
+}
+func (m *CreateWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateWalletRequest.Size(m)
+}
+func (m *CreateWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWalletRequest proto.InternalMessageInfo
+
+func (m *CreateWalletRequest) GetPublicPassphrase() []byte {
+	if m != nil {
+		return m.PublicPassphrase
+	}
+	return nil
+}
+
+func (m *CreateWalletRequest) GetPrivatePassphrase() []byte {
+	if m != nil {
+		return m.PrivatePassphrase
+	}
+	return nil
+}
+
+func (m *CreateWalletRequest) GetMnemonicSeed() string {
+	if m != nil {
+		return m.MnemonicSeed
+	}
+	return ""
+}
+
+func (m *CreateWalletRequest) GetWalletBirthday() int64 {
+	if m != nil {
+		return m.WalletBirthday
+	}
+	return 0
+}
+
+func (m *CreateWalletRequest) GetBip39Passphrase() string {
+	if m != nil {
+		return m.Bip39Passphrase
+	}
+	return ""
+}
+
+func (m *CreateWalletRequest) GetWordCount() uint32 {
+	if m != nil {
+		return m.WordCount
+	}
+	return 0
+}
+
+type CreateWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateWalletResponse) Reset()         { *m = CreateWalletResponse{} }
+func (m *CreateWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateWalletResponse) ProtoMessage()    {}
+func (*CreateWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{106}
+}
+
+func (m *CreateWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateWalletResponse.Unmarshal(m, b)
+}
+func (m *CreateWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateWalletResponse.Merge(m, src)
+}
+func (m *CreateWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateWalletResponse.Size(m)
+}
+func (m *CreateWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateWalletResponse proto.InternalMessageInfo
+
+type OpenWalletRequest struct {
+	PublicPassphrase     []byte   `protobuf:"bytes,1,opt,name=public_passphrase,json=publicPassphrase,proto3" json:"public_passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenWalletRequest) Reset()         { *m = OpenWalletRequest{} }
+func (m *OpenWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*OpenWalletRequest) ProtoMessage()    {}
+func (*OpenWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{107}
+}
+
+func (m *OpenWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenWalletRequest.Unmarshal(m, b)
+}
+func (m *OpenWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *OpenWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenWalletRequest.Merge(m, src)
+}
+func (m *OpenWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_OpenWalletRequest.Size(m)
+}
+func (m *OpenWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenWalletRequest proto.InternalMessageInfo
+
+func (m *OpenWalletRequest) GetPublicPassphrase() []byte {
+	if m != nil {
+		return m.PublicPassphrase
+	}
+	return nil
+}
+
+type OpenWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenWalletResponse) Reset()         { *m = OpenWalletResponse{} }
+func (m *OpenWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*OpenWalletResponse) ProtoMessage()    {}
+func (*OpenWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{108}
+}
+
+func (m *OpenWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenWalletResponse.Unmarshal(m, b)
+}
+func (m *OpenWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *OpenWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenWalletResponse.Merge(m, src)
+}
+func (m *OpenWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_OpenWalletResponse.Size(m)
+}
+func (m *OpenWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenWalletResponse proto.InternalMessageInfo
+
+type CloseWalletRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseWalletRequest) Reset()         { *m = CloseWalletRequest{} }
+func (m *CloseWalletRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseWalletRequest) ProtoMessage()    {}
+func (*CloseWalletRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{109}
+}
+
+func (m *CloseWalletRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseWalletRequest.Unmarshal(m, b)
+}
+func (m *CloseWalletRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseWalletRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseWalletRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseWalletRequest.Merge(m, src)
+}
+func (m *CloseWalletRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseWalletRequest.Size(m)
+}
+func (m *CloseWalletRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseWalletRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseWalletRequest proto.InternalMessageInfo
+
+type CloseWalletResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseWalletResponse) Reset()         { *m = CloseWalletResponse{} }
+func (m *CloseWalletResponse) String() string { return proto.CompactTextString(m) }
+func (*CloseWalletResponse) ProtoMessage()    {}
+func (*CloseWalletResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{110}
+}
+
+func (m *CloseWalletResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseWalletResponse.Unmarshal(m, b)
+}
+func (m *CloseWalletResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseWalletResponse.Marshal(b, m, deterministic)
+}
+func (m *CloseWalletResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseWalletResponse.Merge(m, src)
+}
+func (m *CloseWalletResponse) XXX_Size() int {
+	return xxx_messageInfo_CloseWalletResponse.Size(m)
+}
+func (m *CloseWalletResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseWalletResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseWalletResponse proto.InternalMessageInfo
+
+type WalletExistsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletExistsRequest) Reset()         { *m = WalletExistsRequest{} }
+func (m *WalletExistsRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletExistsRequest) ProtoMessage()    {}
+func (*WalletExistsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{111}
+}
+
+func (m *WalletExistsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletExistsRequest.Unmarshal(m, b)
+}
+func (m *WalletExistsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletExistsRequest.Marshal(b, m, deterministic)
+}
+func (m *WalletExistsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletExistsRequest.Merge(m, src)
+}
+func (m *WalletExistsRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletExistsRequest.Size(m)
+}
+func (m *WalletExistsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletExistsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletExistsRequest proto.InternalMessageInfo
+
+type WalletExistsResponse struct {
+	Exists               bool     `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletExistsResponse) Reset()         { *m = WalletExistsResponse{} }
+func (m *WalletExistsResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletExistsResponse) ProtoMessage()    {}
+func (*WalletExistsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{112}
+}
+
+func (m *WalletExistsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletExistsResponse.Unmarshal(m, b)
+}
+func (m *WalletExistsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletExistsResponse.Marshal(b, m, deterministic)
+}
+func (m *WalletExistsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletExistsResponse.Merge(m, src)
+}
+func (m *WalletExistsResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletExistsResponse.Size(m)
+}
+func (m *WalletExistsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletExistsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletExistsResponse proto.InternalMessageInfo
+
+func (m *WalletExistsResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type StartConsensusRpcRequest struct {
+	NetworkAddress       string   `protobuf:"bytes,1,opt,name=network_address,json=networkAddress,proto3" json:"network_address,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password             []byte   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Certificate          []byte   `protobuf:"bytes,4,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartConsensusRpcRequest) Reset()         { *m = StartConsensusRpcRequest{} }
+func (m *StartConsensusRpcRequest) String() string { return proto.CompactTextString(m) }
+func (*StartConsensusRpcRequest) ProtoMessage()    {}
+func (*StartConsensusRpcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{113}
+}
+
+func (m *StartConsensusRpcRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartConsensusRpcRequest.Unmarshal(m, b)
+}
+func (m *StartConsensusRpcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartConsensusRpcRequest.Marshal(b, m, deterministic)
+}
+func (m *StartConsensusRpcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartConsensusRpcRequest.Merge(m, src)
+}
+func (m *StartConsensusRpcRequest) XXX_Size() int {
+	return xxx_messageInfo_StartConsensusRpcRequest.Size(m)
+}
+func (m *StartConsensusRpcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartConsensusRpcRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartConsensusRpcRequest proto.InternalMessageInfo
+
+func (m *StartConsensusRpcRequest) GetNetworkAddress() string {
+	if m != nil {
+		return m.NetworkAddress
+	}
+	return ""
+}
+
+func (m *StartConsensusRpcRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *StartConsensusRpcRequest) GetPassword() []byte {
+	if m != nil {
+		return m.Password
+	}
+	return nil
+}
+
+func (m *StartConsensusRpcRequest) GetCertificate() []byte {
+	if m != nil {
+		return m.Certificate
+	}
+	return nil
+}
+
+type StartConsensusRpcResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartConsensusRpcResponse) Reset()         { *m = StartConsensusRpcResponse{} }
+func (m *StartConsensusRpcResponse) String() string { return proto.CompactTextString(m) }
+func (*StartConsensusRpcResponse) ProtoMessage()    {}
+func (*StartConsensusRpcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{114}
+}
+
+func (m *StartConsensusRpcResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartConsensusRpcResponse.Unmarshal(m, b)
+}
+func (m *StartConsensusRpcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartConsensusRpcResponse.Marshal(b, m, deterministic)
+}
+func (m *StartConsensusRpcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartConsensusRpcResponse.Merge(m, src)
+}
+func (m *StartConsensusRpcResponse) XXX_Size() int {
+	return xxx_messageInfo_StartConsensusRpcResponse.Size(m)
+}
+func (m *StartConsensusRpcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartConsensusRpcResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartConsensusRpcResponse proto.InternalMessageInfo
+
+type ValidateAddressRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAddressRequest) Reset()         { *m = ValidateAddressRequest{} }
+func (m *ValidateAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressRequest) ProtoMessage()    {}
+func (*ValidateAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{115}
+}
+
+func (m *ValidateAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressRequest.Unmarshal(m, b)
+}
+func (m *ValidateAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressRequest.Merge(m, src)
+}
+func (m *ValidateAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressRequest.Size(m)
+}
+func (m *ValidateAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressRequest proto.InternalMessageInfo
+
+func (m *ValidateAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type ValidateAddressResponse struct {
+	Valid                bool                                    `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	IsMine               bool                                    `protobuf:"varint,2,opt,name=is_mine,json=isMine,proto3" json:"is_mine,omitempty"`
+	AddressType          string                                  `protobuf:"bytes,3,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	IsScript             bool                                    `protobuf:"varint,4,opt,name=is_script,json=isScript,proto3" json:"is_script,omitempty"`
+	IsChange             bool                                    `protobuf:"varint,5,opt,name=is_change,json=isChange,proto3" json:"is_change,omitempty"`
+	Account              uint32                                  `protobuf:"varint,6,opt,name=account,proto3" json:"account,omitempty"`
+	DerivationPath       *ValidateAddressResponse_DerivationPath `protobuf:"bytes,7,opt,name=derivation_path,json=derivationPath,proto3" json:"derivation_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *ValidateAddressResponse) Reset()         { *m = ValidateAddressResponse{} }
+func (m *ValidateAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressResponse) ProtoMessage()    {}
+func (*ValidateAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{116}
+}
+
+func (m *ValidateAddressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressResponse.Unmarshal(m, b)
+}
+func (m *ValidateAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressResponse.Merge(m, src)
+}
+func (m *ValidateAddressResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressResponse.Size(m)
+}
+func (m *ValidateAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressResponse proto.InternalMessageInfo
+
+func (m *ValidateAddressResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateAddressResponse) GetIsMine() bool {
+	if m != nil {
+		return m.IsMine
+	}
+	return false
+}
+
+func (m *ValidateAddressResponse) GetAddressType() string {
+	if m != nil {
+		return m.AddressType
+	}
+	return ""
+}
+
+func (m *ValidateAddressResponse) GetIsScript() bool {
+	if m != nil {
+		return m.IsScript
+	}
+	return false
+}
+
+func (m *ValidateAddressResponse) GetIsChange() bool {
+	if m != nil {
+		return m.IsChange
+	}
+	return false
+}
+
+func (m *ValidateAddressResponse) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *ValidateAddressResponse) GetDerivationPath() *ValidateAddressResponse_DerivationPath {
+	if m != nil {
+		return m.DerivationPath
+	}
+	return nil
+}
+
+type ValidateAddressResponse_DerivationPath struct {
+	Purpose              uint32   `protobuf:"varint,1,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	CoinType             uint32   `protobuf:"varint,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Account              uint32   `protobuf:"varint,3,opt,name=account,proto3" json:"account,omitempty"`
+	Branch               uint32   `protobuf:"varint,4,opt,name=branch,proto3" json:"branch,omitempty"`
+	Index                uint32   `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAddressResponse_DerivationPath) Reset() {
+	*m = ValidateAddressResponse_DerivationPath{}
+}
+func (m *ValidateAddressResponse_DerivationPath) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressResponse_DerivationPath) ProtoMessage()    {}
+func (*ValidateAddressResponse_DerivationPath) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{116, 0}
+}
+
+func (m *ValidateAddressResponse_DerivationPath) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressResponse_DerivationPath.Unmarshal(m, b)
+}
+func (m *ValidateAddressResponse_DerivationPath) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressResponse_DerivationPath.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressResponse_DerivationPath) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressResponse_DerivationPath.Merge(m, src)
+}
+func (m *ValidateAddressResponse_DerivationPath) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressResponse_DerivationPath.Size(m)
+}
+func (m *ValidateAddressResponse_DerivationPath) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressResponse_DerivationPath.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressResponse_DerivationPath proto.InternalMessageInfo
+
+func (m *ValidateAddressResponse_DerivationPath) GetPurpose() uint32 {
+	if m != nil {
+		return m.Purpose
+	}
+	return 0
+}
+
+func (m *ValidateAddressResponse_DerivationPath) GetCoinType() uint32 {
+	if m != nil {
+		return m.CoinType
+	}
+	return 0
+}
+
+func (m *ValidateAddressResponse_DerivationPath) GetAccount() uint32 {
+	if m != nil {
+		return m.Account
+	}
+	return 0
+}
+
+func (m *ValidateAddressResponse_DerivationPath) GetBranch() uint32 {
+	if m != nil {
+		return m.Branch
+	}
+	return 0
+}
+
+func (m *ValidateAddressResponse_DerivationPath) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ValidateAddressesRequest struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAddressesRequest) Reset()         { *m = ValidateAddressesRequest{} }
+func (m *ValidateAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressesRequest) ProtoMessage()    {}
+func (*ValidateAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{117}
+}
+
+func (m *ValidateAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressesRequest.Unmarshal(m, b)
+}
+func (m *ValidateAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressesRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressesRequest.Merge(m, src)
+}
+func (m *ValidateAddressesRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressesRequest.Size(m)
+}
+func (m *ValidateAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressesRequest proto.InternalMessageInfo
+
+func (m *ValidateAddressesRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type ValidateAddressesResponse struct {
+	Results              []*ValidateAddressesResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *ValidateAddressesResponse) Reset()         { *m = ValidateAddressesResponse{} }
+func (m *ValidateAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressesResponse) ProtoMessage()    {}
+func (*ValidateAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{118}
+}
+
+func (m *ValidateAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressesResponse.Unmarshal(m, b)
+}
+func (m *ValidateAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressesResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressesResponse.Merge(m, src)
+}
+func (m *ValidateAddressesResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressesResponse.Size(m)
+}
+func (m *ValidateAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressesResponse proto.InternalMessageInfo
+
+func (m *ValidateAddressesResponse) GetResults() []*ValidateAddressesResponse_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type ValidateAddressesResponse_Result struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Valid                bool     `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	AddressType          string   `protobuf:"bytes,3,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"`
+	IsMine               bool     `protobuf:"varint,4,opt,name=is_mine,json=isMine,proto3" json:"is_mine,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAddressesResponse_Result) Reset()         { *m = ValidateAddressesResponse_Result{} }
+func (m *ValidateAddressesResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*ValidateAddressesResponse_Result) ProtoMessage()    {}
+func (*ValidateAddressesResponse_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{118, 0}
+}
+
+func (m *ValidateAddressesResponse_Result) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAddressesResponse_Result.Unmarshal(m, b)
+}
+func (m *ValidateAddressesResponse_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAddressesResponse_Result.Marshal(b, m, deterministic)
+}
+func (m *ValidateAddressesResponse_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAddressesResponse_Result.Merge(m, src)
+}
+func (m *ValidateAddressesResponse_Result) XXX_Size() int {
+	return xxx_messageInfo_ValidateAddressesResponse_Result.Size(m)
+}
+func (m *ValidateAddressesResponse_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAddressesResponse_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAddressesResponse_Result proto.InternalMessageInfo
+
+func (m *ValidateAddressesResponse_Result) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidateAddressesResponse_Result) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateAddressesResponse_Result) GetAddressType() string {
+	if m != nil {
+		return m.AddressType
+	}
+	return ""
+}
+
+func (m *ValidateAddressesResponse_Result) GetIsMine() bool {
+	if m != nil {
+		return m.IsMine
+	}
+	return false
+}
+
+type GenerateMnemonicSeedRequest struct {
+	BitSize              uint32   `protobuf:"varint,1,opt,name=bit_size,json=bitSize,proto3" json:"bit_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateMnemonicSeedRequest) Reset()         { *m = GenerateMnemonicSeedRequest{} }
+func (m *GenerateMnemonicSeedRequest) String() string { return proto.CompactTextString(m) }
+func (*GenerateMnemonicSeedRequest) ProtoMessage()    {}
+func (*GenerateMnemonicSeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{119}
+}
+
+func (m *GenerateMnemonicSeedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateMnemonicSeedRequest.Unmarshal(m, b)
+}
+func (m *GenerateMnemonicSeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateMnemonicSeedRequest.Marshal(b, m, deterministic)
+}
+func (m *GenerateMnemonicSeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMnemonicSeedRequest.Merge(m, src)
+}
+func (m *GenerateMnemonicSeedRequest) XXX_Size() int {
+	return xxx_messageInfo_GenerateMnemonicSeedRequest.Size(m)
+}
+func (m *GenerateMnemonicSeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMnemonicSeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMnemonicSeedRequest proto.InternalMessageInfo
+
+func (m *GenerateMnemonicSeedRequest) GetBitSize() uint32 {
+	if m != nil {
+		return m.BitSize
+	}
+	return 0
+}
+
+type GenerateMnemonicSeedResponse struct {
+	Mnemonic             string   `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenerateMnemonicSeedResponse) Reset()         { *m = GenerateMnemonicSeedResponse{} }
+func (m *GenerateMnemonicSeedResponse) String() string { return proto.CompactTextString(m) }
+func (*GenerateMnemonicSeedResponse) ProtoMessage()    {}
+func (*GenerateMnemonicSeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{120}
+}
+
+func (m *GenerateMnemonicSeedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenerateMnemonicSeedResponse.Unmarshal(m, b)
+}
+func (m *GenerateMnemonicSeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenerateMnemonicSeedResponse.Marshal(b, m, deterministic)
+}
+func (m *GenerateMnemonicSeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenerateMnemonicSeedResponse.Merge(m, src)
+}
+func (m *GenerateMnemonicSeedResponse) XXX_Size() int {
+	return xxx_messageInfo_GenerateMnemonicSeedResponse.Size(m)
+}
+func (m *GenerateMnemonicSeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenerateMnemonicSeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenerateMnemonicSeedResponse proto.InternalMessageInfo
+
+func (m *GenerateMnemonicSeedResponse) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+type DownloadPaymentRequestRequest struct {
+	Uri                  string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadPaymentRequestRequest) Reset()         { *m = DownloadPaymentRequestRequest{} }
+func (m *DownloadPaymentRequestRequest) String() string { return proto.CompactTextString(m) }
+func (*DownloadPaymentRequestRequest) ProtoMessage()    {}
+func (*DownloadPaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{121}
+}
+
+func (m *DownloadPaymentRequestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadPaymentRequestRequest.Unmarshal(m, b)
+}
+func (m *DownloadPaymentRequestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadPaymentRequestRequest.Marshal(b, m, deterministic)
+}
+func (m *DownloadPaymentRequestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadPaymentRequestRequest.Merge(m, src)
+}
+func (m *DownloadPaymentRequestRequest) XXX_Size() int {
+	return xxx_messageInfo_DownloadPaymentRequestRequest.Size(m)
+}
+func (m *DownloadPaymentRequestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadPaymentRequestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadPaymentRequestRequest proto.InternalMessageInfo
+
+func (m *DownloadPaymentRequestRequest) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type DownloadPaymentRequestResponse struct {
+	PayToName            string                                   `protobuf:"bytes,1,opt,name=pay_to_name,json=payToName,proto3" json:"pay_to_name,omitempty"`
+	Outputs              []*DownloadPaymentRequestResponse_Output `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	Expires              int64                                    `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	Memo                 string                                   `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	PaymentUrl           string                                   `protobuf:"bytes,5,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	MerchantData         []byte                                   `protobuf:"bytes,6,opt,name=merchant_data,json=merchantData,proto3" json:"merchant_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                 `json:"-"`
+	XXX_unrecognized     []byte                                   `json:"-"`
+	XXX_sizecache        int32                                    `json:"-"`
+}
+
+func (m *DownloadPaymentRequestResponse) Reset()         { *m = DownloadPaymentRequestResponse{} }
+func (m *DownloadPaymentRequestResponse) String() string { return proto.CompactTextString(m) }
+func (*DownloadPaymentRequestResponse) ProtoMessage()    {}
+func (*DownloadPaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{122}
+}
+
+func (m *DownloadPaymentRequestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadPaymentRequestResponse.Unmarshal(m, b)
+}
+func (m *DownloadPaymentRequestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadPaymentRequestResponse.Marshal(b, m, deterministic)
+}
+func (m *DownloadPaymentRequestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadPaymentRequestResponse.Merge(m, src)
+}
+func (m *DownloadPaymentRequestResponse) XXX_Size() int {
+	return xxx_messageInfo_DownloadPaymentRequestResponse.Size(m)
+}
+func (m *DownloadPaymentRequestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadPaymentRequestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadPaymentRequestResponse proto.InternalMessageInfo
+
+func (m *DownloadPaymentRequestResponse) GetPayToName() string {
+	if m != nil {
+		return m.PayToName
+	}
+	return ""
+}
+
+func (m *DownloadPaymentRequestResponse) GetOutputs() []*DownloadPaymentRequestResponse_Output {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+func (m *DownloadPaymentRequestResponse) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+func (m *DownloadPaymentRequestResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *DownloadPaymentRequestResponse) GetPaymentUrl() string {
+	if m != nil {
+		return m.PaymentUrl
+	}
+	return ""
+}
+
+func (m *DownloadPaymentRequestResponse) GetMerchantData() []byte {
+	if m != nil {
+		return m.MerchantData
+	}
+	return nil
+}
+
+type DownloadPaymentRequestResponse_Output struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DownloadPaymentRequestResponse_Output) Reset()         { *m = DownloadPaymentRequestResponse_Output{} }
+func (m *DownloadPaymentRequestResponse_Output) String() string { return proto.CompactTextString(m) }
+func (*DownloadPaymentRequestResponse_Output) ProtoMessage()    {}
+func (*DownloadPaymentRequestResponse_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{122, 0}
+}
+
+func (m *DownloadPaymentRequestResponse_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DownloadPaymentRequestResponse_Output.Unmarshal(m, b)
+}
+func (m *DownloadPaymentRequestResponse_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DownloadPaymentRequestResponse_Output.Marshal(b, m, deterministic)
+}
+func (m *DownloadPaymentRequestResponse_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DownloadPaymentRequestResponse_Output.Merge(m, src)
+}
+func (m *DownloadPaymentRequestResponse_Output) XXX_Size() int {
+	return xxx_messageInfo_DownloadPaymentRequestResponse_Output.Size(m)
+}
+func (m *DownloadPaymentRequestResponse_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_DownloadPaymentRequestResponse_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DownloadPaymentRequestResponse_Output proto.InternalMessageInfo
+
+func (m *DownloadPaymentRequestResponse_Output) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DownloadPaymentRequestResponse_Output) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type PostPaymentRequest struct {
+	PaymentUrl           string                     `protobuf:"bytes,1,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	MerchantData         []byte                     `protobuf:"bytes,2,opt,name=merchant_data,json=merchantData,proto3" json:"merchant_data,omitempty"`
+	Transactions         [][]byte                   `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	RefundOutput         *PostPaymentRequest_Output `protobuf:"bytes,4,opt,name=refund_output,json=refundOutput,proto3" json:"refund_output,omitempty"`
+	Memo                 string                     `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *PostPaymentRequest) Reset()         { *m = PostPaymentRequest{} }
+func (m *PostPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*PostPaymentRequest) ProtoMessage()    {}
+func (*PostPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{123}
+}
+
+func (m *PostPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostPaymentRequest.Unmarshal(m, b)
+}
+func (m *PostPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostPaymentRequest.Marshal(b, m, deterministic)
+}
+func (m *PostPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostPaymentRequest.Merge(m, src)
+}
+func (m *PostPaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_PostPaymentRequest.Size(m)
+}
+func (m *PostPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostPaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostPaymentRequest proto.InternalMessageInfo
+
+func (m *PostPaymentRequest) GetPaymentUrl() string {
+	if m != nil {
+		return m.PaymentUrl
+	}
+	return ""
+}
+
+func (m *PostPaymentRequest) GetMerchantData() []byte {
+	if m != nil {
+		return m.MerchantData
+	}
+	return nil
+}
+
+func (m *PostPaymentRequest) GetTransactions() [][]byte {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *PostPaymentRequest) GetRefundOutput() *PostPaymentRequest_Output {
+	if m != nil {
+		return m.RefundOutput
+	}
+	return nil
+}
+
+func (m *PostPaymentRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+type PostPaymentRequest_Output struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostPaymentRequest_Output) Reset()         { *m = PostPaymentRequest_Output{} }
+func (m *PostPaymentRequest_Output) String() string { return proto.CompactTextString(m) }
+func (*PostPaymentRequest_Output) ProtoMessage()    {}
+func (*PostPaymentRequest_Output) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{123, 0}
+}
+
+func (m *PostPaymentRequest_Output) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostPaymentRequest_Output.Unmarshal(m, b)
+}
+func (m *PostPaymentRequest_Output) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostPaymentRequest_Output.Marshal(b, m, deterministic)
+}
+func (m *PostPaymentRequest_Output) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostPaymentRequest_Output.Merge(m, src)
+}
+func (m *PostPaymentRequest_Output) XXX_Size() int {
+	return xxx_messageInfo_PostPaymentRequest_Output.Size(m)
+}
+func (m *PostPaymentRequest_Output) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostPaymentRequest_Output.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostPaymentRequest_Output proto.InternalMessageInfo
+
+func (m *PostPaymentRequest_Output) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PostPaymentRequest_Output) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type PostPaymentResponse struct {
+	Memo                 string   `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PostPaymentResponse) Reset()         { *m = PostPaymentResponse{} }
+func (m *PostPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*PostPaymentResponse) ProtoMessage()    {}
+func (*PostPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{124}
+}
+
+func (m *PostPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PostPaymentResponse.Unmarshal(m, b)
+}
+func (m *PostPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PostPaymentResponse.Marshal(b, m, deterministic)
+}
+func (m *PostPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostPaymentResponse.Merge(m, src)
+}
+func (m *PostPaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_PostPaymentResponse.Size(m)
+}
+func (m *PostPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostPaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostPaymentResponse proto.InternalMessageInfo
+
+func (m *PostPaymentResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("walletrpc.NextAddressRequest_Kind", NextAddressRequest_Kind_name, NextAddressRequest_Kind_value)
+	proto.RegisterEnum("walletrpc.ChangePassphraseRequest_Key", ChangePassphraseRequest_Key_name, ChangePassphraseRequest_Key_value)
+	proto.RegisterType((*VersionRequest)(nil), "walletrpc.VersionRequest")
+	proto.RegisterType((*VersionResponse)(nil), "walletrpc.VersionResponse")
+	proto.RegisterType((*TransactionDetails)(nil), "walletrpc.TransactionDetails")
+	proto.RegisterType((*TransactionDetails_Input)(nil), "walletrpc.TransactionDetails.Input")
+	proto.RegisterType((*TransactionDetails_Output)(nil), "walletrpc.TransactionDetails.Output")
+	proto.RegisterType((*BlockDetails)(nil), "walletrpc.BlockDetails")
+	proto.RegisterType((*AccountBalance)(nil), "walletrpc.AccountBalance")
+	proto.RegisterType((*PingRequest)(nil), "walletrpc.PingRequest")
+	proto.RegisterType((*PingResponse)(nil), "walletrpc.PingResponse")
+	proto.RegisterType((*NetworkRequest)(nil), "walletrpc.NetworkRequest")
+	proto.RegisterType((*NetworkResponse)(nil), "walletrpc.NetworkResponse")
+	proto.RegisterType((*AccountNumberRequest)(nil), "walletrpc.AccountNumberRequest")
+	proto.RegisterType((*AccountNumberResponse)(nil), "walletrpc.AccountNumberResponse")
+	proto.RegisterType((*AccountsRequest)(nil), "walletrpc.AccountsRequest")
+	proto.RegisterType((*AccountsResponse)(nil), "walletrpc.AccountsResponse")
+	proto.RegisterType((*AccountsResponse_Account)(nil), "walletrpc.AccountsResponse.Account")
+	proto.RegisterType((*RenameAccountRequest)(nil), "walletrpc.RenameAccountRequest")
+	proto.RegisterType((*RenameAccountResponse)(nil), "walletrpc.RenameAccountResponse")
+	proto.RegisterType((*NextAccountRequest)(nil), "walletrpc.NextAccountRequest")
+	proto.RegisterType((*NextAccountResponse)(nil), "walletrpc.NextAccountResponse")
+	proto.RegisterType((*DiscoverAccountsRequest)(nil), "walletrpc.DiscoverAccountsRequest")
+	proto.RegisterType((*DiscoverAccountsResponse)(nil), "walletrpc.DiscoverAccountsResponse")
+	proto.RegisterType((*NextAddressRequest)(nil), "walletrpc.NextAddressRequest")
+	proto.RegisterType((*NextAddressResponse)(nil), "walletrpc.NextAddressResponse")
+	proto.RegisterType((*NextAddressesRequest)(nil), "walletrpc.NextAddressesRequest")
+	proto.RegisterType((*NextAddressesResponse)(nil), "walletrpc.NextAddressesResponse")
+	proto.RegisterType((*ImportPrivateKeyRequest)(nil), "walletrpc.ImportPrivateKeyRequest")
+	proto.RegisterType((*ImportPrivateKeyResponse)(nil), "walletrpc.ImportPrivateKeyResponse")
+	proto.RegisterType((*DumpPrivateKeyRequest)(nil), "walletrpc.DumpPrivateKeyRequest")
+	proto.RegisterType((*DumpPrivateKeyResponse)(nil), "walletrpc.DumpPrivateKeyResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "walletrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "walletrpc.SignMessageResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "walletrpc.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "walletrpc.VerifyMessageResponse")
+	proto.RegisterType((*CreateMultisigAddressRequest)(nil), "walletrpc.CreateMultisigAddressRequest")
+	proto.RegisterType((*CreateMultisigAddressResponse)(nil), "walletrpc.CreateMultisigAddressResponse")
+	proto.RegisterType((*ImportPrunedFundsRequest)(nil), "walletrpc.ImportPrunedFundsRequest")
+	proto.RegisterType((*ImportPrunedFundsResponse)(nil), "walletrpc.ImportPrunedFundsResponse")
+	proto.RegisterType((*BalanceRequest)(nil), "walletrpc.BalanceRequest")
+	proto.RegisterType((*BalanceResponse)(nil), "walletrpc.BalanceResponse")
+	proto.RegisterType((*AddressBalanceRequest)(nil), "walletrpc.AddressBalanceRequest")
+	proto.RegisterType((*AddressBalanceResponse)(nil), "walletrpc.AddressBalanceResponse")
+	proto.RegisterType((*CurrentAddressRequest)(nil), "walletrpc.CurrentAddressRequest")
+	proto.RegisterType((*CurrentAddressResponse)(nil), "walletrpc.CurrentAddressResponse")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "walletrpc.GetTransactionsRequest")
+	proto.RegisterType((*GetTransactionsResponse)(nil), "walletrpc.GetTransactionsResponse")
+	proto.RegisterType((*TotalFeesPaidRequest)(nil), "walletrpc.TotalFeesPaidRequest")
+	proto.RegisterType((*TotalFeesPaidResponse)(nil), "walletrpc.TotalFeesPaidResponse")
+	proto.RegisterType((*MempoolStatusRequest)(nil), "walletrpc.MempoolStatusRequest")
+	proto.RegisterType((*MempoolStatusResponse)(nil), "walletrpc.MempoolStatusResponse")
+	proto.RegisterType((*AddressUsageRequest)(nil), "walletrpc.AddressUsageRequest")
+	proto.RegisterType((*AddressUsageResponse)(nil), "walletrpc.AddressUsageResponse")
+	proto.RegisterType((*AddressUsageResponse_Account)(nil), "walletrpc.AddressUsageResponse.Account")
+	proto.RegisterType((*TotalReceivedByAddressRequest)(nil), "walletrpc.TotalReceivedByAddressRequest")
+	proto.RegisterType((*TotalReceivedByAddressResponse)(nil), "walletrpc.TotalReceivedByAddressResponse")
+	proto.RegisterType((*TotalReceivedByAccountRequest)(nil), "walletrpc.TotalReceivedByAccountRequest")
+	proto.RegisterType((*TotalReceivedByAccountResponse)(nil), "walletrpc.TotalReceivedByAccountResponse")
+	proto.RegisterType((*ImmatureCoinbaseOutputsRequest)(nil), "walletrpc.ImmatureCoinbaseOutputsRequest")
+	proto.RegisterType((*ImmatureCoinbaseOutputsResponse)(nil), "walletrpc.ImmatureCoinbaseOutputsResponse")
+	proto.RegisterType((*ImmatureCoinbaseOutputsResponse_Output)(nil), "walletrpc.ImmatureCoinbaseOutputsResponse.Output")
+	proto.RegisterType((*ChangePassphraseRequest)(nil), "walletrpc.ChangePassphraseRequest")
+	proto.RegisterType((*ChangePassphraseResponse)(nil), "walletrpc.ChangePassphraseResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "walletrpc.UnlockWalletRequest")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "walletrpc.UnlockWalletResponse")
+	proto.RegisterType((*LockWalletRequest)(nil), "walletrpc.LockWalletRequest")
+	proto.RegisterType((*LockWalletResponse)(nil), "walletrpc.LockWalletResponse")
+	proto.RegisterType((*UnlockStateRequest)(nil), "walletrpc.UnlockStateRequest")
+	proto.RegisterType((*UnlockStateResponse)(nil), "walletrpc.UnlockStateResponse")
+	proto.RegisterType((*WalletInfoRequest)(nil), "walletrpc.WalletInfoRequest")
+	proto.RegisterType((*WalletInfoResponse)(nil), "walletrpc.WalletInfoResponse")
+	proto.RegisterType((*GetAccountAddressesRequest)(nil), "walletrpc.GetAccountAddressesRequest")
+	proto.RegisterType((*GetAccountAddressesResponse)(nil), "walletrpc.GetAccountAddressesResponse")
+	proto.RegisterType((*GetAccountAddressesResponse_Address)(nil), "walletrpc.GetAccountAddressesResponse.Address")
+	proto.RegisterType((*GetAccountExtendedPubKeyRequest)(nil), "walletrpc.GetAccountExtendedPubKeyRequest")
+	proto.RegisterType((*GetAccountExtendedPubKeyResponse)(nil), "walletrpc.GetAccountExtendedPubKeyResponse")
+	proto.RegisterType((*FundTransactionRequest)(nil), "walletrpc.FundTransactionRequest")
+	proto.RegisterType((*FundTransactionResponse)(nil), "walletrpc.FundTransactionResponse")
+	proto.RegisterType((*FundTransactionResponse_PreviousOutput)(nil), "walletrpc.FundTransactionResponse.PreviousOutput")
+	proto.RegisterType((*LockedOutputsRequest)(nil), "walletrpc.LockedOutputsRequest")
+	proto.RegisterType((*LockedOutputsResponse)(nil), "walletrpc.LockedOutputsResponse")
+	proto.RegisterType((*LockedOutputsResponse_Output)(nil), "walletrpc.LockedOutputsResponse.Output")
+	proto.RegisterType((*UnlockOutputRequest)(nil), "walletrpc.UnlockOutputRequest")
+	proto.RegisterType((*UnlockOutputResponse)(nil), "walletrpc.UnlockOutputResponse")
+	proto.RegisterType((*CreateTransactionRequest)(nil), "walletrpc.CreateTransactionRequest")
+	proto.RegisterType((*CreateTransactionRequest_Output)(nil), "walletrpc.CreateTransactionRequest.Output")
+	proto.RegisterType((*CreateTransactionRequest_OutPoint)(nil), "walletrpc.CreateTransactionRequest.OutPoint")
+	proto.RegisterType((*CreateTransactionResponse)(nil), "walletrpc.CreateTransactionResponse")
+	proto.RegisterType((*SweepAccountRequest)(nil), "walletrpc.SweepAccountRequest")
+	proto.RegisterType((*SweepAccountResponse)(nil), "walletrpc.SweepAccountResponse")
+	proto.RegisterType((*SignTransactionRequest)(nil), "walletrpc.SignTransactionRequest")
+	proto.RegisterType((*SignTransactionResponse)(nil), "walletrpc.SignTransactionResponse")
+	proto.RegisterType((*PublishTransactionRequest)(nil), "walletrpc.PublishTransactionRequest")
+	proto.RegisterType((*PublishTransactionResponse)(nil), "walletrpc.PublishTransactionResponse")
+	proto.RegisterType((*RemoveTransactionRequest)(nil), "walletrpc.RemoveTransactionRequest")
+	proto.RegisterType((*RemoveTransactionResponse)(nil), "walletrpc.RemoveTransactionResponse")
+	proto.RegisterType((*SetTransactionLabelRequest)(nil), "walletrpc.SetTransactionLabelRequest")
+	proto.RegisterType((*SetTransactionLabelResponse)(nil), "walletrpc.SetTransactionLabelResponse")
+	proto.RegisterType((*GetTransactionLabelRequest)(nil), "walletrpc.GetTransactionLabelRequest")
+	proto.RegisterType((*GetTransactionLabelResponse)(nil), "walletrpc.GetTransactionLabelResponse")
+	proto.RegisterType((*SetAddressLabelRequest)(nil), "walletrpc.SetAddressLabelRequest")
+	proto.RegisterType((*SetAddressLabelResponse)(nil), "walletrpc.SetAddressLabelResponse")
+	proto.RegisterType((*GetAddressLabelRequest)(nil), "walletrpc.GetAddressLabelRequest")
+	proto.RegisterType((*GetAddressLabelResponse)(nil), "walletrpc.GetAddressLabelResponse")
+	proto.RegisterType((*RescanRequest)(nil), "walletrpc.RescanRequest")
+	proto.RegisterType((*RescanResponse)(nil), "walletrpc.RescanResponse")
+	proto.RegisterType((*TransactionNotificationsRequest)(nil), "walletrpc.TransactionNotificationsRequest")
+	proto.RegisterType((*TransactionNotificationsResponse)(nil), "walletrpc.TransactionNotificationsResponse")
+	proto.RegisterType((*SpentnessNotificationsRequest)(nil), "walletrpc.SpentnessNotificationsRequest")
+	proto.RegisterType((*SpentnessNotificationsResponse)(nil), "walletrpc.SpentnessNotificationsResponse")
+	proto.RegisterType((*SpentnessNotificationsResponse_Spender)(nil), "walletrpc.SpentnessNotificationsResponse.Spender")
+	proto.RegisterType((*AccountNotificationsRequest)(nil), "walletrpc.AccountNotificationsRequest")
+	proto.RegisterType((*AccountNotificationsResponse)(nil), "walletrpc.AccountNotificationsResponse")
+	proto.RegisterType((*RescanNotificationsRequest)(nil), "walletrpc.RescanNotificationsRequest")
+	proto.RegisterType((*RescanNotificationsResponse)(nil), "walletrpc.RescanNotificationsResponse")
+	proto.RegisterType((*AddressPaymentNotificationsRequest)(nil), "walletrpc.AddressPaymentNotificationsRequest")
+	proto.RegisterType((*AddressPaymentNotificationsResponse)(nil), "walletrpc.AddressPaymentNotificationsResponse")
+	proto.RegisterType((*CreateWalletRequest)(nil), "walletrpc.CreateWalletRequest")
+	proto.RegisterType((*CreateWalletResponse)(nil), "walletrpc.CreateWalletResponse")
+	proto.RegisterType((*OpenWalletRequest)(nil), "walletrpc.OpenWalletRequest")
+	proto.RegisterType((*OpenWalletResponse)(nil), "walletrpc.OpenWalletResponse")
+	proto.RegisterType((*CloseWalletRequest)(nil), "walletrpc.CloseWalletRequest")
+	proto.RegisterType((*CloseWalletResponse)(nil), "walletrpc.CloseWalletResponse")
+	proto.RegisterType((*WalletExistsRequest)(nil), "walletrpc.WalletExistsRequest")
+	proto.RegisterType((*WalletExistsResponse)(nil), "walletrpc.WalletExistsResponse")
+	proto.RegisterType((*StartConsensusRpcRequest)(nil), "walletrpc.StartConsensusRpcRequest")
+	proto.RegisterType((*StartConsensusRpcResponse)(nil), "walletrpc.StartConsensusRpcResponse")
+	proto.RegisterType((*ValidateAddressRequest)(nil), "walletrpc.ValidateAddressRequest")
+	proto.RegisterType((*ValidateAddressResponse)(nil), "walletrpc.ValidateAddressResponse")
+	proto.RegisterType((*ValidateAddressResponse_DerivationPath)(nil), "walletrpc.ValidateAddressResponse.DerivationPath")
+	proto.RegisterType((*ValidateAddressesRequest)(nil), "walletrpc.ValidateAddressesRequest")
+	proto.RegisterType((*ValidateAddressesResponse)(nil), "walletrpc.ValidateAddressesResponse")
+	proto.RegisterType((*ValidateAddressesResponse_Result)(nil), "walletrpc.ValidateAddressesResponse.Result")
+	proto.RegisterType((*GenerateMnemonicSeedRequest)(nil), "walletrpc.GenerateMnemonicSeedRequest")
+	proto.RegisterType((*GenerateMnemonicSeedResponse)(nil), "walletrpc.GenerateMnemonicSeedResponse")
+	proto.RegisterType((*DownloadPaymentRequestRequest)(nil), "walletrpc.DownloadPaymentRequestRequest")
+	proto.RegisterType((*DownloadPaymentRequestResponse)(nil), "walletrpc.DownloadPaymentRequestResponse")
+	proto.RegisterType((*DownloadPaymentRequestResponse_Output)(nil), "walletrpc.DownloadPaymentRequestResponse.Output")
+	proto.RegisterType((*PostPaymentRequest)(nil), "walletrpc.PostPaymentRequest")
+	proto.RegisterType((*PostPaymentRequest_Output)(nil), "walletrpc.PostPaymentRequest.Output")
+	proto.RegisterType((*PostPaymentResponse)(nil), "walletrpc.PostPaymentResponse")
+}
+
+func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
+
+var fileDescriptor_00212fb1f9d3bf1c = []byte{
+	// 5523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd5, 0x3c, 0x4d, 0x8f, 0x24, 0x47,
+	0x56, 0x5b, 0x55, 0xfd, 0xf9, 0xba, 0xbb, 0xba, 0x3b, 0xfb, 0x63, 0xba, 0x6b, 0x3e, 0x9d, 0xfe,
+	0xb6, 0xd7, 0xed, 0x71, 0xdb, 0x2c, 0x5e, 0xb3, 0x18, 0xcf, 0xf4, 0x7c, 0xb8, 0x77, 0x7a, 0x66,
+	0x9a, 0xec, 0x1e, 0xdb, 0x62, 0xc1, 0xa9, 0xec, 0xaa, 0xe8, 0xee, 0xdc, 0xae, 0xca, 0x2a, 0x67,
+	0x56, 0xcd, 0xb8, 0x17, 0x69, 0x85, 0x56, 0x02, 0x69, 0x57, 0x42, 0x8b, 0x40, 0x42, 0x2c, 0x68,
+	0x2f, 0xc0, 0x81, 0x0b, 0x5c, 0x10, 0x82, 0x03, 0x17, 0xae, 0x5c, 0x40, 0x48, 0x20, 0x24, 0x0e,
+	0xfc, 0x07, 0xb8, 0x70, 0xe4, 0x45, 0xc4, 0x8b, 0xcc, 0x88, 0xcc, 0xc8, 0xaa, 0x1a, 0x7b, 0x6c,
+	0xc4, 0xad, 0xf2, 0xc5, 0xd7, 0x8b, 0x17, 0xf1, 0xbe, 0x5f, 0x14, 0xcc, 0x06, 0xbd, 0x70, 0xab,
+	0x17, 0x77, 0xfb, 0x5d, 0x67, 0xf6, 0x49, 0xd0, 0x6e, 0xb3, 0x7e, 0xdc, 0x6b, 0xba, 0x4b, 0x50,
+	0xff, 0x88, 0xc5, 0x49, 0xd8, 0x8d, 0x3c, 0xf6, 0xd9, 0x80, 0x25, 0x7d, 0xf7, 0x1f, 0x2a, 0xb0,
+	0x98, 0x82, 0x92, 0x5e, 0x37, 0x4a, 0x98, 0xf3, 0x22, 0xd4, 0x1f, 0x4b, 0x90, 0x9f, 0xf4, 0xe3,
+	0x30, 0x3a, 0xd9, 0xa8, 0x5c, 0xab, 0xbc, 0x32, 0xeb, 0x2d, 0x10, 0xf4, 0x40, 0x00, 0x9d, 0x55,
+	0x98, 0xec, 0x04, 0xdf, 0xef, 0xc6, 0x1b, 0x55, 0x6c, 0x5d, 0xf0, 0xe4, 0x87, 0x80, 0x86, 0x11,
+	0x42, 0x6b, 0x04, 0xe5, 0x1f, 0x1c, 0xda, 0x0b, 0xfa, 0xcd, 0xd3, 0x8d, 0x09, 0x09, 0x15, 0x1f,
+	0xce, 0x15, 0x80, 0x5e, 0xcc, 0x62, 0xd6, 0x66, 0x41, 0xc2, 0x36, 0x26, 0xc5, 0x22, 0x1a, 0x84,
+	0x23, 0x72, 0x34, 0x08, 0xdb, 0x2d, 0xbf, 0xc3, 0xfa, 0x41, 0x2b, 0xe8, 0x07, 0x1b, 0x53, 0x12,
+	0x11, 0x01, 0xbd, 0x4f, 0x40, 0xf7, 0xc7, 0x13, 0xe0, 0x1c, 0xc6, 0x41, 0x94, 0x04, 0xcd, 0x3e,
+	0xa2, 0x77, 0x0b, 0xe1, 0x61, 0x3b, 0x71, 0x1c, 0x98, 0x38, 0x0d, 0x92, 0x53, 0x81, 0xfc, 0xbc,
+	0x27, 0x7e, 0x3b, 0xd7, 0x60, 0xae, 0x9f, 0xf5, 0x14, 0x98, 0xcf, 0x7b, 0x3a, 0xc8, 0xf9, 0x25,
+	0x98, 0x6a, 0xb1, 0xa3, 0xb0, 0x9f, 0xe0, 0x06, 0x6a, 0xaf, 0xcc, 0x6d, 0x3f, 0xbf, 0x95, 0x92,
+	0x6f, 0xab, 0xb8, 0xc8, 0xd6, 0x6e, 0xd4, 0x1b, 0xf4, 0x3d, 0x1a, 0xe2, 0xbc, 0x0f, 0xd3, 0xcd,
+	0x98, 0xb5, 0xf8, 0xe8, 0x09, 0x31, 0xfa, 0x85, 0xe1, 0xa3, 0x1f, 0x0e, 0xfa, 0x7c, 0xb8, 0x1a,
+	0xe4, 0x2c, 0x41, 0xed, 0x98, 0x49, 0x4a, 0xd4, 0x3c, 0xfe, 0xd3, 0xb9, 0x04, 0xb3, 0xfd, 0xb0,
+	0x83, 0x27, 0x15, 0x74, 0x7a, 0x62, 0xf7, 0x35, 0x2f, 0x03, 0x70, 0xb2, 0xb6, 0x83, 0x23, 0xd6,
+	0xde, 0x98, 0x16, 0x74, 0x91, 0x1f, 0x8d, 0xcf, 0x60, 0x52, 0xa0, 0xc5, 0x9b, 0xc3, 0xa8, 0xc5,
+	0x3e, 0x17, 0x24, 0x40, 0xaa, 0x8b, 0x0f, 0xe7, 0x55, 0x58, 0x42, 0x1a, 0x3f, 0x0e, 0xbb, 0x83,
+	0xc4, 0x0f, 0x9a, 0xcd, 0xee, 0x20, 0xea, 0xd3, 0x11, 0x2e, 0x2a, 0xf8, 0x0d, 0x09, 0x76, 0x5e,
+	0x86, 0xc5, 0xac, 0x6b, 0x47, 0xf4, 0xac, 0x09, 0x1c, 0xea, 0x69, 0x4f, 0x01, 0x6d, 0xfc, 0x4e,
+	0x05, 0xa6, 0xe4, 0x66, 0x4a, 0x16, 0xdd, 0x80, 0x69, 0x73, 0x2d, 0xf5, 0xe9, 0x34, 0x60, 0x26,
+	0x8c, 0xfa, 0x2c, 0x8e, 0x82, 0xb6, 0x98, 0x7c, 0xc6, 0x4b, 0xbf, 0xc5, 0xa8, 0x56, 0x2b, 0x66,
+	0x49, 0x22, 0x2e, 0xce, 0xac, 0xa7, 0x3e, 0x9d, 0x75, 0x98, 0x22, 0x84, 0x24, 0xb1, 0xe8, 0xcb,
+	0xfd, 0x93, 0x0a, 0xcc, 0xdf, 0x6c, 0x77, 0x9b, 0x67, 0xc3, 0x6e, 0x01, 0x0e, 0x3e, 0x65, 0xe1,
+	0xc9, 0xa9, 0xc4, 0x65, 0xd2, 0xa3, 0x2f, 0x93, 0xd8, 0xb5, 0x3c, 0xb1, 0x6f, 0xc0, 0xbc, 0x76,
+	0x51, 0xd4, 0x09, 0x5f, 0x1e, 0x7a, 0xc2, 0x9e, 0x31, 0xc4, 0x7d, 0x08, 0x75, 0x22, 0xed, 0xcd,
+	0xa0, 0x1d, 0x44, 0x4d, 0xa6, 0xd3, 0xa5, 0x62, 0xd2, 0xe5, 0x79, 0x58, 0xe8, 0x77, 0xfb, 0x41,
+	0xdb, 0x3f, 0x92, 0x5d, 0x05, 0xae, 0x35, 0x9c, 0x90, 0x03, 0x69, 0xb8, 0xbb, 0x00, 0x73, 0xfb,
+	0xc8, 0x8b, 0x8a, 0x9b, 0xeb, 0x30, 0x2f, 0x3f, 0x25, 0x27, 0x73, 0x7e, 0x7f, 0xc0, 0xfa, 0x4f,
+	0xba, 0xf1, 0x99, 0xea, 0xf1, 0x2f, 0xc8, 0xef, 0x29, 0x28, 0xe3, 0x77, 0x8e, 0xe0, 0x63, 0xe6,
+	0x47, 0xb2, 0x85, 0x50, 0x59, 0x90, 0x50, 0xea, 0xee, 0x5c, 0x06, 0x38, 0xc2, 0x29, 0xfc, 0x23,
+	0x4e, 0x5e, 0x81, 0xcd, 0xac, 0x37, 0xcb, 0x21, 0x82, 0xde, 0xce, 0x55, 0x98, 0x13, 0xcd, 0x44,
+	0xd9, 0x9a, 0xa0, 0xac, 0x18, 0xf1, 0xa1, 0xa4, 0xee, 0x45, 0x98, 0x4d, 0xce, 0x11, 0xe9, 0x96,
+	0xdf, 0xef, 0x8a, 0xe3, 0x9c, 0xf4, 0x66, 0x24, 0xe0, 0xb0, 0xcb, 0x8f, 0x44, 0xfe, 0x16, 0xe7,
+	0x39, 0xe3, 0xd1, 0x17, 0xa7, 0x02, 0xff, 0xe5, 0xa3, 0x28, 0x3b, 0x11, 0xf7, 0x80, 0xf3, 0x40,
+	0xd5, 0x9b, 0xe7, 0xc0, 0x7d, 0x82, 0xb9, 0xdf, 0x86, 0x55, 0x22, 0xeb, 0x83, 0x41, 0xe7, 0x88,
+	0xc5, 0xb4, 0x59, 0xe7, 0x39, 0x98, 0x27, 0x6a, 0xfa, 0x51, 0xd0, 0x61, 0x24, 0xc6, 0xe6, 0x08,
+	0xf6, 0x00, 0x41, 0xee, 0xfb, 0xb0, 0x96, 0x1b, 0xaa, 0x13, 0x85, 0xc6, 0x8a, 0x96, 0x8c, 0x28,
+	0x5a, 0x77, 0x77, 0x19, 0x16, 0x69, 0x7c, 0xa2, 0x48, 0xfc, 0x77, 0x35, 0x58, 0xca, 0x60, 0x34,
+	0xdd, 0xaf, 0xc0, 0x0c, 0x0d, 0x4c, 0x70, 0xa2, 0xbc, 0x60, 0xc9, 0x77, 0x57, 0x00, 0x2f, 0x1d,
+	0xe4, 0x7c, 0x13, 0x9c, 0xe6, 0x20, 0x8e, 0x59, 0x44, 0x07, 0xe0, 0x8b, 0x5b, 0x2d, 0x05, 0xd8,
+	0x12, 0xb5, 0x88, 0x83, 0xf8, 0x90, 0xdf, 0xf0, 0xeb, 0xb0, 0x9a, 0xeb, 0xad, 0x9f, 0x8a, 0x63,
+	0xf4, 0x17, 0x2d, 0x8d, 0x1f, 0x55, 0x61, 0x5a, 0xb1, 0xfd, 0x78, 0x7b, 0x2f, 0x90, 0xb7, 0x5a,
+	0x20, 0x6f, 0xf1, 0x12, 0xd7, 0x8a, 0x97, 0x98, 0x6f, 0x8d, 0x7d, 0x2e, 0x39, 0xde, 0x3f, 0x63,
+	0xe7, 0xbe, 0x64, 0x07, 0xa9, 0x29, 0x96, 0x54, 0xcb, 0x3d, 0x76, 0xbe, 0x23, 0x90, 0xc3, 0xde,
+	0x4a, 0x3e, 0x68, 0xbd, 0x27, 0x65, 0x6f, 0xd5, 0x62, 0xf4, 0xee, 0xf4, 0xba, 0x71, 0x1f, 0xaf,
+	0x5d, 0xd6, 0x7b, 0x8a, 0x7a, 0x53, 0x8b, 0xea, 0xed, 0x7e, 0x02, 0xab, 0x1e, 0xe3, 0x7b, 0x51,
+	0xf4, 0xa7, 0x8b, 0x34, 0x26, 0x41, 0x36, 0x61, 0x26, 0x62, 0x4f, 0x74, 0x62, 0x4c, 0xe3, 0xb7,
+	0xb8, 0x67, 0x17, 0x60, 0x2d, 0x37, 0x33, 0xb1, 0xe8, 0xc7, 0xe0, 0x3c, 0xc0, 0x3d, 0xe6, 0x16,
+	0xe4, 0x9a, 0x31, 0x48, 0x92, 0xde, 0x69, 0xcc, 0x35, 0xa3, 0x94, 0x5d, 0x1a, 0x64, 0x0c, 0xd2,
+	0xbb, 0xdf, 0x81, 0x15, 0x63, 0xe2, 0xa7, 0xbb, 0xd7, 0x3f, 0xaa, 0xc0, 0x85, 0x5b, 0x61, 0xd2,
+	0xec, 0xa2, 0xce, 0xcf, 0x5d, 0xf0, 0x91, 0xc8, 0x21, 0xa3, 0x9f, 0x04, 0x3d, 0xbf, 0x1d, 0x76,
+	0x42, 0x25, 0xed, 0x67, 0x10, 0xb0, 0xc7, 0xbf, 0x9d, 0xd7, 0x60, 0x39, 0x69, 0x06, 0x91, 0x7f,
+	0x1c, 0x77, 0x3b, 0xfe, 0x09, 0x8b, 0x58, 0x12, 0x26, 0x24, 0xf7, 0x17, 0x79, 0xc3, 0x1d, 0x84,
+	0xdf, 0x95, 0x60, 0xf7, 0x1e, 0x6c, 0x14, 0x71, 0xa0, 0x7d, 0xbc, 0x09, 0x2b, 0x2d, 0x6a, 0xc3,
+	0xa3, 0xd5, 0x78, 0x8b, 0x2f, 0xe7, 0x64, 0x4d, 0x6a, 0xa0, 0xfb, 0xc7, 0x15, 0xa2, 0xb4, 0xd4,
+	0x20, 0x6a, 0x33, 0xe5, 0x02, 0xf8, 0x5b, 0x30, 0x71, 0x86, 0xca, 0x4b, 0xec, 0xa0, 0xbe, 0xed,
+	0x6a, 0xec, 0x5a, 0x9c, 0x66, 0xeb, 0x1e, 0xf6, 0xf4, 0x44, 0x7f, 0x77, 0x1b, 0x26, 0xf8, 0x17,
+	0x2a, 0xc2, 0xa5, 0x9b, 0xbb, 0xfb, 0xd7, 0xaf, 0xbf, 0xf3, 0x8e, 0x7f, 0xfb, 0x93, 0xc3, 0xdb,
+	0xde, 0x83, 0x1b, 0x7b, 0x4b, 0xdf, 0xd0, 0xa1, 0xbb, 0x0f, 0x08, 0x5a, 0x71, 0xdf, 0xa4, 0xc3,
+	0x52, 0x93, 0xd2, 0x26, 0x35, 0xfd, 0x57, 0x31, 0xf4, 0x9f, 0xfb, 0x43, 0x58, 0xd5, 0x06, 0xb0,
+	0xaf, 0x6e, 0x3b, 0x5c, 0x9f, 0x37, 0x53, 0xcd, 0x8f, 0xfa, 0x5c, 0x7c, 0xb8, 0x1f, 0xc1, 0x5a,
+	0x6e, 0x7d, 0x42, 0x19, 0x75, 0x68, 0xa0, 0x80, 0x42, 0xd2, 0xa1, 0x92, 0x48, 0x01, 0x5c, 0x49,
+	0x1c, 0x87, 0x31, 0x6a, 0x09, 0x69, 0x22, 0xc8, 0xcb, 0x01, 0x02, 0xb4, 0xcb, 0x21, 0xee, 0x1f,
+	0xe0, 0xbd, 0xdb, 0x15, 0x6c, 0xb9, 0x1f, 0x87, 0x8f, 0x83, 0x3e, 0x43, 0xde, 0x1c, 0xf7, 0xde,
+	0x95, 0xdb, 0x18, 0x2f, 0x71, 0x3b, 0x46, 0x4c, 0x27, 0x84, 0xc0, 0x93, 0xf0, 0x58, 0xec, 0x06,
+	0x2d, 0xc9, 0x5e, 0xba, 0xca, 0xc7, 0xe1, 0x31, 0xd7, 0x42, 0x88, 0x28, 0xde, 0x42, 0x21, 0x7d,
+	0x50, 0x0b, 0xc9, 0x2f, 0xb7, 0x01, 0x1b, 0x45, 0xa4, 0x88, 0x81, 0x7f, 0x15, 0xd6, 0x6e, 0x0d,
+	0x3a, 0xbd, 0x22, 0xba, 0xa5, 0x87, 0x97, 0xdb, 0x48, 0x35, 0xbf, 0x11, 0xf7, 0x03, 0x58, 0xcf,
+	0x4f, 0x49, 0xd4, 0xb5, 0x6c, 0xa4, 0x62, 0xd9, 0x88, 0x7b, 0x0a, 0xce, 0x41, 0x78, 0x12, 0xdd,
+	0xc7, 0xd5, 0x82, 0x13, 0x36, 0x1a, 0x23, 0x6c, 0xe9, 0xc8, 0xbe, 0x4a, 0x70, 0xd1, 0x67, 0x0e,
+	0xd7, 0x5a, 0x01, 0xd7, 0xb7, 0x61, 0xc5, 0x58, 0x29, 0xbb, 0x06, 0x09, 0x82, 0x83, 0xfe, 0x20,
+	0x56, 0x7a, 0x37, 0x03, 0x20, 0x7a, 0xab, 0xe8, 0x74, 0x84, 0xc7, 0xe7, 0xcf, 0x00, 0x41, 0x63,
+	0xa5, 0x5a, 0x7e, 0xa5, 0x37, 0x60, 0x2d, 0xb7, 0x12, 0x21, 0x88, 0xd7, 0xfa, 0x71, 0xd0, 0x0e,
+	0x5b, 0x62, 0xa1, 0x19, 0x4f, 0x7e, 0xb8, 0xbf, 0x09, 0x97, 0x76, 0x62, 0x86, 0x74, 0xbc, 0x3f,
+	0x68, 0xf7, 0x43, 0x9c, 0x26, 0x27, 0x2d, 0xd0, 0x58, 0x8d, 0xf1, 0x67, 0x88, 0x82, 0x85, 0xf8,
+	0x2b, 0xfd, 0xe6, 0x77, 0xbb, 0x37, 0x38, 0x6a, 0x87, 0x4d, 0x7e, 0x34, 0x09, 0xa2, 0x59, 0x13,
+	0xee, 0x8c, 0x00, 0xe1, 0xb1, 0x24, 0x23, 0x49, 0xf9, 0x29, 0x5c, 0x2e, 0x59, 0x7c, 0x94, 0x38,
+	0xe0, 0x7a, 0x16, 0x51, 0x60, 0xac, 0xe3, 0x27, 0xcd, 0x38, 0xec, 0xf5, 0x89, 0x48, 0xf3, 0x12,
+	0x78, 0x20, 0x60, 0x28, 0x33, 0xd2, 0x5b, 0x3c, 0x88, 0x58, 0xeb, 0xce, 0x20, 0x6a, 0xa5, 0x1b,
+	0xcb, 0x39, 0x46, 0x95, 0xa2, 0x63, 0x84, 0x2a, 0xa7, 0xc3, 0xe2, 0xb3, 0x36, 0xe3, 0xb6, 0x58,
+	0xf7, 0x58, 0xf9, 0x4e, 0x12, 0xb6, 0xcf, 0x41, 0xc2, 0x42, 0xcc, 0x6c, 0x13, 0xb9, 0xc1, 0xd9,
+	0x23, 0x65, 0x94, 0xb8, 0x17, 0x61, 0xd3, 0xb2, 0x3e, 0xb1, 0x51, 0x04, 0x75, 0xb2, 0x07, 0x9e,
+	0x52, 0xe9, 0xfe, 0x02, 0xac, 0xab, 0x23, 0x40, 0xed, 0x1e, 0xa1, 0x2c, 0xe9, 0x04, 0xd2, 0x40,
+	0x97, 0xc6, 0xfd, 0x9a, 0x6a, 0xdd, 0xd1, 0x1b, 0xdd, 0xbf, 0x42, 0x43, 0x38, 0x5d, 0x30, 0xbb,
+	0x13, 0xc2, 0x30, 0x11, 0x0b, 0xd5, 0x3c, 0xf9, 0x21, 0x2e, 0x58, 0x8f, 0x45, 0xad, 0xe0, 0xa8,
+	0xad, 0x8c, 0xf0, 0x0c, 0xc0, 0x5d, 0xa4, 0xb0, 0xd3, 0x11, 0x97, 0xcd, 0x8f, 0xd9, 0x93, 0x20,
+	0x6e, 0x29, 0x17, 0x49, 0x81, 0x3d, 0x01, 0xe5, 0xc4, 0x79, 0xc2, 0xbd, 0x5e, 0xbf, 0x1b, 0xb5,
+	0xcf, 0x85, 0x7c, 0xc1, 0x79, 0x04, 0xe4, 0x21, 0x02, 0xf8, 0xed, 0xe1, 0xae, 0x86, 0xcf, 0xa9,
+	0x45, 0x56, 0x70, 0xcd, 0x03, 0x0e, 0xda, 0x13, 0x10, 0xe4, 0x99, 0x35, 0xba, 0x0f, 0x39, 0x3a,
+	0x95, 0xdf, 0x8a, 0x2f, 0x48, 0x9a, 0xbf, 0xa9, 0xc0, 0x7a, 0x7e, 0xa9, 0xff, 0x0f, 0x14, 0x7a,
+	0x0b, 0xd6, 0x76, 0xa4, 0x61, 0x3b, 0xae, 0x8e, 0x47, 0x5d, 0xbd, 0x9e, 0x1f, 0x32, 0x52, 0xf5,
+	0xfe, 0x51, 0x15, 0xd6, 0xef, 0xb2, 0xbe, 0xe6, 0xec, 0xa5, 0x0b, 0x6d, 0xc1, 0x0a, 0xfa, 0x8a,
+	0x71, 0x1f, 0x7d, 0x30, 0xdd, 0x4a, 0x97, 0xdc, 0xb4, 0xac, 0x9a, 0x32, 0x33, 0x7d, 0x1b, 0xd6,
+	0xf2, 0xfd, 0x33, 0xbf, 0x74, 0xd9, 0x5b, 0x31, 0x47, 0x48, 0x37, 0x0a, 0x0d, 0x28, 0xa4, 0x6c,
+	0x6e, 0x05, 0xc9, 0x6b, 0x8b, 0xb2, 0x21, 0x9b, 0x1f, 0xf1, 0x31, 0xfb, 0xca, 0xd9, 0xa5, 0xf3,
+	0xb5, 0xac, 0xf7, 0x96, 0x73, 0xbf, 0x0f, 0x17, 0x3b, 0x61, 0x14, 0x76, 0x06, 0x1d, 0x3c, 0xa9,
+	0x26, 0xf7, 0x1e, 0x0c, 0x8f, 0x77, 0x52, 0x8c, 0xdb, 0xa4, 0x2e, 0x9e, 0xe8, 0xa1, 0x93, 0xc1,
+	0xfd, 0x6b, 0xd4, 0xde, 0x05, 0xd2, 0x10, 0x41, 0xef, 0x80, 0x83, 0x03, 0xb9, 0xf7, 0xa7, 0x4f,
+	0x29, 0x7d, 0xa1, 0x0b, 0x9a, 0x35, 0xa2, 0x7b, 0xef, 0xde, 0xb2, 0x18, 0xa2, 0xcf, 0xe7, 0xec,
+	0xc3, 0xea, 0x20, 0xb2, 0xcc, 0x54, 0x1d, 0xc7, 0x1d, 0x5f, 0xa1, 0xa1, 0x06, 0xd6, 0xff, 0x56,
+	0x81, 0xd5, 0x43, 0x7e, 0x91, 0xef, 0x30, 0x96, 0xec, 0x07, 0x61, 0xeb, 0x2b, 0x39, 0xce, 0xc9,
+	0xaf, 0xfd, 0x38, 0xdd, 0x6f, 0xc1, 0x5a, 0x6e, 0x5f, 0x74, 0x16, 0xc8, 0x69, 0xd2, 0x2d, 0x3b,
+	0x66, 0x2c, 0x21, 0x5e, 0x9e, 0xed, 0xab, 0xae, 0xee, 0x0d, 0x58, 0xbd, 0xcf, 0x50, 0x52, 0x77,
+	0xdb, 0x07, 0x7d, 0x64, 0xd0, 0xf4, 0x7a, 0xbf, 0x0a, 0x4b, 0x1a, 0xc9, 0x75, 0x62, 0x2c, 0x6a,
+	0x70, 0x21, 0xeb, 0xff, 0xa7, 0x02, 0x6b, 0xb9, 0x39, 0xb2, 0xb5, 0xc3, 0xc8, 0xef, 0xc8, 0x36,
+	0xd2, 0xbe, 0xb3, 0x61, 0x44, 0x9d, 0x55, 0x08, 0xac, 0x9a, 0x85, 0xc0, 0x1c, 0x98, 0x48, 0xc2,
+	0x1f, 0x30, 0xf2, 0x5d, 0xc5, 0x6f, 0x0e, 0xe3, 0x8c, 0x4f, 0x42, 0x42, 0xfc, 0xd6, 0xa2, 0x3a,
+	0x93, 0x46, 0x54, 0x87, 0xeb, 0x11, 0x94, 0x61, 0x49, 0xbf, 0x1b, 0x6b, 0xee, 0x5f, 0x0d, 0xf5,
+	0x08, 0x41, 0xa5, 0xa7, 0x88, 0x9b, 0x6b, 0xa1, 0xb5, 0xc7, 0xa5, 0x16, 0xde, 0x7b, 0xd9, 0x71,
+	0x5a, 0x74, 0x5c, 0xcc, 0xe0, 0xb2, 0x2b, 0xca, 0x3b, 0x12, 0xa7, 0x28, 0x87, 0x66, 0xe4, 0x0e,
+	0x52, 0x80, 0xbb, 0x06, 0x2b, 0x24, 0x4c, 0x1e, 0x69, 0xb6, 0x8d, 0xfb, 0x93, 0x1a, 0xac, 0x9a,
+	0x70, 0x49, 0x90, 0xc6, 0x4f, 0xbf, 0x12, 0xcf, 0xdb, 0xee, 0x54, 0xd7, 0x9e, 0xca, 0xa9, 0x9e,
+	0x28, 0x71, 0xaa, 0xf9, 0x3d, 0x54, 0x73, 0x0f, 0x12, 0xa1, 0x5c, 0x32, 0x1f, 0x7c, 0x59, 0x35,
+	0x3d, 0x4a, 0xb8, 0x62, 0xa1, 0xfe, 0xe9, 0xec, 0x5a, 0x7f, 0xe9, 0x85, 0x2f, 0xab, 0xa6, 0xac,
+	0xff, 0x4e, 0x21, 0x58, 0xf2, 0xb2, 0x1e, 0x2c, 0xb1, 0x10, 0xd1, 0x12, 0x30, 0x19, 0xe6, 0x85,
+	0xba, 0x3d, 0xb8, 0x2c, 0x38, 0x83, 0xcb, 0xb0, 0xf0, 0x31, 0x6b, 0xdd, 0x3c, 0xb7, 0xa8, 0x8c,
+	0x67, 0xaa, 0x54, 0xef, 0xc2, 0x95, 0xb2, 0x15, 0x33, 0xcf, 0x5c, 0x32, 0x65, 0x4c, 0x5d, 0x88,
+	0x31, 0x65, 0x04, 0x45, 0x8d, 0xb3, 0xa1, 0x6e, 0xc6, 0x0e, 0xca, 0x5d, 0xc0, 0x67, 0x87, 0x7a,
+	0x31, 0xa8, 0x30, 0x0e, 0xea, 0xef, 0xc1, 0x95, 0x5d, 0x52, 0xf9, 0x3b, 0xdd, 0x30, 0x3a, 0x42,
+	0xa3, 0x57, 0x06, 0x8d, 0xc7, 0xd0, 0xd4, 0xff, 0x5c, 0x85, 0xab, 0xa5, 0x83, 0x89, 0x93, 0xfe,
+	0x33, 0x8b, 0x42, 0x8f, 0x2f, 0xaa, 0x38, 0x33, 0x75, 0xc5, 0x20, 0xc3, 0x29, 0x9d, 0x93, 0x30,
+	0xe1, 0x95, 0x6a, 0xd1, 0xe6, 0x9a, 0x1e, 0x6d, 0xd6, 0x44, 0xce, 0x84, 0x21, 0x72, 0xd0, 0xe4,
+	0x11, 0x98, 0x86, 0xfd, 0x73, 0xdf, 0x90, 0x49, 0x75, 0x05, 0x26, 0xe9, 0x8f, 0x9c, 0x21, 0x44,
+	0x79, 0xe2, 0xe3, 0x74, 0x61, 0xdb, 0x97, 0xfb, 0x13, 0x9c, 0x81, 0x12, 0x5d, 0x36, 0x3d, 0xe2,
+	0x2d, 0xf7, 0x45, 0x83, 0x73, 0x0f, 0xa6, 0x25, 0x5e, 0x8a, 0x31, 0xde, 0xd2, 0x18, 0x63, 0x04,
+	0x79, 0xd2, 0x6c, 0x03, 0xcd, 0xc0, 0x73, 0x3f, 0x17, 0x76, 0x4e, 0x83, 0xe8, 0x84, 0xed, 0xa7,
+	0x4e, 0x88, 0x3a, 0x88, 0x77, 0xa1, 0x86, 0x72, 0x40, 0x90, 0xac, 0xbe, 0xfd, 0x92, 0xb6, 0x48,
+	0xc9, 0x80, 0x2d, 0xee, 0xa5, 0xf2, 0x21, 0xfc, 0x2e, 0x74, 0xdb, 0x2d, 0xbf, 0xe0, 0xe0, 0x2e,
+	0x20, 0x34, 0x1b, 0xc6, 0xbb, 0xf1, 0x58, 0x59, 0xc1, 0x21, 0x5a, 0x40, 0x68, 0xd6, 0xcd, 0xbd,
+	0x02, 0x35, 0x9c, 0xd9, 0x99, 0x83, 0xe9, 0x7d, 0x6f, 0xf7, 0xa3, 0x1b, 0x87, 0xb7, 0x97, 0xbe,
+	0xe1, 0x00, 0x4c, 0xed, 0x3f, 0xba, 0xb9, 0xb7, 0xbb, 0xb3, 0x54, 0xe1, 0x9e, 0x79, 0x11, 0x23,
+	0x72, 0x29, 0x3e, 0x85, 0x95, 0x47, 0x11, 0x27, 0xe1, 0xc7, 0x02, 0xfb, 0x71, 0xc3, 0x08, 0x78,
+	0x78, 0x5c, 0x9f, 0x20, 0x95, 0xfc, 0x84, 0x21, 0x9b, 0xb4, 0x12, 0xd2, 0x46, 0x75, 0x02, 0x1f,
+	0x48, 0xa8, 0xbb, 0x0e, 0xab, 0xe6, 0xfc, 0xb4, 0xee, 0x0a, 0x2c, 0xef, 0xe5, 0x57, 0x75, 0x57,
+	0xc1, 0xd9, 0x2b, 0x76, 0x45, 0xa8, 0x9c, 0x82, 0x2b, 0xc9, 0x54, 0x55, 0x1c, 0x2a, 0xc4, 0x09,
+	0x4a, 0x5c, 0x86, 0xb7, 0x8d, 0x6c, 0x5f, 0xa9, 0x35, 0xe9, 0x8b, 0x93, 0x72, 0x10, 0xc9, 0xdf,
+	0xf2, 0x1a, 0x11, 0xbe, 0x0b, 0x0a, 0x2a, 0x6e, 0x10, 0x47, 0x4b, 0xae, 0xbe, 0x1b, 0x1d, 0x77,
+	0xd5, 0x52, 0x7f, 0x38, 0x01, 0x8e, 0x0e, 0xcd, 0xac, 0x5f, 0x4a, 0xf6, 0x29, 0x3e, 0xa4, 0x4f,
+	0x91, 0x3d, 0x92, 0x5e, 0x2e, 0x8b, 0x9a, 0xf1, 0x79, 0xaf, 0xcf, 0x64, 0x48, 0x69, 0xc6, 0x5b,
+	0x94, 0xf0, 0xdb, 0x0a, 0xac, 0xe1, 0x5b, 0x33, 0xf0, 0x45, 0x67, 0x55, 0x58, 0xf5, 0xdc, 0x90,
+	0x49, 0x4d, 0xfd, 0x19, 0x6f, 0x5e, 0x01, 0x85, 0xb5, 0x8f, 0x9d, 0x94, 0x8a, 0xd3, 0xb5, 0x8b,
+	0xd2, 0x7b, 0x52, 0x51, 0x64, 0xac, 0xab, 0x6b, 0x14, 0x62, 0x5d, 0xd9, 0xe5, 0x3a, 0x37, 0x17,
+	0xb9, 0x33, 0xd2, 0xf7, 0x8d, 0xae, 0xd3, 0x32, 0x50, 0x48, 0x6d, 0x0f, 0xb5, 0x11, 0xaf, 0xc0,
+	0x52, 0x9a, 0xa7, 0x50, 0xdc, 0x3b, 0x23, 0xb9, 0x57, 0xa5, 0x2b, 0x88, 0x7b, 0x5f, 0x80, 0xba,
+	0xd6, 0x93, 0x8b, 0x98, 0x59, 0x71, 0x9b, 0xe6, 0xd3, 0x7e, 0x5c, 0xbe, 0x34, 0x60, 0xe6, 0x28,
+	0x8c, 0xfb, 0xa7, 0xad, 0xe0, 0x7c, 0x03, 0xc4, 0xc1, 0xa4, 0xdf, 0xdc, 0x62, 0x54, 0xbf, 0x4d,
+	0x9b, 0x6e, 0x4e, 0x5a, 0x8c, 0xaa, 0x51, 0xb7, 0x18, 0xb9, 0xcc, 0xc8, 0x8d, 0xe1, 0x4b, 0xcf,
+	0x4b, 0xab, 0xd4, 0x1c, 0xc1, 0xd7, 0x7f, 0x07, 0xd6, 0x9b, 0xa7, 0x01, 0xda, 0x5c, 0xcd, 0x76,
+	0xc8, 0x04, 0x39, 0xa3, 0x88, 0x35, 0xf9, 0xb9, 0x2d, 0x08, 0xba, 0xaf, 0x8a, 0xd6, 0x1d, 0xd1,
+	0xb8, 0xa3, 0xda, 0xdc, 0x0e, 0x34, 0xd0, 0x92, 0x27, 0x41, 0xff, 0x14, 0x61, 0x46, 0x6c, 0xe9,
+	0x0d, 0xe2, 0x5e, 0x97, 0xf8, 0x1e, 0x5b, 0xe8, 0x93, 0x2b, 0xe4, 0x26, 0x4a, 0x26, 0xbf, 0x7f,
+	0xde, 0x63, 0x64, 0x88, 0xcc, 0x70, 0xc0, 0x21, 0x7e, 0xbb, 0xff, 0x5d, 0x81, 0x8b, 0xd6, 0xf5,
+	0x48, 0xb4, 0xff, 0x76, 0x05, 0x8d, 0xa4, 0x2c, 0x16, 0x54, 0xa2, 0x9b, 0xf5, 0x5c, 0x62, 0x35,
+	0x97, 0x4b, 0x4c, 0xf3, 0x92, 0x35, 0x3d, 0x2f, 0xc9, 0x47, 0x50, 0x16, 0x80, 0xae, 0x61, 0xfa,
+	0xcd, 0x8d, 0x4c, 0x6e, 0xad, 0x50, 0x46, 0x4a, 0xfc, 0x76, 0xf6, 0xf2, 0xe1, 0xcd, 0xb9, 0xed,
+	0x2d, 0x4d, 0x3a, 0x0e, 0xd9, 0x82, 0xb2, 0x5b, 0xb4, 0x70, 0xa8, 0x1b, 0xc3, 0xd5, 0x6c, 0xc4,
+	0x6d, 0xb4, 0x9b, 0x10, 0xa7, 0xd6, 0xfe, 0xe0, 0x28, 0x17, 0x45, 0x7c, 0xa6, 0x94, 0xde, 0x83,
+	0x6b, 0xe5, 0x6b, 0x12, 0xfb, 0x23, 0x0b, 0x30, 0x6a, 0xf1, 0x91, 0xab, 0x7d, 0xa5, 0x0a, 0x66,
+	0xbd, 0x3a, 0x33, 0x46, 0xb8, 0x7f, 0x86, 0xce, 0x30, 0x0f, 0xe4, 0x68, 0x0e, 0xd5, 0x68, 0xcc,
+	0x79, 0x56, 0x28, 0x88, 0x4f, 0x58, 0x5f, 0x25, 0x95, 0x55, 0x6a, 0x53, 0x00, 0x65, 0x4a, 0x79,
+	0x88, 0xb1, 0x52, 0x1b, 0x62, 0xac, 0x38, 0xdf, 0x81, 0x46, 0x18, 0x35, 0xdb, 0x83, 0x16, 0xf3,
+	0xd3, 0xa8, 0x43, 0x93, 0x14, 0x62, 0x42, 0x47, 0xbc, 0x41, 0x3d, 0xf2, 0x0a, 0x33, 0xe1, 0xfc,
+	0xa8, 0x46, 0x37, 0x85, 0x5a, 0x51, 0xf1, 0x34, 0x79, 0x07, 0x56, 0xa8, 0x51, 0xaa, 0x1c, 0x19,
+	0x56, 0xe3, 0x42, 0x48, 0x70, 0xa1, 0x52, 0xcc, 0x53, 0xa2, 0xeb, 0x1c, 0x87, 0x91, 0x06, 0x76,
+	0xff, 0xb4, 0x06, 0x17, 0x0a, 0x54, 0x22, 0x5a, 0xff, 0x3a, 0x8a, 0x1b, 0xd6, 0x16, 0x4c, 0xe7,
+	0x97, 0xeb, 0xf6, 0x92, 0xd1, 0x5b, 0xfb, 0x94, 0x87, 0x27, 0xdd, 0xbe, 0xa8, 0xa6, 0xa2, 0x95,
+	0x39, 0x72, 0xd2, 0x32, 0x33, 0x28, 0x3d, 0x27, 0x60, 0x44, 0x68, 0x3c, 0x6c, 0xda, 0x6b, 0xef,
+	0x4c, 0x6d, 0x57, 0xea, 0xe2, 0xba, 0x84, 0xef, 0x9f, 0xc9, 0x9d, 0x36, 0xfe, 0xa3, 0x02, 0x75,
+	0x73, 0xc1, 0xaf, 0xc9, 0xce, 0xc2, 0x0b, 0x9d, 0xe1, 0x36, 0x21, 0xa6, 0x9f, 0xe9, 0x9d, 0x65,
+	0xf4, 0x27, 0xb3, 0xd3, 0x17, 0x3e, 0xa1, 0x0c, 0x0c, 0xcd, 0x11, 0xec, 0x30, 0x94, 0x69, 0x48,
+	0x91, 0x6f, 0x52, 0x17, 0x81, 0xce, 0x68, 0x9e, 0x03, 0xd5, 0xe1, 0x73, 0x75, 0x2e, 0x03, 0x49,
+	0xa6, 0x4d, 0xea, 0xfe, 0x23, 0xba, 0xb2, 0xb9, 0x06, 0x12, 0x4a, 0xd1, 0xd7, 0x6c, 0x6e, 0xde,
+	0xc8, 0x5b, 0x7f, 0xba, 0x5b, 0x64, 0x45, 0xb1, 0x60, 0xf3, 0x35, 0x95, 0x69, 0x41, 0x0d, 0x4f,
+	0xed, 0xd9, 0x8f, 0x81, 0x7f, 0x66, 0x18, 0xa9, 0x45, 0xc8, 0xda, 0xf9, 0xad, 0x29, 0xb4, 0xd6,
+	0x44, 0x84, 0xfb, 0xa9, 0xc4, 0xc5, 0xad, 0x6c, 0xdb, 0x32, 0xc8, 0xf3, 0x9a, 0x6e, 0x8f, 0x96,
+	0xcc, 0x97, 0xdf, 0xf9, 0x17, 0x95, 0x27, 0xcf, 0xa3, 0x8e, 0x0f, 0xfa, 0x7e, 0x8f, 0xc5, 0xfe,
+	0xd9, 0x11, 0x8f, 0x97, 0x90, 0x57, 0x3c, 0x87, 0xd0, 0x7d, 0x16, 0xdf, 0x3b, 0xba, 0xc3, 0x18,
+	0x37, 0x32, 0x82, 0xc7, 0xdd, 0xb0, 0xe5, 0x93, 0x68, 0xf7, 0x3b, 0xe1, 0xe7, 0xbc, 0x6e, 0x4a,
+	0x4a, 0x0d, 0x47, 0xb4, 0x91, 0xf8, 0xbf, 0x2f, 0x5a, 0xb8, 0xcd, 0x46, 0x4c, 0xa7, 0x54, 0x19,
+	0x95, 0x36, 0x49, 0xa8, 0x52, 0x75, 0xef, 0xc2, 0x86, 0x08, 0xa4, 0xda, 0x64, 0xd9, 0xb4, 0x98,
+	0x7c, 0x5d, 0xb4, 0x17, 0x25, 0x19, 0xb2, 0x8c, 0x90, 0x4a, 0x82, 0x25, 0x66, 0xa4, 0x0e, 0xe0,
+	0x00, 0xc1, 0x0f, 0xef, 0xc1, 0x66, 0xd0, 0x3c, 0x8b, 0xba, 0x4f, 0xda, 0xac, 0x75, 0xa2, 0x09,
+	0xca, 0x38, 0x4c, 0xce, 0x84, 0x0d, 0x33, 0xe3, 0x5d, 0xd0, 0x3a, 0xa8, 0xd9, 0x3d, 0x6c, 0xe6,
+	0xe2, 0x02, 0x35, 0xa1, 0x8f, 0x24, 0x0e, 0x3b, 0x3c, 0x0f, 0xc5, 0x49, 0x02, 0x62, 0x48, 0x1d,
+	0xe1, 0xb7, 0x09, 0xcc, 0xa9, 0x72, 0x15, 0xe6, 0x38, 0xa1, 0x7d, 0x29, 0xd6, 0x85, 0x49, 0xb3,
+	0xe0, 0x01, 0x07, 0x1d, 0x0a, 0x88, 0xf3, 0x3d, 0x70, 0x0c, 0xd1, 0x87, 0xc8, 0xe3, 0x19, 0xcf,
+	0x8b, 0x33, 0xfe, 0xe6, 0x98, 0x67, 0xbc, 0xcf, 0x07, 0x79, 0xcb, 0xba, 0xdc, 0x13, 0xd3, 0x34,
+	0xde, 0x4b, 0x99, 0xb3, 0xdc, 0x5e, 0xc8, 0x18, 0xad, 0xaa, 0x33, 0x5a, 0xe3, 0x13, 0x98, 0x51,
+	0x53, 0x3f, 0x63, 0xd6, 0xf8, 0xd7, 0x0a, 0x6c, 0x5a, 0xb6, 0x43, 0xba, 0x00, 0xef, 0x68, 0xc2,
+	0xe2, 0x30, 0x68, 0x87, 0x3f, 0x30, 0xc3, 0x9b, 0xb4, 0xe2, 0x5a, 0xd6, 0x7a, 0x68, 0xa6, 0x66,
+	0x42, 0x5e, 0xf0, 0xe5, 0x3f, 0x0e, 0xda, 0x48, 0x17, 0xc1, 0x25, 0x28, 0x01, 0x05, 0xec, 0x23,
+	0x01, 0x52, 0x61, 0xb5, 0x5a, 0x16, 0x56, 0x43, 0x37, 0x27, 0x38, 0x4a, 0xba, 0xf1, 0x11, 0xe7,
+	0x07, 0x71, 0xe9, 0x28, 0x9a, 0x56, 0x57, 0x60, 0xa9, 0xe5, 0x2c, 0x1c, 0x30, 0x59, 0xe0, 0x00,
+	0xf7, 0xf7, 0xaa, 0xb0, 0x72, 0xf0, 0x84, 0xb1, 0xde, 0xd8, 0xc1, 0x08, 0x6e, 0x66, 0xf3, 0x01,
+	0xdc, 0x76, 0x56, 0xc7, 0x23, 0xe3, 0x58, 0x75, 0x01, 0x3f, 0xec, 0xde, 0x48, 0x93, 0x5b, 0x79,
+	0x04, 0x6a, 0x45, 0x16, 0x34, 0xa6, 0x6b, 0x66, 0xf1, 0xab, 0x99, 0x6c, 0x3a, 0x5a, 0x98, 0x57,
+	0x0e, 0xf0, 0xdb, 0x1b, 0x09, 0x0e, 0x4f, 0x3b, 0x4f, 0x52, 0xe5, 0x40, 0xd6, 0x74, 0x63, 0x64,
+	0xd8, 0x64, 0x6a, 0x58, 0xd8, 0xe4, 0x9f, 0x2a, 0xb0, 0x6a, 0x92, 0xe4, 0x2b, 0x3f, 0xe5, 0xbc,
+	0xb6, 0xaf, 0x15, 0xb5, 0x3d, 0x5d, 0x84, 0x89, 0xec, 0x22, 0xd8, 0x0e, 0x62, 0xd2, 0x76, 0x10,
+	0xee, 0xdf, 0x56, 0x60, 0x9d, 0x27, 0x7b, 0x2d, 0xd2, 0x7b, 0x94, 0x53, 0x5d, 0xbe, 0xe7, 0xea,
+	0xb0, 0x3d, 0xa3, 0xe2, 0x96, 0x7b, 0x16, 0x0c, 0xc5, 0x64, 0x51, 0x26, 0x7a, 0x81, 0x02, 0xb8,
+	0x2b, 0x61, 0x05, 0xc2, 0x4c, 0x14, 0x08, 0xe3, 0x7e, 0x06, 0x17, 0x0a, 0x88, 0xd3, 0x69, 0x8c,
+	0xce, 0x7c, 0xa2, 0x03, 0x85, 0x6e, 0x22, 0x0e, 0x47, 0xcc, 0x4d, 0x6c, 0xaa, 0x02, 0x9b, 0x55,
+	0xd5, 0xba, 0xab, 0x61, 0xe5, 0x7e, 0x17, 0x36, 0xf7, 0xb9, 0x43, 0x9c, 0x9c, 0x5a, 0xc8, 0xf5,
+	0x06, 0x4a, 0x3e, 0x39, 0x61, 0x71, 0xed, 0x65, 0xd9, 0xa2, 0x8d, 0x72, 0xaf, 0x43, 0xc3, 0x36,
+	0x17, 0xed, 0xc0, 0x52, 0xe2, 0xe8, 0xde, 0x86, 0x0d, 0x8f, 0x75, 0xba, 0x8f, 0x6d, 0x9a, 0xf6,
+	0x29, 0xc2, 0xf8, 0x17, 0x61, 0xd3, 0x32, 0x0d, 0xa9, 0xf3, 0xdf, 0x80, 0xc6, 0x81, 0x91, 0xec,
+	0xd9, 0xe3, 0xe5, 0xa7, 0x5f, 0xc0, 0xa4, 0x48, 0xcb, 0x58, 0xab, 0x5a, 0x19, 0xab, 0x7b, 0x19,
+	0x2e, 0x5a, 0xa7, 0xa7, 0xd5, 0xef, 0x0a, 0x07, 0xf5, 0xcb, 0xaf, 0xee, 0xbe, 0x2d, 0x3c, 0xcf,
+	0xb2, 0x75, 0x32, 0xe4, 0x2a, 0x3a, 0x72, 0x1f, 0x22, 0x27, 0x30, 0xe5, 0xe4, 0x19, 0x2b, 0x97,
+	0x6b, 0x1b, 0xfb, 0x36, 0x37, 0xf1, 0x6a, 0xe6, 0x67, 0xa2, 0x2d, 0x6e, 0x8b, 0x44, 0xe3, 0x53,
+	0x2d, 0xe2, 0xbe, 0x29, 0x32, 0x70, 0xb6, 0xe9, 0x4a, 0x76, 0xb2, 0x0d, 0x0b, 0x9e, 0xa8, 0x72,
+	0xd1, 0xaa, 0x26, 0x8f, 0xd8, 0x09, 0xba, 0x8f, 0x14, 0x8a, 0xa8, 0x08, 0x21, 0x37, 0x27, 0x60,
+	0x94, 0x58, 0x5a, 0x82, 0xba, 0x1a, 0x43, 0xa8, 0x3e, 0x07, 0x57, 0x35, 0x0a, 0x3e, 0xe8, 0xf6,
+	0xc3, 0xe3, 0xb0, 0x19, 0xe8, 0xc9, 0x51, 0xf7, 0xe7, 0x55, 0xb8, 0x56, 0xde, 0x87, 0x70, 0xfc,
+	0x00, 0xb5, 0x52, 0xbf, 0x1f, 0x34, 0x4f, 0x91, 0x35, 0x64, 0xf8, 0x73, 0x54, 0x8a, 0xb0, 0xae,
+	0xfa, 0x0b, 0x68, 0xc2, 0xf5, 0x5a, 0x8b, 0x99, 0x33, 0x70, 0x36, 0x45, 0x6f, 0x46, 0x81, 0xa9,
+	0x63, 0x59, 0x22, 0xb1, 0xf6, 0x45, 0x13, 0x89, 0xdc, 0xf7, 0xb4, 0xcc, 0x28, 0x2e, 0x1f, 0x89,
+	0xa5, 0x79, 0x6f, 0xa3, 0x38, 0xf0, 0x43, 0xd1, 0xee, 0xfe, 0x6e, 0x05, 0x2e, 0x1f, 0xf0, 0x60,
+	0x54, 0x84, 0x27, 0x67, 0xa3, 0xe0, 0x10, 0x65, 0xfa, 0x1a, 0x2c, 0x47, 0x5d, 0x3f, 0xe2, 0x83,
+	0xce, 0x7d, 0x8a, 0x69, 0xa9, 0xb0, 0x5c, 0xd4, 0x15, 0x93, 0x9d, 0x3f, 0x92, 0x60, 0x5e, 0x43,
+	0x94, 0xf5, 0x95, 0x3d, 0x65, 0x7c, 0x6e, 0x41, 0xf5, 0x14, 0x58, 0xb8, 0xbf, 0x5f, 0x85, 0x2b,
+	0x65, 0xf8, 0xd0, 0x69, 0x3d, 0x5b, 0xb7, 0xe7, 0x1e, 0x4c, 0x0b, 0x63, 0x96, 0xc9, 0xc7, 0x03,
+	0xa6, 0x03, 0x3c, 0x1c, 0x13, 0xd1, 0x8c, 0x03, 0x3d, 0x35, 0x43, 0xe3, 0x11, 0x4c, 0x13, 0xec,
+	0x69, 0xb0, 0x44, 0x93, 0x55, 0x93, 0xf0, 0xaa, 0x3e, 0x2d, 0xd3, 0x36, 0x5c, 0x28, 0xa9, 0x7a,
+	0x61, 0xdb, 0x1d, 0xff, 0xaf, 0x0a, 0x5c, 0xb2, 0xb7, 0x3f, 0x55, 0xf9, 0xe5, 0xff, 0x75, 0x82,
+	0xcf, 0x5e, 0x35, 0x3b, 0x59, 0x52, 0x35, 0x7b, 0x09, 0x1a, 0x52, 0x1a, 0x58, 0x49, 0xc2, 0xe0,
+	0xa2, 0xb5, 0xb5, 0x5c, 0x79, 0x95, 0xd6, 0xe7, 0x37, 0x60, 0xe6, 0x38, 0x8c, 0x50, 0x0b, 0xa6,
+	0x21, 0xe5, 0xf4, 0xdb, 0x1d, 0x80, 0x4b, 0x42, 0x6f, 0x3f, 0x38, 0xef, 0x30, 0xfb, 0xf9, 0x8c,
+	0xa8, 0x4e, 0x7c, 0x0b, 0x56, 0x29, 0x2e, 0x65, 0xcb, 0x8e, 0xad, 0xc8, 0x36, 0xd3, 0xc8, 0xfb,
+	0x8b, 0x0a, 0x3c, 0x3f, 0x74, 0xdd, 0x91, 0xa5, 0x5b, 0xb6, 0xdb, 0x59, 0xb5, 0xdf, 0xce, 0xb2,
+	0xb8, 0xc0, 0x0b, 0xb0, 0x60, 0x22, 0x2c, 0xb3, 0x51, 0x26, 0xd0, 0xfd, 0x31, 0x9a, 0xe8, 0xd2,
+	0xf5, 0x30, 0xf3, 0x21, 0xaf, 0xc3, 0x32, 0x45, 0xf4, 0x0b, 0x16, 0x1c, 0x85, 0xfa, 0xb5, 0xb4,
+	0x0d, 0x1a, 0x2e, 0xaa, 0x00, 0xb1, 0x90, 0xe1, 0x59, 0xa6, 0x16, 0xad, 0x3b, 0xda, 0x6f, 0x9d,
+	0x08, 0x0d, 0x88, 0x08, 0x67, 0x4f, 0x18, 0x1d, 0xdb, 0xac, 0x37, 0xaf, 0x80, 0x07, 0x08, 0xe3,
+	0x12, 0x5b, 0xf2, 0xb9, 0x9f, 0xc6, 0xc9, 0xc9, 0x13, 0x91, 0xe0, 0x9b, 0x2a, 0x5a, 0x8e, 0xa4,
+	0x3a, 0x0a, 0x7b, 0x6f, 0x7f, 0x5b, 0x5f, 0x5a, 0x5a, 0xaa, 0x8b, 0x02, 0xae, 0x2d, 0xcc, 0x6b,
+	0x89, 0xba, 0xb1, 0x99, 0x69, 0x9e, 0xe5, 0x10, 0x79, 0x65, 0xd7, 0x61, 0xd5, 0x24, 0x05, 0xa9,
+	0xb1, 0x0f, 0x60, 0xf9, 0x21, 0x4a, 0x8d, 0x2f, 0x4e, 0x20, 0x9e, 0xd1, 0xd1, 0x67, 0xc8, 0xf2,
+	0x3c, 0x3b, 0xed, 0x6e, 0x62, 0x52, 0x9e, 0x57, 0x0a, 0x18, 0x50, 0xea, 0x8c, 0x60, 0x09, 0xb9,
+	0xfd, 0x79, 0x98, 0x64, 0x71, 0xa8, 0x2d, 0x58, 0x35, 0xc1, 0x59, 0x5a, 0x88, 0x09, 0x88, 0x4a,
+	0x0b, 0xc9, 0x2f, 0xf7, 0xe7, 0x15, 0xd8, 0x38, 0xe0, 0x15, 0x27, 0x3b, 0xbc, 0x5b, 0x94, 0x0c,
+	0x12, 0xaf, 0xd7, 0x54, 0x7b, 0x42, 0x9a, 0xd3, 0x63, 0x0f, 0xdf, 0xbc, 0x97, 0x75, 0x02, 0xdf,
+	0xc8, 0x42, 0xea, 0xe8, 0xd6, 0xc7, 0x9a, 0x14, 0x4a, 0xbf, 0x79, 0x1b, 0xa7, 0x08, 0x27, 0x2b,
+	0x45, 0x0c, 0xd3, 0x6f, 0x6e, 0x56, 0x37, 0x59, 0x4c, 0xac, 0xc0, 0x28, 0x68, 0xa7, 0x83, 0xb8,
+	0x6d, 0x69, 0x41, 0x2f, 0x33, 0x7d, 0x3e, 0xe2, 0x15, 0x99, 0xd8, 0x71, 0xdc, 0xcc, 0xbc, 0xfb,
+	0x97, 0x35, 0xb8, 0x50, 0x18, 0x34, 0xac, 0xdc, 0xd3, 0xb9, 0x00, 0xd3, 0x21, 0x0f, 0xd6, 0x44,
+	0x8c, 0x94, 0xe5, 0x54, 0x98, 0xdc, 0xc7, 0x2f, 0x21, 0x7f, 0x29, 0x94, 0x93, 0x06, 0xd1, 0xb9,
+	0xfc, 0x95, 0x30, 0x1e, 0x47, 0xe7, 0x01, 0x16, 0x1c, 0xab, 0xc5, 0x24, 0x79, 0xea, 0x20, 0xa1,
+	0x98, 0xa4, 0x6c, 0x24, 0xb7, 0x7a, 0x52, 0x35, 0x92, 0x43, 0xad, 0xa9, 0xf1, 0x29, 0x53, 0x8d,
+	0xff, 0x1a, 0xb7, 0x5d, 0x04, 0x13, 0x71, 0x51, 0xd0, 0x0b, 0xfa, 0xa7, 0x22, 0xca, 0x63, 0x6a,
+	0xc2, 0x92, 0x2d, 0x6e, 0xdd, 0x4a, 0x47, 0xee, 0xe3, 0x40, 0x6e, 0xee, 0xe8, 0xdf, 0x8d, 0x9f,
+	0x56, 0xa0, 0x6e, 0x76, 0xd1, 0x33, 0x08, 0x95, 0x21, 0x19, 0x84, 0xaa, 0x99, 0x41, 0xd0, 0xf1,
+	0xaf, 0x99, 0xf8, 0xe3, 0x55, 0x3c, 0x42, 0x99, 0x95, 0xbe, 0xf3, 0xa3, 0xaf, 0x2c, 0xf7, 0x32,
+	0xa9, 0xe5, 0x5e, 0xdc, 0x77, 0x61, 0x23, 0xb7, 0x17, 0x36, 0x9e, 0xa0, 0x76, 0xff, 0xbd, 0x02,
+	0x9b, 0x96, 0xa1, 0x14, 0x96, 0xed, 0xc3, 0x14, 0xfe, 0x1e, 0xb4, 0x47, 0xd8, 0xe2, 0xf2, 0x3e,
+	0x54, 0xf5, 0xfb, 0x30, 0xc6, 0xb1, 0x6b, 0x57, 0x66, 0xc2, 0xb8, 0x32, 0xb7, 0x61, 0x3a, 0x16,
+	0xab, 0x2a, 0x8b, 0xf5, 0xf5, 0xf2, 0x33, 0xd3, 0xb2, 0x42, 0x12, 0x53, 0x4f, 0x8d, 0x45, 0xa2,
+	0x5c, 0xe4, 0xcf, 0x1f, 0x62, 0x5e, 0x06, 0xac, 0x09, 0x49, 0x45, 0x97, 0x4d, 0x9e, 0x4c, 0xec,
+	0xfb, 0xa2, 0x20, 0x8a, 0xce, 0x0c, 0xbf, 0x0f, 0xf0, 0xd3, 0x7d, 0x0f, 0x2e, 0xd9, 0x47, 0x12,
+	0x0b, 0x20, 0xb7, 0x2a, 0xb1, 0x4b, 0xd4, 0x48, 0xbf, 0xdd, 0xb7, 0xe0, 0xf2, 0xad, 0xee, 0x93,
+	0xa8, 0xdd, 0x0d, 0x5a, 0xa4, 0xc6, 0x68, 0x41, 0xb5, 0xee, 0x12, 0xd4, 0x06, 0x71, 0x48, 0xe3,
+	0xf8, 0x4f, 0xf7, 0xef, 0xd1, 0x3c, 0x2c, 0x1b, 0x43, 0x2b, 0x5e, 0x81, 0xb9, 0x5e, 0x70, 0xce,
+	0xe3, 0x0a, 0xda, 0xf3, 0xab, 0x59, 0x04, 0x1d, 0x76, 0x85, 0x09, 0xf3, 0xdd, 0x7c, 0x60, 0xf7,
+	0xba, 0x46, 0xb2, 0xe1, 0x73, 0x17, 0xc2, 0xbb, 0x78, 0xd4, 0xec, 0xf3, 0x5e, 0x18, 0xb3, 0x84,
+	0x94, 0xa3, 0xfa, 0xe4, 0x16, 0x46, 0x07, 0xb7, 0x49, 0x2f, 0x08, 0xc5, 0x6f, 0x51, 0xab, 0x2d,
+	0xe7, 0xf5, 0x07, 0x71, 0x3b, 0x7d, 0x7a, 0x2a, 0x41, 0x8f, 0xe2, 0xb6, 0x50, 0x5c, 0x2c, 0xe6,
+	0x0c, 0xdc, 0xf7, 0xd3, 0x97, 0xa7, 0xf3, 0xde, 0xbc, 0x02, 0xde, 0x42, 0xd8, 0x97, 0x09, 0x31,
+	0xba, 0x3f, 0xab, 0x82, 0xb3, 0xdf, 0x4d, 0xfa, 0xe6, 0xf6, 0xf2, 0x88, 0x55, 0x46, 0x23, 0x56,
+	0x2d, 0x22, 0xe6, 0xb8, 0xb9, 0xa7, 0x8a, 0x35, 0xe1, 0x7a, 0x18, 0x30, 0x67, 0x97, 0x97, 0x8c,
+	0x1f, 0x0f, 0x22, 0x95, 0x75, 0x12, 0xf4, 0x31, 0x5f, 0xac, 0x16, 0xf1, 0x53, 0x64, 0x9f, 0x97,
+	0x43, 0x69, 0xf7, 0x8a, 0xc2, 0x93, 0x19, 0x85, 0xbf, 0x14, 0x6d, 0x5e, 0x85, 0x15, 0x63, 0xe9,
+	0xcc, 0x54, 0x14, 0xcb, 0x54, 0xb2, 0x65, 0xb6, 0xbd, 0xf4, 0x45, 0xf3, 0x01, 0x8b, 0x1f, 0x87,
+	0x4d, 0xee, 0x41, 0x4e, 0x13, 0xc4, 0xd9, 0xd4, 0x39, 0xd0, 0x78, 0xf7, 0xdc, 0x68, 0xd8, 0x9a,
+	0xe4, 0x3a, 0xdb, 0x7f, 0xfe, 0x12, 0x2c, 0x48, 0x4d, 0xab, 0xe6, 0xfc, 0x45, 0x98, 0xe0, 0xef,
+	0x2a, 0x9d, 0x75, 0x9d, 0x38, 0xd9, 0xbb, 0xcb, 0xc6, 0x85, 0x02, 0x3c, 0x75, 0x67, 0xa7, 0xd5,
+	0xf3, 0xc9, 0x4d, 0xe3, 0xc5, 0x8d, 0xfe, 0x28, 0xd3, 0x40, 0x26, 0xff, 0x38, 0xd3, 0x83, 0x05,
+	0xe3, 0x81, 0xa2, 0x73, 0xb5, 0xf8, 0x6e, 0xd0, 0x78, 0xf5, 0xd8, 0xb8, 0x56, 0xde, 0x81, 0xe6,
+	0xdc, 0x81, 0x19, 0xf5, 0x2c, 0xca, 0x69, 0x58, 0x9f, 0x21, 0xca, 0x99, 0x2e, 0x0e, 0x79, 0xa2,
+	0xc8, 0xb7, 0xa6, 0x1e, 0xf0, 0xe9, 0x5b, 0x33, 0x8b, 0xd3, 0x8d, 0xad, 0xe5, 0x8b, 0xc9, 0x1f,
+	0x41, 0xdd, 0x2c, 0x33, 0x77, 0xae, 0x15, 0xcb, 0xfc, 0x72, 0xf3, 0x3d, 0x37, 0xa4, 0x47, 0x36,
+	0xad, 0x59, 0xd3, 0x6d, 0x4c, 0x6b, 0xad, 0x10, 0x37, 0xa6, 0x2d, 0x29, 0x08, 0xff, 0x04, 0x16,
+	0x73, 0xa5, 0xcd, 0xce, 0x73, 0x66, 0xe6, 0xdf, 0x52, 0x11, 0xde, 0x70, 0x87, 0x75, 0xc9, 0x8e,
+	0xd8, 0x28, 0xd3, 0x35, 0x8e, 0xd8, 0x56, 0x98, 0x6c, 0x1c, 0xb1, 0xbd, 0xc2, 0x17, 0xe7, 0x34,
+	0xca, 0x6f, 0x8d, 0x39, 0x6d, 0xc5, 0xbd, 0xc6, 0x9c, 0xf6, 0xca, 0xdd, 0x87, 0x30, 0xaf, 0xd7,
+	0x5e, 0x3a, 0x57, 0x4a, 0x8b, 0x32, 0xe5, 0x8c, 0x57, 0x47, 0x14, 0x6d, 0x3a, 0x1d, 0x58, 0xb7,
+	0xd7, 0x44, 0x3a, 0xaf, 0xe4, 0x37, 0x58, 0x56, 0xa8, 0xd9, 0x78, 0x75, 0x8c, 0x9e, 0xe5, 0xcb,
+	0xa9, 0x5c, 0xc4, 0x90, 0x49, 0x8c, 0x7c, 0xc6, 0xd0, 0xe5, 0x72, 0x61, 0xfe, 0x1e, 0x7f, 0xc9,
+	0x66, 0xad, 0xc8, 0x73, 0x5e, 0x1d, 0xa7, 0x6a, 0x4f, 0x2e, 0xf8, 0xda, 0xf8, 0x05, 0x7e, 0xce,
+	0x1e, 0xcc, 0x69, 0x75, 0x63, 0x8e, 0x1e, 0xc2, 0x2a, 0x56, 0x99, 0x35, 0xae, 0x94, 0x35, 0xd3,
+	0x6c, 0xbb, 0x00, 0x59, 0x65, 0x98, 0x73, 0x49, 0xeb, 0x5d, 0x28, 0x23, 0x6b, 0x5c, 0x2e, 0x69,
+	0xa5, 0xa9, 0x5a, 0xb0, 0x62, 0xa9, 0x8c, 0x71, 0x5e, 0x1c, 0x55, 0x39, 0x23, 0x27, 0x7f, 0x69,
+	0xbc, 0x02, 0x1b, 0x27, 0x81, 0x8d, 0xb2, 0xca, 0x16, 0xe7, 0x35, 0xeb, 0x1c, 0xd6, 0x92, 0x9b,
+	0xc6, 0xeb, 0x63, 0xf5, 0xa5, 0x45, 0x07, 0xb0, 0x51, 0x16, 0xd4, 0x34, 0x16, 0x1d, 0x11, 0x1d,
+	0x35, 0x16, 0x1d, 0x15, 0x25, 0xbd, 0x5e, 0x71, 0xba, 0xb0, 0x6e, 0x8f, 0x88, 0x19, 0x77, 0x79,
+	0x68, 0x38, 0xd1, 0xb8, 0xcb, 0xc3, 0xc3, 0x6b, 0xb8, 0x60, 0x98, 0xbd, 0xb1, 0x37, 0x96, 0x7b,
+	0xc9, 0xa2, 0x6d, 0x6c, 0x8b, 0xbd, 0x3c, 0xb2, 0x5f, 0xba, 0xd4, 0x31, 0xac, 0x58, 0x22, 0x46,
+	0xc6, 0x6d, 0x29, 0x8f, 0x37, 0x19, 0xb7, 0x65, 0x48, 0xe0, 0x09, 0xd7, 0xf9, 0x21, 0x5c, 0x1c,
+	0x12, 0xba, 0x71, 0xde, 0x28, 0x8a, 0xaf, 0x21, 0xa1, 0xa5, 0xc6, 0xd6, 0xb8, 0xdd, 0xd3, 0xf5,
+	0xbf, 0x07, 0x4b, 0xf9, 0xda, 0x55, 0xc7, 0x1d, 0x5d, 0x6a, 0xdb, 0x78, 0x7e, 0x68, 0x9f, 0x4c,
+	0x58, 0xeb, 0xc5, 0xa9, 0x4e, 0x91, 0xdb, 0x8d, 0x58, 0x84, 0x21, 0xac, 0x6d, 0x55, 0xad, 0x5c,
+	0x1c, 0x64, 0x05, 0xac, 0x86, 0x38, 0x28, 0x14, 0xbb, 0x1a, 0xe2, 0xa0, 0x58, 0xf5, 0xca, 0x95,
+	0x93, 0xf1, 0x18, 0xde, 0x50, 0x4e, 0xb6, 0x07, 0xf8, 0x86, 0x72, 0xb2, 0xbe, 0xa3, 0xe7, 0xb2,
+	0x4f, 0x7b, 0xee, 0x6e, 0xc8, 0xbe, 0xe2, 0xfb, 0x7a, 0x43, 0xf6, 0xd9, 0x5e, 0xc9, 0xe3, 0xd1,
+	0xe4, 0x5f, 0x9e, 0x1b, 0x47, 0x53, 0xf2, 0x34, 0xde, 0x38, 0x9a, 0xd2, 0xa7, 0xeb, 0x0a, 0x55,
+	0xd2, 0x75, 0x97, 0x87, 0x3e, 0xc5, 0x2e, 0xa2, 0x9a, 0xd3, 0x6a, 0x48, 0x4c, 0xe3, 0x25, 0xb6,
+	0x41, 0x4c, 0xdb, 0x1b, 0x71, 0x83, 0x98, 0xf6, 0x47, 0xdc, 0xb8, 0xfd, 0xfc, 0x7b, 0x67, 0x63,
+	0xfb, 0x25, 0x2f, 0xb4, 0x8d, 0xed, 0x97, 0x3d, 0x98, 0xe6, 0xf6, 0x99, 0xf9, 0xba, 0xd9, 0xb0,
+	0xcf, 0xac, 0x6f, 0xa9, 0x0d, 0xfb, 0xac, 0xe4, 0x69, 0x34, 0x52, 0x55, 0x7b, 0x88, 0x6c, 0x50,
+	0xb5, 0xf8, 0x14, 0xda, 0xa0, 0xaa, 0xed, 0xfd, 0x32, 0x52, 0xd5, 0x78, 0x37, 0x6c, 0x50, 0xd5,
+	0xf6, 0x76, 0xd9, 0xa0, 0xaa, 0xfd, 0xc9, 0xf1, 0xf7, 0x61, 0xcd, 0xfa, 0xbe, 0xd7, 0x79, 0xb9,
+	0x50, 0xeb, 0x62, 0x7f, 0x7e, 0xdc, 0x78, 0x65, 0x74, 0x47, 0x5a, 0xeb, 0x53, 0x58, 0x2e, 0xbc,
+	0xb5, 0x75, 0x6c, 0xc7, 0x93, 0x7f, 0x09, 0xdc, 0x78, 0x61, 0x78, 0xa7, 0xcc, 0x1a, 0xce, 0x95,
+	0x24, 0x1a, 0xd6, 0xb0, 0xbd, 0x24, 0xd4, 0xb0, 0x86, 0xcb, 0xea, 0x21, 0x91, 0xf2, 0x46, 0x29,
+	0x9b, 0x41, 0x79, 0x5b, 0x81, 0x9e, 0x41, 0x79, 0x6b, 0x15, 0x5c, 0x26, 0x0c, 0xc9, 0x25, 0x2d,
+	0x0a, 0x43, 0xa3, 0x1c, 0xce, 0x22, 0x0c, 0xcd, 0x4a, 0x36, 0x4e, 0xde, 0x42, 0x15, 0x8f, 0x41,
+	0xde, 0xb2, 0x92, 0x25, 0x83, 0xbc, 0xe5, 0x85, 0x40, 0x88, 0xb0, 0x5e, 0x3a, 0x62, 0x20, 0x6c,
+	0x29, 0xb3, 0x31, 0x10, 0xb6, 0xd6, 0x9c, 0xe0, 0x79, 0xe5, 0x0a, 0x20, 0x8c, 0xf3, 0xb2, 0x57,
+	0x75, 0x18, 0xe7, 0x55, 0x56, 0x3f, 0x11, 0x80, 0x53, 0xac, 0x4d, 0x70, 0x8c, 0x30, 0x42, 0x59,
+	0x19, 0x44, 0xe3, 0xc5, 0x11, 0xbd, 0x32, 0x6a, 0x17, 0xaa, 0x10, 0x0c, 0x6a, 0x97, 0x95, 0x3a,
+	0x18, 0xd4, 0x2e, 0x2d, 0x64, 0xe0, 0xe6, 0xa9, 0xa5, 0xd2, 0xc0, 0x30, 0x38, 0xca, 0x0b, 0x1d,
+	0x0c, 0x83, 0x63, 0x48, 0xc1, 0x02, 0x19, 0xc1, 0x43, 0x57, 0xb9, 0x3b, 0xde, 0x2a, 0xc3, 0xca,
+	0x15, 0xf8, 0x41, 0x9b, 0xf9, 0x7f, 0xf3, 0xa0, 0xad, 0xf5, 0x04, 0xe6, 0x41, 0x97, 0x94, 0x0f,
+	0x48, 0x07, 0xb8, 0x74, 0xe6, 0xbb, 0xa3, 0x67, 0x2e, 0x2b, 0x4c, 0xf8, 0x65, 0x11, 0xb0, 0x45,
+	0x4b, 0xcd, 0xd9, 0x28, 0x18, 0x6f, 0x6a, 0x9e, 0x4d, 0x4b, 0x4b, 0xe6, 0xd7, 0xd9, 0x83, 0x85,
+	0x86, 0x2d, 0x3c, 0x34, 0xbe, 0x69, 0xd8, 0xc2, 0x23, 0xa2, 0x9a, 0xa8, 0x68, 0xb4, 0xe8, 0x94,
+	0xa1, 0x68, 0x8a, 0x01, 0x33, 0x43, 0xd1, 0xd8, 0x82, 0x5a, 0x48, 0xd5, 0x5c, 0x70, 0xd8, 0xa0,
+	0xaa, 0x3d, 0x09, 0x62, 0x50, 0xb5, 0x2c, 0xe5, 0x81, 0x5c, 0x53, 0x08, 0x3b, 0x1b, 0x5c, 0x53,
+	0x16, 0x7c, 0x37, 0xb8, 0xa6, 0x34, 0x72, 0xbd, 0xfd, 0xb3, 0x09, 0x95, 0xa7, 0xda, 0x43, 0x62,
+	0xb1, 0x58, 0x05, 0xcb, 0x50, 0x76, 0xe9, 0x79, 0x2a, 0x43, 0x76, 0x59, 0xf2, 0x5a, 0x86, 0xec,
+	0xb2, 0x26, 0xb8, 0x70, 0x42, 0x3d, 0x59, 0x67, 0x4c, 0x68, 0x49, 0x68, 0x1a, 0x13, 0xda, 0xb2,
+	0x7c, 0xdc, 0x94, 0xcd, 0x72, 0x74, 0x86, 0x29, 0x5b, 0x48, 0xfe, 0x19, 0xa6, 0x6c, 0x31, 0xb1,
+	0xc7, 0x2f, 0x83, 0x96, 0xc2, 0x33, 0x2e, 0x43, 0x31, 0xe1, 0x67, 0x5c, 0x06, 0x4b, 0xe6, 0x8f,
+	0x1f, 0x59, 0x2e, 0x25, 0xb6, 0xbf, 0x63, 0x1c, 0x59, 0x59, 0x3e, 0xcf, 0x38, 0xb2, 0xd2, 0xac,
+	0x9a, 0x73, 0x02, 0xab, 0xb6, 0x14, 0x81, 0x63, 0x0a, 0x97, 0xd2, 0xec, 0x83, 0xe1, 0xc4, 0x0d,
+	0xcb, 0x35, 0x1c, 0x4d, 0x89, 0xbf, 0x9e, 0x7c, 0xfb, 0x7f, 0x01, 0x41, 0xc1, 0x91, 0xdb, 0x87,
+	0x52, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// VersionServiceClient is the client API for VersionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VersionServiceClient interface {
+	Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error)
+}
+
+type versionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVersionServiceClient(cc *grpc.ClientConn) VersionServiceClient {
+	return &versionServiceClient{cc}
+}
+
+func (c *versionServiceClient) Version(ctx context.Context, in *VersionRequest, opts ...grpc.CallOption) (*VersionResponse, error) {
+	out := new(VersionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.VersionService/Version", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VersionServiceServer is the server API for VersionService service.
+type VersionServiceServer interface {
+	Version(context.Context, *VersionRequest) (*VersionResponse, error)
+}
+
+// UnimplementedVersionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedVersionServiceServer struct {
+}
+
+func (*UnimplementedVersionServiceServer) Version(ctx context.Context, req *VersionRequest) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+
+func RegisterVersionServiceServer(s *grpc.Server, srv VersionServiceServer) {
+	s.RegisterService(&_VersionService_serviceDesc, srv)
+}
+
+func _VersionService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VersionServiceServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.VersionService/Version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VersionServiceServer).Version(ctx, req.(*VersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _VersionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "walletrpc.VersionService",
+	HandlerType: (*VersionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _VersionService_Version_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+// WalletServiceClient is the client API for WalletService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletServiceClient interface {
+	// Queries
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error)
+	AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error)
+	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error)
+	AddressBalance(ctx context.Context, in *AddressBalanceRequest, opts ...grpc.CallOption) (*AddressBalanceResponse, error)
+	CurrentAddress(ctx context.Context, in *CurrentAddressRequest, opts ...grpc.CallOption) (*CurrentAddressResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	TotalFeesPaid(ctx context.Context, in *TotalFeesPaidRequest, opts ...grpc.CallOption) (*TotalFeesPaidResponse, error)
+	MempoolStatus(ctx context.Context, in *MempoolStatusRequest, opts ...grpc.CallOption) (*MempoolStatusResponse, error)
+	AddressUsage(ctx context.Context, in *AddressUsageRequest, opts ...grpc.CallOption) (*AddressUsageResponse, error)
+	TotalReceivedByAddress(ctx context.Context, in *TotalReceivedByAddressRequest, opts ...grpc.CallOption) (*TotalReceivedByAddressResponse, error)
+	TotalReceivedByAccount(ctx context.Context, in *TotalReceivedByAccountRequest, opts ...grpc.CallOption) (*TotalReceivedByAccountResponse, error)
+	ImmatureCoinbaseOutputs(ctx context.Context, in *ImmatureCoinbaseOutputsRequest, opts ...grpc.CallOption) (*ImmatureCoinbaseOutputsResponse, error)
+	UnlockState(ctx context.Context, in *UnlockStateRequest, opts ...grpc.CallOption) (*UnlockStateResponse, error)
+	WalletInfo(ctx context.Context, in *WalletInfoRequest, opts ...grpc.CallOption) (*WalletInfoResponse, error)
+	GetAccountAddresses(ctx context.Context, in *GetAccountAddressesRequest, opts ...grpc.CallOption) (*GetAccountAddressesResponse, error)
+	GetAccountExtendedPubKey(ctx context.Context, in *GetAccountExtendedPubKeyRequest, opts ...grpc.CallOption) (*GetAccountExtendedPubKeyResponse, error)
+	// Notifications
+	TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error)
+	SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error)
+	AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error)
+	RescanNotifications(ctx context.Context, in *RescanNotificationsRequest, opts ...grpc.CallOption) (WalletService_RescanNotificationsClient, error)
+	AddressPaymentNotifications(ctx context.Context, in *AddressPaymentNotificationsRequest, opts ...grpc.CallOption) (WalletService_AddressPaymentNotificationsClient, error)
+	// Control
+	ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error)
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error)
+	RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error)
+	NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error)
+	DiscoverAccounts(ctx context.Context, in *DiscoverAccountsRequest, opts ...grpc.CallOption) (*DiscoverAccountsResponse, error)
+	NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error)
+	NextAddresses(ctx context.Context, in *NextAddressesRequest, opts ...grpc.CallOption) (*NextAddressesResponse, error)
+	ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error)
+	DumpPrivateKey(ctx context.Context, in *DumpPrivateKeyRequest, opts ...grpc.CallOption) (*DumpPrivateKeyResponse, error)
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	CreateMultisigAddress(ctx context.Context, in *CreateMultisigAddressRequest, opts ...grpc.CallOption) (*CreateMultisigAddressResponse, error)
+	ImportPrunedFunds(ctx context.Context, in *ImportPrunedFundsRequest, opts ...grpc.CallOption) (*ImportPrunedFundsResponse, error)
+	FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error)
+	LockedOutputs(ctx context.Context, in *LockedOutputsRequest, opts ...grpc.CallOption) (*LockedOutputsResponse, error)
+	UnlockOutput(ctx context.Context, in *UnlockOutputRequest, opts ...grpc.CallOption) (*UnlockOutputResponse, error)
+	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
+	SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error)
+	SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+	PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error)
+	RemoveTransaction(ctx context.Context, in *RemoveTransactionRequest, opts ...grpc.CallOption) (*RemoveTransactionResponse, error)
+	SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*SetTransactionLabelResponse, error)
+	GetTransactionLabel(ctx context.Context, in *GetTransactionLabelRequest, opts ...grpc.CallOption) (*GetTransactionLabelResponse, error)
+	SetAddressLabel(ctx context.Context, in *SetAddressLabelRequest, opts ...grpc.CallOption) (*SetAddressLabelResponse, error)
+	GetAddressLabel(ctx context.Context, in *GetAddressLabelRequest, opts ...grpc.CallOption) (*GetAddressLabelResponse, error)
+	Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error)
+	// Payment Requests
+	DownloadPaymentRequest(ctx context.Context, in *DownloadPaymentRequestRequest, opts ...grpc.CallOption) (*DownloadPaymentRequestResponse, error)
+	PostPayment(ctx context.Context, in *PostPaymentRequest, opts ...grpc.CallOption) (*PostPaymentResponse, error)
+	// Utilities
+	ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error)
+	ValidateAddresses(ctx context.Context, in *ValidateAddressesRequest, opts ...grpc.CallOption) (*ValidateAddressesResponse, error)
+}
+
+type walletServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Network(ctx context.Context, in *NetworkRequest, opts ...grpc.CallOption) (*NetworkResponse, error) {
+	out := new(NetworkResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Network", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AccountNumber(ctx context.Context, in *AccountNumberRequest, opts ...grpc.CallOption) (*AccountNumberResponse, error) {
+	out := new(AccountNumberResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AccountNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Accounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Balance(ctx context.Context, in *BalanceRequest, opts ...grpc.CallOption) (*BalanceResponse, error) {
+	out := new(BalanceResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AddressBalance(ctx context.Context, in *AddressBalanceRequest, opts ...grpc.CallOption) (*AddressBalanceResponse, error) {
+	out := new(AddressBalanceResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AddressBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CurrentAddress(ctx context.Context, in *CurrentAddressRequest, opts ...grpc.CallOption) (*CurrentAddressResponse, error) {
+	out := new(CurrentAddressResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CurrentAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TotalFeesPaid(ctx context.Context, in *TotalFeesPaidRequest, opts ...grpc.CallOption) (*TotalFeesPaidResponse, error) {
+	out := new(TotalFeesPaidResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/TotalFeesPaid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MempoolStatus(ctx context.Context, in *MempoolStatusRequest, opts ...grpc.CallOption) (*MempoolStatusResponse, error) {
+	out := new(MempoolStatusResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/MempoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AddressUsage(ctx context.Context, in *AddressUsageRequest, opts ...grpc.CallOption) (*AddressUsageResponse, error) {
+	out := new(AddressUsageResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/AddressUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TotalReceivedByAddress(ctx context.Context, in *TotalReceivedByAddressRequest, opts ...grpc.CallOption) (*TotalReceivedByAddressResponse, error) {
+	out := new(TotalReceivedByAddressResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/TotalReceivedByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TotalReceivedByAccount(ctx context.Context, in *TotalReceivedByAccountRequest, opts ...grpc.CallOption) (*TotalReceivedByAccountResponse, error) {
+	out := new(TotalReceivedByAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/TotalReceivedByAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImmatureCoinbaseOutputs(ctx context.Context, in *ImmatureCoinbaseOutputsRequest, opts ...grpc.CallOption) (*ImmatureCoinbaseOutputsResponse, error) {
+	out := new(ImmatureCoinbaseOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ImmatureCoinbaseOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UnlockState(ctx context.Context, in *UnlockStateRequest, opts ...grpc.CallOption) (*UnlockStateResponse, error) {
+	out := new(UnlockStateResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/UnlockState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) WalletInfo(ctx context.Context, in *WalletInfoRequest, opts ...grpc.CallOption) (*WalletInfoResponse, error) {
+	out := new(WalletInfoResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/WalletInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetAccountAddresses(ctx context.Context, in *GetAccountAddressesRequest, opts ...grpc.CallOption) (*GetAccountAddressesResponse, error) {
+	out := new(GetAccountAddressesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetAccountAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetAccountExtendedPubKey(ctx context.Context, in *GetAccountExtendedPubKeyRequest, opts ...grpc.CallOption) (*GetAccountExtendedPubKeyResponse, error) {
+	out := new(GetAccountExtendedPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetAccountExtendedPubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TransactionNotifications(ctx context.Context, in *TransactionNotificationsRequest, opts ...grpc.CallOption) (WalletService_TransactionNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[0], "/walletrpc.WalletService/TransactionNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceTransactionNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_TransactionNotificationsClient interface {
+	Recv() (*TransactionNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceTransactionNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceTransactionNotificationsClient) Recv() (*TransactionNotificationsResponse, error) {
+	m := new(TransactionNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) SpentnessNotifications(ctx context.Context, in *SpentnessNotificationsRequest, opts ...grpc.CallOption) (WalletService_SpentnessNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[1], "/walletrpc.WalletService/SpentnessNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceSpentnessNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_SpentnessNotificationsClient interface {
+	Recv() (*SpentnessNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceSpentnessNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceSpentnessNotificationsClient) Recv() (*SpentnessNotificationsResponse, error) {
+	m := new(SpentnessNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) AccountNotifications(ctx context.Context, in *AccountNotificationsRequest, opts ...grpc.CallOption) (WalletService_AccountNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[2], "/walletrpc.WalletService/AccountNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceAccountNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_AccountNotificationsClient interface {
+	Recv() (*AccountNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceAccountNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceAccountNotificationsClient) Recv() (*AccountNotificationsResponse, error) {
+	m := new(AccountNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) RescanNotifications(ctx context.Context, in *RescanNotificationsRequest, opts ...grpc.CallOption) (WalletService_RescanNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[3], "/walletrpc.WalletService/RescanNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceRescanNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_RescanNotificationsClient interface {
+	Recv() (*RescanNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceRescanNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceRescanNotificationsClient) Recv() (*RescanNotificationsResponse, error) {
+	m := new(RescanNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) AddressPaymentNotifications(ctx context.Context, in *AddressPaymentNotificationsRequest, opts ...grpc.CallOption) (WalletService_AddressPaymentNotificationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WalletService_serviceDesc.Streams[4], "/walletrpc.WalletService/AddressPaymentNotifications", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceAddressPaymentNotificationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_AddressPaymentNotificationsClient interface {
+	Recv() (*AddressPaymentNotificationsResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceAddressPaymentNotificationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceAddressPaymentNotificationsClient) Recv() (*AddressPaymentNotificationsResponse, error) {
+	m := new(AddressPaymentNotificationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
+	out := new(ChangePassphraseResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/UnlockWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) LockWallet(ctx context.Context, in *LockWalletRequest, opts ...grpc.CallOption) (*LockWalletResponse, error) {
+	out := new(LockWalletResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/LockWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error) {
+	out := new(RenameAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/RenameAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error) {
+	out := new(NextAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/NextAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DiscoverAccounts(ctx context.Context, in *DiscoverAccountsRequest, opts ...grpc.CallOption) (*DiscoverAccountsResponse, error) {
+	out := new(DiscoverAccountsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/DiscoverAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error) {
+	out := new(NextAddressResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/NextAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) NextAddresses(ctx context.Context, in *NextAddressesRequest, opts ...grpc.CallOption) (*NextAddressesResponse, error) {
+	out := new(NextAddressesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/NextAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error) {
+	out := new(ImportPrivateKeyResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ImportPrivateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DumpPrivateKey(ctx context.Context, in *DumpPrivateKeyRequest, opts ...grpc.CallOption) (*DumpPrivateKeyResponse, error) {
+	out := new(DumpPrivateKeyResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/DumpPrivateKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	out := new(SignMessageResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SignMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
+	out := new(VerifyMessageResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/VerifyMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateMultisigAddress(ctx context.Context, in *CreateMultisigAddressRequest, opts ...grpc.CallOption) (*CreateMultisigAddressResponse, error) {
+	out := new(CreateMultisigAddressResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CreateMultisigAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ImportPrunedFunds(ctx context.Context, in *ImportPrunedFundsRequest, opts ...grpc.CallOption) (*ImportPrunedFundsResponse, error) {
+	out := new(ImportPrunedFundsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ImportPrunedFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error) {
+	out := new(FundTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FundTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) LockedOutputs(ctx context.Context, in *LockedOutputsRequest, opts ...grpc.CallOption) (*LockedOutputsResponse, error) {
+	out := new(LockedOutputsResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/LockedOutputs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UnlockOutput(ctx context.Context, in *UnlockOutputRequest, opts ...grpc.CallOption) (*UnlockOutputResponse, error) {
+	out := new(UnlockOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/UnlockOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error) {
+	out := new(CreateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CreateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error) {
+	out := new(SweepAccountResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SweepAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SignTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error) {
+	out := new(PublishTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PublishTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RemoveTransaction(ctx context.Context, in *RemoveTransactionRequest, opts ...grpc.CallOption) (*RemoveTransactionResponse, error) {
+	out := new(RemoveTransactionResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/RemoveTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*SetTransactionLabelResponse, error) {
+	out := new(SetTransactionLabelResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SetTransactionLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransactionLabel(ctx context.Context, in *GetTransactionLabelRequest, opts ...grpc.CallOption) (*GetTransactionLabelResponse, error) {
+	out := new(GetTransactionLabelResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetTransactionLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetAddressLabel(ctx context.Context, in *SetAddressLabelRequest, opts ...grpc.CallOption) (*SetAddressLabelResponse, error) {
+	out := new(SetAddressLabelResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SetAddressLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetAddressLabel(ctx context.Context, in *GetAddressLabelRequest, opts ...grpc.CallOption) (*GetAddressLabelResponse, error) {
+	out := new(GetAddressLabelResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/GetAddressLabel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &walletServiceRescanNotificationsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	return out, nil
+}
+
+func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error) {
+	out := new(RescanResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Rescan", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	return out, nil
+}
+
+func (c *walletServiceClient) DownloadPaymentRequest(ctx context.Context, in *DownloadPaymentRequestRequest, opts ...grpc.CallOption) (*DownloadPaymentRequestResponse, error) {
+	out := new(DownloadPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/DownloadPaymentRequest", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return x, nil
+	return out, nil
 }
 
-type WalletService_RescanNotificationsClient interface {
-	Recv() (*RescanNotificationsResponse, error)
-	grpc.ClientStream
+func (c *walletServiceClient) PostPayment(ctx context.Context, in *PostPaymentRequest, opts ...grpc.CallOption) (*PostPaymentResponse, error) {
+	out := new(PostPaymentResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PostPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type walletServiceRescanNotificationsClient struct {
-	grpc.ClientStream
+func (c *walletServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
+	out := new(ValidateAddressResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ValidateAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *walletServiceRescanNotificationsClient) Recv() (*RescanNotificationsResponse, error) {
-	m := new(RescanNotificationsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *walletServiceClient) ValidateAddresses(ctx context.Context, in *ValidateAddressesRequest, opts ...grpc.CallOption) (*ValidateAddressesResponse, error) {
+	out := new(ValidateAddressesResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ValidateAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+type WalletServiceServer interface {
+	// Queries
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	Network(context.Context, *NetworkRequest) (*NetworkResponse, error)
+	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
+	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
+	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
+	AddressBalance(context.Context, *AddressBalanceRequest) (*AddressBalanceResponse, error)
+	CurrentAddress(context.Context, *CurrentAddressRequest) (*CurrentAddressResponse, error)
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	TotalFeesPaid(context.Context, *TotalFeesPaidRequest) (*TotalFeesPaidResponse, error)
+	MempoolStatus(context.Context, *MempoolStatusRequest) (*MempoolStatusResponse, error)
+	AddressUsage(context.Context, *AddressUsageRequest) (*AddressUsageResponse, error)
+	TotalReceivedByAddress(context.Context, *TotalReceivedByAddressRequest) (*TotalReceivedByAddressResponse, error)
+	TotalReceivedByAccount(context.Context, *TotalReceivedByAccountRequest) (*TotalReceivedByAccountResponse, error)
+	ImmatureCoinbaseOutputs(context.Context, *ImmatureCoinbaseOutputsRequest) (*ImmatureCoinbaseOutputsResponse, error)
+	UnlockState(context.Context, *UnlockStateRequest) (*UnlockStateResponse, error)
+	WalletInfo(context.Context, *WalletInfoRequest) (*WalletInfoResponse, error)
+	GetAccountAddresses(context.Context, *GetAccountAddressesRequest) (*GetAccountAddressesResponse, error)
+	GetAccountExtendedPubKey(context.Context, *GetAccountExtendedPubKeyRequest) (*GetAccountExtendedPubKeyResponse, error)
+	// Notifications
+	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
+	SpentnessNotifications(*SpentnessNotificationsRequest, WalletService_SpentnessNotificationsServer) error
+	AccountNotifications(*AccountNotificationsRequest, WalletService_AccountNotificationsServer) error
+	RescanNotifications(*RescanNotificationsRequest, WalletService_RescanNotificationsServer) error
+	AddressPaymentNotifications(*AddressPaymentNotificationsRequest, WalletService_AddressPaymentNotificationsServer) error
+	// Control
+	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
+	UnlockWallet(context.Context, *UnlockWalletRequest) (*UnlockWalletResponse, error)
+	LockWallet(context.Context, *LockWalletRequest) (*LockWalletResponse, error)
+	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
+	NextAccount(context.Context, *NextAccountRequest) (*NextAccountResponse, error)
+	DiscoverAccounts(context.Context, *DiscoverAccountsRequest) (*DiscoverAccountsResponse, error)
+	NextAddress(context.Context, *NextAddressRequest) (*NextAddressResponse, error)
+	NextAddresses(context.Context, *NextAddressesRequest) (*NextAddressesResponse, error)
+	ImportPrivateKey(context.Context, *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error)
+	DumpPrivateKey(context.Context, *DumpPrivateKeyRequest) (*DumpPrivateKeyResponse, error)
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	CreateMultisigAddress(context.Context, *CreateMultisigAddressRequest) (*CreateMultisigAddressResponse, error)
+	ImportPrunedFunds(context.Context, *ImportPrunedFundsRequest) (*ImportPrunedFundsResponse, error)
+	FundTransaction(context.Context, *FundTransactionRequest) (*FundTransactionResponse, error)
+	LockedOutputs(context.Context, *LockedOutputsRequest) (*LockedOutputsResponse, error)
+	UnlockOutput(context.Context, *UnlockOutputRequest) (*UnlockOutputResponse, error)
+	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
+	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
+	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
+	PublishTransaction(context.Context, *PublishTransactionRequest) (*PublishTransactionResponse, error)
+	RemoveTransaction(context.Context, *RemoveTransactionRequest) (*RemoveTransactionResponse, error)
+	SetTransactionLabel(context.Context, *SetTransactionLabelRequest) (*SetTransactionLabelResponse, error)
+	GetTransactionLabel(context.Context, *GetTransactionLabelRequest) (*GetTransactionLabelResponse, error)
+	SetAddressLabel(context.Context, *SetAddressLabelRequest) (*SetAddressLabelResponse, error)
+	GetAddressLabel(context.Context, *GetAddressLabelRequest) (*GetAddressLabelResponse, error)
+	Rescan(context.Context, *RescanRequest) (*RescanResponse, error)
+	// Payment Requests
+	DownloadPaymentRequest(context.Context, *DownloadPaymentRequestRequest) (*DownloadPaymentRequestResponse, error)
+	PostPayment(context.Context, *PostPaymentRequest) (*PostPaymentResponse, error)
+	// Utilities
+	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
+	ValidateAddresses(context.Context, *ValidateAddressesRequest) (*ValidateAddressesResponse, error)
+}
+
+// UnimplementedWalletServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWalletServiceServer struct {
+}
+
+func (*UnimplementedWalletServiceServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedWalletServiceServer) Network(ctx context.Context, req *NetworkRequest) (*NetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Network not implemented")
+}
+func (*UnimplementedWalletServiceServer) AccountNumber(ctx context.Context, req *AccountNumberRequest) (*AccountNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountNumber not implemented")
+}
+func (*UnimplementedWalletServiceServer) Accounts(ctx context.Context, req *AccountsRequest) (*AccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+}
+func (*UnimplementedWalletServiceServer) Balance(ctx context.Context, req *BalanceRequest) (*BalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (*UnimplementedWalletServiceServer) AddressBalance(ctx context.Context, req *AddressBalanceRequest) (*AddressBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressBalance not implemented")
+}
+func (*UnimplementedWalletServiceServer) CurrentAddress(ctx context.Context, req *CurrentAddressRequest) (*CurrentAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentAddress not implemented")
+}
+func (*UnimplementedWalletServiceServer) GetTransactions(ctx context.Context, req *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (*UnimplementedWalletServiceServer) TotalFeesPaid(ctx context.Context, req *TotalFeesPaidRequest) (*TotalFeesPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalFeesPaid not implemented")
+}
+func (*UnimplementedWalletServiceServer) MempoolStatus(ctx context.Context, req *MempoolStatusRequest) (*MempoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MempoolStatus not implemented")
+}
+func (*UnimplementedWalletServiceServer) AddressUsage(ctx context.Context, req *AddressUsageRequest) (*AddressUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressUsage not implemented")
+}
+func (*UnimplementedWalletServiceServer) TotalReceivedByAddress(ctx context.Context, req *TotalReceivedByAddressRequest) (*TotalReceivedByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalReceivedByAddress not implemented")
+}
+func (*UnimplementedWalletServiceServer) TotalReceivedByAccount(ctx context.Context, req *TotalReceivedByAccountRequest) (*TotalReceivedByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalReceivedByAccount not implemented")
+}
+func (*UnimplementedWalletServiceServer) ImmatureCoinbaseOutputs(ctx context.Context, req *ImmatureCoinbaseOutputsRequest) (*ImmatureCoinbaseOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImmatureCoinbaseOutputs not implemented")
+}
+func (*UnimplementedWalletServiceServer) UnlockState(ctx context.Context, req *UnlockStateRequest) (*UnlockStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockState not implemented")
+}
+func (*UnimplementedWalletServiceServer) WalletInfo(ctx context.Context, req *WalletInfoRequest) (*WalletInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalletInfo not implemented")
+}
+func (*UnimplementedWalletServiceServer) GetAccountAddresses(ctx context.Context, req *GetAccountAddressesRequest) (*GetAccountAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountAddresses not implemented")
+}
+func (*UnimplementedWalletServiceServer) GetAccountExtendedPubKey(ctx context.Context, req *GetAccountExtendedPubKeyRequest) (*GetAccountExtendedPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountExtendedPubKey not implemented")
+}
+func (*UnimplementedWalletServiceServer) TransactionNotifications(req *TransactionNotificationsRequest, srv WalletService_TransactionNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TransactionNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) SpentnessNotifications(req *SpentnessNotificationsRequest, srv WalletService_SpentnessNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SpentnessNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) AccountNotifications(req *AccountNotificationsRequest, srv WalletService_AccountNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AccountNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) RescanNotifications(req *RescanNotificationsRequest, srv WalletService_RescanNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method RescanNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) AddressPaymentNotifications(req *AddressPaymentNotificationsRequest, srv WalletService_AddressPaymentNotificationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddressPaymentNotifications not implemented")
+}
+func (*UnimplementedWalletServiceServer) ChangePassphrase(ctx context.Context, req *ChangePassphraseRequest) (*ChangePassphraseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassphrase not implemented")
+}
+func (*UnimplementedWalletServiceServer) UnlockWallet(ctx context.Context, req *UnlockWalletRequest) (*UnlockWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockWallet not implemented")
+}
+func (*UnimplementedWalletServiceServer) LockWallet(ctx context.Context, req *LockWalletRequest) (*LockWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockWallet not implemented")
+}
+func (*UnimplementedWalletServiceServer) RenameAccount(ctx context.Context, req *RenameAccountRequest) (*RenameAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameAccount not implemented")
+}
+func (*UnimplementedWalletServiceServer) NextAccount(ctx context.Context, req *NextAccountRequest) (*NextAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextAccount not implemented")
+}
+func (*UnimplementedWalletServiceServer) DiscoverAccounts(ctx context.Context, req *DiscoverAccountsRequest) (*DiscoverAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverAccounts not implemented")
+}
+func (*UnimplementedWalletServiceServer) NextAddress(ctx context.Context, req *NextAddressRequest) (*NextAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextAddress not implemented")
+}
+func (*UnimplementedWalletServiceServer) NextAddresses(ctx context.Context, req *NextAddressesRequest) (*NextAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextAddresses not implemented")
+}
+func (*UnimplementedWalletServiceServer) ImportPrivateKey(ctx context.Context, req *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPrivateKey not implemented")
+}
+func (*UnimplementedWalletServiceServer) DumpPrivateKey(ctx context.Context, req *DumpPrivateKeyRequest) (*DumpPrivateKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpPrivateKey not implemented")
+}
+func (*UnimplementedWalletServiceServer) SignMessage(ctx context.Context, req *SignMessageRequest) (*SignMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMessage not implemented")
+}
+func (*UnimplementedWalletServiceServer) VerifyMessage(ctx context.Context, req *VerifyMessageRequest) (*VerifyMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMessage not implemented")
+}
+func (*UnimplementedWalletServiceServer) CreateMultisigAddress(ctx context.Context, req *CreateMultisigAddressRequest) (*CreateMultisigAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisigAddress not implemented")
+}
+func (*UnimplementedWalletServiceServer) ImportPrunedFunds(ctx context.Context, req *ImportPrunedFundsRequest) (*ImportPrunedFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPrunedFunds not implemented")
+}
+func (*UnimplementedWalletServiceServer) FundTransaction(ctx context.Context, req *FundTransactionRequest) (*FundTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) LockedOutputs(ctx context.Context, req *LockedOutputsRequest) (*LockedOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedOutputs not implemented")
+}
+func (*UnimplementedWalletServiceServer) UnlockOutput(ctx context.Context, req *UnlockOutputRequest) (*UnlockOutputResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockOutput not implemented")
+}
+func (*UnimplementedWalletServiceServer) CreateTransaction(ctx context.Context, req *CreateTransactionRequest) (*CreateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) SweepAccount(ctx context.Context, req *SweepAccountRequest) (*SweepAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepAccount not implemented")
+}
+func (*UnimplementedWalletServiceServer) SignTransaction(ctx context.Context, req *SignTransactionRequest) (*SignTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) PublishTransaction(ctx context.Context, req *PublishTransactionRequest) (*PublishTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) RemoveTransaction(ctx context.Context, req *RemoveTransactionRequest) (*RemoveTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTransaction not implemented")
+}
+func (*UnimplementedWalletServiceServer) SetTransactionLabel(ctx context.Context, req *SetTransactionLabelRequest) (*SetTransactionLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionLabel not implemented")
+}
+func (*UnimplementedWalletServiceServer) GetTransactionLabel(ctx context.Context, req *GetTransactionLabelRequest) (*GetTransactionLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionLabel not implemented")
+}
+func (*UnimplementedWalletServiceServer) SetAddressLabel(ctx context.Context, req *SetAddressLabelRequest) (*SetAddressLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAddressLabel not implemented")
+}
+func (*UnimplementedWalletServiceServer) GetAddressLabel(ctx context.Context, req *GetAddressLabelRequest) (*GetAddressLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddressLabel not implemented")
+}
+func (*UnimplementedWalletServiceServer) Rescan(ctx context.Context, req *RescanRequest) (*RescanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rescan not implemented")
+}
+func (*UnimplementedWalletServiceServer) DownloadPaymentRequest(ctx context.Context, req *DownloadPaymentRequestRequest) (*DownloadPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadPaymentRequest not implemented")
+}
+func (*UnimplementedWalletServiceServer) PostPayment(ctx context.Context, req *PostPaymentRequest) (*PostPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostPayment not implemented")
+}
+func (*UnimplementedWalletServiceServer) ValidateAddress(ctx context.Context, req *ValidateAddressRequest) (*ValidateAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAddress not implemented")
+}
+func (*UnimplementedWalletServiceServer) ValidateAddresses(ctx context.Context, req *ValidateAddressesRequest) (*ValidateAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateAddresses not implemented")
+}
+
+func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
+	s.RegisterService(&_WalletService_serviceDesc, srv)
+}
+
+func _WalletService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Network(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Network",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Network(ctx, req.(*NetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AccountNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AccountNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AccountNumber(ctx, req.(*AccountNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Accounts(ctx, req.(*AccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ChangePassphrase(ctx context.Context, in *ChangePassphraseRequest, opts ...grpc.CallOption) (*ChangePassphraseResponse, error) {
-	out := new(ChangePassphraseResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ChangePassphrase", in, out, opts...)
-	if err != nil {
+func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Balance(ctx, req.(*BalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) RenameAccount(ctx context.Context, in *RenameAccountRequest, opts ...grpc.CallOption) (*RenameAccountResponse, error) {
-	out := new(RenameAccountResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/RenameAccount", in, out, opts...)
-	if err != nil {
+func _WalletService_AddressBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AddressBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AddressBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AddressBalance(ctx, req.(*AddressBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) NextAccount(ctx context.Context, in *NextAccountRequest, opts ...grpc.CallOption) (*NextAccountResponse, error) {
-	out := new(NextAccountResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/NextAccount", in, out, opts...)
-	if err != nil {
+func _WalletService_CurrentAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrentAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CurrentAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/CurrentAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CurrentAddress(ctx, req.(*CurrentAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) NextAddress(ctx context.Context, in *NextAddressRequest, opts ...grpc.CallOption) (*NextAddressResponse, error) {
-	out := new(NextAddressResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/NextAddress", in, out, opts...)
-	if err != nil {
+func _WalletService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ImportPrivateKey(ctx context.Context, in *ImportPrivateKeyRequest, opts ...grpc.CallOption) (*ImportPrivateKeyResponse, error) {
-	out := new(ImportPrivateKeyResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ImportPrivateKey", in, out, opts...)
-	if err != nil {
+func _WalletService_TotalFeesPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalFeesPaidRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TotalFeesPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/TotalFeesPaid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TotalFeesPaid(ctx, req.(*TotalFeesPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) FundTransaction(ctx context.Context, in *FundTransactionRequest, opts ...grpc.CallOption) (*FundTransactionResponse, error) {
-	out := new(FundTransactionResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/FundTransaction", in, out, opts...)
-	if err != nil {
+func _WalletService_MempoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MempoolStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).MempoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/MempoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).MempoolStatus(ctx, req.(*MempoolStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error) {
-	out := new(CreateTransactionResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/CreateTransaction", in, out, opts...)
-	if err != nil {
+func _WalletService_AddressUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressUsageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AddressUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/AddressUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AddressUsage(ctx, req.(*AddressUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) SweepAccount(ctx context.Context, in *SweepAccountRequest, opts ...grpc.CallOption) (*SweepAccountResponse, error) {
-	out := new(SweepAccountResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SweepAccount", in, out, opts...)
-	if err != nil {
+func _WalletService_TotalReceivedByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalReceivedByAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TotalReceivedByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/TotalReceivedByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TotalReceivedByAddress(ctx, req.(*TotalReceivedByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) SignTransaction(ctx context.Context, in *SignTransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
-	out := new(SignTransactionResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/SignTransaction", in, out, opts...)
-	if err != nil {
+func _WalletService_TotalReceivedByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalReceivedByAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TotalReceivedByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/TotalReceivedByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TotalReceivedByAccount(ctx, req.(*TotalReceivedByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) PublishTransaction(ctx context.Context, in *PublishTransactionRequest, opts ...grpc.CallOption) (*PublishTransactionResponse, error) {
-	out := new(PublishTransactionResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PublishTransaction", in, out, opts...)
-	if err != nil {
+func _WalletService_ImmatureCoinbaseOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImmatureCoinbaseOutputsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ImmatureCoinbaseOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ImmatureCoinbaseOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ImmatureCoinbaseOutputs(ctx, req.(*ImmatureCoinbaseOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error) {
-	out := new(RescanResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/Rescan", in, out, opts...)
-	if err != nil {
+func _WalletService_UnlockState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UnlockState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/UnlockState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UnlockState(ctx, req.(*UnlockStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) DownloadPaymentRequest(ctx context.Context, in *DownloadPaymentRequestRequest, opts ...grpc.CallOption) (*DownloadPaymentRequestResponse, error) {
-	out := new(DownloadPaymentRequestResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/DownloadPaymentRequest", in, out, opts...)
-	if err != nil {
+func _WalletService_WalletInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalletInfoRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).WalletInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/WalletInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).WalletInfo(ctx, req.(*WalletInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) PostPayment(ctx context.Context, in *PostPaymentRequest, opts ...grpc.CallOption) (*PostPaymentResponse, error) {
-	out := new(PostPaymentResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/PostPayment", in, out, opts...)
-	if err != nil {
+func _WalletService_GetAccountAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountAddressesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetAccountAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/GetAccountAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetAccountAddresses(ctx, req.(*GetAccountAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) ValidateAddress(ctx context.Context, in *ValidateAddressRequest, opts ...grpc.CallOption) (*ValidateAddressResponse, error) {
-	out := new(ValidateAddressResponse)
-	err := c.cc.Invoke(ctx, "/walletrpc.WalletService/ValidateAddress", in, out, opts...)
-	if err != nil {
+func _WalletService_GetAccountExtendedPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountExtendedPubKeyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetAccountExtendedPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/GetAccountExtendedPubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetAccountExtendedPubKey(ctx, req.(*GetAccountExtendedPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// WalletServiceServer is the server API for WalletService service.
-type WalletServiceServer interface {
-	// Queries
-	Ping(context.Context, *PingRequest) (*PingResponse, error)
-	Network(context.Context, *NetworkRequest) (*NetworkResponse, error)
-	AccountNumber(context.Context, *AccountNumberRequest) (*AccountNumberResponse, error)
-	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
-	Balance(context.Context, *BalanceRequest) (*BalanceResponse, error)
-	CurrentAddress(context.Context, *CurrentAddressRequest) (*CurrentAddressResponse, error)
-	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
-	// Notifications
-	TransactionNotifications(*TransactionNotificationsRequest, WalletService_TransactionNotificationsServer) error
-	SpentnessNotifications(*SpentnessNotificationsRequest, WalletService_SpentnessNotificationsServer) error
-	AccountNotifications(*AccountNotificationsRequest, WalletService_AccountNotificationsServer) error
-	RescanNotifications(*RescanNotificationsRequest, WalletService_RescanNotificationsServer) error
-	// Control
-	ChangePassphrase(context.Context, *ChangePassphraseRequest) (*ChangePassphraseResponse, error)
-	RenameAccount(context.Context, *RenameAccountRequest) (*RenameAccountResponse, error)
-	NextAccount(context.Context, *NextAccountRequest) (*NextAccountResponse, error)
-	NextAddress(context.Context, *NextAddressRequest) (*NextAddressResponse, error)
-	ImportPrivateKey(context.Context, *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error)
-	FundTransaction(context.Context, *FundTransactionRequest) (*FundTransactionResponse, error)
-	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
-	SweepAccount(context.Context, *SweepAccountRequest) (*SweepAccountResponse, error)
-	SignTransaction(context.Context, *SignTransactionRequest) (*SignTransactionResponse, error)
-	PublishTransaction(context.Context, *PublishTransactionRequest) (*PublishTransactionResponse, error)
-	Rescan(context.Context, *RescanRequest) (*RescanResponse, error)
-	// Payment Requests
-	DownloadPaymentRequest(context.Context, *DownloadPaymentRequestRequest) (*DownloadPaymentRequestResponse, error)
-	PostPayment(context.Context, *PostPaymentRequest) (*PostPaymentResponse, error)
-	// Utilities
-	ValidateAddress(context.Context, *ValidateAddressRequest) (*ValidateAddressResponse, error)
+func _WalletService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).TransactionNotifications(m, &walletServiceTransactionNotificationsServer{stream})
 }
 
-// UnimplementedWalletServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedWalletServiceServer struct {
+type WalletService_TransactionNotificationsServer interface {
+	Send(*TransactionNotificationsResponse) error
+	grpc.ServerStream
 }
 
-func (*UnimplementedWalletServiceServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
-}
-func (*UnimplementedWalletServiceServer) Network(ctx context.Context, req *NetworkRequest) (*NetworkResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Network not implemented")
+type walletServiceTransactionNotificationsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) AccountNumber(ctx context.Context, req *AccountNumberRequest) (*AccountNumberResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountNumber not implemented")
+
+func (x *walletServiceTransactionNotificationsServer) Send(m *TransactionNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedWalletServiceServer) Accounts(ctx context.Context, req *AccountsRequest) (*AccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Accounts not implemented")
+
+func _WalletService_SpentnessNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SpentnessNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).SpentnessNotifications(m, &walletServiceSpentnessNotificationsServer{stream})
 }
-func (*UnimplementedWalletServiceServer) Balance(ctx context.Context, req *BalanceRequest) (*BalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+
+type WalletService_SpentnessNotificationsServer interface {
+	Send(*SpentnessNotificationsResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) CurrentAddress(ctx context.Context, req *CurrentAddressRequest) (*CurrentAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentAddress not implemented")
+
+type walletServiceSpentnessNotificationsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) GetTransactions(ctx context.Context, req *GetTransactionsRequest) (*GetTransactionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+
+func (x *walletServiceSpentnessNotificationsServer) Send(m *SpentnessNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedWalletServiceServer) TransactionNotifications(req *TransactionNotificationsRequest, srv WalletService_TransactionNotificationsServer) error {
-	return status.Errorf(codes.Unimplemented, "method TransactionNotifications not implemented")
+
+func _WalletService_AccountNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AccountNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).AccountNotifications(m, &walletServiceAccountNotificationsServer{stream})
 }
-func (*UnimplementedWalletServiceServer) SpentnessNotifications(req *SpentnessNotificationsRequest, srv WalletService_SpentnessNotificationsServer) error {
-	return status.Errorf(codes.Unimplemented, "method SpentnessNotifications not implemented")
+
+type WalletService_AccountNotificationsServer interface {
+	Send(*AccountNotificationsResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) AccountNotifications(req *AccountNotificationsRequest, srv WalletService_AccountNotificationsServer) error {
-	return status.Errorf(codes.Unimplemented, "method AccountNotifications not implemented")
+
+type walletServiceAccountNotificationsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) RescanNotifications(req *RescanNotificationsRequest, srv WalletService_RescanNotificationsServer) error {
-	return status.Errorf(codes.Unimplemented, "method RescanNotifications not implemented")
+
+func (x *walletServiceAccountNotificationsServer) Send(m *AccountNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedWalletServiceServer) ChangePassphrase(ctx context.Context, req *ChangePassphraseRequest) (*ChangePassphraseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangePassphrase not implemented")
+
+func _WalletService_RescanNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RescanNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).RescanNotifications(m, &walletServiceRescanNotificationsServer{stream})
 }
-func (*UnimplementedWalletServiceServer) RenameAccount(ctx context.Context, req *RenameAccountRequest) (*RenameAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenameAccount not implemented")
+
+type WalletService_RescanNotificationsServer interface {
+	Send(*RescanNotificationsResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) NextAccount(ctx context.Context, req *NextAccountRequest) (*NextAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NextAccount not implemented")
+
+type walletServiceRescanNotificationsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) NextAddress(ctx context.Context, req *NextAddressRequest) (*NextAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NextAddress not implemented")
+
+func (x *walletServiceRescanNotificationsServer) Send(m *RescanNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedWalletServiceServer) ImportPrivateKey(ctx context.Context, req *ImportPrivateKeyRequest) (*ImportPrivateKeyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportPrivateKey not implemented")
+
+func _WalletService_AddressPaymentNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddressPaymentNotificationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).AddressPaymentNotifications(m, &walletServiceAddressPaymentNotificationsServer{stream})
 }
-func (*UnimplementedWalletServiceServer) FundTransaction(ctx context.Context, req *FundTransactionRequest) (*FundTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundTransaction not implemented")
+
+type WalletService_AddressPaymentNotificationsServer interface {
+	Send(*AddressPaymentNotificationsResponse) error
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) CreateTransaction(ctx context.Context, req *CreateTransactionRequest) (*CreateTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+
+type walletServiceAddressPaymentNotificationsServer struct {
+	grpc.ServerStream
 }
-func (*UnimplementedWalletServiceServer) SweepAccount(ctx context.Context, req *SweepAccountRequest) (*SweepAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SweepAccount not implemented")
+
+func (x *walletServiceAddressPaymentNotificationsServer) Send(m *AddressPaymentNotificationsResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (*UnimplementedWalletServiceServer) SignTransaction(ctx context.Context, req *SignTransactionRequest) (*SignTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SignTransaction not implemented")
+
+func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePassphraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ChangePassphrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ChangePassphrase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ChangePassphrase(ctx, req.(*ChangePassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedWalletServiceServer) PublishTransaction(ctx context.Context, req *PublishTransactionRequest) (*PublishTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishTransaction not implemented")
+
+func _WalletService_UnlockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UnlockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/UnlockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UnlockWallet(ctx, req.(*UnlockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedWalletServiceServer) Rescan(ctx context.Context, req *RescanRequest) (*RescanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rescan not implemented")
+
+func _WalletService_LockWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).LockWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/LockWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).LockWallet(ctx, req.(*LockWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedWalletServiceServer) DownloadPaymentRequest(ctx context.Context, req *DownloadPaymentRequestRequest) (*DownloadPaymentRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DownloadPaymentRequest not implemented")
+
+func _WalletService_RenameAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RenameAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/RenameAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RenameAccount(ctx, req.(*RenameAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedWalletServiceServer) PostPayment(ctx context.Context, req *PostPaymentRequest) (*PostPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PostPayment not implemented")
+
+func _WalletService_NextAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NextAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/NextAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NextAccount(ctx, req.(*NextAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedWalletServiceServer) ValidateAddress(ctx context.Context, req *ValidateAddressRequest) (*ValidateAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateAddress not implemented")
+
+func _WalletService_DiscoverAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DiscoverAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/DiscoverAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DiscoverAccounts(ctx, req.(*DiscoverAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
-	s.RegisterService(&_WalletService_serviceDesc, srv)
+func _WalletService_NextAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).NextAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/NextAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).NextAddress(ctx, req.(*NextAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PingRequest)
+func _WalletService_NextAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Ping(ctx, in)
+		return srv.(WalletServiceServer).NextAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Ping",
+		FullMethod: "/walletrpc.WalletService/NextAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Ping(ctx, req.(*PingRequest))
+		return srv.(WalletServiceServer).NextAddresses(ctx, req.(*NextAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Network_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetworkRequest)
+func _WalletService_ImportPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPrivateKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Network(ctx, in)
+		return srv.(WalletServiceServer).ImportPrivateKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Network",
+		FullMethod: "/walletrpc.WalletService/ImportPrivateKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Network(ctx, req.(*NetworkRequest))
+		return srv.(WalletServiceServer).ImportPrivateKey(ctx, req.(*ImportPrivateKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_AccountNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountNumberRequest)
+func _WalletService_DumpPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DumpPrivateKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DumpPrivateKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/DumpPrivateKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DumpPrivateKey(ctx, req.(*DumpPrivateKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).AccountNumber(ctx, in)
+		return srv.(WalletServiceServer).SignMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/AccountNumber",
+		FullMethod: "/walletrpc.WalletService/SignMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).AccountNumber(ctx, req.(*AccountNumberRequest))
+		return srv.(WalletServiceServer).SignMessage(ctx, req.(*SignMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AccountsRequest)
+func _WalletService_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Accounts(ctx, in)
+		return srv.(WalletServiceServer).VerifyMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Accounts",
+		FullMethod: "/walletrpc.WalletService/VerifyMessage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Accounts(ctx, req.(*AccountsRequest))
+		return srv.(WalletServiceServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BalanceRequest)
+func _WalletService_CreateMultisigAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMultisigAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).Balance(ctx, in)
+		return srv.(WalletServiceServer).CreateMultisigAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/Balance",
+		FullMethod: "/walletrpc.WalletService/CreateMultisigAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).Balance(ctx, req.(*BalanceRequest))
+		return srv.(WalletServiceServer).CreateMultisigAddress(ctx, req.(*CreateMultisigAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_CurrentAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrentAddressRequest)
+func _WalletService_ImportPrunedFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPrunedFundsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CurrentAddress(ctx, in)
+		return srv.(WalletServiceServer).ImportPrunedFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/CurrentAddress",
+		FullMethod: "/walletrpc.WalletService/ImportPrunedFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CurrentAddress(ctx, req.(*CurrentAddressRequest))
+		return srv.(WalletServiceServer).ImportPrunedFunds(ctx, req.(*ImportPrunedFundsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsRequest)
+func _WalletService_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetTransactions(ctx, in)
+		return srv.(WalletServiceServer).FundTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/GetTransactions",
+		FullMethod: "/walletrpc.WalletService/FundTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+		return srv.(WalletServiceServer).FundTransaction(ctx, req.(*FundTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_TransactionNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(TransactionNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _WalletService_LockedOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockedOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(WalletServiceServer).TransactionNotifications(m, &walletServiceTransactionNotificationsServer{stream})
-}
-
-type WalletService_TransactionNotificationsServer interface {
-	Send(*TransactionNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceTransactionNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceTransactionNotificationsServer) Send(m *TransactionNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _WalletService_SpentnessNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SpentnessNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(WalletServiceServer).LockedOutputs(ctx, in)
 	}
-	return srv.(WalletServiceServer).SpentnessNotifications(m, &walletServiceSpentnessNotificationsServer{stream})
-}
-
-type WalletService_SpentnessNotificationsServer interface {
-	Send(*SpentnessNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceSpentnessNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceSpentnessNotificationsServer) Send(m *SpentnessNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _WalletService_AccountNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AccountNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/LockedOutputs",
 	}
-	return srv.(WalletServiceServer).AccountNotifications(m, &walletServiceAccountNotificationsServer{stream})
-}
-
-type WalletService_AccountNotificationsServer interface {
-	Send(*AccountNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceAccountNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceAccountNotificationsServer) Send(m *AccountNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _WalletService_RescanNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(RescanNotificationsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).LockedOutputs(ctx, req.(*LockedOutputsRequest))
 	}
-	return srv.(WalletServiceServer).RescanNotifications(m, &walletServiceRescanNotificationsServer{stream})
-}
-
-type WalletService_RescanNotificationsServer interface {
-	Send(*RescanNotificationsResponse) error
-	grpc.ServerStream
-}
-
-type walletServiceRescanNotificationsServer struct {
-	grpc.ServerStream
-}
-
-func (x *walletServiceRescanNotificationsServer) Send(m *RescanNotificationsResponse) error {
-	return x.ServerStream.SendMsg(m)
+	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ChangePassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePassphraseRequest)
+func _WalletService_UnlockOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockOutputRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ChangePassphrase(ctx, in)
+		return srv.(WalletServiceServer).UnlockOutput(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ChangePassphrase",
+		FullMethod: "/walletrpc.WalletService/UnlockOutput",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ChangePassphrase(ctx, req.(*ChangePassphraseRequest))
+		return srv.(WalletServiceServer).UnlockOutput(ctx, req.(*UnlockOutputRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_RenameAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RenameAccountRequest)
+func _WalletService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).RenameAccount(ctx, in)
+		return srv.(WalletServiceServer).CreateTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/RenameAccount",
+		FullMethod: "/walletrpc.WalletService/CreateTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).RenameAccount(ctx, req.(*RenameAccountRequest))
+		return srv.(WalletServiceServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_NextAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextAccountRequest)
+func _WalletService_SweepAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).NextAccount(ctx, in)
+		return srv.(WalletServiceServer).SweepAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/NextAccount",
+		FullMethod: "/walletrpc.WalletService/SweepAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).NextAccount(ctx, req.(*NextAccountRequest))
+		return srv.(WalletServiceServer).SweepAccount(ctx, req.(*SweepAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_NextAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NextAddressRequest)
+func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).NextAddress(ctx, in)
+		return srv.(WalletServiceServer).SignTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/NextAddress",
+		FullMethod: "/walletrpc.WalletService/SignTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).NextAddress(ctx, req.(*NextAddressRequest))
+		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_ImportPrivateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImportPrivateKeyRequest)
+func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).ImportPrivateKey(ctx, in)
+		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/ImportPrivateKey",
+		FullMethod: "/walletrpc.WalletService/PublishTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).ImportPrivateKey(ctx, req.(*ImportPrivateKeyRequest))
+		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_FundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FundTransactionRequest)
+func _WalletService_RemoveTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).FundTransaction(ctx, in)
+		return srv.(WalletServiceServer).RemoveTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/FundTransaction",
+		FullMethod: "/walletrpc.WalletService/RemoveTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).FundTransaction(ctx, req.(*FundTransactionRequest))
+		return srv.(WalletServiceServer).RemoveTransaction(ctx, req.(*RemoveTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransactionRequest)
+func _WalletService_SetTransactionLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CreateTransaction(ctx, in)
+		return srv.(WalletServiceServer).SetTransactionLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/CreateTransaction",
+		FullMethod: "/walletrpc.WalletService/SetTransactionLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
+		return srv.(WalletServiceServer).SetTransactionLabel(ctx, req.(*SetTransactionLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SweepAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SweepAccountRequest)
+func _WalletService_GetTransactionLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SweepAccount(ctx, in)
+		return srv.(WalletServiceServer).GetTransactionLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SweepAccount",
+		FullMethod: "/walletrpc.WalletService/GetTransactionLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SweepAccount(ctx, req.(*SweepAccountRequest))
+		return srv.(WalletServiceServer).GetTransactionLabel(ctx, req.(*GetTransactionLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SignTransactionRequest)
+func _WalletService_SetAddressLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAddressLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).SignTransaction(ctx, in)
+		return srv.(WalletServiceServer).SetAddressLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/SignTransaction",
+		FullMethod: "/walletrpc.WalletService/SetAddressLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).SignTransaction(ctx, req.(*SignTransactionRequest))
+		return srv.(WalletServiceServer).SetAddressLabel(ctx, req.(*SetAddressLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_PublishTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishTransactionRequest)
+func _WalletService_GetAddressLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, in)
+		return srv.(WalletServiceServer).GetAddressLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/walletrpc.WalletService/PublishTransaction",
+		FullMethod: "/walletrpc.WalletService/GetAddressLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).PublishTransaction(ctx, req.(*PublishTransactionRequest))
+		return srv.(WalletServiceServer).GetAddressLabel(ctx, req.(*GetAddressLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5014,6 +9494,24 @@ func _WalletService_ValidateAddress_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletService_ValidateAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ValidateAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletService/ValidateAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ValidateAddresses(ctx, req.(*ValidateAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletService",
 	HandlerType: (*WalletServiceServer)(nil),
@@ -5038,6 +9536,10 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Balance",
 			Handler:    _WalletService_Balance_Handler,
 		},
+		{
+			MethodName: "AddressBalance",
+			Handler:    _WalletService_AddressBalance_Handler,
+		},
 		{
 			MethodName: "CurrentAddress",
 			Handler:    _WalletService_CurrentAddress_Handler,
@@ -5046,10 +9548,58 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTransactions",
 			Handler:    _WalletService_GetTransactions_Handler,
 		},
+		{
+			MethodName: "TotalFeesPaid",
+			Handler:    _WalletService_TotalFeesPaid_Handler,
+		},
+		{
+			MethodName: "MempoolStatus",
+			Handler:    _WalletService_MempoolStatus_Handler,
+		},
+		{
+			MethodName: "AddressUsage",
+			Handler:    _WalletService_AddressUsage_Handler,
+		},
+		{
+			MethodName: "TotalReceivedByAddress",
+			Handler:    _WalletService_TotalReceivedByAddress_Handler,
+		},
+		{
+			MethodName: "TotalReceivedByAccount",
+			Handler:    _WalletService_TotalReceivedByAccount_Handler,
+		},
+		{
+			MethodName: "ImmatureCoinbaseOutputs",
+			Handler:    _WalletService_ImmatureCoinbaseOutputs_Handler,
+		},
+		{
+			MethodName: "UnlockState",
+			Handler:    _WalletService_UnlockState_Handler,
+		},
+		{
+			MethodName: "WalletInfo",
+			Handler:    _WalletService_WalletInfo_Handler,
+		},
+		{
+			MethodName: "GetAccountAddresses",
+			Handler:    _WalletService_GetAccountAddresses_Handler,
+		},
+		{
+			MethodName: "GetAccountExtendedPubKey",
+			Handler:    _WalletService_GetAccountExtendedPubKey_Handler,
+		},
 		{
 			MethodName: "ChangePassphrase",
 			Handler:    _WalletService_ChangePassphrase_Handler,
 		},
+		{
+			MethodName: "UnlockWallet",
+			Handler:    _WalletService_UnlockWallet_Handler,
+		},
+		{
+			MethodName: "LockWallet",
+			Handler:    _WalletService_LockWallet_Handler,
+		},
 		{
 			MethodName: "RenameAccount",
 			Handler:    _WalletService_RenameAccount_Handler,
@@ -5058,18 +9608,54 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NextAccount",
 			Handler:    _WalletService_NextAccount_Handler,
 		},
+		{
+			MethodName: "DiscoverAccounts",
+			Handler:    _WalletService_DiscoverAccounts_Handler,
+		},
 		{
 			MethodName: "NextAddress",
 			Handler:    _WalletService_NextAddress_Handler,
 		},
+		{
+			MethodName: "NextAddresses",
+			Handler:    _WalletService_NextAddresses_Handler,
+		},
 		{
 			MethodName: "ImportPrivateKey",
 			Handler:    _WalletService_ImportPrivateKey_Handler,
 		},
+		{
+			MethodName: "DumpPrivateKey",
+			Handler:    _WalletService_DumpPrivateKey_Handler,
+		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _WalletService_SignMessage_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _WalletService_VerifyMessage_Handler,
+		},
+		{
+			MethodName: "CreateMultisigAddress",
+			Handler:    _WalletService_CreateMultisigAddress_Handler,
+		},
+		{
+			MethodName: "ImportPrunedFunds",
+			Handler:    _WalletService_ImportPrunedFunds_Handler,
+		},
 		{
 			MethodName: "FundTransaction",
 			Handler:    _WalletService_FundTransaction_Handler,
 		},
+		{
+			MethodName: "LockedOutputs",
+			Handler:    _WalletService_LockedOutputs_Handler,
+		},
+		{
+			MethodName: "UnlockOutput",
+			Handler:    _WalletService_UnlockOutput_Handler,
+		},
 		{
 			MethodName: "CreateTransaction",
 			Handler:    _WalletService_CreateTransaction_Handler,
@@ -5086,6 +9672,26 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PublishTransaction",
 			Handler:    _WalletService_PublishTransaction_Handler,
 		},
+		{
+			MethodName: "RemoveTransaction",
+			Handler:    _WalletService_RemoveTransaction_Handler,
+		},
+		{
+			MethodName: "SetTransactionLabel",
+			Handler:    _WalletService_SetTransactionLabel_Handler,
+		},
+		{
+			MethodName: "GetTransactionLabel",
+			Handler:    _WalletService_GetTransactionLabel_Handler,
+		},
+		{
+			MethodName: "SetAddressLabel",
+			Handler:    _WalletService_SetAddressLabel_Handler,
+		},
+		{
+			MethodName: "GetAddressLabel",
+			Handler:    _WalletService_GetAddressLabel_Handler,
+		},
 		{
 			MethodName: "Rescan",
 			Handler:    _WalletService_Rescan_Handler,
@@ -5102,6 +9708,10 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateAddress",
 			Handler:    _WalletService_ValidateAddress_Handler,
 		},
+		{
+			MethodName: "ValidateAddresses",
+			Handler:    _WalletService_ValidateAddresses_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -5124,6 +9734,11 @@ var _WalletService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _WalletService_RescanNotifications_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AddressPaymentNotifications",
+			Handler:       _WalletService_AddressPaymentNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api.proto",
 }
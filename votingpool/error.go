@@ -143,6 +143,14 @@ const (
 	// deserializing withdrawal information.
 	ErrWithdrawalStorage
 
+	// ErrDuplicateOutputRequest indicates that more than one output request
+	// of a withdrawal has the same server and transaction number.
+	ErrDuplicateOutputRequest
+
+	// ErrWithdrawalNotExists indicates that an attempt has been made to
+	// access a withdrawal that does not exist.
+	ErrWithdrawalNotExists
+
 	// lastErr is used for testing, making it possible to iterate over
 	// the error codes in order to check that they all have proper
 	// translations in errorCodeStrings.
@@ -185,6 +193,8 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrWithdrawFromUnusedAddr:    "ErrWithdrawFromUnusedAddr",
 	ErrWithdrawalTxStorage:       "ErrWithdrawalTxStorage",
 	ErrWithdrawalStorage:         "ErrWithdrawalStorage",
+	ErrDuplicateOutputRequest:    "ErrDuplicateOutputRequest",
+	ErrWithdrawalNotExists:       "ErrWithdrawalNotExists",
 }
 
 // String returns the ErrorCode as a human-readable name.
@@ -54,6 +54,8 @@ func TestErrorCodeStringer(t *testing.T) {
 		{vp.ErrWithdrawFromUnusedAddr, "ErrWithdrawFromUnusedAddr"},
 		{vp.ErrWithdrawalTxStorage, "ErrWithdrawalTxStorage"},
 		{vp.ErrWithdrawalStorage, "ErrWithdrawalStorage"},
+		{vp.ErrDuplicateOutputRequest, "ErrDuplicateOutputRequest"},
+		{vp.ErrWithdrawalNotExists, "ErrWithdrawalNotExists"},
 		{0xffff, "Unknown ErrorCode (65535)"},
 	}
 
@@ -55,7 +55,7 @@ func getUniqueID() uint32 {
 // createWithdrawalTx creates a withdrawalTx with the given input and output amounts.
 func createWithdrawalTx(t *testing.T, dbtx walletdb.ReadWriteTx, pool *Pool, inputAmounts []int64, outputAmounts []int64) *withdrawalTx {
 	net := pool.Manager().ChainParams()
-	tx := newWithdrawalTx(defaultTxOptions)
+	tx := newWithdrawalTx(defaultWithdrawalFee, defaultTxOptions)
 	_, credits := tstCreateCreditsOnNewSeries(t, dbtx, pool, inputAmounts)
 	for _, c := range credits {
 		tx.addInput(c)
@@ -457,7 +457,7 @@ func createAndFulfillWithdrawalRequests(t *testing.T, dbtx walletdb.ReadWriteTx,
 	dustThreshold := bchutil.Amount(1e4)
 	startAddr := TstNewWithdrawalAddress(t, dbtx, pool, seriesID, 1, 0)
 	lastSeriesID := seriesID
-	w := newWithdrawal(roundID, requests, eligible, *changeStart)
+	w := newWithdrawal(roundID, requests, eligible, *changeStart, defaultWithdrawalFee)
 	if err := w.fulfillRequests(); err != nil {
 		t.Fatal(err)
 	}
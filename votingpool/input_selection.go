@@ -56,6 +56,11 @@ func (c byAddress) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
 // element at position j. The 'smaller-than' relation is defined to be
 // the lexicographic ordering defined on the tuple (SeriesID, Index,
 // Branch, TxSha, OutputIndex).
+//
+// Since no two credits share an outpoint, this is a total order, so sorting
+// credits gives the same result regardless of their initial order or of the
+// sort algorithm used.  All voting pool members must construct identical
+// withdrawal transactions, so this order must not be changed.
 func (c byAddress) Less(i, j int) bool {
 	iAddr := c[i].addr
 	jAddr := c[j].addr
@@ -6,6 +6,7 @@ package votingpool
 
 import (
 	"bytes"
+	"math/rand"
 	"reflect"
 	"sort"
 	"testing"
@@ -327,6 +328,52 @@ func TestCreditSortingByAddress(t *testing.T) {
 	}
 }
 
+// TestCreditSortingByAddressSameAddress ensures credits locked to the same
+// address are ordered by transaction hash and then output index, whatever
+// their initial order.
+func TestCreditSortingByAddressSameAddress(t *testing.T) {
+	teardown, db, pool := TstCreatePool(t)
+	defer teardown()
+
+	dbtx, err := db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbtx.Commit()
+
+	series := []TstSeriesDef{
+		{ReqSigs: 2, PubKeys: TstPubKeys[1:4], SeriesID: 1},
+	}
+	TstCreateSeries(t, dbtx, pool, series)
+
+	var want []Credit
+	for i := byte(0); i < 8; i++ {
+		for outpointIdx := uint32(0); outpointIdx < 4; outpointIdx++ {
+			want = append(want, newDummyCredit(t, dbtx, pool, 1, 0, 0,
+				bytes.Repeat([]byte{i}, 32), outpointIdx))
+		}
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		credits := make([]Credit, len(want))
+		copy(credits, want)
+		rng.Shuffle(len(credits), func(i, j int) {
+			credits[i], credits[j] = credits[j], credits[i]
+		})
+		sort.Sort(byAddress(credits))
+
+		for idx := range want {
+			if !reflect.DeepEqual(credits[idx], want[idx]) {
+				t.Fatalf("Wrong credit at index %d. Got: %v %v, "+
+					"want: %v %v", idx, credits[idx].OutPoint,
+					&credits[idx], want[idx].OutPoint,
+					&want[idx])
+			}
+		}
+	}
+}
+
 // newDummyCredit creates a new Credit with the given hash and outpointIdx,
 // locked to the votingpool address identified by the given
 // series/index/branch.
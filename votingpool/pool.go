@@ -6,6 +6,7 @@ package votingpool
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	"github.com/gcash/bchd/txscript"
@@ -48,6 +49,16 @@ type Pool struct {
 	ID           []byte
 	seriesLookup map[uint32]*SeriesData
 	manager      *waddrmgr.Manager
+
+	// withdrawalFees maps series IDs to the fee function used by
+	// withdrawals from that series.  Series without an entry use
+	// defaultWithdrawalFee.
+	withdrawalFees map[uint32]WithdrawalFeeFunc
+
+	// changeThresholds maps series IDs to the change threshold used by
+	// withdrawals from that series.  Series without an entry use
+	// defaultChangeThreshold.
+	changeThresholds map[uint32]bchutil.Amount
 }
 
 // PoolAddress represents a voting pool P2SH address, generated by
@@ -108,10 +119,55 @@ func Load(ns walletdb.ReadBucket, m *waddrmgr.Manager, poolID []byte) (*Pool, er
 // newPool creates a new Pool instance.
 func newPool(m *waddrmgr.Manager, poolID []byte) *Pool {
 	return &Pool{
-		ID:           poolID,
-		seriesLookup: make(map[uint32]*SeriesData),
-		manager:      m,
+		ID:               poolID,
+		seriesLookup:     make(map[uint32]*SeriesData),
+		manager:          m,
+		withdrawalFees:   make(map[uint32]WithdrawalFeeFunc),
+		changeThresholds: make(map[uint32]bchutil.Amount),
+	}
+}
+
+// SetWithdrawalFee sets the function used to calculate the network fee of
+// transactions created by withdrawals whose last series is seriesID.  Passing
+// a nil fee restores the default of 0.00001 BCH per started kilobyte.  The
+// setting is not persisted and must be made again after the pool is loaded.
+func (p *Pool) SetWithdrawalFee(seriesID uint32, fee WithdrawalFeeFunc) {
+	if fee == nil {
+		delete(p.withdrawalFees, seriesID)
+		return
+	}
+	p.withdrawalFees[seriesID] = fee
+}
+
+// withdrawalFee returns the fee function configured for seriesID.
+func (p *Pool) withdrawalFee(seriesID uint32) WithdrawalFeeFunc {
+	if fee, ok := p.withdrawalFees[seriesID]; ok {
+		return fee
+	}
+	return defaultWithdrawalFee
+}
+
+// SetWithdrawalChangeThreshold sets the amount below which the change of
+// transactions created by withdrawals whose last series is seriesID is added
+// to the network fee instead of being paid to a change output.  Passing a
+// negative threshold restores the default, which is the dust threshold of a
+// P2SH change output at the default relay fee.  The setting is not persisted
+// and must be made again after the pool is loaded.
+func (p *Pool) SetWithdrawalChangeThreshold(seriesID uint32, threshold bchutil.Amount) {
+	if threshold < 0 {
+		delete(p.changeThresholds, seriesID)
+		return
+	}
+	p.changeThresholds[seriesID] = threshold
+}
+
+// withdrawalChangeThreshold returns the change threshold configured for
+// seriesID.
+func (p *Pool) withdrawalChangeThreshold(seriesID uint32) bchutil.Amount {
+	if threshold, ok := p.changeThresholds[seriesID]; ok {
+		return threshold
 	}
+	return defaultChangeThreshold
 }
 
 // LoadAndGetDepositScript generates and returns a deposit script for the given seriesID,
@@ -524,17 +580,50 @@ func (p *Pool) addressFor(script []byte) (bchutil.Address, error) {
 // a certain number (Series.reqSigs) of the public keys belonging to the series
 // with the given ID are required to sign the transaction for it to be successful.
 func (p *Pool) DepositScript(seriesID uint32, branch Branch, index Index) ([]byte, error) {
+	scripts, err := p.DepositScripts(seriesID, branch, index, 1)
+	if err != nil {
+		return nil, err
+	}
+	return scripts[0], nil
+}
+
+// DepositScripts returns the deposit scripts for count contiguous indices of
+// the given seriesID and branch, starting at startIdx.  The scripts are the
+// same as those returned by calling DepositScript for every index, but the
+// series lookup and branch ordering of its public keys are done only once.
+func (p *Pool) DepositScripts(seriesID uint32, branch Branch, startIdx Index, count uint32) ([][]byte, error) {
 	series := p.Series(seriesID)
 	if series == nil {
 		str := fmt.Sprintf("series #%d does not exist", seriesID)
 		return nil, newError(ErrSeriesNotExists, str, nil)
 	}
+	if uint64(startIdx)+uint64(count) > math.MaxUint32+1 {
+		str := fmt.Sprintf("%d indices starting at %d exceed the maximum index",
+			count, startIdx)
+		return nil, newError(ErrInvalidValue, str, nil)
+	}
 
 	pubKeys, err := branchOrder(series.publicKeys, branch)
 	if err != nil {
 		return nil, err
 	}
 
+	scripts := make([][]byte, count)
+	for i := range scripts {
+		scripts[i], err = p.depositScript(series, pubKeys, startIdx+Index(i))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return scripts, nil
+}
+
+// depositScript constructs the multi-signature redemption script for the given
+// index using the public keys of series, which must already be in branch
+// order.
+func (p *Pool) depositScript(series *SeriesData, pubKeys []*hdkeychain.ExtendedKey,
+	index Index) ([]byte, error) {
+
 	pks := make([]*bchutil.AddressPubKey, len(pubKeys))
 	for i, key := range pubKeys {
 		child, err := key.Child(uint32(index))
@@ -706,50 +795,60 @@ func (p *Pool) EnsureUsedAddr(ns, addrmgrNs walletdb.ReadWriteBucket, seriesID u
 			return err
 		}
 	}
-	lastIdx++
-	for lastIdx <= index {
-		if err := p.addUsedAddr(ns, addrmgrNs, seriesID, branch, lastIdx); err != nil {
-			return err
-		}
-		lastIdx++
+	if lastIdx >= index {
+		return nil
 	}
-	return nil
+	return p.AddUsedAddrs(ns, addrmgrNs, seriesID, branch, lastIdx+1, uint32(index-lastIdx))
 }
 
-// addUsedAddr creates a deposit script for the given seriesID/branch/index,
-// ensures it is imported into the address manager and finaly adds the script
-// hash to our used addresses DB. It must be called with the manager unlocked.
-func (p *Pool) addUsedAddr(ns, addrmgrNs walletdb.ReadWriteBucket, seriesID uint32, branch Branch, index Index) error {
-	script, err := p.DepositScript(seriesID, branch, index)
+// AddUsedAddrs creates the deposit scripts for count contiguous indices of the
+// given seriesID/branch, starting at startIdx, and adds them to the address
+// manager and our used addresses DB using the given buckets, so that many
+// deposit addresses can be provisioned in a single database transaction. It
+// must be called with the manager unlocked.
+func (p *Pool) AddUsedAddrs(ns, addrmgrNs walletdb.ReadWriteBucket, seriesID uint32, branch Branch,
+	startIdx Index, count uint32) error {
+
+	scripts, err := p.DepositScripts(seriesID, branch, startIdx, count)
 	if err != nil {
 		return err
 	}
 
-	// First ensure the address manager has our script. That way there's no way
-	// to have it in the used addresses DB but not in the address manager.
 	// TODO: Decide how far back we want the addr manager to rescan and set the
 	// BlockStamp height according to that.
 	manager, err := p.manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0044)
 	if err != nil {
 		return err
 	}
-	_, err = manager.ImportScript(addrmgrNs, script, &waddrmgr.BlockStamp{})
-	if err != nil && err.(waddrmgr.ManagerError).ErrorCode != waddrmgr.ErrDuplicateAddress {
-		return err
-	}
+	for i, script := range scripts {
+		// First ensure the address manager has our script. That way there's no
+		// way to have it in the used addresses DB but not in the address
+		// manager.
+		_, err = manager.ImportScript(addrmgrNs, script, &waddrmgr.BlockStamp{})
+		if err != nil && err.(waddrmgr.ManagerError).ErrorCode != waddrmgr.ErrDuplicateAddress {
+			return err
+		}
 
-	encryptedHash, err := p.manager.Encrypt(waddrmgr.CKTPublic, bchutil.Hash160(script))
-	if err != nil {
-		return newError(ErrCrypto, "failed to encrypt script hash", err)
-	}
-	err = putUsedAddrHash(ns, p.ID, seriesID, branch, index, encryptedHash)
-	if err != nil {
-		return newError(ErrDatabase, "failed to store used addr script hash", err)
+		encryptedHash, err := p.manager.Encrypt(waddrmgr.CKTPublic, bchutil.Hash160(script))
+		if err != nil {
+			return newError(ErrCrypto, "failed to encrypt script hash", err)
+		}
+		err = putUsedAddrHash(ns, p.ID, seriesID, branch, startIdx+Index(i), encryptedHash)
+		if err != nil {
+			return newError(ErrDatabase, "failed to store used addr script hash", err)
+		}
 	}
 
 	return nil
 }
 
+// addUsedAddr creates a deposit script for the given seriesID/branch/index,
+// ensures it is imported into the address manager and finaly adds the script
+// hash to our used addresses DB. It must be called with the manager unlocked.
+func (p *Pool) addUsedAddr(ns, addrmgrNs walletdb.ReadWriteBucket, seriesID uint32, branch Branch, index Index) error {
+	return p.AddUsedAddrs(ns, addrmgrNs, seriesID, branch, index, 1)
+}
+
 // getUsedAddr gets the script hash for the given series, branch and index from
 // the used addresses DB and uses that to look up the ManagedScriptAddress
 // from the address manager. It must be called with the manager unlocked.
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"math"
 	"reflect"
 	"testing"
 
@@ -186,6 +187,47 @@ func TestDepositScriptAddress(t *testing.T) {
 	}
 }
 
+func TestDepositScripts(t *testing.T) {
+	tearDown, db, pool := vp.TstCreatePool(t)
+	defer tearDown()
+
+	dbtx, err := db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbtx.Commit()
+	ns, _ := vp.TstRWNamespaces(dbtx)
+
+	if err := pool.CreateSeries(ns, 1, 1, 2, vp.TstPubKeys[0:3]); err != nil {
+		t.Fatalf("Cannot creates series: %v", err)
+	}
+
+	scripts, err := pool.DepositScripts(1, 2, 5, 10)
+	if err != nil {
+		t.Fatalf("Failed to get deposit scripts: %v", err)
+	}
+	if len(scripts) != 10 {
+		t.Fatalf("Wrong number of deposit scripts; got %d, want 10", len(scripts))
+	}
+	for i, script := range scripts {
+		index := vp.Index(5 + i)
+		want, err := pool.DepositScript(1, 2, index)
+		if err != nil {
+			t.Fatalf("Failed to get deposit script: %v", err)
+		}
+		if !bytes.Equal(script, want) {
+			t.Fatalf("Deposit script for index %d doesn't match; got %x, want %x",
+				index, script, want)
+		}
+	}
+
+	_, err = pool.DepositScripts(1, 0, vp.Index(math.MaxUint32), 2)
+	vp.TstCheckError(t, "", err, vp.ErrInvalidValue)
+
+	_, err = pool.DepositScripts(2, 0, 0, 1)
+	vp.TstCheckError(t, "", err, vp.ErrSeriesNotExists)
+}
+
 func TestDepositScriptAddressForNonExistentSeries(t *testing.T) {
 	tearDown, _, pool := vp.TstCreatePool(t)
 	defer tearDown()
@@ -75,6 +75,52 @@ func TestPoolEnsureUsedAddr(t *testing.T) {
 	}
 }
 
+func TestPoolAddUsedAddrs(t *testing.T) {
+	tearDown, db, pool := TstCreatePool(t)
+	defer tearDown()
+
+	dbtx, err := db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbtx.Commit()
+	ns, addrmgrNs := TstRWNamespaces(dbtx)
+
+	TstCreateSeries(t, dbtx, pool, []TstSeriesDef{{ReqSigs: 2, PubKeys: TstPubKeys[0:3], SeriesID: 1}})
+
+	TstRunWithManagerUnlocked(t, pool.Manager(), addrmgrNs, func() {
+		err = pool.AddUsedAddrs(ns, addrmgrNs, 1, 0, 2, 5)
+	})
+	if err != nil {
+		t.Fatalf("Failed to add used addresses: %v", err)
+	}
+
+	lastIdx, err := pool.highestUsedIndexFor(ns, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastIdx != 6 {
+		t.Fatalf("Wrong highest used index; got %d, want 6", lastIdx)
+	}
+	for i := Index(2); i <= 6; i++ {
+		addr, err := pool.getUsedAddr(ns, addrmgrNs, 1, 0, i)
+		if err != nil {
+			t.Fatalf("Failed to get addr from used addresses set: %v", err)
+		}
+		var script []byte
+		TstRunWithManagerUnlocked(t, pool.Manager(), addrmgrNs, func() {
+			script, err = addr.Script()
+		})
+		if err != nil {
+			t.Fatalf("Failed to get script: %v", err)
+		}
+		wantScript, _ := pool.DepositScript(1, 0, i)
+		if !bytes.Equal(script, wantScript) {
+			t.Fatalf("Script from looked up addr %d is not what we expect", i)
+		}
+	}
+}
+
 func TestPoolGetUsedAddr(t *testing.T) {
 	tearDown, db, pool := TstCreatePool(t)
 	defer tearDown()
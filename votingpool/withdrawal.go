@@ -14,10 +14,13 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
 	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/wallet/txrules"
 	"github.com/gcash/bchwallet/walletdb"
 	"github.com/gcash/bchwallet/wtxmgr"
 )
@@ -30,6 +33,41 @@ const txMaxSize = 100000
 // added to transactions requiring a fee.
 const feeIncrement = 1e3
 
+// p2shPkScriptSize is the size of a P2SH output script, such as the script of
+// a change output paying to a ChangeAddress.
+const p2shPkScriptSize = 23
+
+// defaultChangeThreshold is the amount below which the change of a withdrawal
+// transaction is added to its fee, when no threshold is configured for the
+// series with SetWithdrawalChangeThreshold.  Change outputs of lower amounts
+// would be dust.
+var defaultChangeThreshold = txrules.GetDustThreshold(p2shPkScriptSize,
+	txrules.DefaultRelayFeePerKb)
+
+// WithdrawalFeeFunc calculates the network fee for a withdrawal transaction
+// with the given estimated serialized size in bytes.
+type WithdrawalFeeFunc func(txSize int) bchutil.Amount
+
+// defaultWithdrawalFee pays feeIncrement for every started kilobyte of the
+// transaction.  It is used when no fee function is configured for a series.
+func defaultWithdrawalFee(txSize int) bchutil.Amount {
+	return bchutil.Amount(1+txSize/1000) * feeIncrement
+}
+
+// ConstantWithdrawalFee returns a WithdrawalFeeFunc paying the given fee for
+// every withdrawal transaction, regardless of its size.
+func ConstantWithdrawalFee(fee bchutil.Amount) WithdrawalFeeFunc {
+	return func(int) bchutil.Amount { return fee }
+}
+
+// SizeWithdrawalFee returns a WithdrawalFeeFunc paying feePerKb for every
+// kilobyte of the transaction's estimated size.
+func SizeWithdrawalFee(feePerKb bchutil.Amount) WithdrawalFeeFunc {
+	return func(txSize int) bchutil.Amount {
+		return txrules.FeeForSerializeSize(feePerKb, txSize)
+	}
+}
+
 type outputStatus byte
 
 const (
@@ -54,7 +92,9 @@ type OutputRequest struct {
 	Amount   bchutil.Amount
 	PkScript []byte
 
-	// The notary server that received the outbailment request.
+	// The notary server that received the outbailment request.  Together
+	// with Transaction it identifies the request, so no two requests of a
+	// withdrawal may have the same Server and Transaction.
 	Server string
 
 	// The server-specific transaction number for the outbailment request.
@@ -212,6 +252,39 @@ func (r OutputRequest) String() string {
 	return fmt.Sprintf("OutputRequest %s to send %v to %s", r.outBailmentID(), r.Amount, r.Address)
 }
 
+// NewOutputRequest returns an OutputRequest paying amount to the given encoded
+// address for the outbailment request with the given server and transaction
+// number.  An ErrInvalidValue error is returned if the address is invalid or
+// not for the pool's network, as the pool's outputs could not be spent to it.
+func (p *Pool) NewOutputRequest(server string, transaction uint32, address string,
+	amount bchutil.Amount) (OutputRequest, error) {
+
+	params := p.manager.ChainParams()
+	addr, err := bchutil.DecodeAddress(address, params)
+	if err != nil {
+		str := fmt.Sprintf("invalid address %q", address)
+		return OutputRequest{}, newError(ErrInvalidValue, str, err)
+	}
+	if !addr.IsForNet(params) {
+		str := fmt.Sprintf("address %q is not for %s", address, params.Name)
+		return OutputRequest{}, newError(ErrInvalidValue, str, nil)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		str := fmt.Sprintf("cannot pay to address %q", address)
+		return OutputRequest{}, newError(ErrInvalidValue, str, err)
+	}
+	return OutputRequest{
+		Address:     addr,
+		Amount:      amount,
+		PkScript:    pkScript,
+		Server:      server,
+		Transaction: transaction,
+	}, nil
+}
+
+// outBailmentID returns the identifier of the request, which is used to track
+// its status in a withdrawal.
 func (r OutputRequest) outBailmentID() OutBailmentID {
 	return OutBailmentID(fmt.Sprintf("%s:%d", r.Server, r.Transaction))
 }
@@ -268,6 +341,12 @@ type withdrawal struct {
 	pendingRequests []OutputRequest
 	eligibleInputs  []Credit
 	current         *withdrawalTx
+	// fee calculates the network fee of every withdrawalTx created as part
+	// of this withdrawal.
+	fee WithdrawalFeeFunc
+	// changeThreshold is the change threshold of every withdrawalTx
+	// created as part of this withdrawal.
+	changeThreshold bchutil.Amount
 	// txOptions is a function called for every new withdrawalTx created as
 	// part of this withdrawal. It is defined as a function field because it
 	// exists mainly so that tests can mock withdrawalTx fields.
@@ -305,6 +384,10 @@ type withdrawalTx struct {
 	// changeOutput holds information about the change for this transaction.
 	changeOutput *wire.TxOut
 
+	// changeThreshold is the amount below which any change is added to the
+	// fee rather than paid to a change output.
+	changeThreshold bchutil.Amount
+
 	// calculateSize returns the estimated serialized size (in bytes) of this
 	// tx. See calculateTxSize() for details on how that's done. We use a
 	// struct field instead of a method so that it can be replaced in tests.
@@ -314,13 +397,14 @@ type withdrawalTx struct {
 	calculateFee func() bchutil.Amount
 }
 
-// newWithdrawalTx creates a new withdrawalTx and calls setOptions()
-// passing the newly created tx.
-func newWithdrawalTx(setOptions func(tx *withdrawalTx)) *withdrawalTx {
-	tx := &withdrawalTx{}
+// newWithdrawalTx creates a new withdrawalTx whose fee is calculated by
+// passing its estimated size to fee, and calls setOptions() passing the newly
+// created tx.
+func newWithdrawalTx(fee WithdrawalFeeFunc, setOptions func(tx *withdrawalTx)) *withdrawalTx {
+	tx := &withdrawalTx{changeThreshold: defaultChangeThreshold}
 	tx.calculateSize = func() int { return calculateTxSize(tx) }
 	tx.calculateFee = func() bchutil.Amount {
-		return bchutil.Amount(1+tx.calculateSize()/1000) * feeIncrement
+		return fee(tx.calculateSize())
 	}
 	setOptions(tx)
 	return tx
@@ -413,8 +497,9 @@ func (tx *withdrawalTx) removeInput() Credit {
 }
 
 // addChange adds a change output if there are any satoshis left after paying
-// all the outputs and network fees. It returns true if a change output was
-// added.
+// all the outputs and network fees. Change below the tx's change threshold is
+// added to the fee instead, as such a change output would be uneconomical to
+// spend. It returns true if a change output was added.
 //
 // This method must be called only once, and no extra inputs/outputs should be
 // added after it's called. Also, callsites must make sure adding a change
@@ -424,7 +509,11 @@ func (tx *withdrawalTx) addChange(pkScript []byte) bool {
 	change := tx.inputTotal() - tx.outputTotal() - tx.fee
 	log.Debugf("addChange: input total %v, output total %v, fee %v", tx.inputTotal(),
 		tx.outputTotal(), tx.fee)
-	if change > 0 {
+	if change > 0 && change < tx.changeThreshold {
+		tx.fee += change
+		log.Debugf("Added change of %v below threshold %v to the fee", change,
+			tx.changeThreshold)
+	} else if change > 0 {
 		tx.changeOutput = wire.NewTxOut(int64(change), pkScript, wire.TokenData{})
 		log.Debugf("Added change output with amount %v", change)
 	}
@@ -461,8 +550,30 @@ func (tx *withdrawalTx) rollBackLastOutput() ([]Credit, *withdrawalTxOut, error)
 
 func defaultTxOptions(tx *withdrawalTx) {}
 
+// checkOutputRequests returns an error if any of the given requests pays an
+// address which is not for the given network, or if more than one of them
+// has the same outbailment ID, as the status of each request is tracked by
+// its ID.
+func checkOutputRequests(requests []OutputRequest, params *chaincfg.Params) error {
+	seen := make(map[OutBailmentID]struct{}, len(requests))
+	for _, request := range requests {
+		if request.Address == nil || !request.Address.IsForNet(params) {
+			str := fmt.Sprintf("%s does not pay an address for %s", request,
+				params.Name)
+			return newError(ErrInvalidValue, str, nil)
+		}
+		id := request.outBailmentID()
+		if _, ok := seen[id]; ok {
+			str := fmt.Sprintf("duplicate output request %s", id)
+			return newError(ErrDuplicateOutputRequest, str, nil)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
 func newWithdrawal(roundID uint32, requests []OutputRequest, inputs []Credit,
-	changeStart ChangeAddress) *withdrawal {
+	changeStart ChangeAddress, fee WithdrawalFeeFunc) *withdrawal {
 	outputs := make(map[OutBailmentID]*WithdrawalOutput, len(requests))
 	for _, request := range requests {
 		outputs[request.outBailmentID()] = &WithdrawalOutput{request: request}
@@ -476,10 +587,20 @@ func newWithdrawal(roundID uint32, requests []OutputRequest, inputs []Credit,
 		pendingRequests: requests,
 		eligibleInputs:  inputs,
 		status:          status,
+		fee:             fee,
+		changeThreshold: defaultChangeThreshold,
 		txOptions:       defaultTxOptions,
 	}
 }
 
+// newTx returns a new withdrawalTx using the fee and change threshold of the
+// withdrawal.
+func (w *withdrawal) newTx() *withdrawalTx {
+	tx := newWithdrawalTx(w.fee, w.txOptions)
+	tx.changeThreshold = w.changeThreshold
+	return tx
+}
+
 // StartWithdrawal uses a fully deterministic algorithm to construct
 // transactions fulfilling as many of the given output requests as possible.
 // It returns a WithdrawalStatus containing the outpoints fulfilling the
@@ -487,12 +608,22 @@ func newWithdrawal(roundID uint32, requests []OutputRequest, inputs []Credit,
 // signature lists (one for every private key available to this wallet) for each
 // of those transaction's inputs. More details about the actual algorithm can be
 // found at http://opentransactions.org/wiki/index.php/Startwithdrawal
+// The network fee of every transaction is calculated by the fee function
+// configured for lastSeriesID with SetWithdrawalFee, and change below the
+// threshold configured with SetWithdrawalChangeThreshold is added to the fee.
+// Every request must have a distinct Server and Transaction pair, otherwise an
+// ErrDuplicateOutputRequest error is returned, and must pay an address for the
+// pool's network, otherwise an ErrInvalidValue error is returned.
 // This method must be called with the address manager unlocked.
 func (p *Pool) StartWithdrawal(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.ReadBucket, roundID uint32, requests []OutputRequest,
 	startAddress WithdrawalAddress, lastSeriesID uint32, changeStart ChangeAddress,
 	txStore *wtxmgr.Store, txmgrNs walletdb.ReadBucket, chainHeight int32, dustThreshold bchutil.Amount) (
 	*WithdrawalStatus, error) {
 
+	if err := checkOutputRequests(requests, p.manager.ChainParams()); err != nil {
+		return nil, err
+	}
+
 	status, err := getWithdrawalStatus(p, ns, addrmgrNs, roundID, requests, startAddress, lastSeriesID,
 		changeStart, dustThreshold)
 	if err != nil {
@@ -508,7 +639,9 @@ func (p *Pool) StartWithdrawal(ns walletdb.ReadWriteBucket, addrmgrNs walletdb.R
 		return nil, err
 	}
 
-	w := newWithdrawal(roundID, requests, eligible, changeStart)
+	w := newWithdrawal(roundID, requests, eligible, changeStart,
+		p.withdrawalFee(lastSeriesID))
+	w.changeThreshold = p.withdrawalChangeThreshold(lastSeriesID)
 	if err := w.fulfillRequests(); err != nil {
 		return nil, err
 	}
@@ -669,7 +802,7 @@ func (w *withdrawal) finalizeCurrentTx() error {
 	}
 
 	w.transactions = append(w.transactions, tx)
-	w.current = newWithdrawalTx(w.txOptions)
+	w.current = w.newTx()
 	return nil
 }
 
@@ -705,7 +838,7 @@ func (w *withdrawal) fulfillRequests() error {
 	// Sort outputs by outBailmentID (hash(server ID, tx #))
 	sort.Sort(byOutBailmentID(w.pendingRequests))
 
-	w.current = newWithdrawalTx(w.txOptions)
+	w.current = w.newTx()
 	for len(w.pendingRequests) > 0 {
 		if err := w.fulfillNextRequest(); err != nil {
 			return err
@@ -945,6 +1078,145 @@ func SignTx(msgtx *wire.MsgTx, inputAmounts InputAmounts, sigs TxSigs, mgr *wadd
 	return nil
 }
 
+// SignedWithdrawalTx is a fully signed transaction of a withdrawal, as returned
+// by ExportWithdrawalTransactions, ready to be broadcast by any node.
+type SignedWithdrawalTx struct {
+	Ntxid Ntxid
+	Txid  chainhash.Hash
+
+	// SerializedTx is the serialized signed transaction.
+	SerializedTx []byte
+
+	// Requests are the output requests of the withdrawal paid, in full or
+	// in part, by the transaction, in the order they were requested.
+	Requests []OutputRequest
+}
+
+// ExportWithdrawalTransactions returns the transactions of the withdrawal
+// stored for the given round, sorted by ntxid, with their inputs signed using
+// the raw signatures this wallet created when the withdrawal was started
+// merged with the given raw signatures from other members of the pool.  An
+// ErrTxSigning error is returned if the merged signatures of any input do not
+// reach the number required by its series.
+// This method must be called with the address manager unlocked.
+func (p *Pool) ExportWithdrawalTransactions(ns, addrmgrNs walletdb.ReadBucket, roundID uint32,
+	store *wtxmgr.Store, txmgrNs walletdb.ReadBucket, sigs ...map[Ntxid]TxSigs) (
+	[]SignedWithdrawalTx, error) {
+
+	serialized := getWithdrawal(ns, p.ID, roundID)
+	if len(serialized) == 0 {
+		str := fmt.Sprintf("no withdrawal for round %d", roundID)
+		return nil, newError(ErrWithdrawalNotExists, str, nil)
+	}
+	wInfo, err := deserializeWithdrawal(p, ns, addrmgrNs, serialized)
+	if err != nil {
+		return nil, err
+	}
+	status := &wInfo.status
+
+	ntxids := make([]string, 0, len(status.transactions))
+	for ntxid := range status.transactions {
+		ntxids = append(ntxids, string(ntxid))
+	}
+	sort.Strings(ntxids)
+
+	txs := make([]SignedWithdrawalTx, len(ntxids))
+	for i, id := range ntxids {
+		ntxid := Ntxid(id)
+		txSigs := []TxSigs{status.sigs[ntxid]}
+		for _, s := range sigs {
+			txSigs = append(txSigs, s[ntxid])
+		}
+		merged, err := mergeTxSigs(txSigs...)
+		if err != nil {
+			return nil, err
+		}
+
+		msgtx := status.transactions[ntxid].MsgTx.Copy()
+		err = SignTx(msgtx, status.amounts[ntxid], merged, p.Manager(), addrmgrNs,
+			store, txmgrNs)
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		buf.Grow(msgtx.SerializeSize())
+		if err := msgtx.Serialize(&buf); err != nil {
+			return nil, newError(ErrTxSigning, "cannot serialize signed transaction", err)
+		}
+
+		txs[i] = SignedWithdrawalTx{
+			Ntxid:        ntxid,
+			Txid:         msgtx.TxHash(),
+			SerializedTx: buf.Bytes(),
+		}
+		for _, request := range wInfo.requests {
+			output, ok := status.outputs[request.outBailmentID()]
+			if !ok {
+				continue
+			}
+			for _, outpoint := range output.outpoints {
+				if outpoint.ntxid == ntxid {
+					txs[i].Requests = append(txs[i].Requests, request)
+					break
+				}
+			}
+		}
+	}
+	return txs, nil
+}
+
+// mergeTxSigs merges the raw signatures for the inputs of a transaction created
+// by different members of a pool.  For every input, the signatures for each
+// public key are taken from the first list with a signature for that key, and
+// the keys without any signature are dropped, keeping the signatures in the
+// order of the public keys in the multi-sig script as SignTx expects.  Nil
+// lists are ignored.
+func mergeTxSigs(sigs ...TxSigs) (TxSigs, error) {
+	var merged TxSigs
+	for _, txSigs := range sigs {
+		if txSigs == nil {
+			continue
+		}
+		if merged == nil {
+			merged = make(TxSigs, len(txSigs))
+			for i := range txSigs {
+				merged[i] = make([]RawSig, len(txSigs[i]))
+			}
+		}
+		if len(txSigs) != len(merged) {
+			str := fmt.Sprintf("signatures for %d inputs do not match the %d "+
+				"inputs of the transaction", len(txSigs), len(merged))
+			return nil, newError(ErrTxSigning, str, nil)
+		}
+		for i, inputSigs := range txSigs {
+			if len(inputSigs) != len(merged[i]) {
+				str := fmt.Sprintf("%d signatures for input %d do not match "+
+					"its %d public keys", len(inputSigs), i, len(merged[i]))
+				return nil, newError(ErrTxSigning, str, nil)
+			}
+			for j, sig := range inputSigs {
+				if len(merged[i][j]) == 0 {
+					merged[i][j] = sig
+				}
+			}
+		}
+	}
+	if merged == nil {
+		return nil, newError(ErrTxSigning, "no signatures for transaction", nil)
+	}
+
+	for i, inputSigs := range merged {
+		var present []RawSig
+		for _, sig := range inputSigs {
+			if len(sig) != 0 {
+				present = append(present, sig)
+			}
+		}
+		merged[i] = present
+	}
+	return merged, nil
+}
+
 // getRedeemScript returns the redeem script for the given P2SH address. It must
 // be called with the manager unlocked.
 func getRedeemScript(mgr *waddrmgr.Manager, addrmgrNs walletdb.ReadBucket, addr *bchutil.AddressScriptHash) ([]byte, error) {
@@ -1072,3 +1344,129 @@ func storeTransactions(store *wtxmgr.Store, txmgrNs walletdb.ReadWriteBucket, tr
 	}
 	return nil
 }
+
+// ReconcileStatus describes how an OutputRequest was paid by the transactions
+// of a withdrawal.
+type ReconcileStatus byte
+
+const (
+	// ReconcileFulfilled indicates the request was paid in full by a
+	// single transaction output.
+	ReconcileFulfilled ReconcileStatus = iota
+
+	// ReconcileSplit indicates the request was paid in full by more than
+	// one transaction output.
+	ReconcileSplit
+
+	// ReconcileDropped indicates the request was not paid because its
+	// amount is below the dust threshold of the withdrawal.
+	ReconcileDropped
+
+	// ReconcileUnfulfilled indicates the request was not paid in full.
+	ReconcileUnfulfilled
+)
+
+func (s ReconcileStatus) String() string {
+	strings := map[ReconcileStatus]string{
+		ReconcileFulfilled:   "fulfilled",
+		ReconcileSplit:       "split",
+		ReconcileDropped:     "dropped",
+		ReconcileUnfulfilled: "unfulfilled",
+	}
+	return strings[s]
+}
+
+// OutputReconciliation compares an OutputRequest of a withdrawal with the
+// transaction outputs paying it.
+type OutputReconciliation struct {
+	Request OutputRequest
+	Status  ReconcileStatus
+
+	// Paid is the total value of the transaction outputs paying the
+	// requested pkScript which were recorded as fulfilling the request.
+	Paid bchutil.Amount
+
+	// Outpoints are the outpoints recorded as fulfilling the request.
+	Outpoints []OutBailmentOutpoint
+}
+
+// WithdrawalReconciliation is the report returned by ReconcileWithdrawal, with
+// an entry for every OutputRequest of the withdrawal, in the order they were
+// requested.
+type WithdrawalReconciliation struct {
+	RoundID uint32
+	Outputs []OutputReconciliation
+}
+
+// Discrepancies returns the entries of the report for requests which were not
+// paid by a single transaction output.
+func (r *WithdrawalReconciliation) Discrepancies() []OutputReconciliation {
+	var discrepancies []OutputReconciliation
+	for _, output := range r.Outputs {
+		if output.Status != ReconcileFulfilled {
+			discrepancies = append(discrepancies, output)
+		}
+	}
+	return discrepancies
+}
+
+// ReconcileWithdrawal compares the output requests of the withdrawal stored
+// for the given round with the outputs of the transactions it produced,
+// reporting which requests were split across several outputs, dropped for
+// being below the dust threshold or not paid in full.
+// This method must be called with the address manager unlocked.
+func (p *Pool) ReconcileWithdrawal(ns, addrmgrNs walletdb.ReadBucket, roundID uint32) (
+	*WithdrawalReconciliation, error) {
+
+	serialized := getWithdrawal(ns, p.ID, roundID)
+	if len(serialized) == 0 {
+		str := fmt.Sprintf("no withdrawal for round %d", roundID)
+		return nil, newError(ErrWithdrawalNotExists, str, nil)
+	}
+	wInfo, err := deserializeWithdrawal(p, ns, addrmgrNs, serialized)
+	if err != nil {
+		return nil, err
+	}
+	return reconcileWithdrawal(roundID, wInfo), nil
+}
+
+// reconcileWithdrawal returns the reconciliation report of the given
+// withdrawal.  Only the transaction outputs recorded in the withdrawal status
+// as fulfilling a request, and which pay the request's pkScript, are counted
+// as paying it.
+func reconcileWithdrawal(roundID uint32, wInfo *withdrawalInfo) *WithdrawalReconciliation {
+	report := &WithdrawalReconciliation{
+		RoundID: roundID,
+		Outputs: make([]OutputReconciliation, len(wInfo.requests)),
+	}
+	for i, request := range wInfo.requests {
+		output := OutputReconciliation{Request: request}
+		if wOutput, ok := wInfo.status.outputs[request.outBailmentID()]; ok {
+			output.Outpoints = wOutput.outpoints
+		}
+		for _, outpoint := range output.Outpoints {
+			tx, ok := wInfo.status.transactions[outpoint.ntxid]
+			if !ok || outpoint.index >= uint32(len(tx.TxOut)) {
+				continue
+			}
+			txOut := tx.TxOut[outpoint.index]
+			if !bytes.Equal(txOut.PkScript, request.PkScript) {
+				continue
+			}
+			output.Paid += bchutil.Amount(txOut.Value)
+		}
+
+		switch {
+		case output.Paid >= request.Amount && len(output.Outpoints) == 1:
+			output.Status = ReconcileFulfilled
+		case output.Paid >= request.Amount:
+			output.Status = ReconcileSplit
+		case output.Paid == 0 && request.Amount < wInfo.dustThreshold:
+			output.Status = ReconcileDropped
+		default:
+			output.Status = ReconcileUnfulfilled
+		}
+		report.Outputs[i] = output
+	}
+	return report
+}
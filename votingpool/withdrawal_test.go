@@ -8,6 +8,8 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
 	"github.com/gcash/bchutil/hdkeychain"
 	vp "github.com/gcash/bchwallet/votingpool"
@@ -105,6 +107,60 @@ func TestStartWithdrawal(t *testing.T) {
 		t.Fatal(err)
 	}
 	vp.TstCheckWithdrawalStatusMatches(t, *status, *status2)
+
+	// The stored withdrawal reconciles with every request paid by a single
+	// output.
+	var report *vp.WithdrawalReconciliation
+	vp.TstRunWithManagerUnlocked(t, mgr, addrmgrNs, func() {
+		report, err = pool.ReconcileWithdrawal(ns, addrmgrNs, 0)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Outputs) != len(requests) {
+		t.Fatalf("Wrong number of reconciled outputs; got %d, want %d", len(report.Outputs),
+			len(requests))
+	}
+	for i, output := range report.Outputs {
+		if output.Status != vp.ReconcileFulfilled || output.Paid != requests[i].Amount {
+			t.Fatalf("Unexpected reconciliation of %v; got %v paying %v", requests[i],
+				output.Status, output.Paid)
+		}
+	}
+	if d := report.Discrepancies(); len(d) != 0 {
+		t.Fatalf("Unexpected discrepancies: %v", d)
+	}
+
+	_, err = pool.ReconcileWithdrawal(ns, addrmgrNs, 1)
+	vp.TstCheckError(t, "unknown round", err, vp.ErrWithdrawalNotExists)
+
+	// All the private keys of the series are available, so the exported
+	// transaction is fully signed by this wallet's signatures alone.
+	var exported []vp.SignedWithdrawalTx
+	vp.TstRunWithManagerUnlocked(t, mgr, addrmgrNs, func() {
+		exported, err = pool.ExportWithdrawalTransactions(ns, addrmgrNs, 0, store, txmgrNs)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(exported) != 1 {
+		t.Fatalf("Wrong number of exported transactions; got %d, want 1", len(exported))
+	}
+	if exported[0].Ntxid != ntxid {
+		t.Fatalf("Wrong ntxid; got %v, want %v", exported[0].Ntxid, ntxid)
+	}
+	var exportedTx wire.MsgTx
+	if err := exportedTx.Deserialize(bytes.NewReader(exported[0].SerializedTx)); err != nil {
+		t.Fatal(err)
+	}
+	if exportedTx.TxHash() != msgtx.TxHash() || exported[0].Txid != msgtx.TxHash() {
+		t.Fatalf("Exported transaction %v does not match signed transaction %v",
+			exportedTx.TxHash(), msgtx.TxHash())
+	}
+	if len(exported[0].Requests) != len(requests) {
+		t.Fatalf("Wrong number of requests for exported transaction; got %d, want %d",
+			len(exported[0].Requests), len(requests))
+	}
 }
 
 func checkWithdrawalOutputs(
@@ -140,3 +196,109 @@ func checkWithdrawalOutputs(
 		}
 	}
 }
+
+// TestStartWithdrawalDuplicateRequests ensures a withdrawal is rejected when
+// more than one of its output requests has the same server and transaction
+// number, while requests from different servers may share a transaction
+// number.
+func TestStartWithdrawalDuplicateRequests(t *testing.T) {
+	tearDown, db, pool, store := vp.TstCreatePoolAndTxStore(t)
+	defer tearDown()
+
+	dbtx, err := db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbtx.Commit()
+	ns, addrmgrNs := vp.TstRWNamespaces(dbtx)
+	txmgrNs := vp.TstTxStoreRWNamespace(dbtx)
+
+	mgr := pool.Manager()
+
+	masters := []*hdkeychain.ExtendedKey{
+		vp.TstCreateMasterKey(t, bytes.Repeat([]byte{0x00, 0x01}, 16)),
+		vp.TstCreateMasterKey(t, bytes.Repeat([]byte{0x02, 0x01}, 16)),
+		vp.TstCreateMasterKey(t, bytes.Repeat([]byte{0x03, 0x01}, 16))}
+	def := vp.TstCreateSeriesDef(t, pool, 2, masters)
+	vp.TstCreateSeries(t, dbtx, pool, []vp.TstSeriesDef{def})
+	vp.TstCreateSeriesCreditsOnStore(t, dbtx, pool, def.SeriesID, []int64{5e6, 4e6}, store)
+	address1 := "pqsxukmp73sd8rrq6s9r984sfvrchk39agrl8rwq9d"
+	address2 := "prcrkfu5u7w3qzjedhrw0t7xjp4cyfhh2uzt7qsx53"
+	changeStart := vp.TstNewChangeAddress(t, pool, def.SeriesID, 0)
+	startAddr := vp.TstNewWithdrawalAddress(t, dbtx, pool, def.SeriesID, 0, 0)
+	dustThreshold := bchutil.Amount(1e4)
+	currentBlock := vp.TstInputsBlock + vp.TstEligibleInputMinConfirmations + 1
+
+	startWithdrawal := func(roundID uint32, requests []vp.OutputRequest) *vp.WithdrawalStatus {
+		var status *vp.WithdrawalStatus
+		vp.TstRunWithManagerUnlocked(t, mgr, addrmgrNs, func() {
+			status, err = pool.StartWithdrawal(ns, addrmgrNs, roundID, requests, *startAddr,
+				def.SeriesID, *changeStart, store, txmgrNs, currentBlock, dustThreshold)
+		})
+		return status
+	}
+
+	// Requests with the same server and transaction number are rejected,
+	// even when paying different addresses.
+	startWithdrawal(0, []vp.OutputRequest{
+		vp.TstNewOutputRequest(t, 1, address1, 4e6, mgr.ChainParams()),
+		vp.TstNewOutputRequest(t, 1, address2, 1e6, mgr.ChainParams()),
+	})
+	vp.TstCheckError(t, "duplicate requests", err, vp.ErrDuplicateOutputRequest)
+
+	// Requests paying an address for another network are rejected.
+	testNetAddr, err := bchutil.NewAddressPubKeyHash(make([]byte, 20), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	startWithdrawal(0, []vp.OutputRequest{
+		vp.TstNewOutputRequest(t, 1, address1, 4e6, mgr.ChainParams()),
+		vp.TstNewOutputRequest(t, 2, testNetAddr.String(), 1e6, &chaincfg.TestNet3Params),
+	})
+	vp.TstCheckError(t, "wrong network", err, vp.ErrInvalidValue)
+
+	// The same transaction number received by another server identifies
+	// another request.
+	other := vp.TstNewOutputRequest(t, 1, address2, 1e6, mgr.ChainParams())
+	other.Server = "other server"
+	status := startWithdrawal(0, []vp.OutputRequest{
+		vp.TstNewOutputRequest(t, 1, address1, 4e6, mgr.ChainParams()),
+		other,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkWithdrawalOutputs(t, status, map[string]bchutil.Amount{address1: 4e6, address2: 1e6})
+}
+
+// TestNewOutputRequest ensures output requests are only created for valid
+// addresses of the pool's network.
+func TestNewOutputRequest(t *testing.T) {
+	tearDown, _, pool := vp.TstCreatePool(t)
+	defer tearDown()
+
+	net := pool.Manager().ChainParams()
+	address := "pqsxukmp73sd8rrq6s9r984sfvrchk39agrl8rwq9d"
+	request, err := pool.NewOutputRequest("server", 1, address, 4e6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := vp.TstNewOutputRequest(t, 1, address, 4e6, net)
+	if request.Address.String() != want.Address.String() ||
+		!bytes.Equal(request.PkScript, want.PkScript) ||
+		request.Amount != want.Amount || request.Server != want.Server ||
+		request.Transaction != want.Transaction {
+
+		t.Fatalf("Wrong output request; got %v, want %v", request, want)
+	}
+
+	testNetAddr, err := bchutil.NewAddressPubKeyHash(make([]byte, 20), &chaincfg.TestNet3Params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	invalid := []string{"", "not an address", testNetAddr.EncodeAddress(), testNetAddr.String()}
+	for _, address := range invalid {
+		_, err := pool.NewOutputRequest("server", 1, address, 4e6)
+		vp.TstCheckError(t, address, err, vp.ErrInvalidValue)
+	}
+}
@@ -43,7 +43,7 @@ func TestOutputSplittingNotEnoughInputs(t *testing.T) {
 		TstNewOutputRequest(t, 2, "34eVkREKgvvGASZW7hkgE2uNc1yycntMK6", output2Amount, net),
 	}
 	seriesID, eligible := tstCreateCreditsOnNewSeries(t, dbtx, pool, []int64{7})
-	w := newWithdrawal(0, requests, eligible, *TstNewChangeAddress(t, pool, seriesID, 0))
+	w := newWithdrawal(0, requests, eligible, *TstNewChangeAddress(t, pool, seriesID, 0), defaultWithdrawalFee)
 	w.txOptions = func(tx *withdrawalTx) {
 		// Trigger an output split because of lack of inputs by forcing a high fee.
 		// If we just started with not enough inputs for the requested outputs,
@@ -93,7 +93,7 @@ func TestOutputSplittingOversizeTx(t *testing.T) {
 		t, 1, "34eVkREKgvvGASZW7hkgE2uNc1yycntMK6", requestAmount, pool.Manager().ChainParams())
 	seriesID, eligible := tstCreateCreditsOnNewSeries(t, dbtx, pool, []int64{smallInput, bigInput})
 	changeStart := TstNewChangeAddress(t, pool, seriesID, 0)
-	w := newWithdrawal(0, []OutputRequest{request}, eligible, *changeStart)
+	w := newWithdrawal(0, []OutputRequest{request}, eligible, *changeStart, defaultWithdrawalFee)
 	w.txOptions = func(tx *withdrawalTx) {
 		tx.calculateFee = TstConstantFee(0)
 		tx.calculateSize = func() int {
@@ -150,7 +150,7 @@ func TestSplitLastOutputNoOutputs(t *testing.T) {
 	}
 	defer dbtx.Commit()
 
-	w := newWithdrawal(0, []OutputRequest{}, []Credit{}, ChangeAddress{})
+	w := newWithdrawal(0, []OutputRequest{}, []Credit{}, ChangeAddress{}, defaultWithdrawalFee)
 	w.current = createWithdrawalTx(t, dbtx, pool, []int64{}, []int64{})
 
 	err = w.splitLastOutput()
@@ -180,7 +180,7 @@ func TestWithdrawalTxOutputs(t *testing.T) {
 	}
 	changeStart := TstNewChangeAddress(t, pool, seriesID, 0)
 
-	w := newWithdrawal(0, outputs, eligible, *changeStart)
+	w := newWithdrawal(0, outputs, eligible, *changeStart, defaultWithdrawalFee)
 	if err := w.fulfillRequests(); err != nil {
 		t.Fatal(err)
 	}
@@ -217,7 +217,7 @@ func TestFulfillRequestsNoSatisfiableOutputs(t *testing.T) {
 		t, 1, "3Qt1EaKRD9g9FeL2DGkLLswhK1AKmmXFSe", bchutil.Amount(3e6), pool.Manager().ChainParams())
 	changeStart := TstNewChangeAddress(t, pool, seriesID, 0)
 
-	w := newWithdrawal(0, []OutputRequest{request}, eligible, *changeStart)
+	w := newWithdrawal(0, []OutputRequest{request}, eligible, *changeStart, defaultWithdrawalFee)
 	if err := w.fulfillRequests(); err != nil {
 		t.Fatal(err)
 	}
@@ -263,7 +263,7 @@ func TestFulfillRequestsNotEnoughCreditsForAllRequests(t *testing.T) {
 	outputs := []OutputRequest{out1, out2, out3}
 	changeStart := TstNewChangeAddress(t, pool, seriesID, 0)
 
-	w := newWithdrawal(0, outputs, eligible, *changeStart)
+	w := newWithdrawal(0, outputs, eligible, *changeStart, defaultWithdrawalFee)
 	if err := w.fulfillRequests(); err != nil {
 		t.Fatal(err)
 	}
@@ -417,7 +417,7 @@ func TestRollbackLastOutputNoInputsRolledBack(t *testing.T) {
 // rollBackLastOutput returns an error if there are less than two
 // outputs in the transaction.
 func TestRollBackLastOutputInsufficientOutputs(t *testing.T) {
-	tx := newWithdrawalTx(defaultTxOptions)
+	tx := newWithdrawalTx(defaultWithdrawalFee, defaultTxOptions)
 	_, _, err := tx.rollBackLastOutput()
 	TstCheckError(t, "", err, ErrPreconditionNotMet)
 
@@ -449,7 +449,9 @@ func TestRollbackLastOutputWhenNewOutputAdded(t *testing.T) {
 	}
 	changeStart := TstNewChangeAddress(t, pool, series, 0)
 
-	w := newWithdrawal(0, requests, eligible, *changeStart)
+	w := newWithdrawal(0, requests, eligible, *changeStart, defaultWithdrawalFee)
+	// Pay even the tiny change of these transactions to change outputs.
+	w.changeThreshold = 0
 	w.txOptions = func(tx *withdrawalTx) {
 		tx.calculateFee = TstConstantFee(0)
 		tx.calculateSize = func() int {
@@ -509,7 +511,7 @@ func TestRollbackLastOutputWhenNewInputAdded(t *testing.T) {
 	}
 	changeStart := TstNewChangeAddress(t, pool, series, 0)
 
-	w := newWithdrawal(0, requests, eligible, *changeStart)
+	w := newWithdrawal(0, requests, eligible, *changeStart, defaultWithdrawalFee)
 	w.txOptions = func(tx *withdrawalTx) {
 		tx.calculateFee = TstConstantFee(0)
 		tx.calculateSize = func() int {
@@ -674,6 +676,41 @@ func TestWithdrawalTxAddChangeNoChange(t *testing.T) {
 	}
 }
 
+// TestWithdrawalTxAddChangeBelowThreshold checks that withdrawalTx.addChange()
+// adds change below the tx's change threshold to the fee rather than creating
+// a dust change output.
+func TestWithdrawalTxAddChangeBelowThreshold(t *testing.T) {
+	tearDown, db, pool := TstCreatePool(t)
+	defer tearDown()
+
+	dbtx, err := db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbtx.Commit()
+
+	input, output, fee := int64(4e6), int64(3e6), int64(1e6-100)
+	tx := createWithdrawalTx(t, dbtx, pool, []int64{input}, []int64{output})
+	tx.calculateFee = TstConstantFee(bchutil.Amount(fee))
+
+	if tx.addChange([]byte{}) {
+		t.Fatal("tx.addChange() returned true, meaning it added a change output")
+	}
+	if tx.fee != bchutil.Amount(input-output) {
+		t.Fatalf("Unexpected fee; got %v, want %v", tx.fee, bchutil.Amount(input-output))
+	}
+
+	// The same change is paid to a change output when the threshold is
+	// lowered.
+	tx = createWithdrawalTx(t, dbtx, pool, []int64{input}, []int64{output})
+	tx.calculateFee = TstConstantFee(bchutil.Amount(fee))
+	tx.changeThreshold = 100
+	if !tx.addChange([]byte{}) {
+		t.Fatal("tx.addChange() returned false, meaning it did not add a change output")
+	}
+	checkTxChangeAmount(t, tx, 100)
+}
+
 func TestWithdrawalTxToMsgTxNoInputsOrOutputsOrChange(t *testing.T) {
 	tearDown, db, pool := TstCreatePool(t)
 	defer tearDown()
@@ -1250,9 +1287,10 @@ func TestTxSizeCalculation(t *testing.T) {
 
 	// Now add a change output, get a msgtx, sign it and get its SerializedSize
 	// to compare with the value above. We need to replace the calculateFee
-	// method so that the tx.addChange() call below always adds a change
-	// output.
+	// method and the change threshold so that the tx.addChange() call below
+	// always adds a change output.
 	tx.calculateFee = TstConstantFee(1)
+	tx.changeThreshold = 0
 	seriesID := tx.inputs[0].addr.SeriesID()
 	tx.addChange(TstNewChangeAddress(t, pool, seriesID, 0).addr.ScriptAddress())
 	msgtx := tx.toMsgTx()
@@ -1286,7 +1324,7 @@ func TestTxSizeCalculation(t *testing.T) {
 }
 
 func TestTxFeeEstimationForSmallTx(t *testing.T) {
-	tx := newWithdrawalTx(defaultTxOptions)
+	tx := newWithdrawalTx(defaultWithdrawalFee, defaultTxOptions)
 
 	// A tx that is smaller than 1000 bytes in size should have a fee of 10000
 	// satoshis.
@@ -1300,7 +1338,7 @@ func TestTxFeeEstimationForSmallTx(t *testing.T) {
 }
 
 func TestTxFeeEstimationForLargeTx(t *testing.T) {
-	tx := newWithdrawalTx(defaultTxOptions)
+	tx := newWithdrawalTx(defaultWithdrawalFee, defaultTxOptions)
 
 	// A tx that is larger than 1000 bytes in size should have a fee of 1e3
 	// satoshis plus 1e3 for every 1000 bytes.
@@ -1313,6 +1351,44 @@ func TestTxFeeEstimationForLargeTx(t *testing.T) {
 	}
 }
 
+func TestTxFeeEstimationWithConfiguredFee(t *testing.T) {
+	tx := newWithdrawalTx(ConstantWithdrawalFee(5e3), defaultTxOptions)
+	tx.calculateSize = func() int { return 3000 }
+	if fee := tx.calculateFee(); fee != 5e3 {
+		t.Fatalf("Unexpected constant tx fee; got %v, want %v", fee, bchutil.Amount(5e3))
+	}
+
+	// A size-based fee is proportional to the tx size.
+	tx = newWithdrawalTx(SizeWithdrawalFee(2e3), defaultTxOptions)
+	tx.calculateSize = func() int { return 2500 }
+	if fee := tx.calculateFee(); fee != 5e3 {
+		t.Fatalf("Unexpected size-based tx fee; got %v, want %v", fee, bchutil.Amount(5e3))
+	}
+	tx.calculateSize = func() int { return 100 }
+	if fee := tx.calculateFee(); fee != 200 {
+		t.Fatalf("Unexpected size-based tx fee; got %v, want %v", fee, bchutil.Amount(200))
+	}
+}
+
+func TestPoolWithdrawalFee(t *testing.T) {
+	tearDown, _, pool := TstCreatePool(t)
+	defer tearDown()
+
+	pool.SetWithdrawalFee(1, ConstantWithdrawalFee(7e3))
+	if fee := pool.withdrawalFee(1)(100); fee != 7e3 {
+		t.Fatalf("Unexpected fee for series 1; got %v, want %v", fee, bchutil.Amount(7e3))
+	}
+	// Other series keep using the default fee.
+	if fee := pool.withdrawalFee(2)(100); fee != feeIncrement {
+		t.Fatalf("Unexpected fee for series 2; got %v, want %v", fee, bchutil.Amount(feeIncrement))
+	}
+
+	pool.SetWithdrawalFee(1, nil)
+	if fee := pool.withdrawalFee(1)(100); fee != feeIncrement {
+		t.Fatalf("Unexpected fee after reset; got %v, want %v", fee, bchutil.Amount(feeIncrement))
+	}
+}
+
 func TestStoreTransactionsWithoutChangeOutput(t *testing.T) {
 	tearDown, db, pool, store := TstCreatePoolAndTxStore(t)
 	defer tearDown()
@@ -1414,7 +1490,7 @@ func createWithdrawalTxWithStoreCredits(t *testing.T, dbtx walletdb.ReadWriteTx,
 	def := TstCreateSeriesDef(t, pool, 2, masters)
 	TstCreateSeries(t, dbtx, pool, []TstSeriesDef{def})
 	net := pool.Manager().ChainParams()
-	tx := newWithdrawalTx(defaultTxOptions)
+	tx := newWithdrawalTx(defaultWithdrawalFee, defaultTxOptions)
 	for _, c := range TstCreateSeriesCreditsOnStore(t, dbtx, pool, def.SeriesID, inputAmounts, store) {
 		tx.addInput(c)
 	}
@@ -1611,3 +1687,179 @@ func checkLastOutputWasSplit(t *testing.T, w *withdrawal, tx *withdrawalTx,
 		t.Fatalf("Wrong output status; got '%s', want '%s'", status, statusPartial)
 	}
 }
+
+func TestReconcileWithdrawal(t *testing.T) {
+	net := &chaincfg.MainNetParams
+	address := "34eVkREKgvvGASZW7hkgE2uNc1yycntMK6"
+	otherAddress := "1MirQ9bwyQcGVJPwKUgapu5ouK2E2Ey4gX"
+	fulfilled := TstNewOutputRequest(t, 1, address, 3e6, net)
+	split := TstNewOutputRequest(t, 2, address, 5e6, net)
+	dropped := TstNewOutputRequest(t, 3, address, 1e3, net)
+	unfulfilled := TstNewOutputRequest(t, 4, address, 4e6, net)
+	partial := TstNewOutputRequest(t, 5, address, 2e6, net)
+	mismatched := TstNewOutputRequest(t, 6, address, 1e6, net)
+	otherScript := TstNewOutputRequest(t, 7, otherAddress, 1e6, net).PkScript
+
+	tx1 := wire.NewMsgTx(wire.TxVersion)
+	tx1.AddTxOut(wire.NewTxOut(3e6, fulfilled.PkScript, wire.TokenData{}))
+	tx1.AddTxOut(wire.NewTxOut(2e6, split.PkScript, wire.TokenData{}))
+	tx1.AddTxOut(wire.NewTxOut(15e5, partial.PkScript, wire.TokenData{}))
+	tx2 := wire.NewMsgTx(wire.TxVersion)
+	tx2.AddTxOut(wire.NewTxOut(3e6, split.PkScript, wire.TokenData{}))
+	tx2.AddTxOut(wire.NewTxOut(1e6, otherScript, wire.TokenData{}))
+
+	outpoints := func(ops ...OutBailmentOutpoint) []OutBailmentOutpoint { return ops }
+	op := func(ntxid Ntxid, index uint32, amount bchutil.Amount) OutBailmentOutpoint {
+		return OutBailmentOutpoint{ntxid: ntxid, index: index, amount: amount}
+	}
+	requests := []OutputRequest{fulfilled, split, dropped, unfulfilled, partial, mismatched}
+	wOutputs := map[OutBailmentID][]OutBailmentOutpoint{
+		fulfilled.outBailmentID():  outpoints(op("tx1", 0, 3e6)),
+		split.outBailmentID():      outpoints(op("tx1", 1, 2e6), op("tx2", 0, 3e6)),
+		partial.outBailmentID():    outpoints(op("tx1", 2, 15e5)),
+		mismatched.outBailmentID(): outpoints(op("tx2", 1, 1e6)),
+	}
+	wInfo := &withdrawalInfo{
+		requests:      requests,
+		dustThreshold: 1e4,
+		status: WithdrawalStatus{
+			outputs: make(map[OutBailmentID]*WithdrawalOutput),
+			transactions: map[Ntxid]changeAwareTx{
+				"tx1": {MsgTx: tx1, changeIdx: -1},
+				"tx2": {MsgTx: tx2, changeIdx: -1},
+			},
+		},
+	}
+	for _, request := range requests {
+		wInfo.status.outputs[request.outBailmentID()] = &WithdrawalOutput{
+			request:   request,
+			outpoints: wOutputs[request.outBailmentID()],
+		}
+	}
+
+	report := reconcileWithdrawal(7, wInfo)
+	if report.RoundID != 7 {
+		t.Fatalf("Wrong round ID; got %d, want 7", report.RoundID)
+	}
+	tests := []struct {
+		status ReconcileStatus
+		paid   bchutil.Amount
+	}{
+		{ReconcileFulfilled, 3e6},
+		{ReconcileSplit, 5e6},
+		{ReconcileDropped, 0},
+		{ReconcileUnfulfilled, 0},
+		{ReconcileUnfulfilled, 15e5},
+		// The recorded output pays another address.
+		{ReconcileUnfulfilled, 0},
+	}
+	if len(report.Outputs) != len(tests) {
+		t.Fatalf("Wrong number of reconciled outputs; got %d, want %d", len(report.Outputs),
+			len(tests))
+	}
+	for i, test := range tests {
+		output := report.Outputs[i]
+		if output.Request.outBailmentID() != requests[i].outBailmentID() {
+			t.Fatalf("Output %d: got request %v, want %v", i, output.Request, requests[i])
+		}
+		if output.Status != test.status || output.Paid != test.paid {
+			t.Fatalf("Output %d: got %v paying %v, want %v paying %v", i, output.Status,
+				output.Paid, test.status, test.paid)
+		}
+	}
+	if d := report.Discrepancies(); len(d) != len(tests)-1 {
+		t.Fatalf("Wrong number of discrepancies; got %d, want %d", len(d), len(tests)-1)
+	}
+}
+
+func TestMergeTxSigs(t *testing.T) {
+	// Two inputs, each locked by a multi-sig script with three public keys.
+	own := TxSigs{{RawSig{1}, nil, nil}, {nil, RawSig{2}, nil}}
+	other := TxSigs{{RawSig{9}, nil, RawSig{3}}, {nil, nil, nil}}
+	third := TxSigs{{nil, nil, nil}, {RawSig{4}, nil, RawSig{5}}}
+
+	merged, err := mergeTxSigs(own, nil, other, third)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Signatures already present are kept, and the missing ones are
+	// dropped, preserving the order of the public keys.
+	want := TxSigs{{RawSig{1}, RawSig{3}}, {RawSig{4}, RawSig{2}, RawSig{5}}}
+	if !reflect.DeepEqual(merged, want) {
+		t.Fatalf("Wrong merged signatures; got %v, want %v", merged, want)
+	}
+
+	_, err = mergeTxSigs(own, TxSigs{{nil, nil, nil}})
+	TstCheckError(t, "input mismatch", err, ErrTxSigning)
+	_, err = mergeTxSigs(own, TxSigs{{nil, nil}, {nil, nil, nil}})
+	TstCheckError(t, "public key mismatch", err, ErrTxSigning)
+	_, err = mergeTxSigs(nil)
+	TstCheckError(t, "no signatures", err, ErrTxSigning)
+}
+
+// TestWithdrawalDustChangeAddedToFee checks that a withdrawal whose change
+// would be dust adds it to the network fee instead of paying it to the next
+// change address.
+func TestWithdrawalDustChangeAddedToFee(t *testing.T) {
+	tearDown, db, pool := TstCreatePool(t)
+	defer tearDown()
+
+	dbtx, err := db.BeginReadWriteTx()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dbtx.Commit()
+
+	net := pool.Manager().ChainParams()
+	seriesID, eligible := tstCreateCreditsOnNewSeries(t, dbtx, pool, []int64{2e6})
+	// The change left after paying the request and the fee is 100
+	// satoshis, well below the dust threshold of a change output.
+	requests := []OutputRequest{
+		TstNewOutputRequest(t, 1, "34eVkREKgvvGASZW7hkgE2uNc1yycntMK6", 2e6-1e3-100, net),
+	}
+	changeStart := TstNewChangeAddress(t, pool, seriesID, 0)
+
+	w := newWithdrawal(0, requests, eligible, *changeStart, ConstantWithdrawalFee(1e3))
+	if err := w.fulfillRequests(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(w.transactions) != 1 {
+		t.Fatalf("Unexpected number of transactions; got %d, want 1", len(w.transactions))
+	}
+	tx := w.transactions[0]
+	if tx.hasChange() {
+		t.Fatalf("Unexpected change output with amount %v", tx.changeOutput.Value)
+	}
+	checkMsgTxOutputs(t, tx.toMsgTx(), requests)
+	if w.status.Fees() != 1100 {
+		t.Fatalf("Unexpected fees; got %v, want %v", w.status.Fees(), bchutil.Amount(1100))
+	}
+	// The change address was not used, so the next withdrawal starts with
+	// it.
+	if w.status.NextChangeAddr().Index() != changeStart.Index() {
+		t.Fatalf("Unexpected next change address index; got %d, want %d",
+			w.status.NextChangeAddr().Index(), changeStart.Index())
+	}
+}
+
+func TestPoolWithdrawalChangeThreshold(t *testing.T) {
+	tearDown, _, pool := TstCreatePool(t)
+	defer tearDown()
+
+	pool.SetWithdrawalChangeThreshold(1, 0)
+	if threshold := pool.withdrawalChangeThreshold(1); threshold != 0 {
+		t.Fatalf("Unexpected change threshold for series 1; got %v, want 0", threshold)
+	}
+	// Other series keep using the default threshold.
+	if threshold := pool.withdrawalChangeThreshold(2); threshold != defaultChangeThreshold {
+		t.Fatalf("Unexpected change threshold for series 2; got %v, want %v", threshold,
+			defaultChangeThreshold)
+	}
+
+	pool.SetWithdrawalChangeThreshold(1, -1)
+	if threshold := pool.withdrawalChangeThreshold(1); threshold != defaultChangeThreshold {
+		t.Fatalf("Unexpected change threshold after reset; got %v, want %v", threshold,
+			defaultChangeThreshold)
+	}
+}
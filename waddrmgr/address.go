@@ -64,6 +64,12 @@ type ManagedAddress interface {
 	// Used returns true if the backing address has been used in a transaction.
 	Used(ns walletdb.ReadBucket) bool
 
+	// WatchOnly returns true if no private key is known for the backing
+	// address although the address manager otherwise holds private keys.
+	// Addresses of a watching-only address manager, which creates unsigned
+	// transactions spending any of its outputs, are not watch-only.
+	WatchOnly() bool
+
 	// AddrType returns the address type of the managed address. This can
 	// be used to quickly discern the address type without further
 	// processing
@@ -116,6 +122,7 @@ type managedAddress struct {
 	address          bchutil.Address
 	imported         bool
 	internal         bool
+	acctWatchOnly    bool // derived from a watch-only account
 	compressed       bool
 	used             bool
 	addrType         AddressType
@@ -233,6 +240,26 @@ func (a *managedAddress) Used(ns walletdb.ReadBucket) bool {
 	return a.manager.fetchUsed(ns, a.AddrHash())
 }
 
+// WatchOnly returns true if the address belongs to a watch-only account or is
+// an imported address whose private key is not known.  Addresses of a
+// watching-only address manager are never watch-only, since none of its
+// addresses have private keys.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *managedAddress) WatchOnly() bool {
+	if a.manager.rootManager.WatchOnly() {
+		return false
+	}
+	if a.acctWatchOnly {
+		return true
+	}
+
+	// Addresses derived from an account's extended key always have their
+	// private key available once the manager is unlocked, even when it has
+	// not been derived yet.
+	return a.imported && len(a.privKeyEncrypted) == 0
+}
+
 // PubKey returns the public key associated with the address.
 //
 // This is part of the ManagedPubKeyAddress interface implementation.
@@ -258,12 +285,14 @@ func (a *managedAddress) ExportPubKey() string {
 }
 
 // PrivKey returns the private key for the address.  It can fail if the address
-// manager is watching-only or locked, or the address does not have any keys.
+// manager is watching-only or locked, the address belongs to a watch-only
+// account, or the address does not have any keys.
 //
 // This is part of the ManagedPubKeyAddress interface implementation.
 func (a *managedAddress) PrivKey() (*bchec.PrivateKey, error) {
-	// No private keys are available for a watching-only address manager.
-	if a.manager.rootManager.WatchOnly() {
+	// No private keys are available for a watching-only address manager
+	// or for the addresses of a watch-only account.
+	if a.manager.rootManager.WatchOnly() || a.acctWatchOnly {
 		return nil, managerError(ErrWatchingOnly, errWatchingOnly, nil)
 	}
 
@@ -555,6 +584,14 @@ func (a *scriptAddress) Used(ns walletdb.ReadBucket) bool {
 	return a.manager.fetchUsed(ns, a.AddrHash())
 }
 
+// WatchOnly always returns false, since the redeem script of an imported
+// script address is known whenever the address manager holds private keys.
+//
+// This is part of the ManagedAddress interface implementation.
+func (a *scriptAddress) WatchOnly() bool {
+	return false
+}
+
 // Script returns the script associated with the address.
 //
 // This implements the ScriptAddress interface.
@@ -139,6 +139,11 @@ const (
 	// ErrBlockNotFound is returned when we attempt to retrieve the hash for
 	// a block that we do not know of.
 	ErrBlockNotFound
+
+	// ErrGapLimitExceeded indicates that generating the requested external
+	// addresses would leave more consecutive unused addresses than the
+	// configured gap limit allows.
+	ErrGapLimitExceeded
 )
 
 // Map of ErrorCode values back to their constant names for pretty printing.
@@ -165,6 +170,7 @@ var errorCodeStrings = map[ErrorCode]string{
 	ErrCallBackBreak:     "ErrCallBackBreak",
 	ErrEmptyPassphrase:   "ErrEmptyPassphrase",
 	ErrScopeNotFound:     "ErrScopeNotFound",
+	ErrGapLimitExceeded:  "ErrGapLimitExceeded",
 }
 
 // String returns the ErrorCode as a human-readable name.
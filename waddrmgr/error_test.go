@@ -39,6 +39,8 @@ func TestErrorCodeStringer(t *testing.T) {
 		{waddrmgr.ErrWrongNet, "ErrWrongNet"},
 		{waddrmgr.ErrCallBackBreak, "ErrCallBackBreak"},
 		{waddrmgr.ErrEmptyPassphrase, "ErrEmptyPassphrase"},
+		{waddrmgr.ErrScopeNotFound, "ErrScopeNotFound"},
+		{waddrmgr.ErrGapLimitExceeded, "ErrGapLimitExceeded"},
 		{0xffff, "Unknown ErrorCode (65535)"},
 	}
 	t.Logf("Running %d tests", len(tests))
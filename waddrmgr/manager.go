@@ -9,6 +9,7 @@ import (
 	"crypto/sha512"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gcash/bchd/chaincfg"
@@ -142,7 +143,8 @@ type accountInfo struct {
 
 	// The account key is used to derive the branches which in turn derive
 	// the internal and external addresses.  The accountKeyPriv will be nil
-	// when the address manager is locked.
+	// when the address manager is locked.  Watch-only accounts have no
+	// encrypted private key at all.
 	acctKeyEncrypted []byte
 	acctKeyPriv      *hdkeychain.ExtendedKey
 	acctKeyPub       *hdkeychain.ExtendedKey
@@ -158,6 +160,12 @@ type accountInfo struct {
 	lastInternalAddr  ManagedAddress
 }
 
+// watchOnly returns whether the account was imported from an extended public
+// key and so has no private key material.
+func (a *accountInfo) watchOnly() bool {
+	return len(a.acctKeyEncrypted) == 0
+}
+
 // AccountProperties contains properties associated with each account, such as
 // the account name, number, and the nubmer of derived and imported keys.
 type AccountProperties struct {
@@ -249,6 +257,11 @@ var newCryptoKey = defaultNewCryptoKey
 type Manager struct {
 	mtx sync.RWMutex
 
+	// gapLimit is the maximum number of consecutive unused external
+	// addresses that may be generated for an account, or zero for no
+	// limit.  It must be accessed atomically.
+	gapLimit uint32
+
 	// scopedManager is a mapping of scope of scoped manager, the manager
 	// itself loaded into memory.
 	scopedManagers map[KeyScope]*ScopedKeyManager
@@ -301,6 +314,20 @@ type Manager struct {
 	hashedPrivPassphrase [sha512.Size]byte
 }
 
+// SetGapLimit sets the maximum number of consecutive unused external addresses
+// that may be generated for an account.  Once reached, requests for new
+// external addresses fail with ErrGapLimitExceeded until one of the unused
+// addresses receives funds.  A limit of zero disables the check.
+func (m *Manager) SetGapLimit(limit uint32) {
+	atomic.StoreUint32(&m.gapLimit, limit)
+}
+
+// GapLimit returns the maximum number of consecutive unused external addresses
+// that may be generated for an account, or zero if there is no limit.
+func (m *Manager) GapLimit() uint32 {
+	return atomic.LoadUint32(&m.gapLimit)
+}
+
 // WatchOnly returns true if the root manager is in watch only mode, and false
 // otherwise.
 func (m *Manager) WatchOnly() bool {
@@ -405,6 +432,40 @@ func (m *Manager) NewScopedKeyManager(ns walletdb.ReadWriteBucket, scope KeyScop
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
+	return m.newScopedKeyManager(ns, scope, addrSchema)
+}
+
+// EnableScope returns the scoped key manager of the given scope, creating it
+// with the given address schema if the scope does not exist yet.  Unlike
+// NewScopedKeyManager, it may be called again for an existing scope, in which
+// case the existing manager is returned and its address schema is left
+// unchanged.  Creating a scope has the same requirements as
+// NewScopedKeyManager: ErrWatchingOnly is returned for watching-only managers
+// and ErrLocked when the manager is locked.
+func (m *Manager) EnableScope(ns walletdb.ReadWriteBucket, scope KeyScope,
+	addrSchema ScopeAddrSchema) (*ScopedKeyManager, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if sm, ok := m.scopedManagers[scope]; ok {
+		return sm, nil
+	}
+	return m.newScopedKeyManager(ns, scope, addrSchema)
+}
+
+// newScopedKeyManager creates a new scoped key manager as described by
+// NewScopedKeyManager.
+//
+// This function MUST be called with the manager lock held for writes.
+func (m *Manager) newScopedKeyManager(ns walletdb.ReadWriteBucket, scope KeyScope,
+	addrSchema ScopeAddrSchema) (*ScopedKeyManager, error) {
+
+	// A watching-only manager has no root key to derive the scope from.
+	if m.watchingOnly {
+		return nil, managerError(ErrWatchingOnly, errWatchingOnly, nil)
+	}
+
 	// If the manager is locked, then we can't create a new scoped manager.
 	if m.locked {
 		return nil, managerError(ErrLocked, errLocked, nil)
@@ -763,6 +824,29 @@ func (m *Manager) ChainParams() *chaincfg.Params {
 	return m.chainParams
 }
 
+// ScryptOptions returns the scrypt parameters used to derive the key protecting
+// the public or private passphrase, depending on the private flag.  The
+// parameters are stored alongside the master keys, so they are the ones chosen
+// when the manager was created or its passphrase last changed.  The default
+// options are returned for the private passphrase of a watching-only manager.
+func (m *Manager) ScryptOptions(private bool) ScryptOptions {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+
+	masterKey := m.masterKeyPub
+	if private {
+		masterKey = m.masterKeyPriv
+	}
+	if masterKey == nil || masterKey.Parameters.N == 0 {
+		return DefaultScryptOptions
+	}
+	return ScryptOptions{
+		N: masterKey.Parameters.N,
+		R: masterKey.Parameters.R,
+		P: masterKey.Parameters.P,
+	}
+}
+
 // ChangePassphrase changes either the public or private passphrase to the
 // provided value depending on the private flag.  In order to change the
 // private password, the address manager must not be watching-only.  The new
@@ -1108,6 +1192,10 @@ func (m *Manager) Unlock(ns walletdb.ReadBucket, passphrase []byte) error {
 	// extended keys.
 	for _, manager := range m.scopedManagers {
 		for account, acctInfo := range manager.acctInfo {
+			if acctInfo.watchOnly() {
+				continue
+			}
+
 			decrypted, err := m.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
 			if err != nil {
 				m.lock()
@@ -18,6 +18,7 @@ import (
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/hdkeychain"
 	"github.com/gcash/bchwallet/snacl"
 	"github.com/gcash/bchwallet/walletdb"
 )
@@ -2453,3 +2454,654 @@ func TestNewRawAccount(t *testing.T) {
 			accountTargetAddr.AddrHash())
 	}
 }
+
+// TestEnableScope ensures that enabling a scope creates it the first time and
+// returns the existing scoped manager afterwards, and that a new scope can't be
+// enabled while the manager is locked or watching-only.
+func TestEnableScope(t *testing.T) {
+	teardown, db := emptyDB(t)
+	defer teardown()
+
+	var mgr *Manager
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := tx.CreateTopLevelBucket(waddrmgrNamespaceKey)
+		if err != nil {
+			return err
+		}
+		err = Create(
+			ns, seed, pubPassphrase, privPassphrase,
+			&chaincfg.MainNetParams, fastScrypt, time.Time{},
+		)
+		if err != nil {
+			return err
+		}
+
+		mgr, err = Open(ns, pubPassphrase, &chaincfg.MainNetParams)
+		if err != nil {
+			return err
+		}
+
+		return mgr.Unlock(ns, privPassphrase)
+	})
+	if err != nil {
+		t.Fatalf("create/open: unexpected error: %v", err)
+	}
+	defer mgr.Close()
+
+	testScope := KeyScope{
+		Purpose: 84,
+		Coin:    145,
+	}
+	addrSchema := ScopeAddrSchema{
+		ExternalAddrType: PubKeyHash,
+		InternalAddrType: PubKeyHash,
+	}
+	enableScope := func(scope KeyScope) (*ScopedKeyManager, error) {
+		var scopedMgr *ScopedKeyManager
+		err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+			var err error
+			scopedMgr, err = mgr.EnableScope(ns, scope, addrSchema)
+			return err
+		})
+		return scopedMgr, err
+	}
+
+	// Enabling the scope twice must create it once and return the same
+	// scoped manager both times.
+	first, err := enableScope(testScope)
+	if err != nil {
+		t.Fatalf("unable to enable scope: %v", err)
+	}
+	second, err := enableScope(testScope)
+	if err != nil {
+		t.Fatalf("unable to enable existing scope: %v", err)
+	}
+	if first != second {
+		t.Fatal("enabling an existing scope created a new scoped manager")
+	}
+	fetched, err := mgr.FetchScopedKeyManager(testScope)
+	if err != nil {
+		t.Fatalf("unable to fetch enabled scope: %v", err)
+	}
+	if fetched != first {
+		t.Fatal("fetched scoped manager differs from the enabled one")
+	}
+
+	// An existing scope can be enabled while the manager is locked, but a
+	// new one can't.
+	if err := mgr.Lock(); err != nil {
+		t.Fatalf("unable to lock manager: %v", err)
+	}
+	if _, err := enableScope(testScope); err != nil {
+		t.Fatalf("unable to enable existing scope while locked: %v",
+			err)
+	}
+	_, err = enableScope(KeyScope{Purpose: 99, Coin: 145})
+	checkManagerError(t, "EnableScope locked", err, ErrLocked)
+
+	// Nor can a new scope be enabled for a watching-only manager.
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return mgr.ConvertToWatchingOnly(ns)
+	})
+	if err != nil {
+		t.Fatalf("unable to convert to watching-only: %v", err)
+	}
+	_, err = enableScope(KeyScope{Purpose: 99, Coin: 145})
+	checkManagerError(t, "EnableScope watching-only", err, ErrWatchingOnly)
+}
+
+// TestGapLimit ensures that external addresses can't be generated beyond the
+// configured gap limit until one of the unused addresses has been used, and
+// that internal addresses are not limited.
+func TestGapLimit(t *testing.T) {
+	t.Parallel()
+
+	teardown, db := emptyDB(t)
+	defer teardown()
+
+	var mgr *Manager
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := tx.CreateTopLevelBucket(waddrmgrNamespaceKey)
+		if err != nil {
+			return err
+		}
+		err = Create(
+			ns, seed, pubPassphrase, privPassphrase,
+			&chaincfg.MainNetParams, fastScrypt, time.Time{},
+		)
+		if err != nil {
+			return err
+		}
+
+		mgr, err = Open(ns, pubPassphrase, &chaincfg.MainNetParams)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("create/open: unexpected error: %v", err)
+	}
+	defer mgr.Close()
+
+	scopedMgr, err := mgr.FetchScopedKeyManager(KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to fetch scope %v: %v", KeyScopeBIP0044, err)
+	}
+
+	const gapLimit = 3
+	mgr.SetGapLimit(gapLimit)
+
+	nextAddrs := func(num uint32, internal bool) ([]ManagedAddress, error) {
+		var addrs []ManagedAddress
+		err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			var err error
+			if internal {
+				addrs, err = scopedMgr.NextInternalAddresses(
+					ns, DefaultAccountNum, num,
+				)
+			} else {
+				addrs, err = scopedMgr.NextExternalAddresses(
+					ns, DefaultAccountNum, num,
+				)
+			}
+			return err
+		})
+		return addrs, err
+	}
+
+	// Requesting more addresses than the gap limit at once must fail.
+	if _, err := nextAddrs(gapLimit+1, false); !IsError(err, ErrGapLimitExceeded) {
+		t.Fatalf("expected ErrGapLimitExceeded, got %v", err)
+	}
+
+	addrs, err := nextAddrs(gapLimit, false)
+	if err != nil {
+		t.Fatalf("unable to derive addresses: %v", err)
+	}
+	if _, err := nextAddrs(1, false); !IsError(err, ErrGapLimitExceeded) {
+		t.Fatalf("expected ErrGapLimitExceeded, got %v", err)
+	}
+
+	// Internal addresses are not subject to the gap limit.
+	if _, err := nextAddrs(gapLimit+1, true); err != nil {
+		t.Fatalf("unable to derive internal addresses: %v", err)
+	}
+
+	// Using the second address leaves a single unused address after it,
+	// allowing two more addresses to be generated.
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return scopedMgr.MarkUsed(ns, addrs[1].Address())
+	})
+	if err != nil {
+		t.Fatalf("unable to mark address used: %v", err)
+	}
+	if _, err := nextAddrs(2, false); err != nil {
+		t.Fatalf("unable to derive addresses: %v", err)
+	}
+	if _, err := nextAddrs(1, false); !IsError(err, ErrGapLimitExceeded) {
+		t.Fatalf("expected ErrGapLimitExceeded, got %v", err)
+	}
+
+	// Removing the limit allows addresses to be generated again.
+	mgr.SetGapLimit(0)
+	if _, err := nextAddrs(1, false); err != nil {
+		t.Fatalf("unable to derive addresses: %v", err)
+	}
+}
+
+// TestAccountExtendedPubKey ensures the exported extended public key of an
+// account derives the same addresses as the manager, both before and after
+// the manager is converted to watching-only, and that unknown accounts are
+// rejected.
+func TestAccountExtendedPubKey(t *testing.T) {
+	teardown, db := emptyDB(t)
+	defer teardown()
+
+	var mgr *Manager
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := tx.CreateTopLevelBucket(waddrmgrNamespaceKey)
+		if err != nil {
+			return err
+		}
+		err = Create(
+			ns, seed, pubPassphrase, privPassphrase,
+			&chaincfg.MainNetParams, fastScrypt, time.Time{},
+		)
+		if err != nil {
+			return err
+		}
+
+		mgr, err = Open(ns, pubPassphrase, &chaincfg.MainNetParams)
+		if err != nil {
+			return err
+		}
+
+		return mgr.Unlock(ns, privPassphrase)
+	})
+	if err != nil {
+		t.Fatalf("create/open: unexpected error: %v", err)
+	}
+	defer mgr.Close()
+
+	scopedMgr, err := mgr.FetchScopedKeyManager(KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to fetch scope %v: %v", KeyScopeBIP0044, err)
+	}
+
+	var addrs []ManagedAddress
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		addrs, err = scopedMgr.NextExternalAddresses(ns, 0, 3)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to derive addresses: %v", err)
+	}
+
+	accountXpub := func() (string, error) {
+		var xpub string
+		err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+			ns := tx.ReadBucket(waddrmgrNamespaceKey)
+			key, err := scopedMgr.AccountExtendedPubKey(ns, 0)
+			if err != nil {
+				return err
+			}
+			xpub = key.String()
+			return nil
+		})
+		return xpub, err
+	}
+	xpub, err := accountXpub()
+	if err != nil {
+		t.Fatalf("unable to export account key: %v", err)
+	}
+
+	// Derive the external addresses from the exported key alone.
+	key, err := hdkeychain.NewKeyFromString(xpub)
+	if err != nil {
+		t.Fatalf("unable to parse %q: %v", xpub, err)
+	}
+	if key.IsPrivate() {
+		t.Fatalf("exported account key is private")
+	}
+	external, err := key.Child(ExternalBranch)
+	if err != nil {
+		t.Fatalf("unable to derive external branch: %v", err)
+	}
+	for i, addr := range addrs {
+		child, err := external.Child(uint32(i))
+		if err != nil {
+			t.Fatalf("unable to derive child %d: %v", i, err)
+		}
+		derived, err := child.Address(&chaincfg.MainNetParams)
+		if err != nil {
+			t.Fatalf("unable to derive address %d: %v", i, err)
+		}
+		if derived.String() != addr.Address().String() {
+			t.Fatalf("address %d derived from xpub is %v, want %v",
+				i, derived, addr.Address())
+		}
+	}
+
+	// Unknown accounts are not found.
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := scopedMgr.AccountExtendedPubKey(ns, 5)
+		return err
+	})
+	if !IsError(err, ErrAccountNotFound) {
+		t.Fatalf("got error %v for unknown account, want %v", err,
+			ErrAccountNotFound)
+	}
+
+	// The same key is exported by a watching-only manager.
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return mgr.ConvertToWatchingOnly(ns)
+	})
+	if err != nil {
+		t.Fatalf("unable to convert to watching-only: %v", err)
+	}
+	watchXpub, err := accountXpub()
+	if err != nil {
+		t.Fatalf("unable to export account key when watching-only: %v",
+			err)
+	}
+	if watchXpub != xpub {
+		t.Fatalf("watching-only account key is %v, want %v", watchXpub,
+			xpub)
+	}
+}
+
+// TestImportAccountWatchOnly ensures that an account imported from an
+// extended public key derives the expected addresses but never exposes their
+// private keys, alongside spendable accounts of the same manager.
+func TestImportAccountWatchOnly(t *testing.T) {
+	teardown, db := emptyDB(t)
+	defer teardown()
+
+	openUnlocked := func() (*Manager, error) {
+		var mgr *Manager
+		err := walletdb.View(db, func(tx walletdb.ReadTx) error {
+			ns := tx.ReadBucket(waddrmgrNamespaceKey)
+			var err error
+			mgr, err = Open(ns, pubPassphrase, &chaincfg.MainNetParams)
+			if err != nil {
+				return err
+			}
+			return mgr.Unlock(ns, privPassphrase)
+		})
+		return mgr, err
+	}
+
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := tx.CreateTopLevelBucket(waddrmgrNamespaceKey)
+		if err != nil {
+			return err
+		}
+		return Create(
+			ns, seed, pubPassphrase, privPassphrase,
+			&chaincfg.MainNetParams, fastScrypt, time.Time{},
+		)
+	})
+	if err != nil {
+		t.Fatalf("create: unexpected error: %v", err)
+	}
+	mgr, err := openUnlocked()
+	if err != nil {
+		t.Fatalf("open: unexpected error: %v", err)
+	}
+
+	scopedMgr, err := mgr.FetchScopedKeyManager(KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to fetch scope %v: %v", KeyScopeBIP0044, err)
+	}
+
+	// Derive the account key of another wallet's seed.
+	accountKey := func(params *chaincfg.Params) *hdkeychain.ExtendedKey {
+		otherSeed := bytes.Repeat([]byte{0x01}, hdkeychain.RecommendedSeedLen)
+		key, err := hdkeychain.NewMaster(otherSeed, params)
+		if err != nil {
+			t.Fatalf("unable to create master key: %v", err)
+		}
+		path := []uint32{
+			KeyScopeBIP0044.Purpose, KeyScopeBIP0044.Coin, 0,
+		}
+		for _, i := range path {
+			key, err = key.Child(i + hdkeychain.HardenedKeyStart)
+			if err != nil {
+				t.Fatalf("unable to derive account key: %v", err)
+			}
+		}
+		return key
+	}
+	acctKey := accountKey(&chaincfg.MainNetParams)
+	acctKeyPub, err := acctKey.Neuter()
+	if err != nil {
+		t.Fatalf("unable to neuter account key: %v", err)
+	}
+
+	importAccount := func(name string,
+		key *hdkeychain.ExtendedKey) (uint32, error) {
+
+		var account uint32
+		err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			var err error
+			account, err = scopedMgr.ImportAccountWatchOnly(
+				ns, name, key,
+			)
+			return err
+		})
+		return account, err
+	}
+
+	// Keys for another network and keys that are not account keys are
+	// rejected.
+	_, err = importAccount("watch", accountKey(&chaincfg.TestNet3Params))
+	if !IsError(err, ErrWrongNet) {
+		t.Fatalf("got error %v for wrong network, want %v", err,
+			ErrWrongNet)
+	}
+	master, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to create master key: %v", err)
+	}
+	_, err = importAccount("watch", master)
+	if !IsError(err, ErrKeyChain) {
+		t.Fatalf("got error %v for master key, want %v", err,
+			ErrKeyChain)
+	}
+
+	// Only the public part of a private account key is imported.
+	account, err := importAccount("watch", acctKey)
+	if err != nil {
+		t.Fatalf("unable to import account: %v", err)
+	}
+	if account != 1 {
+		t.Fatalf("imported account number is %d, want 1", account)
+	}
+	_, err = importAccount("watch", acctKeyPub)
+	if !IsError(err, ErrDuplicateAccount) {
+		t.Fatalf("got error %v for duplicate name, want %v", err,
+			ErrDuplicateAccount)
+	}
+
+	var external, internal, spendable []ManagedAddress
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		external, err = scopedMgr.NextExternalAddresses(ns, account, 2)
+		if err != nil {
+			return err
+		}
+		internal, err = scopedMgr.NextInternalAddresses(ns, account, 2)
+		if err != nil {
+			return err
+		}
+		spendable, err = scopedMgr.NextExternalAddresses(
+			ns, DefaultAccountNum, 1,
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to derive addresses: %v", err)
+	}
+
+	// The addresses must match those derived from the account key and
+	// have no private keys.
+	checkAddrs := func(addrs []ManagedAddress, branch uint32) {
+		branchKey, err := acctKeyPub.Child(branch)
+		if err != nil {
+			t.Fatalf("unable to derive branch %d: %v", branch, err)
+		}
+		for i, addr := range addrs {
+			child, err := branchKey.Child(uint32(i))
+			if err != nil {
+				t.Fatalf("unable to derive child %d: %v", i, err)
+			}
+			want, err := child.Address(&chaincfg.MainNetParams)
+			if err != nil {
+				t.Fatalf("unable to derive address %d: %v", i, err)
+			}
+			if addr.Address().String() != want.String() {
+				t.Fatalf("branch %d address %d is %v, want %v",
+					branch, i, addr.Address(), want)
+			}
+			if !addr.WatchOnly() {
+				t.Fatalf("address %v is not watch-only",
+					addr.Address())
+			}
+			pkAddr := addr.(ManagedPubKeyAddress)
+			if _, err := pkAddr.PrivKey(); !IsError(err, ErrWatchingOnly) {
+				t.Fatalf("got error %v for private key of %v, "+
+					"want %v", err, addr.Address(),
+					ErrWatchingOnly)
+			}
+		}
+	}
+	checkAddrs(external, ExternalBranch)
+	checkAddrs(internal, InternalBranch)
+
+	// Locking and unlocking the manager must skip the watch-only account
+	// while still decrypting the spendable one.
+	if err := mgr.Lock(); err != nil {
+		t.Fatalf("unable to lock manager: %v", err)
+	}
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		return mgr.Unlock(ns, privPassphrase)
+	})
+	if err != nil {
+		t.Fatalf("unable to unlock manager: %v", err)
+	}
+	spendableAddr := spendable[0].(ManagedPubKeyAddress)
+	if _, err := spendableAddr.PrivKey(); err != nil {
+		t.Fatalf("unable to get private key of spendable address: %v",
+			err)
+	}
+	checkAddrs(external, ExternalBranch)
+
+	// The account remains watch-only after reopening the manager.
+	mgr.Close()
+	mgr, err = openUnlocked()
+	if err != nil {
+		t.Fatalf("reopen: unexpected error: %v", err)
+	}
+	defer mgr.Close()
+	scopedMgr, err = mgr.FetchScopedKeyManager(KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to fetch scope %v: %v", KeyScopeBIP0044, err)
+	}
+	var reloaded ManagedAddress
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		reloaded, err = scopedMgr.Address(ns, internal[1].Address())
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to look up address: %v", err)
+	}
+	checkAddrs([]ManagedAddress{internal[0], reloaded}, InternalBranch)
+}
+
+// TestDeriveAccountAddresses ensures the addresses derived for an account that
+// does not exist yet match those of the account once it is created, and that
+// the account is not created by deriving them.
+func TestDeriveAccountAddresses(t *testing.T) {
+	teardown, db := emptyDB(t)
+	defer teardown()
+
+	var mgr *Manager
+	err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns, err := tx.CreateTopLevelBucket(waddrmgrNamespaceKey)
+		if err != nil {
+			return err
+		}
+		err = Create(
+			ns, seed, pubPassphrase, privPassphrase,
+			&chaincfg.MainNetParams, fastScrypt, time.Time{},
+		)
+		if err != nil {
+			return err
+		}
+
+		mgr, err = Open(ns, pubPassphrase, &chaincfg.MainNetParams)
+		if err != nil {
+			return err
+		}
+
+		return mgr.Unlock(ns, privPassphrase)
+	})
+	if err != nil {
+		t.Fatalf("create/open: unexpected error: %v", err)
+	}
+	defer mgr.Close()
+
+	scopedMgr, err := mgr.FetchScopedKeyManager(KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to fetch scope %v: %v", KeyScopeBIP0044, err)
+	}
+
+	const account = 1
+	var external, internal map[uint32]bchutil.Address
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		external, err = scopedMgr.DeriveAccountAddresses(
+			ns, account, ExternalBranch, 3,
+		)
+		if err != nil {
+			return err
+		}
+		internal, err = scopedMgr.DeriveAccountAddresses(
+			ns, account, InternalBranch, 3,
+		)
+		if err != nil {
+			return err
+		}
+		last, err := scopedMgr.LastAccount(ns)
+		if err != nil {
+			return err
+		}
+		if last != 0 {
+			t.Fatalf("got last account %d, want 0", last)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to derive addresses: %v", err)
+	}
+	if len(external) != 3 || len(internal) != 3 {
+		t.Fatalf("got %d external and %d internal addresses, want 3",
+			len(external), len(internal))
+	}
+
+	var next, nextChange []ManagedAddress
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		if err := scopedMgr.NewRawAccount(ns, account); err != nil {
+			return err
+		}
+		var err error
+		next, err = scopedMgr.NextExternalAddresses(ns, account, 3)
+		if err != nil {
+			return err
+		}
+		nextChange, err = scopedMgr.NextInternalAddresses(ns, account, 3)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to create account addresses: %v", err)
+	}
+	for i := range next {
+		if got := external[uint32(i)]; got.String() != next[i].Address().String() {
+			t.Fatalf("external address %d is %v, want %v", i, got,
+				next[i].Address())
+		}
+		if got := internal[uint32(i)]; got.String() != nextChange[i].Address().String() {
+			t.Fatalf("internal address %d is %v, want %v", i, got,
+				nextChange[i].Address())
+		}
+	}
+
+	// Deriving the keys of an account requires the private cointype key.
+	if err := mgr.Lock(); err != nil {
+		t.Fatalf("unable to lock manager: %v", err)
+	}
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		_, err := scopedMgr.DeriveAccountAddresses(
+			ns, account+1, ExternalBranch, 1,
+		)
+		return err
+	})
+	if !IsError(err, ErrLocked) {
+		t.Fatalf("got error %v, want ErrLocked", err)
+	}
+}
@@ -1,7 +1,6 @@
 package waddrmgr
 
 import (
-	"errors"
 	"fmt"
 	"sync"
 
@@ -186,8 +185,9 @@ func (s *ScopedKeyManager) Close() {
 // The passed derivedKey is zeroed after the new address is created.
 //
 // This function MUST be called with the manager lock held for writes.
-func (s *ScopedKeyManager) keyToManaged(derivedKey *hdkeychain.ExtendedKey,
-	account, branch, index uint32) (ManagedAddress, error) {
+func (s *ScopedKeyManager) keyToManaged(acctInfo *accountInfo,
+	derivedKey *hdkeychain.ExtendedKey, account, branch,
+	index uint32) (ManagedAddress, error) {
 
 	var addrType AddressType
 	if branch == InternalBranch {
@@ -212,8 +212,9 @@ func (s *ScopedKeyManager) keyToManaged(derivedKey *hdkeychain.ExtendedKey,
 	if err != nil {
 		return nil, err
 	}
+	ma.acctWatchOnly = acctInfo.watchOnly()
 
-	if !derivedKey.IsPrivate() {
+	if !derivedKey.IsPrivate() && !ma.acctWatchOnly {
 		// Add the managed address to the list of addresses that need
 		// their private keys derived when the address manager is next
 		// unlocked.
@@ -239,9 +240,10 @@ func (s *ScopedKeyManager) deriveKey(acctInfo *accountInfo, branch,
 
 	// Choose the public or private extended key based on whether or not
 	// the private flag was specified.  This, in turn, allows for public or
-	// private child derivation.
+	// private child derivation.  Watch-only accounts only ever derive
+	// public keys.
 	acctKey := acctInfo.acctKeyPub
-	if private {
+	if private && !acctInfo.watchOnly() {
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -317,7 +319,7 @@ func (s *ScopedKeyManager) loadAccountInfo(ns walletdb.ReadBucket,
 		nextInternalIndex: row.nextInternalIndex,
 	}
 
-	if !s.rootManager.isLocked() {
+	if !s.rootManager.isLocked() && !acctInfo.watchOnly() {
 		// Use the crypto private key to decrypt the account private
 		// extended keys.
 		decrypted, err := s.rootManager.cryptoKeyPriv.Decrypt(acctInfo.acctKeyEncrypted)
@@ -347,7 +349,9 @@ func (s *ScopedKeyManager) loadAccountInfo(ns walletdb.ReadBucket,
 	if err != nil {
 		return nil, err
 	}
-	lastExtAddr, err := s.keyToManaged(lastExtKey, account, branch, index)
+	lastExtAddr, err := s.keyToManaged(
+		acctInfo, lastExtKey, account, branch, index,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -364,7 +368,9 @@ func (s *ScopedKeyManager) loadAccountInfo(ns walletdb.ReadBucket,
 	if err != nil {
 		return nil, err
 	}
-	lastIntAddr, err := s.keyToManaged(lastIntKey, account, branch, index)
+	lastIntAddr, err := s.keyToManaged(
+		acctInfo, lastIntKey, account, branch, index,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -423,6 +429,29 @@ func (s *ScopedKeyManager) AccountProperties(ns walletdb.ReadBucket,
 	return props, nil
 }
 
+// AccountExtendedPubKey returns the extended public key of the account, from
+// which all of its addresses are derived.
+func (s *ScopedKeyManager) AccountExtendedPubKey(ns walletdb.ReadBucket,
+	account uint32) (*hdkeychain.ExtendedKey, error) {
+
+	if account > MaxAccountNum {
+		err := managerError(ErrAccountNumTooHigh, errAcctTooHigh, nil)
+		return nil, err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	acctInfo, err := s.loadAccountInfo(ns, account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return a copy, since the cached key is zeroed when the manager is
+	// closed.
+	return hdkeychain.NewKeyFromString(acctInfo.acctKeyPub.String())
+}
+
 // DeriveFromKeyPath attempts to derive a maximal child key (under the BIP0044
 // scheme) from a given key path. If key derivation isn't possible, then an
 // error will be returned.
@@ -432,14 +461,101 @@ func (s *ScopedKeyManager) DeriveFromKeyPath(ns walletdb.ReadBucket,
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
-	extKey, err := s.deriveKeyFromPath(
-		ns, kp.Account, kp.Branch, kp.Index, !s.rootManager.IsLocked(),
+	acctInfo, err := s.loadAccountInfo(ns, kp.Account)
+	if err != nil {
+		return nil, err
+	}
+	extKey, err := s.deriveKey(
+		acctInfo, kp.Branch, kp.Index, !s.rootManager.IsLocked(),
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.keyToManaged(extKey, kp.Account, kp.Branch, kp.Index)
+	return s.keyToManaged(acctInfo, extKey, kp.Account, kp.Branch, kp.Index)
+}
+
+// DeriveAccountAddresses returns the addresses of the first count valid child
+// indexes of a branch of an account, keyed by child index.  Unlike
+// DeriveFromKeyPath, the account need not exist, and nothing is stored, which
+// allows probing accounts for usage before creating them.  Since the account
+// key is derived from the cointype key, the manager must be unlocked.
+func (s *ScopedKeyManager) DeriveAccountAddresses(ns walletdb.ReadBucket,
+	account, branch, count uint32) (map[uint32]bchutil.Address, error) {
+
+	if s.rootManager.WatchOnly() {
+		return nil, managerError(ErrWatchingOnly, errWatchingOnly, nil)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.rootManager.IsLocked() {
+		return nil, managerError(ErrLocked, errLocked, nil)
+	}
+
+	_, coinTypePrivEnc, err := fetchCoinTypeKeys(ns, &s.scope)
+	if err != nil {
+		return nil, err
+	}
+	serializedKeyPriv, err := s.rootManager.cryptoKeyPriv.Decrypt(coinTypePrivEnc)
+	if err != nil {
+		str := "failed to decrypt cointype serialized private key"
+		return nil, managerError(ErrLocked, str, err)
+	}
+	coinTypeKeyPriv, err := hdkeychain.NewKeyFromString(string(serializedKeyPriv))
+	zero.Bytes(serializedKeyPriv)
+	if err != nil {
+		str := "failed to create cointype extended private key"
+		return nil, managerError(ErrKeyChain, str, err)
+	}
+	acctKeyPriv, err := deriveAccountKey(coinTypeKeyPriv, account)
+	coinTypeKeyPriv.Zero()
+	if err != nil {
+		str := "failed to convert private key for account"
+		return nil, managerError(ErrKeyChain, str, err)
+	}
+	defer acctKeyPriv.Zero()
+	acctKeyPub, err := acctKeyPriv.Neuter()
+	if err != nil {
+		str := "failed to convert public key for account"
+		return nil, managerError(ErrKeyChain, str, err)
+	}
+	branchKey, err := acctKeyPub.Child(branch)
+	if err != nil {
+		str := fmt.Sprintf("failed to derive extended key branch %d",
+			branch)
+		return nil, managerError(ErrKeyChain, str, err)
+	}
+
+	addrType := s.addrSchema.ExternalAddrType
+	if branch == InternalBranch {
+		addrType = s.addrSchema.InternalAddrType
+	}
+
+	addrs := make(map[uint32]bchutil.Address, count)
+	for index := uint32(0); uint32(len(addrs)) < count; index++ {
+		key, err := branchKey.Child(index)
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			str := fmt.Sprintf("failed to derive child extended key "+
+				"-- branch %d, child %d", branch, index)
+			return nil, managerError(ErrKeyChain, str, err)
+		}
+		path := DerivationPath{
+			Account: account,
+			Branch:  branch,
+			Index:   index,
+		}
+		ma, err := newManagedAddressFromExtKey(s, path, key, addrType)
+		if err != nil {
+			return nil, err
+		}
+		addrs[index] = ma.Address()
+	}
+	return addrs, nil
 }
 
 // deriveKeyFromPath returns either a public or private derived extended key
@@ -469,14 +585,20 @@ func (s *ScopedKeyManager) chainAddressRowToManaged(ns walletdb.ReadBucket,
 	// function, we use the internal isLocked to avoid a deadlock.
 	isLocked := s.rootManager.isLocked()
 
-	addressKey, err := s.deriveKeyFromPath(
-		ns, row.account, row.branch, row.index, !isLocked,
+	acctInfo, err := s.loadAccountInfo(ns, row.account)
+	if err != nil {
+		return nil, err
+	}
+	addressKey, err := s.deriveKey(
+		acctInfo, row.branch, row.index, !isLocked,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return s.keyToManaged(addressKey, row.account, row.branch, row.index)
+	return s.keyToManaged(
+		acctInfo, addressKey, row.account, row.branch, row.index,
+	)
 }
 
 // importedAddressRowToManaged returns a new managed address based on imported
@@ -660,9 +782,9 @@ func (s *ScopedKeyManager) nextAddresses(ns walletdb.ReadWriteBucket,
 	}
 
 	// Choose the account key to used based on whether the address manager
-	// is locked.
+	// is locked and the account has private key material.
 	acctKey := acctInfo.acctKeyPub
-	if !s.rootManager.IsLocked() {
+	if !s.rootManager.IsLocked() && !acctInfo.watchOnly() {
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -698,6 +820,25 @@ func (s *ScopedKeyManager) nextAddresses(ns walletdb.ReadWriteBucket,
 	}
 	defer branchKey.Zero() // Ensure branch key is zeroed when done.
 
+	// Refuse to extend the external branch beyond the gap limit, if any,
+	// since addresses past it would not be found when restoring the
+	// wallet from its seed.
+	if gapLimit := s.rootManager.GapLimit(); gapLimit > 0 && !internal {
+		unused, err := s.unusedTail(
+			ns, branchKey, account, branchNum, nextIndex, addrType,
+			gapLimit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if unused+numAddresses > gapLimit {
+			str := fmt.Sprintf("%d new addresses would exceed the "+
+				"gap limit of %d unused addresses (%d unused)",
+				numAddresses, gapLimit, unused)
+			return nil, managerError(ErrGapLimitExceeded, str, nil)
+		}
+	}
+
 	// Create the requested number of addresses and keep track of the index
 	// with each one.
 	addressInfo := make([]*unlockDeriveInfo, 0, numAddresses)
@@ -749,6 +890,7 @@ func (s *ScopedKeyManager) nextAddresses(ns walletdb.ReadWriteBucket,
 		if internal {
 			addr.internal = true
 		}
+		addr.acctWatchOnly = acctInfo.watchOnly()
 		managedAddr := addr
 		nextKey.Zero()
 
@@ -817,7 +959,9 @@ func (s *ScopedKeyManager) nextAddresses(ns walletdb.ReadWriteBucket,
 			// Add the new managed address to the list of addresses
 			// that need their private keys derived when the
 			// address manager is next unlocked.
-			if s.rootManager.isLocked() && !s.rootManager.watchOnly() {
+			if s.rootManager.isLocked() && !s.rootManager.watchOnly() &&
+				!acctInfo.watchOnly() {
+
 				s.deriveOnUnlock = append(s.deriveOnUnlock, info)
 			}
 		}
@@ -837,6 +981,50 @@ func (s *ScopedKeyManager) nextAddresses(ns walletdb.ReadWriteBucket,
 	return managedAddresses, nil
 }
 
+// unusedTail returns the number of consecutive unused addresses preceding
+// nextIndex on the given branch, counting at most limit addresses.
+//
+// This function MUST be called with the manager lock held for writes.
+func (s *ScopedKeyManager) unusedTail(ns walletdb.ReadBucket,
+	branchKey *hdkeychain.ExtendedKey, account, branch, nextIndex uint32,
+	addrType AddressType, limit uint32) (uint32, error) {
+
+	var unused uint32
+	for index := nextIndex; index > 0 && unused < limit; index-- {
+		key, err := branchKey.Child(index - 1)
+		if err == hdkeychain.ErrInvalidChild {
+			continue
+		}
+		if err != nil {
+			str := fmt.Sprintf("failed to generate child %d", index-1)
+			return 0, managerError(ErrKeyChain, str, err)
+		}
+		pubKey, err := key.ECPubKey()
+		if err != nil {
+			str := fmt.Sprintf("failed to generate child %d", index-1)
+			return 0, managerError(ErrKeyChain, str, err)
+		}
+
+		derivationPath := DerivationPath{
+			Account: account,
+			Branch:  branch,
+			Index:   index - 1,
+		}
+		addr, err := newManagedAddressWithoutPrivKey(
+			s, derivationPath, pubKey, true, addrType,
+		)
+		if err != nil {
+			return 0, err
+		}
+		if addr.Used(ns) {
+			break
+		}
+		unused++
+	}
+
+	return unused, nil
+}
+
 // extendAddresses ensures that all addresses up to and including the lastIndex
 // are derived for either an internal or external branch. If the child at
 // lastIndex is invalid, this method will proceed until the next valid child is
@@ -855,9 +1043,9 @@ func (s *ScopedKeyManager) extendAddresses(ns walletdb.ReadWriteBucket,
 	}
 
 	// Choose the account key to used based on whether the address manager
-	// is locked.
+	// is locked and the account has private key material.
 	acctKey := acctInfo.acctKeyPub
-	if !s.rootManager.IsLocked() {
+	if !s.rootManager.IsLocked() && !acctInfo.watchOnly() {
 		acctKey = acctInfo.acctKeyPriv
 	}
 
@@ -951,6 +1139,7 @@ func (s *ScopedKeyManager) extendAddresses(ns walletdb.ReadWriteBucket,
 		if internal {
 			addr.internal = true
 		}
+		addr.acctWatchOnly = acctInfo.watchOnly()
 		managedAddr := addr
 		nextKey.Zero()
 
@@ -1005,7 +1194,9 @@ func (s *ScopedKeyManager) extendAddresses(ns walletdb.ReadWriteBucket,
 		// Add the new managed address to the list of addresses that
 		// need their private keys derived when the address manager is
 		// next unlocked.
-		if s.rootManager.IsLocked() && !s.rootManager.WatchOnly() {
+		if s.rootManager.IsLocked() && !s.rootManager.WatchOnly() &&
+			!acctInfo.watchOnly() {
+
 			s.deriveOnUnlock = append(s.deriveOnUnlock, info)
 		}
 	}
@@ -1127,7 +1318,11 @@ func (s *ScopedKeyManager) LastExternalAddress(ns walletdb.ReadBucket,
 	return nil, managerError(ErrAddressNotFound, "no previous external address", nil)
 }
 
-// FirstUnusedAddress returns the first unused address found in the keychain.
+// FirstUnusedAddress returns the unused address with the lowest child index on
+// the account's external or internal branch.  Since addresses are only ever
+// appended to a branch, the same address is returned until it is marked used,
+// regardless of how many addresses are derived after it.  ErrAddressNotFound
+// is returned if every address of the branch has been used.
 //
 // This function will return an error if the provided account number is greater
 // than the MaxAccountNum constant or there is no account information for the
@@ -1141,19 +1336,38 @@ func (s *ScopedKeyManager) FirstUnusedAddress(ns walletdb.ReadBucket,
 		return nil, err
 	}
 
-	var first *ManagedAddress
-	var breakEarlyErr = errors.New("this error is used to break early when the first unused is found")
-	s.ForEachAccountAddress(ns, account, func(maddr ManagedAddress) error {
-		if first == nil && !maddr.Used(ns) && maddr.Internal() == internal {
-			first = &maddr
-			return breakEarlyErr
+	var (
+		first      ManagedAddress
+		firstIndex uint32
+	)
+	err := s.ForEachAccountAddress(ns, account, func(maddr ManagedAddress) error {
+		if maddr.Internal() != internal || maddr.Used(ns) {
+			return nil
+		}
+		pka, ok := maddr.(ManagedPubKeyAddress)
+		if !ok {
+			return nil
+		}
+		_, path, ok := pka.DerivationInfo()
+		if !ok {
+			return nil
+		}
+		if first == nil || path.Index < firstIndex {
+			first, firstIndex = maddr, path.Index
 		}
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 	if first == nil {
-		return nil, managerError(ErrAddressNotFound, "no unused external address", nil)
+		str := "no unused external address"
+		if internal {
+			str = "no unused internal address"
+		}
+		return nil, managerError(ErrAddressNotFound, str, nil)
 	}
-	return *first, nil
+	return first, nil
 }
 
 // CountUnused returns the total number of unused addresses in the keychain.
@@ -1272,6 +1486,81 @@ func (s *ScopedKeyManager) NewAccount(ns walletdb.ReadWriteBucket, name string)
 	return account, nil
 }
 
+// ImportAccountWatchOnly creates a new watch-only account with the given name
+// from an account extended key, such as one exported by
+// AccountExtendedPubKey, and returns its account number.  Only the neutered
+// key is stored, so addresses of the account can be derived and watched, but
+// their private keys are never available and PrivKey returns
+// ErrWatchingOnly.  Since no private key material is involved, the manager
+// does not need to be unlocked.
+func (s *ScopedKeyManager) ImportAccountWatchOnly(ns walletdb.ReadWriteBucket,
+	name string, accountKey *hdkeychain.ExtendedKey) (uint32, error) {
+
+	if !accountKey.IsForNet(s.rootManager.chainParams) {
+		str := "extended key is not for the same network as the " +
+			"address manager"
+		return 0, managerError(ErrWrongNet, str, nil)
+	}
+
+	// Account extended keys are always found at the third level of the
+	// m/purpose'/<coin type>'/<account>' hierarchy.
+	if accountKey.Depth() != 3 {
+		str := fmt.Sprintf("extended key has depth %d, account keys "+
+			"have depth 3", accountKey.Depth())
+		return 0, managerError(ErrKeyChain, str, nil)
+	}
+
+	acctKeyPub, err := accountKey.Neuter()
+	if err != nil {
+		str := "failed to convert public key for account"
+		return 0, managerError(ErrKeyChain, str, err)
+	}
+	if err := checkBranchKeys(acctKeyPub); err != nil {
+		str := "failed to derive branch keys for account"
+		return 0, managerError(ErrKeyChain, str, err)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	// Validate the account name and check that an account with the same
+	// name does not exist.
+	if err := ValidateAccountName(name); err != nil {
+		return 0, err
+	}
+	_, err = s.lookupAccount(ns, name)
+	if err == nil {
+		str := "account with the same name already exists"
+		return 0, managerError(ErrDuplicateAccount, str, err)
+	}
+
+	account, err := fetchLastAccount(ns, &s.scope)
+	if err != nil {
+		return 0, err
+	}
+	account++
+
+	acctPubEnc, err := s.rootManager.cryptoKeyPub.Encrypt(
+		[]byte(acctKeyPub.String()),
+	)
+	if err != nil {
+		str := "failed to encrypt public key for account"
+		return 0, managerError(ErrCrypto, str, err)
+	}
+
+	// Save the account without an encrypted private key, which is what
+	// marks it as watch-only.
+	err = putAccountInfo(ns, &s.scope, account, acctPubEnc, nil, 0, 0, name)
+	if err != nil {
+		return 0, err
+	}
+	if err := putLastAccount(ns, &s.scope, account); err != nil {
+		return 0, err
+	}
+
+	return account, nil
+}
+
 // newAccount is a helper function that derives a new precise account number,
 // and creates a mapping from the passed name to the account number in the
 // database.
@@ -1457,8 +1746,53 @@ func (s *ScopedKeyManager) ImportPrivateKey(ns walletdb.ReadWriteBucket,
 		return nil, managerError(ErrLocked, errLocked, nil)
 	}
 
+	// The private key is only stored when not a watching-only address
+	// manager.
+	privKey := wif.PrivKey
+	if s.rootManager.WatchOnly() {
+		privKey = nil
+	}
+	pubKey := (*bchec.PublicKey)(&wif.PrivKey.PublicKey)
+	return s.importKey(ns, pubKey, privKey, wif.CompressPubKey, bs)
+}
+
+// ImportPublicKey imports a public key into the address manager as a
+// watch-only address.  The imported address is created using either a
+// compressed or uncompressed serialized public key, depending on the
+// compressed bool.
+//
+// All imported addresses will be part of the account defined by the
+// ImportedAddrAccount constant.  Since no private key is available, outputs
+// paying to the returned address can be watched but not spent by the wallet.
+//
+// This function will return an error if the address already exists.  Any
+// other errors returned are generally unexpected.
+func (s *ScopedKeyManager) ImportPublicKey(ns walletdb.ReadWriteBucket,
+	pubKey *bchec.PublicKey, compressed bool,
+	bs *BlockStamp) (ManagedPubKeyAddress, error) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.importKey(ns, pubKey, nil, compressed, bs)
+}
+
+// importKey imports a public key, and optionally its private key, into the
+// imported account of the address manager.  A nil privKey results in a
+// watch-only address.
+//
+// This function MUST be called with the manager lock held for writes.
+func (s *ScopedKeyManager) importKey(ns walletdb.ReadWriteBucket,
+	pubKey *bchec.PublicKey, privKey *bchec.PrivateKey, compressed bool,
+	bs *BlockStamp) (*managedAddress, error) {
+
 	// Prevent duplicates.
-	serializedPubKey := wif.SerializePubKey()
+	var serializedPubKey []byte
+	if compressed {
+		serializedPubKey = pubKey.SerializeCompressed()
+	} else {
+		serializedPubKey = pubKey.SerializeUncompressed()
+	}
 	pubKeyHash := bchutil.Hash160(serializedPubKey)
 	alreadyExists := s.existsAddress(ns, pubKeyHash)
 	if alreadyExists {
@@ -1477,10 +1811,10 @@ func (s *ScopedKeyManager) ImportPrivateKey(ns walletdb.ReadWriteBucket,
 		return nil, managerError(ErrCrypto, str, err)
 	}
 
-	// Encrypt the private key when not a watching-only address manager.
+	// Encrypt the private key when one was provided.
 	var encryptedPrivKey []byte
-	if !s.rootManager.WatchOnly() {
-		privKeyBytes := wif.PrivKey.Serialize()
+	if privKey != nil {
+		privKeyBytes := privKey.Serialize()
 		encryptedPrivKey, err = s.rootManager.cryptoKeyPriv.Encrypt(privKeyBytes)
 		zero.Bytes(privKeyBytes)
 		if err != nil {
@@ -1529,15 +1863,14 @@ func (s *ScopedKeyManager) ImportPrivateKey(ns walletdb.ReadWriteBucket,
 
 	// Create a new managed address based on the imported address.
 	var managedAddr *managedAddress
-	if !s.rootManager.WatchOnly() {
+	if privKey != nil {
 		managedAddr, err = newManagedAddress(
-			s, importedDerivationPath, wif.PrivKey,
-			wif.CompressPubKey, s.addrSchema.ExternalAddrType,
+			s, importedDerivationPath, privKey, compressed,
+			s.addrSchema.ExternalAddrType,
 		)
 	} else {
-		pubKey := (*bchec.PublicKey)(&wif.PrivKey.PublicKey)
 		managedAddr, err = newManagedAddressWithoutPrivKey(
-			s, importedDerivationPath, pubKey, wif.CompressPubKey,
+			s, importedDerivationPath, pubKey, compressed,
 			s.addrSchema.ExternalAddrType,
 		)
 	}
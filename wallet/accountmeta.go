@@ -0,0 +1,254 @@
+package wallet
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/hdkeychain"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// AccountMetadata describes an account of a key scope in a form that may be
+// serialized, so that the account can be recreated with the same number, name
+// and derived addresses by another wallet restored from the same seed.
+type AccountMetadata struct {
+	Number uint32 `json:"number"`
+	Name   string `json:"name"`
+
+	// ExtendedPubKey is the serialized extended public key of the
+	// account.  Accounts the importing wallet does not have are imported
+	// as watch-only accounts from it, and those it has are verified to
+	// derive the same key.
+	ExtendedPubKey string `json:"xpub"`
+
+	// ExternalKeyCount and InternalKeyCount are the numbers of addresses
+	// derived on each branch of the account.
+	ExternalKeyCount uint32 `json:"externalkeycount"`
+	InternalKeyCount uint32 `json:"internalkeycount"`
+}
+
+// AccountMetadataExport holds the metadata of every account of a key scope
+// along with the gap limit of the exporting wallet.  A bare seed restore only
+// recovers accounts with transaction history and names them after their
+// numbers; importing this export restores the account organization.
+type AccountMetadataExport struct {
+	Scope    waddrmgr.KeyScope `json:"scope"`
+	GapLimit uint32            `json:"gaplimit"`
+	Accounts []AccountMetadata `json:"accounts"`
+}
+
+// ExportAccountMetadata returns the metadata of every account of the key
+// scope, excluding the imported account, ordered by account number.  Only
+// public data is exported.
+func (w *Wallet) ExportAccountMetadata(scope waddrmgr.KeyScope) (*AccountMetadataExport, error) {
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &AccountMetadataExport{
+		Scope:    scope,
+		GapLimit: w.Manager.GapLimit(),
+	}
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		return manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			if account == waddrmgr.ImportedAddrAccount {
+				return nil
+			}
+			props, err := manager.AccountProperties(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			xpub, err := manager.AccountExtendedPubKey(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			export.Accounts = append(export.Accounts, AccountMetadata{
+				Number:           account,
+				Name:             props.AccountName,
+				ExtendedPubKey:   xpub.String(),
+				ExternalKeyCount: props.ExternalKeyCount,
+				InternalKeyCount: props.InternalKeyCount,
+			})
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(export.Accounts, func(i, j int) bool {
+		return export.Accounts[i].Number < export.Accounts[j].Number
+	})
+	return export, nil
+}
+
+// ImportAccountMetadata recreates the accounts described by an export of
+// another wallet sharing the same seed.  Accounts the wallet does not have are
+// imported as watch-only accounts from their exported extended public keys,
+// existing accounts are renamed to their exported names, and addresses are
+// derived on each branch up to the exported key counts.  No private keys are
+// involved, so the wallet need not be unlocked.  The import is refused without
+// any changes if the extended public key of an existing account differs from
+// the exported one, or if the accounts to import do not directly follow the
+// last account of the wallet.  The gap limit of the export is not applied.
+//
+// Derived addresses are watched for new transactions, but a rescan is needed
+// to find the history of addresses that were not previously derived.
+func (w *Wallet) ImportAccountMetadata(export *AccountMetadataExport) error {
+	manager, err := w.Manager.FetchScopedKeyManager(export.Scope)
+	if err != nil {
+		return err
+	}
+
+	accounts := make([]AccountMetadata, len(export.Accounts))
+	copy(accounts, export.Accounts)
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Number < accounts[j].Number
+	})
+
+	var props []*waddrmgr.AccountProperties
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		// Verify the accounts the wallet already has, and the names
+		// and keys of the exported accounts, before modifying
+		// anything, so that an export from a different seed is
+		// rejected without creating accounts.
+		lastAccount, err := manager.LastAccount(addrmgrNs)
+		if err != nil {
+			return err
+		}
+		nextAccount := lastAccount + 1
+		acctKeys := make(map[uint32]*hdkeychain.ExtendedKey)
+		for _, acct := range accounts {
+			if acct.Number == waddrmgr.ImportedAddrAccount {
+				return fmt.Errorf("the imported account can not " +
+					"be recreated")
+			}
+			if err := waddrmgr.ValidateAccountName(acct.Name); err != nil {
+				return err
+			}
+			other, err := manager.LookupAccount(addrmgrNs, acct.Name)
+			if err == nil && other != acct.Number {
+				return fmt.Errorf("account name %q is already used "+
+					"by account %d", acct.Name, other)
+			}
+			if acct.Number <= lastAccount {
+				err := checkAccountPubKey(manager, addrmgrNs, acct)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			// Imported accounts are numbered after the last
+			// account, so they must follow it without gaps.
+			if acct.Number != nextAccount {
+				return fmt.Errorf("account %d does not follow "+
+					"the last account %d", acct.Number,
+					nextAccount-1)
+			}
+			nextAccount++
+			acctKey, err := hdkeychain.NewKeyFromString(acct.ExtendedPubKey)
+			if err != nil {
+				return fmt.Errorf("invalid extended public key "+
+					"for account %d: %v", acct.Number, err)
+			}
+			acctKeys[acct.Number] = acctKey
+		}
+
+		for _, acct := range accounts {
+			if acctKey, ok := acctKeys[acct.Number]; ok {
+				_, err := manager.ImportAccountWatchOnly(addrmgrNs,
+					acct.Name, acctKey)
+				if err != nil {
+					return err
+				}
+			}
+			name, err := manager.AccountName(addrmgrNs, acct.Number)
+			if err != nil {
+				return err
+			}
+			if name != acct.Name {
+				err := manager.RenameAccount(addrmgrNs,
+					acct.Number, acct.Name)
+				if err != nil {
+					return err
+				}
+			}
+			if acct.ExternalKeyCount > 0 {
+				err := manager.ExtendExternalAddresses(addrmgrNs,
+					acct.Number, acct.ExternalKeyCount-1)
+				if err != nil {
+					return err
+				}
+			}
+			if acct.InternalKeyCount > 0 {
+				err := manager.ExtendInternalAddresses(addrmgrNs,
+					acct.Number, acct.InternalKeyCount-1)
+				if err != nil {
+					return err
+				}
+			}
+			p, err := manager.AccountProperties(addrmgrNs, acct.Number)
+			if err != nil {
+				return err
+			}
+			props = append(props, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, p := range props {
+		w.NtfnServer.notifyAccountProperties(p)
+	}
+
+	// Without a chain client, the addresses are watched once the wallet
+	// is synced with a chain server.
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil
+	}
+	var addrs []bchutil.Address
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		for _, acct := range accounts {
+			err := manager.ForEachAccountAddress(addrmgrNs, acct.Number,
+				func(maddr waddrmgr.ManagedAddress) error {
+					addrs = append(addrs, maddr.Address())
+					return nil
+				})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return chainClient.NotifyReceived(addrs)
+}
+
+// checkAccountPubKey returns an error if the extended public key of the
+// wallet's account differs from the exported one.
+func checkAccountPubKey(manager *waddrmgr.ScopedKeyManager,
+	ns walletdb.ReadBucket, acct AccountMetadata) error {
+
+	xpub, err := manager.AccountExtendedPubKey(ns, acct.Number)
+	if err != nil {
+		return err
+	}
+	if xpub.String() != acct.ExtendedPubKey {
+		return fmt.Errorf("extended public key of account %d does not "+
+			"match the export; the wallet does not share its seed",
+			acct.Number)
+	}
+	return nil
+}
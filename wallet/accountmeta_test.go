@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchutil/hdkeychain"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestAccountMetadataExportImport ensures the accounts exported from a wallet
+// are recreated by a wallet with the same seed, and that imports into wallets
+// with a different seed are refused.
+func TestAccountMetadataExportImport(t *testing.T) {
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.MinSeedBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, cleanup := testWalletFromSeed(t, seed)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	for _, name := range []string{"savings", "spending"} {
+		if _, err := w.NextAccount(scope, name); err != nil {
+			t.Fatalf("unable to create account: %v", err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := w.NewAddress(1, scope); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := w.NewChangeAddress(2, scope); err != nil {
+		t.Fatal(err)
+	}
+
+	export, err := w.ExportAccountMetadata(scope)
+	if err != nil {
+		t.Fatalf("unable to export: %v", err)
+	}
+	if len(export.Accounts) != 3 {
+		t.Fatalf("exported %d accounts, want 3", len(export.Accounts))
+	}
+	if export.Accounts[1].Name != "savings" ||
+		export.Accounts[1].ExternalKeyCount != 3 ||
+		export.Accounts[2].InternalKeyCount != 1 {
+
+		t.Fatalf("unexpected exported accounts: %+v", export.Accounts)
+	}
+
+	// The export must survive serialization.
+	b, err := json.Marshal(export)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded AccountMetadataExport
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	// The import needs no private keys, so the restored wallet may be
+	// locked.
+	restored, cleanupRestored := testWalletFromSeed(t, seed)
+	defer cleanupRestored()
+	restored.Lock()
+	if err := restored.ImportAccountMetadata(&decoded); err != nil {
+		t.Fatalf("unable to import: %v", err)
+	}
+	reexport, err := restored.ExportAccountMetadata(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(reexport.Accounts, export.Accounts) {
+		t.Fatalf("restored accounts %+v, want %+v", reexport.Accounts,
+			export.Accounts)
+	}
+
+	// The imported accounts are watch-only.
+	addr, err := restored.NewAddress(1, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	maddr, err := restored.AddressInfo(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !maddr.WatchOnly() {
+		t.Fatalf("address %v of an imported account is not watch-only",
+			addr)
+	}
+
+	// Importing again is a no-op.
+	if err := restored.ImportAccountMetadata(&decoded); err != nil {
+		t.Fatalf("unable to import twice: %v", err)
+	}
+
+	// Accounts can not be imported with gaps in their numbering.
+	gapped, cleanupGapped := testWalletFromSeed(t, seed)
+	defer cleanupGapped()
+	partial := decoded
+	partial.Accounts = decoded.Accounts[2:]
+	if err := gapped.ImportAccountMetadata(&partial); err == nil {
+		t.Fatal("expected import of a gapped account to fail")
+	}
+
+	other, cleanupOther := testWallet(t)
+	defer cleanupOther()
+	if err := other.ImportAccountMetadata(&decoded); err == nil {
+		t.Fatal("expected import into a wallet with another seed to fail")
+	}
+	otherExport, err := other.ExportAccountMetadata(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(otherExport.Accounts) != 1 {
+		t.Fatalf("failed import created %d accounts",
+			len(otherExport.Accounts)-1)
+	}
+}
@@ -0,0 +1,119 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// TestAddressBalance ensures the balances of single derived and imported
+// addresses only include the outputs paying to them, that addresses of the
+// wallet which never received have zero balances, and that unknown addresses
+// are rejected.
+func TestAddressBalance(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	manager, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to fetch scoped manager: %v", err)
+	}
+	derived, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	unused, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+
+	privKey, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+	wif, err := bchutil.NewWIF(privKey, w.chainParams, true)
+	if err != nil {
+		t.Fatalf("unable to create wif: %v", err)
+	}
+	watchKey, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+	var importedAddr, watchAddr waddrmgr.ManagedPubKeyAddress
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		bs := &waddrmgr.BlockStamp{}
+		var err error
+		importedAddr, err = manager.ImportPrivateKey(ns, wif, bs)
+		if err != nil {
+			return err
+		}
+		watchAddr, err = manager.ImportPublicKey(
+			ns, watchKey.PubKey(), true, bs,
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to import keys: %v", err)
+	}
+	imported := importedAddr.Address()
+	pubKeyAddr, err := bchutil.NewAddressPubKey(
+		importedAddr.PubKey().SerializeCompressed(), w.chainParams,
+	)
+	if err != nil {
+		t.Fatalf("unable to create pubkey address: %v", err)
+	}
+
+	addTestCredits(t, w, 100, 100,
+		[]bchutil.Address{derived, imported, watchAddr.Address()},
+		[]int64{100000, 200000, 300000})
+	addTestCredits(t, w, 109, 110,
+		[]bchutil.Address{derived, pubKeyAddr},
+		[]int64{40000, 50000})
+
+	tests := []struct {
+		name string
+		addr bchutil.Address
+		want Balances
+	}{{
+		name: "derived",
+		addr: derived,
+		want: Balances{Total: 140000, Spendable: 100000},
+	}, {
+		name: "imported",
+		addr: imported,
+		want: Balances{Total: 250000, Spendable: 200000},
+	}, {
+		name: "watch-only",
+		addr: watchAddr.Address(),
+		want: Balances{Total: 300000, WatchOnly: 300000},
+	}, {
+		name: "never received",
+		addr: unused,
+		want: Balances{},
+	}}
+	for _, test := range tests {
+		bals, err := w.AddressBalance(test.addr, 6)
+		if err != nil {
+			t.Fatalf("%s: unable to get balance: %v", test.name, err)
+		}
+		if bals != test.want {
+			t.Fatalf("%s: got balances %+v, want %+v", test.name,
+				bals, test.want)
+		}
+	}
+
+	unknown, err := bchutil.NewAddressPubKeyHash(make([]byte, 20),
+		w.chainParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.AddressBalance(unknown, 1)
+	if !waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+		t.Fatalf("got error %v for unknown address, want %v", err,
+			waddrmgr.ErrAddressNotFound)
+	}
+}
@@ -0,0 +1,152 @@
+package wallet
+
+import (
+	"sort"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// AccountAddressUsage describes how far the external and internal branches of
+// an account have been derived and how far they have been used.  The
+// difference between the key count and used count of a branch is the number
+// of trailing unused addresses, which may not be found when restoring the
+// wallet from its seed if it exceeds the recovery window.
+type AccountAddressUsage struct {
+	AccountNumber uint32
+	AccountName   string
+
+	// ExternalKeyCount and InternalKeyCount are the numbers of addresses
+	// derived on each branch, one more than the highest derived index.
+	ExternalKeyCount uint32
+	InternalKeyCount uint32
+
+	// ExternalUsedCount and InternalUsedCount are one more than the
+	// highest index of a used address on each branch, or zero if no
+	// address of the branch has been used.
+	ExternalUsedCount uint32
+	InternalUsedCount uint32
+}
+
+// AddressUsage returns the derivation and usage high-water marks of every
+// account of the key scope, excluding the imported account.  They are
+// computed from the address manager alone, without querying the chain server.
+func (w *Wallet) AddressUsage(scope waddrmgr.KeyScope) ([]AccountAddressUsage, error) {
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage []AccountAddressUsage
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		return manager.ForEachAccount(addrmgrNs, func(account uint32) error {
+			if account == waddrmgr.ImportedAddrAccount {
+				return nil
+			}
+			props, err := manager.AccountProperties(addrmgrNs, account)
+			if err != nil {
+				return err
+			}
+			u := AccountAddressUsage{
+				AccountNumber:    account,
+				AccountName:      props.AccountName,
+				ExternalKeyCount: props.ExternalKeyCount,
+				InternalKeyCount: props.InternalKeyCount,
+			}
+			err = manager.ForEachAccountAddress(addrmgrNs, account,
+				func(maddr waddrmgr.ManagedAddress) error {
+					addr, ok := maddr.(waddrmgr.ManagedPubKeyAddress)
+					if !ok || !addr.Used(addrmgrNs) {
+						return nil
+					}
+					_, path, ok := addr.DerivationInfo()
+					if !ok {
+						return nil
+					}
+					used := &u.ExternalUsedCount
+					if addr.Internal() {
+						used = &u.InternalUsedCount
+					}
+					if path.Index+1 > *used {
+						*used = path.Index + 1
+					}
+					return nil
+				})
+			if err != nil {
+				return err
+			}
+			usage = append(usage, u)
+			return nil
+		})
+	})
+	return usage, err
+}
+
+// AccountAddress describes an address stored for an account, as returned by
+// AccountAddressDetails.
+type AccountAddress struct {
+	Address bchutil.Address
+
+	// Internal and Index are the branch and index of the address's
+	// derivation path.  They are unset for imported addresses.
+	Internal bool
+	Index    uint32
+
+	Imported bool
+	Used     bool
+}
+
+// AccountAddressDetails returns every address stored for the account of the
+// key scope, including imported addresses when the imported account is
+// requested.  Unlike NewAddress, no addresses are derived.  Derived addresses
+// are ordered by branch, external first, and then by index.
+func (w *Wallet) AccountAddressDetails(scope waddrmgr.KeyScope,
+	account uint32) ([]AccountAddress, error) {
+
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var addrs []AccountAddress
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		// Ensure the account exists, as no addresses are returned for
+		// an unknown account.
+		_, err := manager.AccountProperties(addrmgrNs, account)
+		if err != nil {
+			return err
+		}
+		return manager.ForEachAccountAddress(addrmgrNs, account,
+			func(maddr waddrmgr.ManagedAddress) error {
+				a := AccountAddress{
+					Address:  maddr.Address(),
+					Imported: maddr.Imported(),
+					Used:     maddr.Used(addrmgrNs),
+				}
+				if pka, ok := maddr.(waddrmgr.ManagedPubKeyAddress); ok {
+					_, path, ok := pka.DerivationInfo()
+					if ok && !a.Imported {
+						a.Internal = path.Branch == waddrmgr.InternalBranch
+						a.Index = path.Index
+					}
+				}
+				addrs = append(addrs, a)
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(addrs, func(i, j int) bool {
+		if addrs[i].Internal != addrs[j].Internal {
+			return !addrs[i].Internal
+		}
+		return addrs[i].Index < addrs[j].Index
+	})
+	return addrs, nil
+}
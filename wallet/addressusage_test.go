@@ -0,0 +1,169 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// TestAddressUsage ensures the derivation and usage high-water marks of
+// accounts are reported.
+func TestAddressUsage(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	var addrs []bchutil.Address
+	for i := 0; i < 5; i++ {
+		addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		addrs = append(addrs, addr)
+	}
+	usage, err := w.AddressUsage(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(usage) != 1 {
+		t.Fatalf("got usage of %d accounts, want 1", len(usage))
+	}
+	keyCount := usage[0].ExternalKeyCount
+	if keyCount < 5 || usage[0].ExternalUsedCount != 0 {
+		t.Fatalf("unexpected usage before receiving: %+v", usage[0])
+	}
+
+	// Mark the third new address, but not the later ones, as used.
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.MarkUsed(ns, addrs[2])
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage, err = w.AddressUsage(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := AccountAddressUsage{
+		AccountNumber:     0,
+		AccountName:       "default",
+		ExternalKeyCount:  keyCount,
+		InternalKeyCount:  usage[0].InternalKeyCount,
+		ExternalUsedCount: keyCount - 2,
+	}
+	if usage[0] != want {
+		t.Fatalf("got usage %+v, want %+v", usage[0], want)
+	}
+}
+
+// TestAccountAddressDetails ensures every address of an account is returned
+// with its branch, index and usage, without deriving new addresses, and that
+// imported addresses are returned for the imported account.
+func TestAccountAddressDetails(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	var external []bchutil.Address
+	for i := 0; i < 3; i++ {
+		addr, err := w.NewAddress(0, scope)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		external = append(external, addr)
+	}
+	change, err := w.NewChangeAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to create change address: %v", err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.MarkUsed(ns, external[1])
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	wif, err := bchutil.NewWIF(key, w.ChainParams(), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var imported waddrmgr.ManagedPubKeyAddress
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		var err error
+		imported, err = manager.ImportPrivateKey(ns, wif,
+			&waddrmgr.BlockStamp{})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to import private key: %v", err)
+	}
+
+	props, err := w.AccountProperties(scope, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addrs, err := w.AccountAddressDetails(scope, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLen := int(props.ExternalKeyCount + props.InternalKeyCount)
+	if len(addrs) != wantLen {
+		t.Fatalf("got %d addresses, want %d", len(addrs), wantLen)
+	}
+
+	// External addresses come first, ordered by index, followed by the
+	// internal ones.
+	for i, a := range addrs {
+		internal := uint32(i) >= props.ExternalKeyCount
+		index := uint32(i)
+		if internal {
+			index -= props.ExternalKeyCount
+		}
+		if a.Internal != internal || a.Index != index || a.Imported {
+			t.Fatalf("address %d: got %+v, want internal %v, "+
+				"index %d", i, a, internal, index)
+		}
+	}
+	for i, addr := range external {
+		a := addrs[props.ExternalKeyCount-3+uint32(i)]
+		if a.Address.String() != addr.String() || a.Used != (i == 1) {
+			t.Fatalf("got %+v for address %v, used %v", a, addr,
+				i == 1)
+		}
+	}
+	a := addrs[props.ExternalKeyCount+props.InternalKeyCount-1]
+	if a.Address.String() != change.String() || a.Used {
+		t.Fatalf("got %+v for change address %v", a, change)
+	}
+
+	// The imported address is only returned for the imported account.
+	addrs, err = w.AccountAddressDetails(scope, waddrmgr.ImportedAddrAccount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 1 || !addrs[0].Imported ||
+		addrs[0].Address.String() != imported.Address().String() {
+
+		t.Fatalf("got imported account addresses %+v, want %v", addrs,
+			imported.Address())
+	}
+
+	// Addresses of an unknown account are not returned.
+	_, err = w.AccountAddressDetails(scope, 5)
+	if !waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound) {
+		t.Fatalf("got error %v for unknown account", err)
+	}
+}
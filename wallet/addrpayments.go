@@ -0,0 +1,183 @@
+package wallet
+
+import (
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+)
+
+// AddressPayment describes a payment to a watched address, as reported by an
+// AddressPaymentFilter.
+type AddressPayment struct {
+	Address bchutil.Address
+
+	// Hash is the hash of the first transaction crediting the address.
+	Hash chainhash.Hash
+
+	// Amount is the total value of the transaction's outputs paying to
+	// the address.
+	Amount bchutil.Amount
+
+	// Confirmations is the number of confirmations of the transaction,
+	// which is zero while it is unmined.
+	Confirmations int32
+}
+
+// watchedAddress records the first payment to an address watched by an
+// AddressPaymentFilter.
+type watchedAddress struct {
+	addr      bchutil.Address
+	funded    bool
+	hash      chainhash.Hash
+	amount    bchutil.Amount
+	block     chainhash.Hash
+	height    int32 // -1 while unmined
+	confirmed bool  // target confirmations reported
+}
+
+// AddressPaymentFilter filters TransactionNotifications for payments to a set
+// of watched addresses.  A payment is reported the first time each address is
+// credited, and again when the crediting transaction first reaches the target
+// number of confirmations.  When the first report already has the target
+// number of confirmations, such as when the target is zero, no second report
+// is made.  Later payments to an address are not reported.
+//
+// The filter must be passed every notification received by a
+// TransactionNotificationsClient, in order, so that it can follow the chain
+// tip.  Payments made before the filter is created are not reported.
+type AddressPaymentFilter struct {
+	watched     map[string]*watchedAddress // keyed by output script
+	addrs       []*watchedAddress          // in the order given
+	targetConfs int32
+	tipHeight   int32
+}
+
+// NewAddressPaymentFilter returns a filter reporting payments to addrs until
+// they reach targetConfs confirmations.
+func NewAddressPaymentFilter(addrs []bchutil.Address,
+	targetConfs int32) (*AddressPaymentFilter, error) {
+
+	f := &AddressPaymentFilter{
+		watched:     make(map[string]*watchedAddress, len(addrs)),
+		targetConfs: targetConfs,
+		tipHeight:   -1,
+	}
+	for _, addr := range addrs {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := f.watched[string(pkScript)]; ok {
+			continue
+		}
+		w := &watchedAddress{addr: addr, height: -1}
+		f.watched[string(pkScript)] = w
+		f.addrs = append(f.addrs, w)
+	}
+	return f, nil
+}
+
+// payment returns the payment of a funded watched address.
+func (f *AddressPaymentFilter) payment(w *watchedAddress) AddressPayment {
+	var confs int32
+	if w.height != -1 && f.tipHeight != -1 {
+		confs = confirms(w.height, f.tipHeight)
+	}
+	return AddressPayment{
+		Address:       w.addr,
+		Hash:          w.hash,
+		Amount:        w.amount,
+		Confirmations: confs,
+	}
+}
+
+// credits records the payments to watched addresses made by tx, which is
+// mined in block at height, or unmined if block is nil.  It returns the
+// addresses credited for the first time.
+func (f *AddressPaymentFilter) credits(tx *TransactionSummary,
+	block *chainhash.Hash, height int32) []*watchedAddress {
+
+	var funded []*watchedAddress
+	for i := range tx.MyOutputs {
+		out := &tx.MyOutputs[i]
+		if out.Address == nil {
+			continue
+		}
+		pkScript, err := txscript.PayToAddrScript(out.Address)
+		if err != nil {
+			continue
+		}
+		w, ok := f.watched[string(pkScript)]
+		if !ok {
+			continue
+		}
+		if !w.funded {
+			w.funded = true
+			w.hash = *tx.Hash
+			funded = append(funded, w)
+		}
+		if w.hash != *tx.Hash {
+			continue
+		}
+		if block != nil {
+			w.block, w.height = *block, height
+		}
+
+		// Only count the outputs of a transaction the first time it is
+		// seen, rather than again when an unmined transaction is mined.
+		for _, fw := range funded {
+			if fw == w {
+				w.amount += out.Amount
+				break
+			}
+		}
+	}
+	return funded
+}
+
+// Filter returns the payments to watched addresses reported by n.
+func (f *AddressPaymentFilter) Filter(n *TransactionNotifications) []AddressPayment {
+	// Transactions of detached blocks are unmined until they are mined
+	// again in an attached block.
+	for _, hash := range n.DetachedBlocks {
+		for _, w := range f.addrs {
+			if w.funded && w.height != -1 && w.block == *hash {
+				w.height = -1
+			}
+		}
+	}
+
+	var funded []*watchedAddress
+	for i := range n.AttachedBlocks {
+		b := &n.AttachedBlocks[i]
+		for j := range b.Transactions {
+			funded = append(funded, f.credits(&b.Transactions[j],
+				b.Hash, b.Height)...)
+		}
+		f.tipHeight = b.Height
+	}
+	for i := range n.UnminedTransactions {
+		funded = append(funded, f.credits(&n.UnminedTransactions[i],
+			nil, -1)...)
+	}
+
+	var payments []AddressPayment
+	reported := make(map[*watchedAddress]bool, len(funded))
+	for _, w := range funded {
+		p := f.payment(w)
+		w.confirmed = p.Confirmations >= f.targetConfs
+		reported[w] = true
+		payments = append(payments, p)
+	}
+	for _, w := range f.addrs {
+		if !w.funded || w.confirmed || reported[w] {
+			continue
+		}
+		p := f.payment(w)
+		if p.Confirmations >= f.targetConfs {
+			w.confirmed = true
+			payments = append(payments, p)
+		}
+	}
+	return payments
+}
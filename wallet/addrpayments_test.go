@@ -0,0 +1,125 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+)
+
+// TestAddressPaymentFilter ensures payments to watched addresses are reported
+// when the address is first credited and again when the payment reaches the
+// target number of confirmations, following the chain across reorganizations.
+func TestAddressPaymentFilter(t *testing.T) {
+	params := &chaincfg.TestNet3Params
+	var addrs []bchutil.Address
+	for i := byte(0); i < 3; i++ {
+		hash := make([]byte, 20)
+		hash[0] = i + 1
+		addr, err := bchutil.NewAddressPubKeyHash(hash, params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		addrs = append(addrs, addr)
+	}
+	watched, second, other := addrs[0], addrs[1], addrs[2]
+
+	f, err := NewAddressPaymentFilter([]bchutil.Address{watched, second}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := func(hash byte, outs ...TransactionSummaryOutput) TransactionSummary {
+		return TransactionSummary{
+			Hash:      &chainhash.Hash{hash},
+			MyOutputs: outs,
+		}
+	}
+	out := func(addr bchutil.Address, amount bchutil.Amount) TransactionSummaryOutput {
+		return TransactionSummaryOutput{Address: addr, Amount: amount}
+	}
+	block := func(height int32, txs ...TransactionSummary) Block {
+		return Block{
+			Hash:         &chainhash.Hash{0xbb, byte(height)},
+			Height:       height,
+			Transactions: txs,
+		}
+	}
+	check := func(got []AddressPayment, want ...AddressPayment) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("got payments %+v, want %+v", got, want)
+		}
+		for i := range want {
+			if got[i].Address.String() != want[i].Address.String() ||
+				got[i].Hash != want[i].Hash ||
+				got[i].Amount != want[i].Amount ||
+				got[i].Confirmations != want[i].Confirmations {
+
+				t.Fatalf("got payment %+v, want %+v", got[i], want[i])
+			}
+		}
+	}
+	payment := func(hash byte, amount bchutil.Amount, confs int32) AddressPayment {
+		return AddressPayment{
+			Address:       watched,
+			Hash:          chainhash.Hash{hash},
+			Amount:        amount,
+			Confirmations: confs,
+		}
+	}
+
+	// An unmined payment with two outputs to the watched address is
+	// reported with zero confirmations.  Payments to other addresses are
+	// not reported.
+	check(f.Filter(&TransactionNotifications{
+		UnminedTransactions: []TransactionSummary{
+			tx(1, out(watched, 1e8), out(other, 5e8), out(watched, 2e7)),
+		},
+	}), payment(1, 12e7, 0))
+
+	// Mining the transaction or a later payment to the address is not
+	// reported before the target confirmations are reached.
+	check(f.Filter(&TransactionNotifications{
+		AttachedBlocks: []Block{block(100, tx(1, out(watched, 1e8),
+			out(watched, 2e7)), tx(2, out(watched, 3e8)))},
+	}))
+	check(f.Filter(&TransactionNotifications{
+		AttachedBlocks: []Block{block(101)},
+	}))
+
+	// A reorganization removing the block leaves the payment unmined
+	// until it is mined again.
+	check(f.Filter(&TransactionNotifications{
+		DetachedBlocks: []*chainhash.Hash{{0xbb, 101}, {0xbb, 100}},
+		AttachedBlocks: []Block{block(100), block(101), block(102)},
+	}))
+	check(f.Filter(&TransactionNotifications{
+		AttachedBlocks: []Block{block(103, tx(1, out(watched, 1e8),
+			out(watched, 2e7))), block(104)},
+	}))
+
+	// The target number of confirmations is reported once.
+	check(f.Filter(&TransactionNotifications{
+		AttachedBlocks: []Block{block(105)},
+	}), payment(1, 12e7, 3))
+	check(f.Filter(&TransactionNotifications{
+		AttachedBlocks: []Block{block(106)},
+	}))
+
+	// A payment first seen with the target number of confirmations is
+	// reported only once.
+	check(f.Filter(&TransactionNotifications{
+		AttachedBlocks: []Block{block(107, tx(3, out(second, 4e8))),
+			block(108), block(109)},
+	}), AddressPayment{
+		Address:       second,
+		Hash:          chainhash.Hash{3},
+		Amount:        4e8,
+		Confirmations: 3,
+	})
+	check(f.Filter(&TransactionNotifications{
+		AttachedBlocks: []Block{block(110)},
+	}))
+}
@@ -0,0 +1,86 @@
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/gcash/bchwallet/internal/zero"
+)
+
+// PassphraseProvider fetches the private passphrase of a wallet from a secret
+// source outside of the wallet, such as an environment variable, a file or an
+// OS keyring, so that a wallet may be unlocked without an interactive prompt.
+//
+// Storing the private passphrase where it can be read without user
+// interaction means anyone able to read it, and any process running as the
+// wallet's user, can spend the wallet's funds.  Providers should only be used
+// when the secret source is at least as well protected as the wallet itself.
+type PassphraseProvider interface {
+	// PrivatePassphrase returns the private passphrase.  The caller zeroes
+	// the returned slice after use.
+	PrivatePassphrase() ([]byte, error)
+}
+
+// EnvPassphrase is a PassphraseProvider reading the private passphrase from
+// the environment variable with the given name.  The variable is unset after
+// it is read so that it is not inherited by child processes, although its
+// value may remain in the memory of the process.
+type EnvPassphrase string
+
+// PrivatePassphrase returns the value of the environment variable.
+func (e EnvPassphrase) PrivatePassphrase() ([]byte, error) {
+	value, ok := os.LookupEnv(string(e))
+	if !ok {
+		return nil, fmt.Errorf("environment variable %s is not set",
+			string(e))
+	}
+	os.Unsetenv(string(e))
+	return []byte(value), nil
+}
+
+// FilePassphrase is a PassphraseProvider reading the private passphrase from
+// the file at the given path.  A single trailing newline is removed.  Files
+// readable or writable by users other than their owner are refused.
+type FilePassphrase string
+
+// PrivatePassphrase returns the contents of the file.
+func (f FilePassphrase) PrivatePassphrase() ([]byte, error) {
+	info, err := os.Stat(string(f))
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("passphrase file %s must not be accessible "+
+			"by other users (mode %v)", string(f), info.Mode().Perm())
+	}
+	pass, err := ioutil.ReadFile(string(f))
+	if err != nil {
+		return nil, err
+	}
+	trimmed := bytes.TrimSuffix(bytes.TrimSuffix(pass, []byte("\n")),
+		[]byte("\r"))
+	if len(trimmed) == 0 {
+		zero.Bytes(pass)
+		return nil, errors.New("passphrase file is empty")
+	}
+	return trimmed, nil
+}
+
+// autoUnlock unlocks w using the private passphrase fetched from provider,
+// locking it again after timeout if it is positive.  The passphrase is zeroed
+// before returning.
+func autoUnlock(w *Wallet, provider PassphraseProvider,
+	timeout time.Duration) error {
+
+	pass, err := provider.PrivatePassphrase()
+	if err != nil {
+		return err
+	}
+	defer zero.Bytes(pass)
+
+	return w.UnlockFor(pass, timeout)
+}
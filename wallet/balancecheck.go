@@ -0,0 +1,103 @@
+package wallet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// BalanceMismatchError describes a wallet balance which differs from the
+// balance recomputed from the wallet's unspent outputs.  It indicates a bug in
+// the accounting of the transaction store.
+type BalanceMismatchError struct {
+	// Balance is the balance calculated by the transaction store from its
+	// running total of mined credits.
+	Balance bchutil.Amount
+
+	// Recomputed is the sum of every spendable unspent output.
+	Recomputed bchutil.Amount
+
+	// Height is the height the wallet was synced to when the balances were
+	// calculated.
+	Height int32
+}
+
+// Error satisfies the error interface.
+func (e *BalanceMismatchError) Error() string {
+	return fmt.Sprintf("wallet balance %v at height %d does not match the "+
+		"sum %v of unspent outputs", e.Balance, e.Height, e.Recomputed)
+}
+
+// VerifyBalance recomputes the wallet balance from scratch, as the sum of the
+// unspent outputs excluding immature coinbase outputs, and compares it to the
+// balance calculated by the transaction store.  A *BalanceMismatchError is
+// returned when they differ.
+func (w *Wallet) VerifyBalance() error {
+	var mismatch *BalanceMismatchError
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		syncHeight := w.Manager.SyncedTo().Height
+		balance, err := w.TxStore.Balance(txmgrNs, 0, syncHeight)
+		if err != nil {
+			return err
+		}
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		maturity := int32(w.chainParams.CoinbaseMaturity)
+		var recomputed bchutil.Amount
+		for i := range unspent {
+			output := &unspent[i]
+			if output.FromCoinBase &&
+				!confirmed(maturity, output.Height, syncHeight) {
+				continue
+			}
+			recomputed += output.Amount
+		}
+		if balance != recomputed {
+			mismatch = &BalanceMismatchError{
+				Balance:    balance,
+				Recomputed: recomputed,
+				Height:     syncHeight,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if mismatch != nil {
+		return mismatch
+	}
+	return nil
+}
+
+// balanceChecker periodically verifies the wallet balance, logging and
+// notifying clients of any mismatch, until the wallet is shut down.
+func (w *Wallet) balanceChecker(interval time.Duration) {
+	defer w.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-w.quitChan():
+			return
+		}
+
+		err := w.VerifyBalance()
+		switch err := err.(type) {
+		case nil:
+		case *BalanceMismatchError:
+			log.Errorf("Balance self-check failed: %v", err)
+			w.NtfnServer.notifyBalanceMismatch(err)
+		default:
+			log.Warnf("Unable to verify balance: %v", err)
+		}
+	}
+}
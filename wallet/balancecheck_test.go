@@ -0,0 +1,65 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// TestVerifyBalance ensures a consistent balance passes the self-check and
+// that a drifted balance is reported, both on demand and by the periodic
+// check.
+func TestVerifyBalance(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addTestCredits(t, w, 100, 100, []bchutil.Address{addr, addr},
+		[]int64{1e8, 2e8})
+
+	if err := w.VerifyBalance(); err != nil {
+		t.Fatalf("unexpected self-check failure: %v", err)
+	}
+
+	// Corrupt the running balance kept by the transaction store.
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(wtxmgrNamespaceKey)
+		v := make([]byte, 8)
+		binary.BigEndian.PutUint64(v, 4e8)
+		return ns.Put([]byte("bal"), v)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = w.VerifyBalance()
+	mismatch, ok := err.(*BalanceMismatchError)
+	if !ok {
+		t.Fatalf("expected BalanceMismatchError, got %v", err)
+	}
+	if mismatch.Balance != 4e8 || mismatch.Recomputed != 3e8 ||
+		mismatch.Height != 100 {
+
+		t.Fatalf("unexpected mismatch %+v", mismatch)
+	}
+
+	client := w.NtfnServer.BalanceMismatchNotifications()
+	defer client.Done()
+	w.wg.Add(1)
+	go w.balanceChecker(10 * time.Millisecond)
+	select {
+	case n := <-client.C:
+		if *n != *mismatch {
+			t.Fatalf("notified mismatch %+v, want %+v", n, mismatch)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no mismatch notification received")
+	}
+}
@@ -0,0 +1,101 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestBalanceAtHeight ensures the balance of an account at past heights
+// reflects the credits and spends mined at or below each height, and that
+// heights which can't be reconstructed are rejected.
+func TestBalanceAtHeight(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 100, []bchutil.Address{addr},
+		[]int64{1e8})
+	addTestCredits(t, w, 105, 110, []bchutil.Address{addr}, []int64{2e8})
+
+	// Spend the first credit at height 103 and set the birthday block to
+	// height 50.
+	spend := wire.NewMsgTx(1)
+	spend.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: rec.Hash}, nil))
+	spend.AddTxOut(wire.NewTxOut(9e7, []byte{0x51}, wire.TokenData{}))
+	spendRec, err := wtxmgr.NewTxRecordFromMsgTx(spend, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		block := &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{Hash: chainhash.Hash{103}, Height: 103},
+			Time:  time.Unix(1387737310, 0),
+		}
+		if err := w.TxStore.InsertTx(ns, spendRec, block); err != nil {
+			return err
+		}
+
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.SetBirthdayBlock(addrmgrNs, waddrmgr.BlockStamp{
+			Hash:   chainhash.Hash{50},
+			Height: 50,
+		}, true)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	check := func(height int32, want bchutil.Amount, wantErr error) {
+		t.Helper()
+		balance, err := w.BalanceAtHeight(0, height)
+		if !errors.Is(err, wantErr) {
+			t.Fatalf("height %d: got error %v, want %v", height, err,
+				wantErr)
+		}
+		if balance != want {
+			t.Fatalf("height %d: got balance %v, want %v", height,
+				balance, want)
+		}
+	}
+	check(50, 0, nil)
+	check(100, 1e8, nil)
+	check(102, 1e8, nil)
+	check(103, 0, nil)
+	check(105, 2e8, nil)
+	check(110, 2e8, nil)
+	check(111, 0, ErrHeightNotSynced)
+	check(49, 0, ErrHistoryUnavailable)
+
+	// Other accounts have no balance.
+	balance, err := w.BalanceAtHeight(1, 110)
+	if err != nil || balance != 0 {
+		t.Fatalf("got balance %v, error %v for another account",
+			balance, err)
+	}
+
+	// Once the spent transaction is pruned, the balance while it was
+	// unspent can no longer be reconstructed.
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		_, err := w.TxStore.PruneSpentTransactions(ns, 103)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	check(102, 0, ErrHistoryUnavailable)
+	check(103, 0, nil)
+	check(110, 2e8, nil)
+}
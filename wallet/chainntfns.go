@@ -6,10 +6,12 @@ package wallet
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/rpcclient"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchwallet/chain"
@@ -84,6 +86,10 @@ func (w *Wallet) handleChainNotifications() {
 		return err
 	}
 
+	// connected records whether the chain backend has connected before, so
+	// that reconnects can be detected.
+	var connected bool
+
 	for {
 		select {
 		case n, ok := <-chainClient.Notifications():
@@ -95,6 +101,19 @@ func (w *Wallet) handleChainNotifications() {
 			var err error
 			switch n := n.(type) {
 			case chain.ClientConnected:
+				// Notifications may have been missed while the
+				// backend was disconnected, and the backend may
+				// have lost the rescan's transaction filter.
+				// Mark the wallet out of sync until the rescan
+				// of the sync below, which also resubscribes to
+				// its notifications, has finished.
+				if connected {
+					log.Infof("Chain backend reconnected, " +
+						"resynchronizing wallet")
+					w.SetChainSynced(false)
+				}
+				connected = true
+
 				// Before attempting to sync with our backend,
 				// we'll make sure that our birthday block has
 				// been set correctly to potentially prevent
@@ -106,13 +125,27 @@ func (w *Wallet) handleChainNotifications() {
 				birthdayBlock, err := birthdaySanityCheck(
 					chainClient, birthdayStore,
 				)
+				if isChainDisconnectError(err) {
+					log.Warnf("Chain backend disconnected "+
+						"before the wallet synchronized: %v",
+						err)
+					continue
+				}
 				if err != nil && !waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet) {
 					panic(fmt.Errorf("Unable to sanity "+
 						"check wallet birthday block: %v",
 						err))
 				}
 
+				// If the backend disconnected again, the wallet
+				// will synchronize when it next reconnects.
 				err = w.syncWithChain(birthdayBlock)
+				if isChainDisconnectError(err) {
+					log.Warnf("Chain backend disconnected "+
+						"before the wallet synchronized: %v",
+						err)
+					continue
+				}
 				if err != nil && !w.ShuttingDown() {
 					panic(fmt.Errorf("Unable to synchronize "+
 						"wallet to chain: %v", err))
@@ -126,6 +159,14 @@ func (w *Wallet) handleChainNotifications() {
 				err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 					return w.disconnectBlock(tx, wtxmgr.BlockMeta(n))
 				})
+				var txErr wtxmgr.Error
+				if errors.As(err, &txErr) && txErr.Code == wtxmgr.ErrPruned {
+					log.Warnf("Chain reorganization at height %d "+
+						"reaches pruned transactions, "+
+						"resyncing from birthday block",
+						n.Height)
+					err = w.resyncPrunedHistory()
+				}
 				notificationName = "block disconnected"
 			case chain.RelevantTx:
 				w.recoveryInterruptChan <- struct{}{}
@@ -197,6 +238,13 @@ func (w *Wallet) handleChainNotifications() {
 	}
 }
 
+// isChainDisconnectError returns whether err was caused by the chain backend
+// disconnecting while the wallet was synchronizing with it.
+func isChainDisconnectError(err error) bool {
+	return errors.Is(err, rpcclient.ErrClientDisconnect) ||
+		errors.Is(err, rpcclient.ErrClientNotConnected)
+}
+
 // connectBlock handles a chain server notification by marking a wallet
 // that's currently in-sync with the chain server as being synced up to
 // the passed block.
@@ -213,6 +261,15 @@ func (w *Wallet) connectBlock(dbtx walletdb.ReadWriteTx, b wtxmgr.BlockMeta) err
 		return err
 	}
 
+	if w.pruneSpentTxs && b.Height%pruneInterval == 0 {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		_, err := w.TxStore.PruneSpentTransactions(txmgrNs,
+			b.Height-w.maxRollbackDepth)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Notify interested clients of the connected block.
 	//
 	// TODO: move all notifications outside of the database transaction.
@@ -254,13 +311,17 @@ func (w *Wallet) disconnectBlock(dbtx walletdb.ReadWriteTx, b wtxmgr.BlockMeta)
 				return err
 			}
 
-			bs.Timestamp = header.Timestamp
-			err = w.Manager.SetSyncedTo(addrmgrNs, &bs)
+			// Roll back the transaction store first, as the
+			// manager's in-memory sync state is not restored when
+			// the rollback fails on pruned transactions.
+			w.gapDeposits.invalidate(b.Height)
+			err = w.TxStore.Rollback(txmgrNs, b.Height)
 			if err != nil {
 				return err
 			}
 
-			err = w.TxStore.Rollback(txmgrNs, b.Height)
+			bs.Timestamp = header.Timestamp
+			err = w.Manager.SetSyncedTo(addrmgrNs, &bs)
 			if err != nil {
 				return err
 			}
@@ -286,6 +347,12 @@ func (w *Wallet) addRelevantTx(dbtx walletdb.ReadWriteTx, rec *wtxmgr.TxRecord,
 		return err
 	}
 
+	// Transactions found by a rescan or recovery may be mined below the
+	// height the confirmed deposits are cached through.
+	if block != nil {
+		w.gapDeposits.invalidate(block.Height)
+	}
+
 	// Check every output to determine whether it is controlled by a wallet
 	// key.  If so, mark the output as a credit.
 	for i, output := range rec.MsgTx.TxOut {
@@ -3,13 +3,18 @@ package wallet
 import (
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/chain"
 	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
 	_ "github.com/gcash/bchwallet/walletdb/bdb"
 )
 
@@ -101,6 +106,146 @@ func (c *mockChainConn) GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader,
 	return &block.Header, nil
 }
 
+// mockChainConnClient is a mock chain client backed by a mock chain which
+// considers itself current.  Blocks are filtered for the transactions paying
+// any of the addresses of the BIP0044 scope watched by the filter request,
+// out of the payments made at their height.
+type mockChainConnClient struct {
+	mockChainClient
+
+	mu       sync.Mutex
+	conn     *mockChainConn
+	payments map[int32][]*wire.MsgTx
+
+	// filterCalls and filtered count the filter requests made and the
+	// blocks they filtered.
+	filterCalls int
+	filtered    int
+}
+
+func (c *mockChainConnClient) GetBestBlock() (*chainhash.Hash, int32, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.GetBestBlock()
+}
+
+func (c *mockChainConnClient) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.GetBlockHash(height)
+}
+
+func (c *mockChainConnClient) GetBlockHeader(hash *chainhash.Hash) (*wire.BlockHeader,
+	error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.GetBlockHeader(hash)
+}
+
+func (c *mockChainConnClient) IsCurrent() bool {
+	return true
+}
+
+func (c *mockChainConnClient) FilterBlocks(req *chain.FilterBlocksRequest) (
+	*chain.FilterBlocksResponse, error) {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.filterCalls++
+	scope := waddrmgr.KeyScopeBIP0044
+	externalIndexes := make(map[string]uint32)
+	for scopedIndex, addr := range req.ExternalAddrs {
+		externalIndexes[addr.EncodeAddress()] = scopedIndex.Index
+	}
+	internalIndexes := make(map[string]uint32)
+	for scopedIndex, addr := range req.InternalAddrs {
+		internalIndexes[addr.EncodeAddress()] = scopedIndex.Index
+	}
+
+	for i, block := range req.Blocks {
+		c.filtered++
+
+		external := make(map[uint32]struct{})
+		internal := make(map[uint32]struct{})
+		var relevant []*wire.MsgTx
+		for _, tx := range c.payments[block.Height] {
+			found := false
+			for _, txOut := range tx.TxOut {
+				_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+					txOut.PkScript, &chaincfg.TestNet3Params,
+				)
+				if err != nil || len(addrs) != 1 {
+					continue
+				}
+				addr := addrs[0].EncodeAddress()
+				if index, ok := externalIndexes[addr]; ok {
+					external[index] = struct{}{}
+					found = true
+				}
+				if index, ok := internalIndexes[addr]; ok {
+					internal[index] = struct{}{}
+					found = true
+				}
+			}
+			if found {
+				relevant = append(relevant, tx)
+			}
+		}
+		if len(relevant) == 0 {
+			continue
+		}
+
+		return &chain.FilterBlocksResponse{
+			BatchIndex: uint32(i),
+			BlockMeta:  block,
+			FoundExternalAddrs: map[waddrmgr.KeyScope]map[uint32]struct{}{
+				scope: external,
+			},
+			FoundInternalAddrs: map[waddrmgr.KeyScope]map[uint32]struct{}{
+				scope: internal,
+			},
+			RelevantTxns: relevant,
+		}, nil
+	}
+	return nil, nil
+}
+
+// testPaymentTx returns a transaction paying amount to the address at index of
+// the branch of an account of the BIP0044 scope, neither of which need have
+// been created by the wallet yet.
+func testPaymentTx(t *testing.T, w *Wallet, account, branch, index uint32,
+	amount int64) *wire.MsgTx {
+
+	t.Helper()
+
+	manager, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var addrs map[uint32]bchutil.Address
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		addrs, err = manager.DeriveAccountAddresses(
+			ns, account, branch, index+1,
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to derive addresses: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addrs[index])
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: account}, nil))
+	tx.AddTxOut(&wire.TxOut{Value: amount, PkScript: pkScript})
+	return tx
+}
+
 // mockBirthdayStore is a mock in-memory implementation of the birthdayStore interface
 // that will be used for the birthday block sanity check tests.
 type mockBirthdayStore struct {
@@ -55,6 +55,160 @@ func makeInputSource(eligible []wtxmgr.Credit) txauthor.InputSource {
 	}
 }
 
+// DefaultMinChangeAmount is the default smallest change output created by the
+// wallet.  Smaller change is added to the transaction fee instead.  It is three
+// times the dust threshold of a P2PKH output at the default relay fee.
+const DefaultMinChangeAmount bchutil.Amount = 3 * 546
+
+// CoinSelectionStrategy describes how inputs are chosen from the eligible
+// unspent outputs when creating a transaction.
+type CoinSelectionStrategy uint8
+
+// These constants define the supported coin selection strategies.
+const (
+	// CoinSelectionLargest selects the largest outputs first, regardless
+	// of the addresses they pay to.  This is the default.
+	CoinSelectionLargest CoinSelectionStrategy = iota
+
+	// CoinSelectionSingleAddress prefers spending every output paying to a
+	// single address when that address alone covers the target, so that
+	// the transaction does not link unrelated addresses together.  Outputs
+	// from multiple addresses are only combined when no single address
+	// holds enough value.
+	CoinSelectionSingleAddress
+)
+
+// TxCreateOptions holds the optional parameters of CreateUnsignedTx.  The zero
+// value selects inputs with CoinSelectionLargest, pays change to a change
+// address of the account, and does not spend immature coinbase outputs.
+type TxCreateOptions struct {
+	// Strategy determines how inputs are selected.
+	Strategy CoinSelectionStrategy
+
+	// ChangeAddr, if non-nil, is paid the change instead of a change
+	// address of the account.  It must be controlled by the wallet.
+	ChangeAddr bchutil.Address
+
+	// Immature, if non-nil, permits coinbase outputs which have not yet
+	// matured to be selected.  See ImmatureCoinbaseSpend.
+	Immature *ImmatureCoinbaseSpend
+}
+
+// makeSingleAddressInputSource creates an input source implementing
+// CoinSelectionSingleAddress.  Of the addresses able to cover a target, all
+// outputs of the one with the smallest total are selected to minimize change.
+// When no address can cover the target on its own, inputs are selected
+// largest-first across all addresses.
+func makeSingleAddressInputSource(eligible []wtxmgr.Credit) txauthor.InputSource {
+	// Group the credits by output script, keeping the groups in the order
+	// their first credit appears so selection is deterministic.
+	type addressCredits struct {
+		credits []wtxmgr.Credit
+		total   bchutil.Amount
+	}
+	var groups []*addressCredits
+	byScript := make(map[string]*addressCredits)
+	for _, credit := range eligible {
+		group, ok := byScript[string(credit.PkScript)]
+		if !ok {
+			group = new(addressCredits)
+			byScript[string(credit.PkScript)] = group
+			groups = append(groups, group)
+		}
+		group.credits = append(group.credits, credit)
+		group.total += credit.Amount
+	}
+
+	mixed := make([]wtxmgr.Credit, len(eligible))
+	copy(mixed, eligible)
+	mixedSource := makeInputSource(mixed)
+
+	return func(target bchutil.Amount) (bchutil.Amount, []*wire.TxIn,
+		[]bchutil.Amount, [][]byte, error) {
+
+		var best *addressCredits
+		for _, group := range groups {
+			if group.total < target {
+				continue
+			}
+			if best == nil || group.total < best.total {
+				best = group
+			}
+		}
+		if best == nil {
+			return mixedSource(target)
+		}
+
+		inputs := make([]*wire.TxIn, 0, len(best.credits))
+		inputValues := make([]bchutil.Amount, 0, len(best.credits))
+		scripts := make([][]byte, 0, len(best.credits))
+		for i := range best.credits {
+			credit := &best.credits[i]
+			inputs = append(inputs, wire.NewTxIn(&credit.OutPoint, nil))
+			inputValues = append(inputValues, credit.Amount)
+			scripts = append(scripts, credit.PkScript)
+		}
+		return best.total, inputs, inputValues, scripts, nil
+	}
+}
+
+// selectInputs returns the eligible credits for the given outpoints, in the
+// same order.  An error wrapping ErrInputNotEligible is returned if an outpoint
+// is not eligible or is selected more than once.
+func selectInputs(eligible []wtxmgr.Credit,
+	inputs []wire.OutPoint) ([]wtxmgr.Credit, error) {
+
+	credits := make(map[wire.OutPoint]*wtxmgr.Credit, len(eligible))
+	for i := range eligible {
+		credits[eligible[i].OutPoint] = &eligible[i]
+	}
+	selected := make([]wtxmgr.Credit, 0, len(inputs))
+	for _, op := range inputs {
+		credit, ok := credits[op]
+		if !ok {
+			return nil, fmt.Errorf("%w: %v", ErrInputNotEligible, op)
+		}
+		selected = append(selected, *credit)
+
+		// Remove the credit so that selecting it again is an error.
+		delete(credits, op)
+	}
+	return selected, nil
+}
+
+// makeFixedInputSource creates an input source that always spends every one
+// of the given credits, regardless of the target amount.
+func makeFixedInputSource(credits []wtxmgr.Credit) txauthor.InputSource {
+	var total bchutil.Amount
+	inputs := make([]*wire.TxIn, len(credits))
+	values := make([]bchutil.Amount, len(credits))
+	scripts := make([][]byte, len(credits))
+	for i := range credits {
+		credit := &credits[i]
+		total += credit.Amount
+		inputs[i] = wire.NewTxIn(&credit.OutPoint, nil)
+		values[i] = credit.Amount
+		scripts[i] = credit.PkScript
+	}
+
+	return func(bchutil.Amount) (bchutil.Amount, []*wire.TxIn,
+		[]bchutil.Amount, [][]byte, error) {
+
+		return total, inputs, values, scripts, nil
+	}
+}
+
+// makeStrategyInputSource creates the input source for the given coin
+// selection strategy.
+func makeStrategyInputSource(eligible []wtxmgr.Credit,
+	strategy CoinSelectionStrategy) txauthor.InputSource {
+
+	if strategy == CoinSelectionSingleAddress {
+		return makeSingleAddressInputSource(eligible)
+	}
+	return makeInputSource(eligible)
+}
+
 // secretSource is an implementation of txauthor.SecretSource for the wallet's
 // address manager.
 type secretSource struct {
@@ -102,11 +256,14 @@ func (s secretSource) GetScript(addr bchutil.Address) ([]byte, error) {
 // change to the wallet.  An appropriate fee is included based on the wallet's
 // current relay fee.  The wallet must be unlocked to create the transaction.
 //
+// If keyScope is non-nil, only outputs of the account under that scope are
+// spent and change is derived under the same scope.
+//
 // NOTE: The dryRun argument can be set true to create a tx that doesn't alter
 // the database. A tx created with this set to true will intentionally have no
 // input scripts added and SHOULD NOT be broadcasted.
-func (w *Wallet) txToOutputs(outputs []*wire.TxOut, account uint32,
-	minconf int32, feeSatPerKb bchutil.Amount, dryRun bool) (
+func (w *Wallet) txToOutputs(outputs []*wire.TxOut, keyScope *waddrmgr.KeyScope,
+	account uint32, minconf int32, feeSatPerKb bchutil.Amount, dryRun bool) (
 	tx *txauthor.AuthoredTx, err error) {
 
 	chainClient, err := w.requireChainClient()
@@ -128,12 +285,14 @@ func (w *Wallet) txToOutputs(outputs []*wire.TxOut, account uint32,
 		return nil, err
 	}
 
-	eligible, err := w.findEligibleOutputs(dbtx, account, minconf, bs)
+	eligible, err := w.findEligibleOutputs(dbtx, keyScope, account, minconf,
+		bs)
 	if err != nil {
 		return nil, err
 	}
 
 	inputSource := makeInputSource(eligible)
+	scope := w.changeScope(keyScope)
 	changeSource := func() ([]byte, error) {
 		// Derive the change output script.  As a hack to allow
 		// spending from the imported account, change addresses are
@@ -141,17 +300,18 @@ func (w *Wallet) txToOutputs(outputs []*wire.TxOut, account uint32,
 		var changeAddr bchutil.Address
 		var err error
 		if account == waddrmgr.ImportedAddrAccount {
-			changeAddr, err = w.newChangeAddress(addrmgrNs, 0)
+			changeAddr, err = w.newChangeAddress(addrmgrNs, 0, scope)
 		} else {
-			changeAddr, err = w.newChangeAddress(addrmgrNs, account)
+			changeAddr, err = w.newChangeAddress(addrmgrNs, account,
+				scope)
 		}
 		if err != nil {
 			return nil, err
 		}
 		return txscript.PayToAddrScript(changeAddr)
 	}
-	tx, err = txauthor.NewUnsignedTransaction(outputs, feeSatPerKb,
-		inputSource, changeSource)
+	tx, err = txauthor.NewUnsignedTransactionMinChange(outputs, feeSatPerKb,
+		w.minChangeAmount, inputSource, changeSource)
 	if err != nil {
 		return nil, err
 	}
@@ -213,9 +373,31 @@ func (w *Wallet) txToOutputs(outputs []*wire.TxOut, account uint32,
 // outputs.  Previous outputs to reedeem are chosen from the passed account's
 // UTXO set and minconf policy. An additional output may be added to return
 // change to the wallet.  An appropriate fee is included based on the wallet's
-// current relay fee.  The wallet must be unlocked to create the transaction.
-func (w *Wallet) createUnsigned(outputs []*wire.TxOut, account uint32,
-	minconf int32, feeSatPerKb bchutil.Amount) (tx *txauthor.AuthoredTx, err error) {
+// current relay fee.  Change smaller than the wallet's minimum change amount
+// is added to the fee instead.  Inputs are selected according to the coin selection
+// strategy.  Change is paid to changeAddr, which must be controlled by the
+// wallet, or to the account's current change address if it is nil.  If
+// keyScope is non-nil, only outputs of the account under that scope are spent
+// and change is derived under the same scope.  If immature is non-nil,
+// immature coinbase outputs may be spent as described by
+// ImmatureCoinbaseSpend.  If inputs is non-nil, exactly those outputs are
+// spent instead of selecting them by the strategy.
+func (w *Wallet) createUnsigned(outputs []*wire.TxOut,
+	keyScope *waddrmgr.KeyScope, account uint32, minconf int32,
+	feeSatPerKb bchutil.Amount, strategy CoinSelectionStrategy,
+	changeAddr bchutil.Address, immature *ImmatureCoinbaseSpend,
+	inputs []wire.OutPoint) (tx *txauthor.AuthoredTx, err error) {
+
+	if immature != nil {
+		if !immature.AcknowledgeRisk {
+			return nil, ErrImmatureSpendNotAcknowledged
+		}
+		if immature.LockTime >= txscript.LockTimeThreshold {
+			return nil, fmt.Errorf("%w: lock time %d is not a block "+
+				"height", ErrImmatureSpendLockTime,
+				immature.LockTime)
+		}
+	}
 
 	chainClient, err := w.requireChainClient()
 	if err != nil {
@@ -223,6 +405,16 @@ func (w *Wallet) createUnsigned(outputs []*wire.TxOut, account uint32,
 	}
 
 	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		if changeAddr != nil {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			_, err := w.Manager.Address(addrmgrNs, changeAddr)
+			if waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+				return ErrChangeAddressNotOwned
+			}
+			if err != nil {
+				return err
+			}
+		}
 
 		// Get current block's height and hash.
 		bs, err := chainClient.BlockStamp()
@@ -230,34 +422,83 @@ func (w *Wallet) createUnsigned(outputs []*wire.TxOut, account uint32,
 			return err
 		}
 
-		eligible, err := w.findEligibleOutputs(dbtx, account, minconf, bs)
+		// Coinbase outputs must normally be mature by the next block.
+		// When immature outputs may be spent, they must instead be
+		// mature by the lock time, or by any height if the lock time
+		// is chosen from the selected inputs.
+		maturity := int32(w.chainParams.CoinbaseMaturity)
+		coinbaseHeight := bs.Height
+		if immature != nil {
+			coinbaseHeight = bs.Height + maturity - 1
+			if immature.LockTime != 0 {
+				coinbaseHeight = int32(immature.LockTime)
+			}
+			if coinbaseHeight < bs.Height {
+				coinbaseHeight = bs.Height
+			}
+		}
+		eligible, err := w.findEligibleOutputsAt(dbtx, keyScope,
+			account, minconf, bs, coinbaseHeight)
 		if err != nil {
 			return err
 		}
 
-		inputSource := makeInputSource(eligible)
+		// Record the maturity height of each selectable immature
+		// coinbase output to validate the lock time against.
+		maturityHeights := make(map[wire.OutPoint]int32)
+		for i := range eligible {
+			credit := &eligible[i]
+			if credit.FromCoinBase &&
+				!confirmed(maturity, credit.Height, bs.Height) {
+
+				maturityHeights[credit.OutPoint] = credit.Height +
+					maturity - 1
+			}
+		}
+
+		inputSource := makeStrategyInputSource(eligible, strategy)
+		if inputs != nil {
+			selected, err := selectInputs(eligible, inputs)
+			if err != nil {
+				return err
+			}
+			inputSource = makeFixedInputSource(selected)
+		}
+		scope := w.changeScope(keyScope)
 		changeSource := func() ([]byte, error) {
+			if changeAddr != nil {
+				return txscript.PayToAddrScript(changeAddr)
+			}
+
 			// Derive the change output script.  As a hack to allow
 			// spending from the imported account, change addresses
 			// are created from account 0.
 			var changeAddr bchutil.Address
 			var err error
 			if account == waddrmgr.ImportedAddrAccount {
-				changeAddr, err = w.CurrentChangeAddress(0, waddrmgr.KeyScopeBIP0044)
+				changeAddr, err = w.CurrentChangeAddress(0, scope)
 			} else {
-				changeAddr, err = w.CurrentChangeAddress(account, waddrmgr.KeyScopeBIP0044)
+				changeAddr, err = w.CurrentChangeAddress(account, scope)
 			}
 			if err != nil {
 				return nil, err
 			}
 			return txscript.PayToAddrScript(changeAddr)
 		}
-		tx, err = txauthor.NewUnsignedTransaction(outputs, feeSatPerKb,
-			inputSource, changeSource)
+		tx, err = txauthor.NewUnsignedTransactionMinChange(outputs,
+			feeSatPerKb, w.minChangeAmount, inputSource, changeSource)
 		if err != nil {
 			return err
 		}
 
+		if immature != nil {
+			err = setImmatureSpendLockTime(tx.Tx, maturityHeights,
+				immature.LockTime)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Randomize change position, if change exists, before signing.
 		// This doesn't affect the serialize size, so the change amount
 		// will still be valid.
@@ -271,7 +512,9 @@ func (w *Wallet) createUnsigned(outputs []*wire.TxOut, account uint32,
 		return nil, err
 	}
 
-	if tx.ChangeIndex >= 0 && account == waddrmgr.ImportedAddrAccount {
+	if tx.ChangeIndex >= 0 && account == waddrmgr.ImportedAddrAccount &&
+		changeAddr == nil {
+
 		changeAmount := bchutil.Amount(tx.Tx.TxOut[tx.ChangeIndex].Value)
 		log.Warnf("Spend from imported account produced change: moving"+
 			" %v from imported account into default account.", changeAmount)
@@ -280,7 +523,88 @@ func (w *Wallet) createUnsigned(outputs []*wire.TxOut, account uint32,
 	return tx, nil
 }
 
-func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minconf int32, bs *waddrmgr.BlockStamp) ([]wtxmgr.Credit, error) {
+// ImmatureCoinbaseSpend permits a transaction to spend coinbase outputs which
+// have not yet reached coinbase maturity, so that it may be signed in advance
+// and published once they mature.  The transaction is given a block height lock
+// time no earlier than the height at which the last of its coinbase inputs
+// matures, which is the MaturityHeight reported by ImmatureCoinbaseOutputs.
+// Until then, the transaction is rejected by the network.
+type ImmatureCoinbaseSpend struct {
+	// LockTime is the lock time of the transaction.  Only coinbase
+	// outputs which are mature at this height are selected.  If zero, any
+	// immature coinbase output may be selected and the lock time is set to
+	// the latest maturity height of the selected coinbase inputs.
+	LockTime uint32
+
+	// AcknowledgeRisk must be set to acknowledge that the transaction can
+	// not be mined before the lock time and becomes invalid if a coinbase
+	// transaction it spends is removed from the main chain by a
+	// reorganization before maturing.
+	AcknowledgeRisk bool
+}
+
+// setImmatureSpendLockTime sets the lock time of tx, which may spend immature
+// coinbase outputs with the given maturity heights, and enables it by making
+// every input sequence non-final.  A zero lockTime is replaced by the latest
+// maturity height of the inputs.  ErrImmatureSpendLockTime is returned if the
+// lock time precedes the maturity height of any input.
+func setImmatureSpendLockTime(tx *wire.MsgTx,
+	maturityHeights map[wire.OutPoint]int32, lockTime uint32) error {
+
+	latest := int32(-1)
+	for _, in := range tx.TxIn {
+		height, ok := maturityHeights[in.PreviousOutPoint]
+		if ok && height > latest {
+			latest = height
+		}
+	}
+	if latest < 0 && lockTime == 0 {
+		// No immature coinbase outputs were selected.
+		return nil
+	}
+	if lockTime == 0 {
+		lockTime = uint32(latest)
+	}
+	if int64(lockTime) < int64(latest) {
+		return fmt.Errorf("%w: lock time %d precedes maturity height %d",
+			ErrImmatureSpendLockTime, lockTime, latest)
+	}
+
+	tx.LockTime = lockTime
+	for _, in := range tx.TxIn {
+		in.Sequence = wire.MaxTxInSequenceNum - 1
+	}
+	return nil
+}
+
+// changeScope returns the key scope change of a transaction spending outputs
+// of an account under keyScope is derived under.  When no scope is given,
+// change is derived under the default scope for pay-to-pubkey-hash addresses.
+func (w *Wallet) changeScope(keyScope *waddrmgr.KeyScope) waddrmgr.KeyScope {
+	if keyScope != nil {
+		return *keyScope
+	}
+	return w.Manager.ScopesForExternalAddrType(waddrmgr.PubKeyHash)[0]
+}
+
+// findEligibleOutputs returns the unspent outputs of the account which may be
+// spent by a transaction included in the next block.  If keyScope is non-nil,
+// only outputs of the account under that scope are returned.
+func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx,
+	keyScope *waddrmgr.KeyScope, account uint32, minconf int32,
+	bs *waddrmgr.BlockStamp) ([]wtxmgr.Credit, error) {
+
+	return w.findEligibleOutputsAt(dbtx, keyScope, account, minconf, bs,
+		bs.Height)
+}
+
+// findEligibleOutputsAt is findEligibleOutputs, except that coinbase outputs
+// are returned if they are mature once the main chain reaches coinbaseHeight
+// rather than the height of bs.
+func (w *Wallet) findEligibleOutputsAt(dbtx walletdb.ReadTx,
+	keyScope *waddrmgr.KeyScope, account uint32, minconf int32,
+	bs *waddrmgr.BlockStamp, coinbaseHeight int32) ([]wtxmgr.Credit, error) {
+
 	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
 	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
 
@@ -306,11 +630,17 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 		}
 		if output.FromCoinBase {
 			target := int32(w.chainParams.CoinbaseMaturity)
-			if !confirmed(target, output.Height, bs.Height) {
+			if !confirmed(target, output.Height, coinbaseHeight) {
 				continue
 			}
 		}
 
+		// Outputs that are still time-locked can not be spent by a
+		// transaction included in the next block.
+		if creditLockHeight(dbtx, output) > bs.Height {
+			continue
+		}
+
 		// Locked unspent outputs are skipped.
 		if w.LockedOutpoint(output.OutPoint) {
 			continue
@@ -326,8 +656,21 @@ func (w *Wallet) findEligibleOutputs(dbtx walletdb.ReadTx, account uint32, minco
 		if err != nil || len(addrs) != 1 {
 			continue
 		}
-		_, addrAcct, err := w.Manager.AddrAccount(addrmgrNs, addrs[0])
-		if err != nil || addrAcct != account {
+		ma, err := w.Manager.Address(addrmgrNs, addrs[0])
+		if err != nil || ma.Account() != account {
+			continue
+		}
+		if keyScope != nil {
+			scopedMgr, _, err := w.Manager.AddrAccount(addrmgrNs,
+				addrs[0])
+			if err != nil || scopedMgr.Scope() != *keyScope {
+				continue
+			}
+		}
+
+		// Watch-only outputs can not be signed for, so they are never
+		// selected automatically.
+		if ma.WatchOnly() {
 			continue
 		}
 		eligible = append(eligible, *output)
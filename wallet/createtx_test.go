@@ -6,6 +6,7 @@ package wallet
 
 import (
 	"bytes"
+	"errors"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -15,8 +16,10 @@ import (
 	"github.com/gcash/bchd/chaincfg/chainhash"
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
 	"github.com/gcash/bchutil/hdkeychain"
 	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/wallet/txauthor"
 	"github.com/gcash/bchwallet/walletdb"
 	_ "github.com/gcash/bchwallet/walletdb/bdb"
 	"github.com/gcash/bchwallet/wtxmgr"
@@ -119,7 +122,7 @@ func TestTxToOutputsDryRun(t *testing.T) {
 
 	// First do a few dry-runs, making sure the number of addresses in the
 	// database us not inflated.
-	dryRunTx, err := w.txToOutputs(txOuts, 0, 1, 1000, true)
+	dryRunTx, err := w.txToOutputs(txOuts, nil, 0, 1, 1000, true)
 	if err != nil {
 		t.Fatalf("unable to author tx: %v", err)
 	}
@@ -134,7 +137,7 @@ func TestTxToOutputsDryRun(t *testing.T) {
 		t.Fatalf("expected 20 addresses, found %v", len(addresses))
 	}
 
-	dryRunTx2, err := w.txToOutputs(txOuts, 0, 1, 1000, true)
+	dryRunTx2, err := w.txToOutputs(txOuts, nil, 0, 1, 1000, true)
 	if err != nil {
 		t.Fatalf("unable to author tx: %v", err)
 	}
@@ -167,7 +170,7 @@ func TestTxToOutputsDryRun(t *testing.T) {
 
 	// Now we do a proper, non-dry run. This should add a change address
 	// to the database.
-	tx, err := w.txToOutputs(txOuts, 0, 1, 1000, false)
+	tx, err := w.txToOutputs(txOuts, nil, 0, 1, 1000, false)
 	if err != nil {
 		t.Fatalf("unable to author tx: %v", err)
 	}
@@ -198,3 +201,436 @@ func TestTxToOutputsDryRun(t *testing.T) {
 			"than wet run")
 	}
 }
+
+// TestCreateUnsignedTxSingleAddress ensures the single address coin selection
+// strategy spends every output of one address when that address covers the
+// target, and only combines addresses when none can cover it alone.
+func TestCreateUnsignedTxSingleAddress(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	var addrs []bchutil.Address
+	for i := 0; i < 3; i++ {
+		addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		addrs = append(addrs, addr)
+	}
+	addTestCredits(t, w, 100, 100,
+		[]bchutil.Address{addrs[0], addrs[0], addrs[1], addrs[2]},
+		[]int64{3e8, 3e8, 4e8, 1e8})
+
+	pkScript, err := txscript.PayToAddrScript(addrs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	payTo := func(amount int64) []*wire.TxOut {
+		return []*wire.TxOut{wire.NewTxOut(amount, pkScript,
+			wire.TokenData{})}
+	}
+	spentAddrs := func(tx *txauthor.AuthoredTx) map[string]int {
+		spent := make(map[string]int)
+		for _, script := range tx.PrevScripts {
+			_, addrs, _, err := txscript.ExtractPkScriptAddrs(script,
+				w.ChainParams())
+			if err != nil {
+				t.Fatal(err)
+			}
+			spent[addrs[0].EncodeAddress()]++
+		}
+		return spent
+	}
+
+	// Largest-first selection mixes the outputs of the first two
+	// addresses.
+	tx, err := w.CreateUnsignedTx(nil, 0, payTo(5e8), 1, 1000,
+		TxCreateOptions{})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	if spent := spentAddrs(tx); len(spent) != 2 {
+		t.Fatalf("expected largest-first selection to spend from 2 "+
+			"addresses, spent %v", spent)
+	}
+
+	// The first address covers the target on its own, so both of its
+	// outputs are spent and nothing else.
+	tx, err = w.CreateUnsignedTx(nil, 0, payTo(5e8), 1, 1000,
+		TxCreateOptions{Strategy: CoinSelectionSingleAddress})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	spent := spentAddrs(tx)
+	if len(spent) != 1 || spent[addrs[0].EncodeAddress()] != 2 {
+		t.Fatalf("expected both outputs of %v to be spent, spent %v",
+			addrs[0], spent)
+	}
+
+	// Of the addresses able to cover the target, the one with the
+	// smallest total is chosen.
+	tx, err = w.CreateUnsignedTx(nil, 0, payTo(35e7), 1, 1000,
+		TxCreateOptions{Strategy: CoinSelectionSingleAddress})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	spent = spentAddrs(tx)
+	if len(spent) != 1 || spent[addrs[1].EncodeAddress()] != 1 {
+		t.Fatalf("expected the output of %v to be spent, spent %v",
+			addrs[1], spent)
+	}
+
+	// No single address covers the target, so addresses are combined.
+	tx, err = w.CreateUnsignedTx(nil, 0, payTo(8e8), 1, 1000,
+		TxCreateOptions{Strategy: CoinSelectionSingleAddress})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	if spent := spentAddrs(tx); len(spent) < 2 {
+		t.Fatalf("expected outputs from multiple addresses to be "+
+			"spent, spent %v", spent)
+	}
+}
+
+// TestCreateUnsignedTxChangeAddress ensures change is paid to a requested
+// wallet address and that addresses not controlled by the wallet are rejected.
+func TestCreateUnsignedTxChangeAddress(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	changeAddr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	addTestCredits(t, w, 100, 100, []bchutil.Address{addr}, []int64{1e8})
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := []*wire.TxOut{wire.NewTxOut(5e7, pkScript, wire.TokenData{})}
+
+	tx, err := w.CreateUnsignedTx(nil, 0, outputs, 1, 1000,
+		TxCreateOptions{ChangeAddr: changeAddr})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	if tx.ChangeIndex < 0 {
+		t.Fatal("expected transaction to have change")
+	}
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tx.Tx.TxOut[tx.ChangeIndex].PkScript, changeScript) {
+		t.Fatalf("change not paid to %v", changeAddr)
+	}
+
+	foreignAddr, err := bchutil.NewAddressPubKeyHash(make([]byte, 20),
+		w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.CreateUnsignedTx(nil, 0, outputs, 1, 1000,
+		TxCreateOptions{ChangeAddr: foreignAddr})
+	if err != ErrChangeAddressNotOwned {
+		t.Fatalf("expected ErrChangeAddressNotOwned, got %v", err)
+	}
+}
+
+// TestCreateUnsignedTxKeyScope ensures spending from an account under a key
+// scope other than BIP0044 only spends outputs of that scope and derives
+// change under the same scope.
+func TestCreateUnsignedTxKeyScope(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScope{Purpose: 1017, Coin: 1}
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		_, err := w.Manager.NewScopedKeyManager(addrmgrNs, scope,
+			waddrmgr.ScopeAddrSchema{
+				InternalAddrType: waddrmgr.PubKeyHash,
+				ExternalAddrType: waddrmgr.PubKeyHash,
+			})
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to create scoped key manager: %v", err)
+	}
+
+	scopeAddr, err := w.NewAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	bip44Addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	addTestCredits(t, w, 100, 100, []bchutil.Address{scopeAddr, bip44Addr},
+		[]int64{1e8, 2e8})
+
+	scopeOf := func(addr bchutil.Address) waddrmgr.KeyScope {
+		t.Helper()
+		var addrScope waddrmgr.KeyScope
+		err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+			addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+			manager, _, err := w.Manager.AddrAccount(addrmgrNs, addr)
+			if err != nil {
+				return err
+			}
+			addrScope = manager.Scope()
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unable to look up address %v: %v", addr, err)
+		}
+		return addrScope
+	}
+
+	changeAddr, err := w.NewChangeAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to create change address: %v", err)
+	}
+	if got := scopeOf(changeAddr); got != scope {
+		t.Fatalf("change address derived under scope %v, want %v",
+			&got, &scope)
+	}
+
+	pkScript, err := txscript.PayToAddrScript(bip44Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputs := []*wire.TxOut{wire.NewTxOut(5e7, pkScript, wire.TokenData{})}
+	tx, err := w.CreateUnsignedTx(&scope, 0, outputs, 1, 1000,
+		TxCreateOptions{})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	scopeScript, err := txscript.PayToAddrScript(scopeAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.PrevScripts) != 1 ||
+		!bytes.Equal(tx.PrevScripts[0], scopeScript) {
+
+		t.Fatalf("expected only the output of %v to be spent", scopeAddr)
+	}
+	if tx.ChangeIndex < 0 {
+		t.Fatal("expected transaction to have change")
+	}
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		tx.Tx.TxOut[tx.ChangeIndex].PkScript, w.ChainParams())
+	if err != nil || len(addrs) != 1 {
+		t.Fatalf("unable to extract change address: %v", err)
+	}
+	if got := scopeOf(addrs[0]); got != scope {
+		t.Fatalf("change derived under scope %v, want %v", &got, &scope)
+	}
+}
+
+// TestCreateUnsignedTxImmatureCoinbase ensures immature coinbase outputs are
+// only spent with an acknowledgment of the risk, that the transaction is locked
+// until the latest maturity height of its coinbase inputs, and that a caller
+// provided lock time limits the coinbase outputs which may be selected.
+func TestCreateUnsignedTxImmatureCoinbase(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The mock chain client is at height 500000.  Insert coinbase
+	// transactions maturing at heights 500049 and 500089 and a mature
+	// regular credit.
+	coinbases := make(map[wire.OutPoint]int32)
+	for height, amount := range map[int32]int64{499950: 3e8, 499990: 5e8} {
+		tx := &wire.MsgTx{TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+			SignatureScript:  []byte{byte(height), 0x00},
+		}}}
+		tx.TxOut = append(tx.TxOut, wire.NewTxOut(amount, pkScript,
+			wire.TokenData{}))
+		var b bytes.Buffer
+		if err := tx.Serialize(&b); err != nil {
+			t.Fatal(err)
+		}
+		rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   chainhash.Hash{byte(height), byte(height >> 8)},
+				Height: height,
+			},
+			Time: time.Unix(1387737310, 0),
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, block, 0, false)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		coinbases[wire.OutPoint{Hash: rec.Hash}] = height + 99
+	}
+	addTestCredits(t, w, 100, 500000, []bchutil.Address{addr},
+		[]int64{1e8})
+
+	outputs := []*wire.TxOut{wire.NewTxOut(2e8, pkScript, wire.TokenData{})}
+	create := func(immature *ImmatureCoinbaseSpend) (*txauthor.AuthoredTx,
+		error) {
+
+		return w.CreateUnsignedTx(nil, 0, outputs, 1, 1000,
+			TxCreateOptions{Immature: immature})
+	}
+	checkLockTime := func(tx *txauthor.AuthoredTx, lockTime uint32) {
+		t.Helper()
+		if tx.Tx.LockTime != lockTime {
+			t.Fatalf("lock time %d, want %d", tx.Tx.LockTime, lockTime)
+		}
+		for _, in := range tx.Tx.TxIn {
+			if in.Sequence == wire.MaxTxInSequenceNum {
+				t.Fatal("input sequence does not enable the " +
+					"lock time")
+			}
+			maturity, ok := coinbases[in.PreviousOutPoint]
+			if ok && int64(maturity) > int64(lockTime) {
+				t.Fatalf("spent coinbase output maturing at %d "+
+					"with lock time %d", maturity, lockTime)
+			}
+		}
+	}
+
+	// Immature coinbase outputs are not spent by default, nor without
+	// acknowledging the risk.
+	if _, err := create(nil); err == nil {
+		t.Fatal("spent immature coinbase outputs by default")
+	}
+	_, err = create(&ImmatureCoinbaseSpend{})
+	if err != ErrImmatureSpendNotAcknowledged {
+		t.Fatalf("got error %v, want ErrImmatureSpendNotAcknowledged", err)
+	}
+
+	// Without a lock time, the largest output is selected and the lock
+	// time is its maturity height.
+	tx, err := create(&ImmatureCoinbaseSpend{AcknowledgeRisk: true})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	checkLockTime(tx, 500089)
+
+	// An earlier lock time only allows the coinbase output maturing by
+	// then to be spent.
+	tx, err = create(&ImmatureCoinbaseSpend{
+		LockTime:        500050,
+		AcknowledgeRisk: true,
+	})
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	checkLockTime(tx, 500050)
+	if len(tx.Tx.TxIn) != 1 || tx.PrevInputValues[0] != 3e8 {
+		t.Fatalf("expected only the coinbase output maturing at 500049 "+
+			"to be spent, spent %v", tx.PrevInputValues)
+	}
+
+	// Lock times which are not block heights are refused.
+	_, err = create(&ImmatureCoinbaseSpend{
+		LockTime:        txscript.LockTimeThreshold,
+		AcknowledgeRisk: true,
+	})
+	if !errors.Is(err, ErrImmatureSpendLockTime) {
+		t.Fatalf("got error %v, want ErrImmatureSpendLockTime", err)
+	}
+
+	// A lock time preceding the maturity of a selected input is refused.
+	msgTx := tx.Tx.Copy()
+	err = setImmatureSpendLockTime(msgTx, coinbases, 500048)
+	if !errors.Is(err, ErrImmatureSpendLockTime) {
+		t.Fatalf("got error %v, want ErrImmatureSpendLockTime", err)
+	}
+}
+
+// TestCreateUnsignedTxWithInputs ensures exactly the selected outputs are
+// spent and that outputs which are not eligible to be spent are rejected.
+func TestCreateUnsignedTxWithInputs(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 100,
+		[]bchutil.Address{addr, addr, addr}, []int64{3e8, 4e8, 5e8})
+
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payTo := func(amount int64) []*wire.TxOut {
+		return []*wire.TxOut{wire.NewTxOut(amount, pkScript,
+			wire.TokenData{})}
+	}
+	outPoint := func(index uint32) wire.OutPoint {
+		return wire.OutPoint{Hash: rec.Hash, Index: index}
+	}
+
+	// Largest-first selection would spend only the third output, so the
+	// first two being spent shows the selection is honored.
+	inputs := []wire.OutPoint{outPoint(0), outPoint(1)}
+	tx, err := w.CreateUnsignedTxWithInputs(0, payTo(1e8), inputs, 1000)
+	if err != nil {
+		t.Fatalf("unable to create tx: %v", err)
+	}
+	if len(tx.Tx.TxIn) != len(inputs) {
+		t.Fatalf("expected %d inputs, got %d", len(inputs),
+			len(tx.Tx.TxIn))
+	}
+	for i, in := range tx.Tx.TxIn {
+		if in.PreviousOutPoint != inputs[i] {
+			t.Fatalf("input %d spends %v, expected %v", i,
+				in.PreviousOutPoint, inputs[i])
+		}
+	}
+	if tx.ChangeIndex < 0 {
+		t.Fatal("expected the excess input value to be paid as change")
+	}
+
+	// Outpoints that are unknown to the wallet or selected twice are
+	// rejected, as is an empty selection.
+	for _, inputs := range [][]wire.OutPoint{
+		{outPoint(3)},
+		{outPoint(0), outPoint(0)},
+		nil,
+	} {
+		_, err := w.CreateUnsignedTxWithInputs(0, payTo(1e8), inputs,
+			1000)
+		if !errors.Is(err, ErrInputNotEligible) {
+			t.Fatalf("expected ErrInputNotEligible for inputs %v, "+
+				"got %v", inputs, err)
+		}
+	}
+
+	// The selected inputs must cover the outputs.
+	_, err = w.CreateUnsignedTxWithInputs(0, payTo(4e8),
+		[]wire.OutPoint{outPoint(0)}, 1000)
+	if err == nil {
+		t.Fatal("expected insufficient inputs to be rejected")
+	}
+}
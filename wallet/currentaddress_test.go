@@ -0,0 +1,143 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// TestCurrentAddressSticky ensures the current receiving address of an account
+// does not change as new addresses are derived, and only advances once it has
+// been used.
+func TestCurrentAddressSticky(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	current := func() bchutil.Address {
+		t.Helper()
+		addr, err := w.CurrentAddress(0, scope)
+		if err != nil {
+			t.Fatalf("unable to get current address: %v", err)
+		}
+		return addr
+	}
+	markUsed := func(addr bchutil.Address) {
+		t.Helper()
+		err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			return w.Manager.MarkUsed(ns, addr)
+		})
+		if err != nil {
+			t.Fatalf("unable to mark address used: %v", err)
+		}
+	}
+
+	first := current()
+	for i := 0; i < 5; i++ {
+		if _, err := w.NewAddress(0, scope); err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		if addr := current(); addr.String() != first.String() {
+			t.Fatalf("current address changed from %v to %v after "+
+				"deriving a new address", first, addr)
+		}
+	}
+
+	// Once paid, the current address advances to the next unused address
+	// and then remains there.
+	markUsed(first)
+	second := current()
+	if second.String() == first.String() {
+		t.Fatalf("current address did not advance after being used")
+	}
+	if addr := current(); addr.String() != second.String() {
+		t.Fatalf("current address changed from %v to %v", second, addr)
+	}
+
+	// Using every derived address causes a new one to be derived.
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		t.Fatalf("unable to fetch scoped manager: %v", err)
+	}
+	var addrs []bchutil.Address
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		return manager.ForEachAccountAddress(ns, 0,
+			func(maddr waddrmgr.ManagedAddress) error {
+				if !maddr.Internal() {
+					addrs = append(addrs, maddr.Address())
+				}
+				return nil
+			})
+	})
+	if err != nil {
+		t.Fatalf("unable to list addresses: %v", err)
+	}
+	for _, addr := range addrs {
+		markUsed(addr)
+	}
+	last := current()
+	for _, addr := range addrs {
+		if last.String() == addr.String() {
+			t.Fatalf("current address %v has already been used", last)
+		}
+	}
+}
+
+// TestCurrentAddressGapConfirmations ensures a new current address is not
+// derived while the addresses used without confirmed deposits fill the gap
+// limit.
+func TestCurrentAddressGapConfirmations(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	w.Manager.SetGapLimit(waddrmgr.NumInitialAddrs)
+	w.gapConfirmations = 1
+
+	// Every address derived on creation is used by deposits which have
+	// not confirmed.
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		t.Fatalf("unable to fetch scoped manager: %v", err)
+	}
+	var addrs []bchutil.Address
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		err := manager.ForEachAccountAddress(ns, 0,
+			func(maddr waddrmgr.ManagedAddress) error {
+				if !maddr.Internal() {
+					addrs = append(addrs, maddr.Address())
+				}
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		for _, addr := range addrs {
+			if err := w.Manager.MarkUsed(ns, addr); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to mark addresses used: %v", err)
+	}
+	last := addrs[len(addrs)-1]
+
+	_, err = w.CurrentAddress(0, scope)
+	if !waddrmgr.IsError(err, waddrmgr.ErrGapLimitExceeded) {
+		t.Fatalf("expected ErrGapLimitExceeded, got %v", err)
+	}
+
+	// A confirmed deposit to the last address closes the gap.
+	addTestCredits(t, w, 100, 100, []bchutil.Address{last}, []int64{1e8})
+	if _, err := w.CurrentAddress(0, scope); err != nil {
+		t.Fatalf("unable to get current address after a confirmed "+
+			"deposit: %v", err)
+	}
+}
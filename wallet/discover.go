@@ -0,0 +1,361 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/chain"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// ErrDiscoveryInterrupted describes an error where account discovery was
+// stopped by the wallet shutting down before every account was probed.
+var ErrDiscoveryInterrupted = errors.New("account discovery interrupted")
+
+// ErrNoBirthdayBlock describes an error where account discovery was requested
+// for a wallet which does not know its birthday block without requesting a
+// search of the entire blockchain.
+var ErrNoBirthdayBlock = errors.New("wallet birthday block is not set")
+
+// DiscoverAccounts searches the blockchain from the wallet's birthday block
+// for transactions paying to accounts of the BIP0044 scope following the last
+// account of the wallet, as is done when restoring a wallet from its seed.
+// Accounts are probed for usage of the addresses within the recovery windows
+// of their external and internal branches until gapLimit consecutive accounts
+// are found unused.  Every account up to the last used one is created, the
+// addresses and transactions of the used accounts are recovered, and the
+// number of accounts created is returned.
+//
+// Every account which may be used is probed at once, in a single pass over the
+// blockchain, so a pass is only repeated for the accounts newly within the gap
+// limit after a used account is found.  Blocks are fetched in batches of
+// recoveryBatchSize blocks.
+//
+// ErrNoBirthdayBlock is returned if the wallet does not know its birthday
+// block, unless scanFromGenesis is set to search from the genesis block
+// instead.  Since account keys are derived from the private cointype key, the
+// wallet must be unlocked.
+func (w *Wallet) DiscoverAccounts(ctx context.Context, gapLimit uint32,
+	scanFromGenesis bool) (int, error) {
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return 0, err
+	}
+	manager, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		startHeight int32
+		lastAccount uint32
+	)
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		birthdayBlock, _, err := w.Manager.BirthdayBlock(ns)
+		switch {
+		case waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet):
+			if !scanFromGenesis {
+				return ErrNoBirthdayBlock
+			}
+		case err != nil:
+			return err
+		default:
+			startHeight = birthdayBlock.Height
+		}
+		lastAccount, err = manager.LastAccount(ns)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	_, bestHeight, err := chainClient.GetBestBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	// Probe the accounts following those already probed up to gapLimit
+	// accounts past the last used one, until a pass finds no further
+	// used accounts.
+	var used []uint32
+	lastUsed, lastProbed := lastAccount, lastAccount
+	for lastProbed < lastUsed+gapLimit {
+		found, err := w.probeAccounts(ctx, chainClient, manager,
+			lastProbed+1, lastUsed+gapLimit, startHeight, bestHeight)
+		if err != nil {
+			return 0, err
+		}
+		lastProbed = lastUsed + gapLimit
+		for _, account := range found {
+			used = append(used, account)
+			if account > lastUsed {
+				lastUsed = account
+			}
+		}
+	}
+	if len(used) == 0 {
+		return 0, nil
+	}
+	sort.Slice(used, func(i, j int) bool { return used[i] < used[j] })
+
+	// Accounts are numbered contiguously, so the unused accounts preceding
+	// the last used one are created as well.
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for account := lastAccount + 1; account <= lastUsed; account++ {
+			err := manager.NewRawAccount(ns, account)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	discovered := int(lastUsed - lastAccount)
+
+	for _, account := range used {
+		log.Infof("Discovered used account %d, recovering its addresses",
+			account)
+
+		err := w.recoverAccount(ctx, chainClient, manager, account,
+			startHeight, bestHeight)
+		if err != nil {
+			return discovered, err
+		}
+	}
+
+	return discovered, nil
+}
+
+// forEachBlockBatch calls f with successive batches of at most
+// recoveryBatchSize blocks from startHeight to bestHeight, fetched from the
+// chain client into the block batch of the recovery manager.  Iteration stops
+// early if f returns true or an error.
+func forEachBlockBatch(ctx context.Context, chainClient chain.Interface,
+	recoveryMgr *RecoveryManager, startHeight, bestHeight int32,
+	f func([]wtxmgr.BlockMeta) (bool, error)) error {
+
+	defer recoveryMgr.ResetBlockBatch()
+	for height := startHeight; height <= bestHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		hash, err := chainClient.GetBlockHash(int64(height))
+		if err != nil {
+			return err
+		}
+		header, err := chainClient.GetBlockHeader(hash)
+		if err != nil {
+			return err
+		}
+		recoveryMgr.AddToBlockBatch(hash, height, header.Timestamp)
+
+		batch := recoveryMgr.BlockBatch()
+		if len(batch) < recoveryBatchSize && height != bestHeight {
+			continue
+		}
+		brk, err := f(batch)
+		if err != nil || brk {
+			return err
+		}
+		recoveryMgr.ResetBlockBatch()
+	}
+	return nil
+}
+
+// probeAccounts returns which of the accounts from first to last, which need
+// not exist, have any of the addresses within the recovery windows of their
+// branches used in the blocks from startHeight to bestHeight.  The addresses
+// of every account are filtered for together, and those of an account are no
+// longer filtered for once it is found used.
+func (w *Wallet) probeAccounts(ctx context.Context, chainClient chain.Interface,
+	manager *waddrmgr.ScopedKeyManager, first, last uint32, startHeight,
+	bestHeight int32) ([]uint32, error) {
+
+	// The filter request only identifies found addresses by index, so
+	// each address is given a unique index mapping to its account.
+	var (
+		owners   []uint32
+		external = make(map[waddrmgr.ScopedIndex]bchutil.Address)
+		internal = make(map[waddrmgr.ScopedIndex]bchutil.Address)
+	)
+	addAddrs := func(addrs map[waddrmgr.ScopedIndex]bchutil.Address,
+		account uint32, branchAddrs map[uint32]bchutil.Address) {
+
+		for _, addr := range branchAddrs {
+			scopedIndex := waddrmgr.ScopedIndex{
+				Scope: manager.Scope(),
+				Index: uint32(len(owners)),
+			}
+			addrs[scopedIndex] = addr
+			owners = append(owners, account)
+		}
+	}
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		for account := first; account <= last; account++ {
+			addrs, err := manager.DeriveAccountAddresses(
+				ns, account, waddrmgr.ExternalBranch,
+				w.recoveryWindow,
+			)
+			if err != nil {
+				return err
+			}
+			addAddrs(external, account, addrs)
+
+			addrs, err = manager.DeriveAccountAddresses(
+				ns, account, waddrmgr.InternalBranch,
+				w.internalRecoveryWindow,
+			)
+			if err != nil {
+				return err
+			}
+			addAddrs(internal, account, addrs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var used []uint32
+	usedAccounts := make(map[uint32]struct{})
+	markUsed := func(found map[uint32]struct{}) {
+		for index := range found {
+			account := owners[index]
+			if _, ok := usedAccounts[account]; ok {
+				continue
+			}
+			usedAccounts[account] = struct{}{}
+			used = append(used, account)
+		}
+	}
+	unwatchUsed := func(addrs map[waddrmgr.ScopedIndex]bchutil.Address) {
+		for scopedIndex := range addrs {
+			if _, ok := usedAccounts[owners[scopedIndex.Index]]; ok {
+				delete(addrs, scopedIndex)
+			}
+		}
+	}
+
+	recoveryMgr := NewRecoveryManager(
+		w.recoveryWindow, w.internalRecoveryWindow, recoveryBatchSize,
+		w.chainParams,
+	)
+	err = forEachBlockBatch(ctx, chainClient, recoveryMgr, startHeight,
+		bestHeight, func(batch []wtxmgr.BlockMeta) (bool, error) {
+			for len(batch) > 0 {
+				if len(external) == 0 && len(internal) == 0 {
+					return true, nil
+				}
+
+				filterReq := &chain.FilterBlocksRequest{
+					Blocks:           batch,
+					ExternalAddrs:    external,
+					InternalAddrs:    internal,
+					WatchedOutPoints: make(map[wire.OutPoint]bchutil.Address),
+					Interrupt:        make(chan struct{}),
+					Workers:          w.filterWorkers,
+				}
+				w.addRecoveryInterruptChan(filterReq.Interrupt)
+				filterResp, err := chainClient.FilterBlocks(filterReq)
+				w.removeRecoveryInterruptChan(filterReq.Interrupt)
+				switch {
+				case err == chain.ErrFilterReqInterrupt:
+					return false, ErrDiscoveryInterrupted
+				case err != nil:
+					return false, err
+				case filterResp == nil:
+					return false, nil
+				}
+
+				markUsed(filterResp.FoundExternalAddrs[manager.Scope()])
+				markUsed(filterResp.FoundInternalAddrs[manager.Scope()])
+				unwatchUsed(external)
+				unwatchUsed(internal)
+				batch = batch[filterResp.BatchIndex+1:]
+			}
+			return false, nil
+		})
+	return used, err
+}
+
+// recoverAccount recovers the addresses and transactions of an existing
+// account used in the blocks from startHeight to bestHeight, in batches of
+// recoveryBatchSize blocks, and begins watching for transactions paying to its
+// addresses.
+func (w *Wallet) recoverAccount(ctx context.Context, chainClient chain.Interface,
+	manager *waddrmgr.ScopedKeyManager, account uint32, startHeight,
+	bestHeight int32) error {
+
+	recoveryMgr := NewRecoveryManager(
+		w.recoveryWindow, w.internalRecoveryWindow, recoveryBatchSize,
+		w.chainParams,
+	)
+	recoveryState := recoveryMgr.State()
+	recoveryState.StateForScope(manager.Scope()).Account = account
+	scopedMgrs := map[waddrmgr.KeyScope]*waddrmgr.ScopedKeyManager{
+		manager.Scope(): manager,
+	}
+
+	err := forEachBlockBatch(ctx, chainClient, recoveryMgr, startHeight,
+		bestHeight, func(batch []wtxmgr.BlockMeta) (bool, error) {
+			err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+				ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+				syncedTo, err := w.recoverScopedAddresses(
+					chainClient, tx, ns, batch, recoveryState,
+					scopedMgrs,
+				)
+				if err != nil {
+					return err
+				}
+				if syncedTo != batch[len(batch)-1].Height {
+					return ErrDiscoveryInterrupted
+				}
+				return nil
+			})
+			return false, err
+		})
+	if err != nil {
+		return err
+	}
+
+	// Watch the recovered addresses for new transactions.
+	var (
+		addrs []bchutil.Address
+		props *waddrmgr.AccountProperties
+	)
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		err := manager.ForEachAccountAddress(ns, account,
+			func(maddr waddrmgr.ManagedAddress) error {
+				addrs = append(addrs, maddr.Address())
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+		props, err = manager.AccountProperties(ns, account)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := chainClient.NotifyReceived(addrs); err != nil {
+		return err
+	}
+
+	w.NtfnServer.notifyAccountProperties(props)
+
+	return nil
+}
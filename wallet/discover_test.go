@@ -0,0 +1,159 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// setDiscoveryBirthdayBlock sets the birthday block of the wallet to the
+// genesis block of the mock chain.
+func setDiscoveryBirthdayBlock(t *testing.T, w *Wallet, c *mockChainConnClient) {
+	t.Helper()
+	hash, err := c.GetBlockHash(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.SetBirthdayBlock(ns, waddrmgr.BlockStamp{
+			Hash:   *hash,
+			Height: 0,
+		}, true)
+	})
+	if err != nil {
+		t.Fatalf("unable to set birthday block: %v", err)
+	}
+}
+
+// TestDiscoverAccounts ensures that accounts paid after the last account of
+// the wallet are created and their transactions recovered, up to the last used
+// account followed by the gap limit of unused accounts.
+func TestDiscoverAccounts(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	const window = 5
+	w.recoveryWindow = window
+	w.internalRecoveryWindow = window
+
+	scope := waddrmgr.KeyScopeBIP0044
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Account 2 is unused, and account 6 is past the gap of two unused
+	// accounts following account 3.
+	c := &mockChainConnClient{
+		conn: createMockChainConn(
+			chaincfg.TestNet3Params.GenesisBlock, 100,
+			defaultBlockInterval,
+		),
+		payments: map[int32][]*wire.MsgTx{
+			10: {testPaymentTx(t, w, 1, waddrmgr.ExternalBranch, 3, 1e8)},
+			// The second payment is past the recovery window of
+			// the branch, and found once the first is recovered.
+			20: {testPaymentTx(t, w, 3, waddrmgr.InternalBranch, 2, 2e8)},
+			30: {testPaymentTx(t, w, 3, waddrmgr.InternalBranch, 6, 3e8)},
+			40: {testPaymentTx(t, w, 6, waddrmgr.ExternalBranch, 0, 4e8)},
+		},
+	}
+	w.chainClient = c
+	setDiscoveryBirthdayBlock(t, w, c)
+
+	discovered, err := w.DiscoverAccounts(context.Background(), 2, false)
+	if err != nil {
+		t.Fatalf("unable to discover accounts: %v", err)
+	}
+	if discovered != 3 {
+		t.Fatalf("discovered %d accounts, want 3", discovered)
+	}
+
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		ns := tx.ReadBucket(waddrmgrNamespaceKey)
+		last, err := manager.LastAccount(ns)
+		if err != nil {
+			return err
+		}
+		if last != 3 {
+			t.Fatalf("got last account %d, want 3", last)
+		}
+		props, err := manager.AccountProperties(ns, 3)
+		if err != nil {
+			return err
+		}
+		if props.InternalKeyCount <= 6 {
+			t.Fatalf("got %d internal keys for account 3, want "+
+				"more than 6", props.InternalKeyCount)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for account, want := range map[uint32]bchutil.Amount{1: 1e8, 2: 0, 3: 5e8} {
+		bals, err := w.CalculateAccountBalances(account, 0)
+		if err != nil {
+			t.Fatalf("unable to calculate balance: %v", err)
+		}
+		if bals.Total != want {
+			t.Fatalf("account %d has balance %v, want %v", account,
+				bals.Total, want)
+		}
+	}
+
+	// Discovering again finds no further accounts.
+	discovered, err = w.DiscoverAccounts(context.Background(), 2, false)
+	if err != nil {
+		t.Fatalf("unable to discover accounts: %v", err)
+	}
+	if discovered != 0 {
+		t.Fatalf("discovered %d accounts, want 0", discovered)
+	}
+}
+
+// TestDiscoverAccountsSinglePass ensures every account within the gap limit is
+// probed in the same pass over the blockchain, and that a wallet without a
+// birthday block is only searched from the genesis block when requested.
+func TestDiscoverAccountsSinglePass(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	w.recoveryWindow = 5
+	w.internalRecoveryWindow = 5
+
+	c := &mockChainConnClient{
+		conn: createMockChainConn(
+			chaincfg.TestNet3Params.GenesisBlock, 100,
+			defaultBlockInterval,
+		),
+	}
+	w.chainClient = c
+
+	_, err := w.DiscoverAccounts(context.Background(), 10, false)
+	if err != ErrNoBirthdayBlock {
+		t.Fatalf("got error %v, want ErrNoBirthdayBlock", err)
+	}
+	if c.filterCalls != 0 {
+		t.Fatalf("filtered blocks %d times without a birthday block",
+			c.filterCalls)
+	}
+
+	discovered, err := w.DiscoverAccounts(context.Background(), 10, true)
+	if err != nil {
+		t.Fatalf("unable to discover accounts: %v", err)
+	}
+	if discovered != 0 {
+		t.Fatalf("discovered %d accounts, want 0", discovered)
+	}
+	if c.filterCalls != 1 {
+		t.Fatalf("filtered blocks %d times, want once", c.filterCalls)
+	}
+}
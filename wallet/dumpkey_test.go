@@ -0,0 +1,67 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestDumpWIFPrivateKey ensures the private key of a wallet address is
+// exported, and that addresses without a private key in the wallet are
+// rejected with distinguishable errors.
+func TestDumpWIFPrivateKey(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	wifStr, err := w.DumpWIFPrivateKey(addr)
+	if err != nil {
+		t.Fatalf("unable to dump private key: %v", err)
+	}
+	wif, err := bchutil.DecodeWIF(wifStr)
+	if err != nil {
+		t.Fatalf("invalid WIF %q: %v", wifStr, err)
+	}
+	keyAddr, err := bchutil.NewAddressPubKeyHash(
+		bchutil.Hash160(wif.SerializePubKey()), w.ChainParams(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keyAddr.String() != addr.String() {
+		t.Fatalf("dumped key is for address %v, want %v", keyAddr, addr)
+	}
+
+	// Addresses unknown to the wallet are not found.
+	unknown, err := bchutil.NewAddressPubKeyHash(make([]byte, 20),
+		w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = w.DumpWIFPrivateKey(unknown)
+	if !waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+		t.Fatalf("got error %v for unknown address, want %v", err,
+			waddrmgr.ErrAddressNotFound)
+	}
+
+	// Script addresses have no single private key.
+	script, err := txscript.NewScriptBuilder().AddOp(txscript.OP_TRUE).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	scriptAddr, err := w.ImportP2SHRedeemScript(script)
+	if err != nil {
+		t.Fatalf("unable to import script: %v", err)
+	}
+	_, err = w.DumpWIFPrivateKey(scriptAddr)
+	if !errors.Is(err, ErrNotPubKeyAddress) {
+		t.Fatalf("got error %v for script address, want %v", err,
+			ErrNotPubKeyAddress)
+	}
+}
@@ -0,0 +1,47 @@
+package wallet
+
+import (
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/wallet/txrules"
+)
+
+// feeEstimator is implemented by chain clients able to estimate the fee rate
+// needed for a transaction to be mined.
+type feeEstimator interface {
+	EstimateFee(numBlocks int64) (float64, error)
+}
+
+// EstimateFeeRate returns the fee rate per kilobyte estimated by the chain
+// server for a transaction to be mined within confTarget blocks.  When the
+// chain server has no estimate, such as when it has not yet observed enough
+// blocks or does not support fee estimation, txrules.DefaultRelayFeePerKb is
+// returned instead.  The default relay fee is also the minimum rate returned,
+// as transactions paying less would not be relayed.
+func (w *Wallet) EstimateFeeRate(confTarget uint32) (bchutil.Amount, error) {
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return 0, err
+	}
+	client, ok := chainClient.(feeEstimator)
+	if !ok {
+		log.Debugf("Chain backend %s does not support fee estimation, "+
+			"using the default relay fee", chainClient.BackEnd())
+		return txrules.DefaultRelayFeePerKb, nil
+	}
+
+	bchPerKb, err := client.EstimateFee(int64(confTarget))
+	if _, ok := err.(*btcjson.RPCError); ok {
+		log.Debugf("No fee estimate for %d blocks, using the default "+
+			"relay fee: %v", confTarget, err)
+		return txrules.DefaultRelayFeePerKb, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	feeRate, err := bchutil.NewAmount(bchPerKb)
+	if err != nil || feeRate < txrules.DefaultRelayFeePerKb {
+		return txrules.DefaultRelayFeePerKb, nil
+	}
+	return feeRate, nil
+}
@@ -0,0 +1,82 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/rpcclient"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/wallet/txrules"
+)
+
+// feeEstimatorChainClient is a mock chain client returning a fixed fee
+// estimate.
+type feeEstimatorChainClient struct {
+	mockChainClient
+	bchPerKb float64
+	err      error
+}
+
+func (c *feeEstimatorChainClient) EstimateFee(int64) (float64, error) {
+	return c.bchPerKb, c.err
+}
+
+// TestEstimateFeeRate ensures the fee rate estimated by the chain server is
+// returned, and that the default relay fee is used when there is no estimate.
+func TestEstimateFeeRate(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	noEstimate := &btcjson.RPCError{
+		Code:    btcjson.ErrRPCMisc,
+		Message: "not enough blocks have been observed",
+	}
+	tests := []struct {
+		name    string
+		client  *feeEstimatorChainClient
+		want    bchutil.Amount
+		wantErr bool
+	}{
+		{
+			name:   "estimate",
+			client: &feeEstimatorChainClient{bchPerKb: 0.00002},
+			want:   2000,
+		},
+		{
+			name:   "below relay fee",
+			client: &feeEstimatorChainClient{bchPerKb: 0.000001},
+			want:   txrules.DefaultRelayFeePerKb,
+		},
+		{
+			name:   "no estimate",
+			client: &feeEstimatorChainClient{bchPerKb: -1, err: noEstimate},
+			want:   txrules.DefaultRelayFeePerKb,
+		},
+		{
+			name: "disconnected",
+			client: &feeEstimatorChainClient{
+				err: rpcclient.ErrClientDisconnect,
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		w.chainClient = test.client
+		feeRate, err := w.EstimateFeeRate(6)
+		if (err != nil) != test.wantErr {
+			t.Fatalf("%s: unexpected error %v", test.name, err)
+		}
+		if feeRate != test.want {
+			t.Fatalf("%s: got fee rate %v, want %v", test.name,
+				feeRate, test.want)
+		}
+	}
+
+	// Chain backends without fee estimation use the default relay fee.
+	w.chainClient = &mockChainClient{}
+	feeRate, err := w.EstimateFeeRate(6)
+	if err != nil || feeRate != txrules.DefaultRelayFeePerKb {
+		t.Fatalf("got fee rate %v, error %v without fee estimation",
+			feeRate, err)
+	}
+}
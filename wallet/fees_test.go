@@ -0,0 +1,103 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestTotalFeesPaid ensures only the fees of transactions funded by the wallet
+// and mined in the requested range are summed.
+func TestTotalFeesPaid(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The incoming transaction has a foreign input, so its fee is not
+	// paid by the wallet.
+	incoming := addTestCredits(t, w, 100, 130, []bchutil.Address{addr},
+		[]int64{1e8})
+
+	// spend spends output 0 of prev at the given height, paying amount
+	// back to the wallet and the remainder as fee.
+	spend := func(prev *wtxmgr.TxRecord, height int32, amount int64) *wtxmgr.TxRecord {
+		tx := wire.NewMsgTx(1)
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: prev.Hash}, nil))
+		tx.AddTxOut(wire.NewTxOut(amount, pkScript, wire.TokenData{}))
+		var buf bytes.Buffer
+		if err := tx.Serialize(&buf); err != nil {
+			t.Fatal(err)
+		}
+		rec, err := wtxmgr.NewTxRecord(buf.Bytes(), time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   chainhash.Hash{byte(height)},
+				Height: height,
+			},
+			Time: time.Unix(1387737310, 0),
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, block, 0, false)
+		})
+		if err != nil {
+			t.Fatalf("unable to insert spend: %v", err)
+		}
+		return rec
+	}
+	first := spend(incoming, 105, 1e8-10000)
+	spend(first, 120, 1e8-15000)
+
+	tests := []struct {
+		name       string
+		start, end *BlockIdentifier
+		fees       bchutil.Amount
+	}{
+		{"all time", nil, nil, 15000},
+		{"first spend", NewBlockIdentifierFromHeight(100),
+			NewBlockIdentifierFromHeight(110), 10000},
+		{"second spend", NewBlockIdentifierFromHeight(110), nil, 5000},
+		{"single block", NewBlockIdentifierFromHeight(120),
+			NewBlockIdentifierFromHeight(120), 5000},
+		{"incoming only", nil, NewBlockIdentifierFromHeight(104), 0},
+	}
+	for _, test := range tests {
+		fees, err := w.TotalFeesPaid(test.start, test.end)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if fees != test.fees {
+			t.Fatalf("%s: got fees %v, want %v", test.name, fees,
+				test.fees)
+		}
+	}
+
+	_, err = w.TotalFeesPaid(NewBlockIdentifierFromHeight(120),
+		NewBlockIdentifierFromHeight(110))
+	if err != ErrInvalidBlockRange {
+		t.Fatalf("got error %v for reversed range, want %v", err,
+			ErrInvalidBlockRange)
+	}
+}
@@ -0,0 +1,164 @@
+package wallet
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// checkConfirmedGap returns an ErrGapLimitExceeded error if deriving the next
+// numAddresses external addresses of the account would exceed the gap limit
+// when addresses only count as used once a transaction paying them has
+// gapConfirmations confirmations.  The address manager itself counts addresses
+// as used as soon as any transaction paying them is seen, so an unconfirmed
+// deposit that is later dropped could otherwise let addresses be handed out
+// past the gap a seed restore is able to find.
+func (w *Wallet) checkConfirmedGap(dbtx walletdb.ReadTx,
+	scope waddrmgr.KeyScope, account, numAddresses uint32) error {
+
+	gapLimit := w.Manager.GapLimit()
+	if w.gapConfirmations <= 0 || gapLimit == 0 {
+		return nil
+	}
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return err
+	}
+
+	addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+	txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+
+	props, err := manager.AccountProperties(addrmgrNs, account)
+	if err != nil {
+		return err
+	}
+
+	// Collect the trailing addresses that could count toward the gap,
+	// newest first, and which of them the address manager considers used.
+	var tail []string
+	candidates := make(map[string]bool)
+	for index := props.ExternalKeyCount; index > 0 &&
+		uint32(len(tail)) < gapLimit; index-- {
+
+		maddr, err := manager.DeriveFromKeyPath(addrmgrNs,
+			waddrmgr.DerivationPath{
+				Account: account,
+				Branch:  waddrmgr.ExternalBranch,
+				Index:   index - 1,
+			})
+		if err != nil {
+			return err
+		}
+		addr := maddr.Address().EncodeAddress()
+		tail = append(tail, addr)
+		if maddr.Used(addrmgrNs) {
+			candidates[addr] = false
+		}
+	}
+
+	// Find which used addresses were paid by a transaction with enough
+	// confirmations.
+	stopHeight := w.Manager.SyncedTo().Height - w.gapConfirmations + 1
+	if len(candidates) > 0 && stopHeight >= 0 {
+		err := w.gapDeposits.update(w, txmgrNs, stopHeight)
+		if err != nil {
+			return err
+		}
+		for addr := range candidates {
+			candidates[addr] = w.gapDeposits.paid(addr)
+		}
+	}
+
+	var unused uint32
+	for _, addr := range tail {
+		if candidates[addr] {
+			break
+		}
+		unused++
+	}
+	if unused+numAddresses > gapLimit {
+		str := fmt.Sprintf("%d new addresses would exceed the gap limit "+
+			"of %d addresses without deposits of at least %d "+
+			"confirmations (%d unused)", numAddresses, gapLimit,
+			w.gapConfirmations, unused)
+		return waddrmgr.ManagerError{
+			ErrorCode:   waddrmgr.ErrGapLimitExceeded,
+			Description: str,
+		}
+	}
+	return nil
+}
+
+// confirmedDeposits caches the addresses paid by transactions mined at or
+// below a height, so that the confirmed gap check only ranges over the blocks
+// mined since it last ran instead of the wallet's entire history.  The cache is
+// invalidated when transactions are inserted or rolled back at or below the
+// cached height.
+type confirmedDeposits struct {
+	mu     sync.Mutex
+	height int32
+	addrs  map[string]struct{} // nil when nothing is cached
+}
+
+// update adds the addresses paid by transactions mined since the cached height
+// up to and including height, rebuilding the cache if it is invalid or
+// already extends past height.
+func (c *confirmedDeposits) update(w *Wallet, txmgrNs walletdb.ReadBucket,
+	height int32) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	start := c.height + 1
+	if c.addrs == nil || height < c.height {
+		c.addrs = make(map[string]struct{})
+		start = 0
+	}
+	rangeFn := func(details []wtxmgr.TxDetails) (bool, error) {
+		for i := range details {
+			for _, cred := range details[i].Credits {
+				pkScript := details[i].CreditPkScript(cred.Index)
+				_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+					pkScript, w.chainParams)
+				if err != nil {
+					continue
+				}
+				for _, a := range addrs {
+					c.addrs[a.EncodeAddress()] = struct{}{}
+				}
+			}
+		}
+		return false, nil
+	}
+	if start > height {
+		return nil
+	}
+	err := w.TxStore.RangeTransactions(txmgrNs, start, height, rangeFn)
+	if err != nil {
+		c.addrs = nil
+		return err
+	}
+	c.height = height
+	return nil
+}
+
+// paid returns whether the address is paid by a cached deposit.
+func (c *confirmedDeposits) paid(addr string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.addrs[addr]
+	return ok
+}
+
+// invalidate drops the cache if it includes blocks at or above height.
+func (c *confirmedDeposits) invalidate(height int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.addrs != nil && height <= c.height {
+		c.addrs = nil
+	}
+}
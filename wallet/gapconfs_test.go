@@ -0,0 +1,193 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestGapConfirmations ensures an unconfirmed deposit, which may be dropped,
+// does not close the gap counted against the gap limit when confirmations are
+// required, while a confirmed deposit does.
+func TestGapConfirmations(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	w.Manager.SetGapLimit(waddrmgr.NumInitialAddrs + 2)
+	w.gapConfirmations = 1
+
+	// Fill the gap after the addresses derived on creation.
+	var last bchutil.Address
+	for i := 0; i < 2; i++ {
+		addr, err := w.NewAddress(0, scope)
+		if err != nil {
+			t.Fatalf("unable to create address: %v", err)
+		}
+		last = addr
+	}
+	assertGapExceeded := func() {
+		t.Helper()
+		_, err := w.NewAddress(0, scope)
+		if !waddrmgr.IsError(err, waddrmgr.ErrGapLimitExceeded) {
+			t.Fatalf("expected ErrGapLimitExceeded, got %v", err)
+		}
+	}
+	assertGapExceeded()
+
+	// Receive an unconfirmed deposit to the last address, as when the
+	// transaction is relayed, and mark the address used.
+	pkScript, err := txscript.PayToAddrScript(last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &wire.MsgTx{TxIn: []*wire.TxIn{{}}}
+	tx.TxOut = append(tx.TxOut, wire.NewTxOut(1e8, pkScript,
+		wire.TokenData{}))
+	var b bytes.Buffer
+	if err := tx.Serialize(&b); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.InsertTx(txmgrNs, rec, nil); err != nil {
+			return err
+		}
+		err := w.TxStore.AddCredit(txmgrNs, rec, nil, 0, false)
+		if err != nil {
+			return err
+		}
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.MarkUsed(addrmgrNs, last)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The unconfirmed deposit does not close the gap, and still does not
+	// once it is dropped from the wallet.
+	assertGapExceeded()
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.RemoveUnminedTx(txmgrNs, rec)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGapExceeded()
+
+	// A confirmed deposit closes the gap.
+	addTestCredits(t, w, 100, 100, []bchutil.Address{last}, []int64{1e8})
+	if _, err := w.NewAddress(0, scope); err != nil {
+		t.Fatalf("unable to create address after a confirmed deposit: %v",
+			err)
+	}
+}
+
+// TestGapConfirmationsPruned ensures a confirmed deposit closes the gap after
+// its transaction is pruned from the transaction store.
+func TestGapConfirmationsPruned(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	w.Manager.SetGapLimit(waddrmgr.NumInitialAddrs + 1)
+	w.gapConfirmations = 1
+
+	addr, err := w.NewAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.MarkUsed(addrmgrNs, addr)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Receive a deposit to the address which is spent and pruned.
+	rec := addTestCredits(t, w, 100, 101, []bchutil.Address{addr},
+		[]int64{1e8})
+	spendTestCredit(t, w, rec, 101)
+	pruneTestTransactions(t, w, 101, 2)
+
+	if _, err := w.NewAddress(0, scope); err != nil {
+		t.Fatalf("unable to create address after a pruned deposit: %v",
+			err)
+	}
+}
+
+// TestGapConfirmationsRecovery ensures an address found used by recovery in a
+// block with fewer than the required confirmations does not close the gap
+// until the block is sufficiently confirmed.
+func TestGapConfirmationsRecovery(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	w.Manager.SetGapLimit(waddrmgr.NumInitialAddrs)
+	w.gapConfirmations = 3
+	w.recoveryWindow = 10
+	w.internalRecoveryWindow = 10
+
+	// The last address derived on creation is paid in the block before
+	// the chain tip.
+	c := &mockChainConnClient{
+		conn: createMockChainConn(
+			chaincfg.TestNet3Params.GenesisBlock, 40,
+			defaultBlockInterval,
+		),
+		payments: map[int32][]*wire.MsgTx{
+			29: {testPaymentTx(t, w, 0, waddrmgr.ExternalBranch,
+				waddrmgr.NumInitialAddrs-1, 1e8)},
+		},
+	}
+	c.conn.chainTip = 30
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-w.rescanNotifications:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := w.recovery(context.Background(), c, &waddrmgr.BlockStamp{})
+	if err != nil {
+		t.Fatalf("unable to recover: %v", err)
+	}
+	_, err = w.NewAddress(0, scope)
+	if !waddrmgr.IsError(err, waddrmgr.ErrGapLimitExceeded) {
+		t.Fatalf("expected ErrGapLimitExceeded, got %v", err)
+	}
+
+	// Once the chain grows and the recovered deposit has the required
+	// confirmations, it closes the gap.
+	c.conn.chainTip = 31
+	err = w.recovery(context.Background(), c, &waddrmgr.BlockStamp{})
+	if err != nil {
+		t.Fatalf("unable to resume recovery: %v", err)
+	}
+	if _, err := w.NewAddress(0, scope); err != nil {
+		t.Fatalf("unable to create address after the recovered deposit "+
+			"confirmed: %v", err)
+	}
+}
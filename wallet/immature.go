@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"sort"
+
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// ImmatureCoinbaseOutput describes an unspent coinbase output which has not
+// yet reached coinbase maturity and therefore can not be spent.
+type ImmatureCoinbaseOutput struct {
+	OutPoint wire.OutPoint
+	Amount   bchutil.Amount
+
+	// Height is the height of the block containing the coinbase
+	// transaction.
+	Height int32
+
+	// MaturityHeight is the height the main chain must reach before the
+	// output is mature and counted as spendable.
+	MaturityHeight int32
+
+	// BlocksUntilMature is the number of blocks which must still be mined
+	// on top of the wallet's synced tip for the output to mature.
+	BlocksUntilMature int32
+}
+
+// ImmatureCoinbaseOutputs returns each unspent coinbase output of the account
+// which has not reached coinbase maturity as of the block the wallet is synced
+// to, ordered by the height they mature at.  This is a breakdown of the
+// ImmatureReward balance returned by CalculateAccountBalances.
+func (w *Wallet) ImmatureCoinbaseOutputs(account uint32) (
+	[]ImmatureCoinbaseOutput, error) {
+
+	var immature []ImmatureCoinbaseOutput
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		syncHeight := w.Manager.SyncedTo().Height
+		maturity := int32(w.chainParams.CoinbaseMaturity)
+
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		for i := range unspent {
+			output := &unspent[i]
+			if !output.FromCoinBase ||
+				confirmed(maturity, output.Height, syncHeight) {
+
+				continue
+			}
+
+			ma, err := w.outputAddress(addrmgrNs, output.PkScript)
+			if err != nil || ma.Account() != account || ma.WatchOnly() {
+				continue
+			}
+
+			immature = append(immature, ImmatureCoinbaseOutput{
+				OutPoint:       output.OutPoint,
+				Amount:         output.Amount,
+				Height:         output.Height,
+				MaturityHeight: output.Height + maturity - 1,
+				BlocksUntilMature: maturity -
+					confirms(output.Height, syncHeight),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(immature, func(i, j int) bool {
+		return immature[i].MaturityHeight < immature[j].MaturityHeight
+	})
+	return immature, nil
+}
@@ -0,0 +1,131 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestImmatureCoinbaseOutputs ensures each immature coinbase output is listed
+// with the number of blocks remaining until it matures, and that outputs are
+// no longer listed once mature.
+func TestImmatureCoinbaseOutputs(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Insert coinbase transactions mined at heights 150 and 100, and a
+	// regular credit which must not be listed.
+	var hashes []chainhash.Hash
+	for _, height := range []int32{150, 100} {
+		tx := &wire.MsgTx{TxIn: []*wire.TxIn{{
+			PreviousOutPoint: wire.OutPoint{Index: wire.MaxPrevOutIndex},
+			SignatureScript:  []byte{byte(height), 0x00},
+		}}}
+		tx.TxOut = append(tx.TxOut, wire.NewTxOut(int64(height)*1e6,
+			pkScript, wire.TokenData{}))
+		var b bytes.Buffer
+		if err := tx.Serialize(&b); err != nil {
+			t.Fatal(err)
+		}
+		rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   chainhash.Hash{byte(height)},
+				Height: height,
+			},
+			Time: time.Unix(1387737310, 0),
+		}
+		err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+			ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+			if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+				return err
+			}
+			return w.TxStore.AddCredit(ns, rec, block, 0, false)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, rec.Hash)
+	}
+	addTestCredits(t, w, 140, 160, []bchutil.Address{addr}, []int64{1e8})
+
+	immature, err := w.ImmatureCoinbaseOutputs(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []ImmatureCoinbaseOutput{{
+		OutPoint:          wire.OutPoint{Hash: hashes[1]},
+		Amount:            100e6,
+		Height:            100,
+		MaturityHeight:    199,
+		BlocksUntilMature: 39,
+	}, {
+		OutPoint:          wire.OutPoint{Hash: hashes[0]},
+		Amount:            150e6,
+		Height:            150,
+		MaturityHeight:    249,
+		BlocksUntilMature: 89,
+	}}
+	if len(immature) != len(want) {
+		t.Fatalf("got %d immature outputs, want %d", len(immature),
+			len(want))
+	}
+	for i := range want {
+		if immature[i] != want[i] {
+			t.Fatalf("immature output %d: got %+v, want %+v", i,
+				immature[i], want[i])
+		}
+	}
+
+	bals, err := w.CalculateAccountBalances(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bals.ImmatureReward != want[0].Amount+want[1].Amount {
+		t.Fatalf("immature reward %v does not match listed outputs",
+			bals.ImmatureReward)
+	}
+
+	// Once the chain reaches the maturity height of the first output, only
+	// the second is still immature.
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.SetSyncedTo(addrmgrNs, &waddrmgr.BlockStamp{
+			Hash:   chainhash.Hash{199},
+			Height: 199,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	immature, err = w.ImmatureCoinbaseOutputs(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(immature) != 1 || immature[0].OutPoint.Hash != hashes[0] ||
+		immature[0].BlocksUntilMature != 50 {
+
+		t.Fatalf("unexpected immature outputs at height 199: %+v",
+			immature)
+	}
+}
@@ -0,0 +1,152 @@
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil/merkleblock"
+	"github.com/gcash/bchwallet/chain"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+var (
+	// ErrInvalidMerkleProof describes an error where a merkle proof for an
+	// imported transaction does not prove its inclusion in the block.
+	ErrInvalidMerkleProof = errors.New("merkle proof does not validate")
+
+	// ErrTxNotRelevant describes an error where an imported transaction
+	// does not pay to any address controlled by the wallet.
+	ErrTxNotRelevant = errors.New("transaction does not pay to the wallet")
+)
+
+// blockHeightClient is implemented by chain clients able to look up the
+// height of a block by its hash.
+type blockHeightClient interface {
+	GetBlockHeight(*chainhash.Hash) (int32, error)
+}
+
+// ImportTransaction adds a mined transaction to the wallet without requiring
+// a rescan.  The merkle proof is a serialized merkleblock, as returned by the
+// gettxoutproof RPC, proving the transaction's inclusion in the block with the
+// given hash.  The block header is fetched from the chain server and the proof
+// is verified against its merkle root before the transaction is recorded with
+// the block's metadata and its outputs paying to the wallet are credited.
+//
+// ErrInvalidMerkleProof is returned if the proof does not validate and
+// ErrTxNotRelevant if none of the transaction's outputs pay to the wallet.
+func (w *Wallet) ImportTransaction(txBytes, merkleProof []byte,
+	blockHash *chainhash.Hash) error {
+
+	rec, err := wtxmgr.NewTxRecord(txBytes, time.Now())
+	if err != nil {
+		return err
+	}
+	proofHeader, err := verifyMerkleProof(merkleProof, blockHash, &rec.Hash)
+	if err != nil {
+		return err
+	}
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return err
+	}
+	header, err := chainClient.GetBlockHeader(blockHash)
+	if err != nil {
+		return err
+	}
+	if header.MerkleRoot != proofHeader.MerkleRoot {
+		return ErrInvalidMerkleProof
+	}
+	height, err := blockHeight(chainClient, blockHash)
+	if err != nil {
+		return err
+	}
+
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: *blockHash, Height: height},
+		Time:  header.Timestamp,
+	}
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		relevant, err := w.paysToWallet(addrmgrNs, rec.MsgTx.TxOut)
+		if err != nil {
+			return err
+		}
+		if !relevant {
+			return ErrTxNotRelevant
+		}
+		return w.addRelevantTx(dbtx, rec, block)
+	})
+}
+
+// verifyMerkleProof checks that a serialized merkleblock commits to the block
+// with the given hash and proves the inclusion of txHash in it.  The block
+// header included in the proof is returned.
+func verifyMerkleProof(merkleProof []byte, blockHash,
+	txHash *chainhash.Hash) (*wire.BlockHeader, error) {
+
+	var msg wire.MsgMerkleBlock
+	err := msg.BchDecode(bytes.NewReader(merkleProof), wire.ProtocolVersion,
+		wire.LatestEncoding)
+	if err != nil {
+		return nil, ErrInvalidMerkleProof
+	}
+	if msg.Header.BlockHash() != *blockHash {
+		return nil, ErrInvalidMerkleProof
+	}
+
+	partial := merkleblock.NewMerkleBlockFromMsg(msg)
+	root := partial.ExtractMatches()
+	if root == nil || partial.BadTree() || *root != msg.Header.MerkleRoot {
+		return nil, ErrInvalidMerkleProof
+	}
+	for _, match := range partial.GetMatches() {
+		if *match == *txHash {
+			return &msg.Header, nil
+		}
+	}
+	return nil, ErrInvalidMerkleProof
+}
+
+// blockHeight looks up the height of a block using the chain client.
+func blockHeight(chainClient chain.Interface, hash *chainhash.Hash) (int32, error) {
+	client, ok := chainClient.(blockHeightClient)
+	if !ok {
+		return 0, fmt.Errorf("chain backend %s does not support block "+
+			"height lookups", chainClient.BackEnd())
+	}
+	return client.GetBlockHeight(hash)
+}
+
+// paysToWallet returns whether any of the outputs pay to an address known to
+// the address manager.
+func (w *Wallet) paysToWallet(addrmgrNs walletdb.ReadBucket,
+	outputs []*wire.TxOut) (bool, error) {
+
+	for _, output := range outputs {
+		_, addrs, _, err := txscript.ExtractPkScriptAddrs(
+			output.PkScript, w.chainParams,
+		)
+		if err != nil {
+			// Non-standard outputs are skipped.
+			continue
+		}
+		for _, addr := range addrs {
+			_, err := w.Manager.Address(addrmgrNs, addr)
+			if err == nil {
+				return true, nil
+			}
+			if !waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+				return false, err
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,154 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/blockchain"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/merkleblock"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// importChainClient is a mock chain client serving a single block header.
+type importChainClient struct {
+	mockChainClient
+	header *wire.BlockHeader
+	height int32
+}
+
+func (c *importChainClient) GetBlockHeader(*chainhash.Hash) (*wire.BlockHeader,
+	error) {
+
+	return c.header, nil
+}
+
+func (c *importChainClient) GetBlockHeight(*chainhash.Hash) (int32, error) {
+	return c.height, nil
+}
+
+// TestImportTransaction ensures a transaction is only imported with a merkle
+// proof committing to the known block header, and that its outputs paying to
+// the wallet are credited at the block's height.
+func TestImportTransaction(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	coinbase := wire.NewMsgTx(1)
+	coinbase.AddTxIn(&wire.TxIn{})
+	coinbase.AddTxOut(wire.NewTxOut(5e9, []byte{txscript.OP_TRUE},
+		wire.TokenData{}))
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 1}})
+	tx.AddTxOut(wire.NewTxOut(1e8, pkScript, wire.TokenData{}))
+	unrelated := wire.NewMsgTx(1)
+	unrelated.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 2}})
+	unrelated.AddTxOut(wire.NewTxOut(1e8, []byte{txscript.OP_TRUE},
+		wire.TokenData{}))
+
+	msgBlock := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			Version:   1,
+			Timestamp: time.Unix(1387737310, 0),
+		},
+		Transactions: []*wire.MsgTx{coinbase, tx, unrelated},
+	}
+	block := bchutil.NewBlock(msgBlock)
+	merkles := blockchain.BuildMerkleTreeStore(block.Transactions())
+	msgBlock.Header.MerkleRoot = *merkles[len(merkles)-1]
+	blockHash := msgBlock.Header.BlockHash()
+
+	proofFor := func(tx *wire.MsgTx) []byte {
+		txHash := tx.TxHash()
+		mBlock, _ := merkleblock.NewMerkleBlockWithTxnSet(
+			block, []*chainhash.Hash{&txHash},
+		)
+		var buf bytes.Buffer
+		err := mBlock.BchEncode(&buf, wire.ProtocolVersion,
+			wire.LatestEncoding)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+	txBytes := func(tx *wire.MsgTx) []byte {
+		var buf bytes.Buffer
+		if err := tx.Serialize(&buf); err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes()
+	}
+
+	w.chainClient = &importChainClient{header: &msgBlock.Header, height: 100}
+
+	// A proof for a different transaction, a proof for a different block,
+	// and a known header with a different merkle root must all be
+	// rejected.
+	err = w.ImportTransaction(txBytes(tx), proofFor(unrelated), &blockHash)
+	if err != ErrInvalidMerkleProof {
+		t.Fatalf("got error %v importing with another tx's proof", err)
+	}
+	otherHash := chainhash.Hash{0x01}
+	err = w.ImportTransaction(txBytes(tx), proofFor(tx), &otherHash)
+	if err != ErrInvalidMerkleProof {
+		t.Fatalf("got error %v importing with wrong block hash", err)
+	}
+	forgedHeader := msgBlock.Header
+	forgedHeader.MerkleRoot = chainhash.Hash{0x02}
+	w.chainClient = &importChainClient{header: &forgedHeader, height: 100}
+	err = w.ImportTransaction(txBytes(tx), proofFor(tx), &blockHash)
+	if err != ErrInvalidMerkleProof {
+		t.Fatalf("got error %v importing against another header", err)
+	}
+	w.chainClient = &importChainClient{header: &msgBlock.Header, height: 100}
+
+	// A valid proof for a transaction not paying to the wallet is
+	// rejected.
+	err = w.ImportTransaction(txBytes(unrelated), proofFor(unrelated),
+		&blockHash)
+	if err != ErrTxNotRelevant {
+		t.Fatalf("got error %v importing unrelated tx", err)
+	}
+
+	err = w.ImportTransaction(txBytes(tx), proofFor(tx), &blockHash)
+	if err != nil {
+		t.Fatalf("unable to import transaction: %v", err)
+	}
+
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		unspent, err := w.TxStore.UnspentOutputs(ns)
+		if err != nil {
+			return err
+		}
+		if len(unspent) != 1 {
+			t.Fatalf("expected 1 unspent output, got %d", len(unspent))
+		}
+		credit := unspent[0]
+		if credit.Hash != tx.TxHash() || credit.Amount != 1e8 {
+			t.Fatalf("unexpected credit %v", credit.OutPoint)
+		}
+		if credit.Block.Hash != blockHash || credit.Block.Height != 100 {
+			t.Fatalf("credit recorded in block %v at height %d",
+				credit.Block.Hash, credit.Block.Height)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
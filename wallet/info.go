@@ -0,0 +1,123 @@
+package wallet
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// Info describes the state of a wallet as a whole.
+type Info struct {
+	// Version is the wallet-level database version.
+	Version uint32
+
+	// PublicEncrypted is whether the public data of the wallet is
+	// encrypted with a passphrase other than InsecurePubPassphrase.
+	PublicEncrypted bool
+
+	Locked       bool
+	WatchingOnly bool
+
+	// AccountCount is the number of accounts of every key scope, not
+	// including the imported accounts.
+	AccountCount uint32
+
+	// OutputCount is the number of outputs controlled by the wallet in
+	// every recorded transaction, and UnspentOutputCount the number of
+	// those which are unspent.  Outputs of unmined transactions are
+	// included.
+	OutputCount        int
+	UnspentOutputCount int
+
+	SyncedTo waddrmgr.BlockStamp
+
+	// Birthday is the birthday of the wallet, and BirthdayBlock the block
+	// the wallet begins syncing from, or nil if it has not been set.
+	Birthday      time.Time
+	BirthdayBlock *waddrmgr.BlockStamp
+
+	ChainClientConnected bool
+}
+
+// Info returns a summary of the state of the wallet.
+func (w *Wallet) Info() (*Info, error) {
+	info := &Info{
+		PublicEncrypted:      w.PublicEncrypted(),
+		Locked:               w.Locked(),
+		WatchingOnly:         w.Manager.WatchOnly(),
+		SyncedTo:             w.Manager.SyncedTo(),
+		Birthday:             w.Manager.Birthday(),
+		ChainClientConnected: w.ChainClient() != nil,
+	}
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		walletNs := tx.ReadBucket(walletNamespaceKey)
+		if walletNs != nil {
+			version, err := readWalletVersion(walletNs)
+			if err != nil {
+				return err
+			}
+			info.Version = version
+		}
+
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		for _, manager := range w.Manager.ActiveScopedKeyManagers() {
+			lastAccount, err := manager.LastAccount(addrmgrNs)
+			if err != nil {
+				return err
+			}
+			info.AccountCount += lastAccount + 1
+		}
+
+		birthdayBlock, _, err := w.Manager.BirthdayBlock(addrmgrNs)
+		switch {
+		case waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet):
+		case err != nil:
+			return err
+		default:
+			info.BirthdayBlock = &birthdayBlock
+		}
+
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.RangeTransactions(txmgrNs, 0, -1,
+			func(details []wtxmgr.TxDetails) (bool, error) {
+				for i := range details {
+					for _, cred := range details[i].Credits {
+						info.OutputCount++
+						if !cred.Spent {
+							info.UnspentOutputCount++
+						}
+					}
+				}
+				return false, nil
+			})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// PublicEncrypted returns whether the public data of the wallet is encrypted
+// with a passphrase other than InsecurePubPassphrase.
+func (w *Wallet) PublicEncrypted() bool {
+	w.publicEncryptedMtx.Lock()
+	defer w.publicEncryptedMtx.Unlock()
+	return w.publicEncrypted
+}
+
+// setPublicEncrypted records the public passphrase the public data of the
+// wallet was encrypted with.
+func (w *Wallet) setPublicEncrypted(pubPass []byte) {
+	w.publicEncryptedMtx.Lock()
+	w.publicEncrypted = !isInsecurePubPassphrase(pubPass)
+	w.publicEncryptedMtx.Unlock()
+}
+
+// isInsecurePubPassphrase returns whether the public passphrase is the
+// default InsecurePubPassphrase.
+func isInsecurePubPassphrase(pubPass []byte) bool {
+	return bytes.Equal(pubPass, []byte(InsecurePubPassphrase))
+}
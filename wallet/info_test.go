@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestInfo ensures the summary of the wallet reflects its accounts, outputs
+// and public passphrase.
+func TestInfo(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	before, err := w.Info()
+	if err != nil {
+		t.Fatalf("unable to get wallet info: %v", err)
+	}
+	if before.Version != latestWalletVersion() {
+		t.Fatalf("got version %d, want %d", before.Version,
+			latestWalletVersion())
+	}
+	if !before.PublicEncrypted {
+		t.Fatal("wallet with a public passphrase not reported encrypted")
+	}
+	if before.Locked || before.WatchingOnly || !before.ChainClientConnected {
+		t.Fatalf("unexpected wallet state %+v", before)
+	}
+	if before.OutputCount != 0 || before.UnspentOutputCount != 0 {
+		t.Fatalf("got %d outputs, %d unspent, want none",
+			before.OutputCount, before.UnspentOutputCount)
+	}
+
+	scope := waddrmgr.KeyScopeBIP0044
+	if _, err := w.NextAccount(scope, "second"); err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	addr, err := w.NewAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	addTestCredits(t, w, 100, 100, []bchutil.Address{addr, addr},
+		[]int64{1e8, 2e8})
+
+	err = w.ChangePublicPassphrase([]byte("hello"),
+		[]byte(InsecurePubPassphrase))
+	if err != nil {
+		t.Fatalf("unable to change public passphrase: %v", err)
+	}
+
+	after, err := w.Info()
+	if err != nil {
+		t.Fatalf("unable to get wallet info: %v", err)
+	}
+	if after.AccountCount != before.AccountCount+1 {
+		t.Fatalf("got %d accounts, want %d", after.AccountCount,
+			before.AccountCount+1)
+	}
+	if after.OutputCount != 2 || after.UnspentOutputCount != 2 {
+		t.Fatalf("got %d outputs, %d unspent, want 2 unspent",
+			after.OutputCount, after.UnspentOutputCount)
+	}
+	if after.PublicEncrypted {
+		t.Fatal("wallet with the default public passphrase reported " +
+			"encrypted")
+	}
+}
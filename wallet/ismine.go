@@ -0,0 +1,132 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// AddressInfo describes the wallet address found to control an output script.
+type AddressInfo struct {
+	// Address is the managed wallet address controlling the script.
+	Address waddrmgr.ManagedAddress
+
+	// Account is the account the address belongs to.
+	Account uint32
+
+	// KeyScope is the key scope of the manager the address is stored in.
+	KeyScope waddrmgr.KeyScope
+
+	// Derived is true when the address is backed by a key derived from the
+	// wallet's HD root, in which case DerivationPath is set.  Imported keys
+	// and scripts are not derived.
+	Derived bool
+
+	// DerivationPath is the path of the key backing the address under the
+	// key scope.  Its key has been re-derived from the account's extended
+	// key and verified to match the address.
+	DerivationPath waddrmgr.DerivationPath
+}
+
+// IsMine determines whether an arbitrary output script is controlled by the
+// wallet.  The addresses paid by the script are extracted and each is looked
+// up in the address manager, returning information about the first address
+// the wallet owns.  For addresses derived from the wallet's HD root, the key is
+// re-derived from its derivation path and checked against the stored address.
+//
+// Pay-to-pubkey scripts are matched by their pubkey hash address.  A bare
+// multisig script is considered owned if it has been imported as a redeem
+// script, or otherwise if any of its keys belong to the wallet.
+func (w *Wallet) IsMine(pkScript []byte) (bool, *AddressInfo, error) {
+	class, addrs, _, err := txscript.ExtractPkScriptAddrs(
+		pkScript, w.chainParams,
+	)
+	if err != nil {
+		return false, nil, err
+	}
+
+	candidates := make([]bchutil.Address, 0, len(addrs)+1)
+	if class == txscript.MultiSigTy {
+		scriptAddr, err := bchutil.NewAddressScriptHash(
+			pkScript, w.chainParams,
+		)
+		if err != nil {
+			return false, nil, err
+		}
+		candidates = append(candidates, scriptAddr)
+	}
+	candidates = append(candidates, addrs...)
+
+	var info *AddressInfo
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		for _, addr := range candidates {
+			var err error
+			info, err = w.addressInfo(addrmgrNs, addr)
+			if err != nil || info != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return false, nil, err
+	}
+	return info != nil, info, nil
+}
+
+// addressInfo looks up an address in each active scoped key manager.  A nil
+// AddressInfo is returned without error if no manager knows the address.
+func (w *Wallet) addressInfo(addrmgrNs walletdb.ReadBucket,
+	addr bchutil.Address) (*AddressInfo, error) {
+
+	for _, scopedMgr := range w.Manager.ActiveScopedKeyManagers() {
+		ma, err := scopedMgr.Address(addrmgrNs, addr)
+		if waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		info := &AddressInfo{
+			Address:  ma,
+			Account:  ma.Account(),
+			KeyScope: scopedMgr.Scope(),
+		}
+
+		pka, ok := ma.(waddrmgr.ManagedPubKeyAddress)
+		if !ok {
+			return info, nil
+		}
+		_, path, ok := pka.DerivationInfo()
+		if !ok {
+			return info, nil
+		}
+
+		// Re-derive the key from the account's extended key to verify
+		// the stored address really corresponds to its path.
+		derived, err := scopedMgr.DeriveFromKeyPath(addrmgrNs, path)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(derived.Address().ScriptAddress(),
+			ma.Address().ScriptAddress()) {
+
+			return nil, fmt.Errorf("address %v does not match the key "+
+				"derived at m/%d'/%d'/%d'/%d/%d", ma.Address(),
+				info.KeyScope.Purpose, info.KeyScope.Coin,
+				path.Account, path.Branch, path.Index)
+		}
+
+		info.Derived = true
+		info.DerivationPath = path
+		return info, nil
+	}
+
+	return nil, nil
+}
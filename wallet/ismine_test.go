@@ -0,0 +1,123 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestIsMine ensures output scripts paying to derived addresses, their
+// pubkeys, and imported multisig scripts are recognized as owned by the wallet
+// while scripts paying elsewhere are not.
+func TestIsMine(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	ma, err := w.AddressInfo(addr)
+	if err != nil {
+		t.Fatalf("unable to lookup address: %v", err)
+	}
+	walletPubKey := ma.(waddrmgr.ManagedPubKeyAddress).PubKey()
+	_, walletPath, _ := ma.(waddrmgr.ManagedPubKeyAddress).DerivationInfo()
+	walletPKAddr, err := bchutil.NewAddressPubKey(
+		walletPubKey.SerializeCompressed(), w.chainParams,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	foreignKey, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	foreignPKAddr, err := bchutil.NewAddressPubKey(
+		foreignKey.PubKey().SerializeCompressed(), w.chainParams,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mustScript := func(addr bchutil.Address) []byte {
+		script, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return script
+	}
+
+	// A bare multisig script containing only a foreign key is not owned
+	// until it is imported as a redeem script.
+	foreignMultiSig, err := txscript.MultiSigScript(
+		[]*bchutil.AddressPubKey{foreignPKAddr}, 1,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine, _, err := w.IsMine(foreignMultiSig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mine {
+		t.Fatalf("unimported multisig script reported as mine")
+	}
+	p2shAddr, err := w.ImportP2SHRedeemScript(foreignMultiSig)
+	if err != nil {
+		t.Fatalf("unable to import redeem script: %v", err)
+	}
+
+	walletMultiSig, err := txscript.MultiSigScript(
+		[]*bchutil.AddressPubKey{foreignPKAddr, walletPKAddr}, 1,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		script  []byte
+		mine    bool
+		derived bool
+	}{
+		{"p2pkh", mustScript(addr), true, true},
+		{"p2pk", mustScript(walletPKAddr), true, true},
+		{"foreign p2pk", mustScript(foreignPKAddr), false, false},
+		{"imported p2sh", mustScript(p2shAddr), true, false},
+		{"imported bare multisig", foreignMultiSig, true, false},
+		{"multisig with wallet key", walletMultiSig, true, true},
+	}
+	for _, test := range tests {
+		mine, info, err := w.IsMine(test.script)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if mine != test.mine {
+			t.Fatalf("%s: got mine=%v, want %v", test.name, mine,
+				test.mine)
+		}
+		if !mine {
+			if info != nil {
+				t.Fatalf("%s: unexpected address info", test.name)
+			}
+			continue
+		}
+		if info.Derived != test.derived {
+			t.Fatalf("%s: got derived=%v, want %v", test.name,
+				info.Derived, test.derived)
+		}
+		if info.KeyScope != waddrmgr.KeyScopeBIP0044 {
+			t.Fatalf("%s: unexpected key scope %v", test.name,
+				info.KeyScope)
+		}
+		if test.derived && info.DerivationPath != walletPath {
+			t.Fatalf("%s: unexpected derivation path %v", test.name,
+				info.DerivationPath)
+		}
+	}
+}
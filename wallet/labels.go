@@ -0,0 +1,149 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// MaxLabelLen is the maximum length, in bytes, of a transaction or address
+// label.
+const MaxLabelLen = 500
+
+// ErrLabelTooLong describes an error where a label is longer than
+// MaxLabelLen.
+var ErrLabelTooLong = fmt.Errorf("label exceeds %d bytes", MaxLabelLen)
+
+var (
+	// txLabelsBucketKey and addrLabelsBucketKey are the keys of the
+	// buckets of the labels namespace holding transaction labels, keyed by
+	// transaction hash, and address labels, keyed by addressLabelKey.
+	txLabelsBucketKey   = []byte("tx")
+	addrLabelsBucketKey = []byte("addr")
+)
+
+// putLabel sets the label of key in the nested bucket of the labels
+// namespace, or removes it if the label is empty.
+func putLabel(dbtx walletdb.ReadWriteTx, bucketKey, key []byte,
+	label string) error {
+
+	ns := dbtx.ReadWriteBucket(labelsNamespaceKey)
+	if ns == nil {
+		return errors.New("missing labels namespace")
+	}
+	bucket, err := ns.CreateBucketIfNotExists(bucketKey)
+	if err != nil {
+		return err
+	}
+	if label == "" {
+		return bucket.Delete(key)
+	}
+	return bucket.Put(key, []byte(label))
+}
+
+// fetchLabel returns the label of key in the nested bucket of the labels
+// namespace, or the empty string if it has none.
+func fetchLabel(dbtx walletdb.ReadTx, bucketKey, key []byte) string {
+	ns := dbtx.ReadBucket(labelsNamespaceKey)
+	if ns == nil {
+		return ""
+	}
+	bucket := ns.NestedReadBucket(bucketKey)
+	if bucket == nil {
+		return ""
+	}
+	return string(bucket.Get(key))
+}
+
+// PutTxLabel sets the label of a transaction recorded by the wallet.  An empty
+// label removes the current label, which is allowed even if the wallet no
+// longer has a record of the transaction.  ErrTxNotFound is returned when
+// labeling a transaction unknown to the wallet.
+func (w *Wallet) PutTxLabel(hash *chainhash.Hash, label string) error {
+	if len(label) > MaxLabelLen {
+		return ErrLabelTooLong
+	}
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		if label != "" {
+			txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			details, err := w.TxStore.TxDetails(txmgrNs, hash)
+			if err != nil {
+				return err
+			}
+			if details == nil {
+				return ErrTxNotFound
+			}
+		}
+		return putLabel(dbtx, txLabelsBucketKey, hash[:], label)
+	})
+}
+
+// FetchTxLabel returns the label of a transaction, or the empty string if it
+// has none.
+func (w *Wallet) FetchTxLabel(hash *chainhash.Hash) (string, error) {
+	var label string
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		label = fetchLabel(dbtx, txLabelsBucketKey, hash[:])
+		return nil
+	})
+	return label, err
+}
+
+// addressLabelKey returns the key of the label of an address, which is the
+// type of the address followed by its script address.  The cashaddr and legacy
+// encodings of an address have the same key, while addresses of different
+// types never do, even when their hashes are equal.
+func addressLabelKey(addr bchutil.Address) []byte {
+	var addrType byte
+	switch addr.(type) {
+	case *bchutil.AddressPubKeyHash, *bchutil.LegacyAddressPubKeyHash:
+		addrType = 0
+	case *bchutil.AddressScriptHash, *bchutil.LegacyAddressScriptHash:
+		addrType = 1
+	case *bchutil.AddressScriptHash32:
+		addrType = 2
+	case *bchutil.AddressPubKey:
+		addrType = 3
+	default:
+		addrType = 0xff
+	}
+	return append([]byte{addrType}, addr.ScriptAddress()...)
+}
+
+// PutAddressLabel sets the label of an address of the wallet.  An empty label
+// removes the current label.  An error with the waddrmgr.ErrAddressNotFound
+// code is returned when labeling an address the wallet does not own.
+//
+// The cashaddr and legacy encodings of an address share a label, but the
+// pay-to-pubkey and pay-to-pubkey-hash addresses of a key do not.
+func (w *Wallet) PutAddressLabel(addr bchutil.Address, label string) error {
+	if len(label) > MaxLabelLen {
+		return ErrLabelTooLong
+	}
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		if label != "" {
+			addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+			_, err := w.Manager.Address(addrmgrNs, addr)
+			if err != nil {
+				return err
+			}
+		}
+		return putLabel(dbtx, addrLabelsBucketKey,
+			addressLabelKey(addr), label)
+	})
+}
+
+// FetchAddressLabel returns the label of an address, or the empty string if it
+// has none.
+func (w *Wallet) FetchAddressLabel(addr bchutil.Address) (string, error) {
+	var label string
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		label = fetchLabel(dbtx, addrLabelsBucketKey,
+			addressLabelKey(addr))
+		return nil
+	})
+	return label, err
+}
@@ -0,0 +1,123 @@
+package wallet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// TestLabels ensures transaction and address labels are stored, included in
+// transaction summaries, persisted across reopening the wallet, and removed
+// by setting an empty label.
+func TestLabels(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 100, []bchutil.Address{addr},
+		[]int64{1e8})
+
+	if err := w.PutTxLabel(&rec.Hash, "rent"); err != nil {
+		t.Fatalf("unable to label transaction: %v", err)
+	}
+	if err := w.PutAddressLabel(addr, "landlord"); err != nil {
+		t.Fatalf("unable to label address: %v", err)
+	}
+
+	// Transactions and addresses unknown to the wallet can not be
+	// labeled, and labels may not be too long.
+	err = w.PutTxLabel(&chainhash.Hash{1}, "unknown")
+	if err != ErrTxNotFound {
+		t.Fatalf("got error %v, want ErrTxNotFound", err)
+	}
+	other, err := bchutil.NewAddressPubKeyHash(make([]byte, 20),
+		w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.PutAddressLabel(other, "unknown")
+	if !waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+		t.Fatalf("got error %v, want ErrAddressNotFound", err)
+	}
+	long := strings.Repeat("x", MaxLabelLen+1)
+	if err := w.PutTxLabel(&rec.Hash, long); !errors.Is(err, ErrLabelTooLong) {
+		t.Fatalf("got error %v, want ErrLabelTooLong", err)
+	}
+
+	// The labels are read by a newly opened wallet.
+	w2, err := Open(w.db, []byte("hello"), nil, w.ChainParams(), 0)
+	if err != nil {
+		t.Fatalf("unable to reopen wallet: %v", err)
+	}
+	label, err := w2.FetchTxLabel(&rec.Hash)
+	if err != nil || label != "rent" {
+		t.Fatalf("got transaction label %q (%v), want %q", label, err,
+			"rent")
+	}
+	label, err = w2.FetchAddressLabel(addr)
+	if err != nil || label != "landlord" {
+		t.Fatalf("got address label %q (%v), want %q", label, err,
+			"landlord")
+	}
+
+	// The legacy encoding of the address shares its label, but a script
+	// hash address with the same hash does not.
+	legacy, err := bchutil.NewLegacyAddressPubKeyHash(addr.ScriptAddress(),
+		w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	label, err = w.FetchAddressLabel(legacy)
+	if err != nil || label != "landlord" {
+		t.Fatalf("got legacy address label %q (%v), want %q", label,
+			err, "landlord")
+	}
+	p2sh, err := bchutil.NewAddressScriptHashFromHash(addr.ScriptAddress(),
+		w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if label, _ := w.FetchAddressLabel(p2sh); label != "" {
+		t.Fatalf("script hash address with the same hash has label %q",
+			label)
+	}
+
+	var summary TransactionSummary
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		details, err := w.TxStore.TxDetails(txmgrNs, &rec.Hash)
+		if err != nil {
+			return err
+		}
+		summary = makeTxSummary(dbtx, w, details)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Label != "rent" {
+		t.Fatalf("got summary label %q, want %q", summary.Label, "rent")
+	}
+
+	// Empty labels remove the current labels.
+	if err := w.PutTxLabel(&rec.Hash, ""); err != nil {
+		t.Fatalf("unable to remove transaction label: %v", err)
+	}
+	if err := w.PutAddressLabel(addr, ""); err != nil {
+		t.Fatalf("unable to remove address label: %v", err)
+	}
+	if label, _ := w.FetchTxLabel(&rec.Hash); label != "" {
+		t.Fatalf("transaction label %q was not removed", label)
+	}
+	if label, _ := w.FetchAddressLabel(addr); label != "" {
+		t.Fatalf("address label %q was not removed", label)
+	}
+}
@@ -6,12 +6,15 @@ package wallet
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sync"
 	"time"
 
 	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchutil"
 	"github.com/gcash/bchwallet/internal/prompt"
 	"github.com/gcash/bchwallet/waddrmgr"
 	"github.com/gcash/bchwallet/walletdb"
@@ -33,6 +36,27 @@ var (
 	// ErrExists describes the error condition of attempting to create a new
 	// wallet when one exists already.
 	ErrExists = errors.New("wallet already exists")
+
+	// ErrInvalidScryptOptions describes the error condition of attempting
+	// to create wallets with scrypt parameters that are unsafely weak or
+	// unreasonably expensive.
+	ErrInvalidScryptOptions = errors.New("invalid scrypt options")
+)
+
+const (
+	// minScryptN is the smallest scrypt cost parameter accepted for new
+	// wallets.  Weaker parameters make brute forcing the passphrases of a
+	// stolen wallet database cheap.
+	minScryptN = 1 << 12
+
+	// maxScryptMemory is the largest amount of memory, in bytes, that
+	// deriving a passphrase key with the scrypt parameters of new wallets
+	// may require.
+	maxScryptMemory = 1 << 30
+
+	// maxScryptP is the largest scrypt parallelization parameter accepted
+	// for new wallets.
+	maxScryptP = 16
 )
 
 // Loader implements the creating of new and opening of existing wallets, while
@@ -43,19 +67,34 @@ var (
 //
 // Loader is safe for concurrent access.
 type Loader struct {
-	callbacks      []func(*Wallet)
-	chainParams    *chaincfg.Params
-	dbDirPath      string
-	noFreelistSync bool
-	recoveryWindow uint32
-	wallet         *Wallet
-	db             walletdb.DB
-	mu             sync.Mutex
+	callbacks              []func(*Wallet)
+	chainParams            *chaincfg.Params
+	dbDirPath              string
+	noFreelistSync         bool
+	recoveryWindow         uint32
+	internalRecoveryWindow uint32
+	maxRollbackDepth       int32
+	pruneSpentTxs          bool
+	publishAttempts        uint32
+	publishRetryDelay      time.Duration
+	broadcasters           []TxBroadcaster
+	minChangeAmount        bchutil.Amount
+	balanceCheckInterval   time.Duration
+	gapConfirmations       int32
+	filterWorkers          int
+	unlockProvider         PassphraseProvider
+	unlockTimeout          time.Duration
+	openCallbacks          OpenCallbacksProvider
+	scryptOptions          *waddrmgr.ScryptOptions
+	wallet                 *Wallet
+	db                     walletdb.DB
+	mu                     sync.Mutex
 }
 
 // NewLoader constructs a Loader with an optional recovery window. If the
 // recovery window is non-zero, the wallet will attempt to recovery addresses
-// starting from the last SyncedTo height.
+// starting from the last SyncedTo height.  Change addresses are recovered using
+// the same window unless another is set with SetInternalRecoveryWindow.
 func NewLoader(chainParams *chaincfg.Params, dbDirPath string,
 	noFreelistSync bool, recoveryWindow uint32) *Loader {
 
@@ -70,10 +109,226 @@ func NewLoader(chainParams *chaincfg.Params, dbDirPath string,
 		},
 	}
 	return &Loader{
-		chainParams:    chainParams,
-		dbDirPath:      dbDirPath,
-		noFreelistSync: noFreelistSync,
-		recoveryWindow: recoveryWindow,
+		chainParams:            chainParams,
+		dbDirPath:              dbDirPath,
+		noFreelistSync:         noFreelistSync,
+		recoveryWindow:         recoveryWindow,
+		internalRecoveryWindow: recoveryWindow,
+		maxRollbackDepth:       waddrmgr.MaxReorgDepth,
+		publishAttempts:        DefaultPublishAttempts,
+		publishRetryDelay:      DefaultPublishRetryDelay,
+		minChangeAmount:        DefaultMinChangeAmount,
+		filterWorkers:          runtime.NumCPU(),
+		openCallbacks:          defaultOpenCallbacks,
+	}
+}
+
+// SetInternalRecoveryWindow sets the key-derivation lookahead used by wallets
+// loaded afterwards when recovering change addresses.  Wallets generate change
+// addresses at a different rate than receiving addresses, so their change may
+// be found past the recovery window of the external branch.  Zero selects the
+// loader's recovery window.
+func (l *Loader) SetInternalRecoveryWindow(window uint32) {
+	l.mu.Lock()
+	if window == 0 {
+		window = l.recoveryWindow
+	}
+	l.internalRecoveryWindow = window
+	l.mu.Unlock()
+}
+
+// SetMaxRollbackDepth sets the deepest chain reorganization, in blocks, that
+// wallets loaded afterwards handle by rolling back their state block by block
+// when syncing with the chain.  When a deeper reorg is detected, the wallet
+// instead discards its transaction history and resyncs from its birthday
+// block.  Depths that are not positive or exceed waddrmgr.MaxReorgDepth, the
+// number of recent block hashes the wallet stores, are capped to it.
+func (l *Loader) SetMaxRollbackDepth(depth int32) {
+	if depth <= 0 || depth > waddrmgr.MaxReorgDepth {
+		depth = waddrmgr.MaxReorgDepth
+	}
+
+	l.mu.Lock()
+	l.maxRollbackDepth = depth
+	l.mu.Unlock()
+}
+
+// SetPruneSpentTransactions sets whether wallets loaded afterwards drop the
+// serialized transactions of fully-spent transactions mined deeper than the
+// maximum rollback depth from their transaction store.  See
+// wtxmgr.Store.PruneSpentTransactions for the compact records that are kept.
+func (l *Loader) SetPruneSpentTransactions(prune bool) {
+	l.mu.Lock()
+	l.pruneSpentTxs = prune
+	l.mu.Unlock()
+}
+
+// SetPublishRetry sets how many times wallets loaded afterwards send a
+// transaction to the chain backend when sending fails because of a connection
+// error, and the delay before the first retry, which doubles with each
+// subsequent one.  Rejections of the transaction are never retried.  Zero
+// values select DefaultPublishAttempts and DefaultPublishRetryDelay.
+func (l *Loader) SetPublishRetry(attempts uint32, delay time.Duration) {
+	if attempts == 0 {
+		attempts = DefaultPublishAttempts
+	}
+	if delay <= 0 {
+		delay = DefaultPublishRetryDelay
+	}
+
+	l.mu.Lock()
+	l.publishAttempts = attempts
+	l.publishRetryDelay = delay
+	l.mu.Unlock()
+}
+
+// SetBroadcasters sets the endpoints transactions published by wallets loaded
+// afterwards are broadcast to in addition to the chain backend, improving
+// their propagation.  A transaction is published when any endpoint accepts it,
+// and is only removed from the wallet when every endpoint rejects it.
+func (l *Loader) SetBroadcasters(broadcasters []TxBroadcaster) {
+	l.mu.Lock()
+	l.broadcasters = broadcasters
+	l.mu.Unlock()
+}
+
+// SetMinChangeAmount sets the smallest change output created by wallets loaded
+// afterwards.  When the value left over after paying the outputs and fee of a
+// transaction is smaller, it is added to the fee rather than returned to the
+// wallet in a change output that would cost nearly as much to spend as it is
+// worth.  Change below the dust threshold is never created regardless of this
+// setting.
+func (l *Loader) SetMinChangeAmount(amount bchutil.Amount) {
+	l.mu.Lock()
+	l.minChangeAmount = amount
+	l.mu.Unlock()
+}
+
+// SetBalanceCheckInterval sets the interval at which wallets loaded afterwards
+// verify their balance against the sum of their unspent outputs in the
+// background, logging an error and notifying BalanceMismatchNotifications
+// clients when they differ.  Zero disables the check.  See
+// Wallet.VerifyBalance.
+func (l *Loader) SetBalanceCheckInterval(interval time.Duration) {
+	l.mu.Lock()
+	l.balanceCheckInterval = interval
+	l.mu.Unlock()
+}
+
+// SetGapConfirmations sets the number of confirmations a deposit to an
+// external address needs before wallets loaded afterwards count the address as
+// used when enforcing the gap limit.  This prevents an unconfirmed deposit
+// that is later dropped from allowing addresses to be generated past the gap
+// that restoring the wallet from its seed is able to find.  Zero counts an
+// address as soon as any deposit to it is seen.
+func (l *Loader) SetGapConfirmations(confs int32) {
+	l.mu.Lock()
+	l.gapConfirmations = confs
+	l.mu.Unlock()
+}
+
+// SetFilterWorkers sets the maximum number of blocks the chain backend fetches
+// and filters concurrently when wallets loaded afterwards recover addresses.
+// Matches are still recorded in block order.  Values that are not positive
+// select one worker per CPU.
+func (l *Loader) SetFilterWorkers(workers int) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	l.mu.Lock()
+	l.filterWorkers = workers
+	l.mu.Unlock()
+}
+
+// SetAutoUnlock sets a provider of the private passphrase used to unlock
+// wallets opened afterwards with OpenExistingWallet, without an interactive
+// prompt.  If timeout is positive, the wallet is locked again after it
+// elapses; otherwise it stays unlocked until explicitly locked.  A nil
+// provider disables automatic unlocking, which is the default.  Failing to
+// fetch the passphrase or unlock the wallet is logged and leaves the wallet
+// locked.  See PassphraseProvider for the security implications.
+func (l *Loader) SetAutoUnlock(provider PassphraseProvider, timeout time.Duration) {
+	l.mu.Lock()
+	l.unlockProvider = provider
+	l.unlockTimeout = timeout
+	l.mu.Unlock()
+}
+
+// SetScryptOptions sets the scrypt parameters used to derive the keys
+// protecting the passphrases of wallets created afterwards.  Cheaper
+// parameters than waddrmgr.DefaultScryptOptions make unlocking faster on
+// low-powered devices, at the cost of making the passphrases easier to brute
+// force.  The parameters are stored with each wallet, so existing wallets are
+// not affected.  ErrInvalidScryptOptions is returned if N is not a power of two
+// of at least 4096, P is not between 1 and 16, or the key derivation would
+// require more than 1 GiB of memory.  Nil selects the default options.
+func (l *Loader) SetScryptOptions(opts *waddrmgr.ScryptOptions) error {
+	if opts != nil {
+		if err := validateScryptOptions(opts); err != nil {
+			return err
+		}
+		optsCopy := *opts
+		opts = &optsCopy
+	}
+
+	l.mu.Lock()
+	l.scryptOptions = opts
+	l.mu.Unlock()
+	return nil
+}
+
+// validateScryptOptions checks that the scrypt parameters are within the
+// bounds accepted for new wallets.
+func validateScryptOptions(opts *waddrmgr.ScryptOptions) error {
+	switch {
+	case opts.N < minScryptN || opts.N&(opts.N-1) != 0:
+		return fmt.Errorf("%w: N must be a power of two of at least %d",
+			ErrInvalidScryptOptions, minScryptN)
+	case opts.R < 1 || opts.N > maxScryptMemory/128/opts.R:
+		return fmt.Errorf("%w: R must be positive and 128*N*R at most "+
+			"%d bytes", ErrInvalidScryptOptions, maxScryptMemory)
+	case opts.P < 1 || opts.P > maxScryptP:
+		return fmt.Errorf("%w: P must be between 1 and %d",
+			ErrInvalidScryptOptions, maxScryptP)
+	}
+	return nil
+}
+
+// OpenCallbacksProvider constructs the callbacks used to obtain the wallet
+// seed and private passphrase when a database upgrade opening an existing
+// wallet requires them.  canConsolePrompt reports whether the caller of
+// OpenExistingWallet permits prompting on standard input.
+type OpenCallbacksProvider func(canConsolePrompt bool) *waddrmgr.OpenCallbacks
+
+// SetOpenCallbacksProvider replaces the provider of the callbacks used during
+// upgrades of existing wallets.  By default, the seed and private passphrase
+// are prompted for on the console when permitted, and upgrades requiring them
+// fail otherwise.  Headless deployments may use this to supply them
+// programmatically, for example with UpgradeSecrets.
+func (l *Loader) SetOpenCallbacksProvider(provider OpenCallbacksProvider) {
+	l.mu.Lock()
+	l.openCallbacks = provider
+	l.mu.Unlock()
+}
+
+// UpgradeSecrets returns an OpenCallbacksProvider supplying the given seed and
+// private passphrase to any upgrade requiring them, regardless of console
+// access.  Either may be nil, in which case upgrades requiring it fail.
+func UpgradeSecrets(seed, privPassphrase []byte) OpenCallbacksProvider {
+	provide := func(secret []byte) waddrmgr.ObtainUserInputFunc {
+		if secret == nil {
+			return noConsole
+		}
+		return func() ([]byte, error) {
+			return append([]byte(nil), secret...), nil
+		}
+	}
+	return func(bool) *waddrmgr.OpenCallbacks {
+		return &waddrmgr.OpenCallbacks{
+			ObtainSeed:        provide(seed),
+			ObtainPrivatePass: provide(privPassphrase),
+		}
 	}
 }
 
@@ -137,8 +392,9 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte,
 	}
 
 	// Initialize the newly created database for the wallet before opening.
-	err = Create(
+	err = CreateWithScryptOptions(
 		db, pubPassphrase, privPassphrase, seed, l.chainParams, bday,
+		l.scryptOptions,
 	)
 	if err != nil {
 		return nil, err
@@ -149,6 +405,16 @@ func (l *Loader) CreateNewWallet(pubPassphrase, privPassphrase, seed []byte,
 	if err != nil {
 		return nil, err
 	}
+	w.internalRecoveryWindow = l.internalRecoveryWindow
+	w.maxRollbackDepth = l.maxRollbackDepth
+	w.pruneSpentTxs = l.pruneSpentTxs
+	w.publishAttempts = l.publishAttempts
+	w.publishRetryDelay = l.publishRetryDelay
+	w.broadcasters = l.broadcasters
+	w.minChangeAmount = l.minChangeAmount
+	w.balanceCheckInterval = l.balanceCheckInterval
+	w.gapConfirmations = l.gapConfirmations
+	w.filterWorkers = l.filterWorkers
 	w.Start()
 
 	l.onLoaded(w, db)
@@ -161,10 +427,26 @@ func noConsole() ([]byte, error) {
 	return nil, errNoConsole
 }
 
+// defaultOpenCallbacks prompts for upgrade input on the console if permitted,
+// and otherwise fails any upgrade requiring it.
+func defaultOpenCallbacks(canConsolePrompt bool) *waddrmgr.OpenCallbacks {
+	if canConsolePrompt {
+		return &waddrmgr.OpenCallbacks{
+			ObtainSeed:        prompt.ProvideSeed,
+			ObtainPrivatePass: prompt.ProvidePrivPassphrase,
+		}
+	}
+	return &waddrmgr.OpenCallbacks{
+		ObtainSeed:        noConsole,
+		ObtainPrivatePass: noConsole,
+	}
+}
+
 // OpenExistingWallet opens the wallet from the loader's wallet database path
 // and the public passphrase.  If the loader is being called by a context where
 // standard input prompts may be used during wallet upgrades, setting
-// canConsolePrompt will enables these prompts.
+// canConsolePrompt will enables these prompts.  The callbacks used to obtain
+// upgrade input may be replaced with SetOpenCallbacksProvider.
 func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool) (*Wallet, error) {
 	defer l.mu.Unlock()
 	l.mu.Lock()
@@ -186,18 +468,7 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool)
 		return nil, err
 	}
 
-	var cbs *waddrmgr.OpenCallbacks
-	if canConsolePrompt {
-		cbs = &waddrmgr.OpenCallbacks{
-			ObtainSeed:        prompt.ProvideSeed,
-			ObtainPrivatePass: prompt.ProvidePrivPassphrase,
-		}
-	} else {
-		cbs = &waddrmgr.OpenCallbacks{
-			ObtainSeed:        noConsole,
-			ObtainPrivatePass: noConsole,
-		}
-	}
+	cbs := l.openCallbacks(canConsolePrompt)
 	w, err := Open(db, pubPassphrase, cbs, l.chainParams, l.recoveryWindow)
 	if err != nil {
 		// If opening the wallet fails (e.g. because of wrong
@@ -209,8 +480,27 @@ func (l *Loader) OpenExistingWallet(pubPassphrase []byte, canConsolePrompt bool)
 		}
 		return nil, err
 	}
+	w.internalRecoveryWindow = l.internalRecoveryWindow
+	w.maxRollbackDepth = l.maxRollbackDepth
+	w.pruneSpentTxs = l.pruneSpentTxs
+	w.publishAttempts = l.publishAttempts
+	w.publishRetryDelay = l.publishRetryDelay
+	w.broadcasters = l.broadcasters
+	w.minChangeAmount = l.minChangeAmount
+	w.balanceCheckInterval = l.balanceCheckInterval
+	w.gapConfirmations = l.gapConfirmations
+	w.filterWorkers = l.filterWorkers
 	w.Start()
 
+	if l.unlockProvider != nil {
+		err := autoUnlock(w, l.unlockProvider, l.unlockTimeout)
+		if err != nil {
+			log.Warnf("Unable to automatically unlock wallet: %v", err)
+		} else {
+			log.Infof("Wallet automatically unlocked")
+		}
+	}
+
 	l.onLoaded(w, db)
 	return w, nil
 }
@@ -0,0 +1,217 @@
+package wallet
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestOpenCallbacksProvider ensures OpenExistingWallet obtains its upgrade
+// callbacks from the configured provider.
+func TestOpenCallbacksProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loader_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	pubPass := []byte("hello")
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, true, 250)
+	_, err = loader.CreateNewWallet(pubPass, []byte("world"), nil, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	if err := loader.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+
+	var calls int
+	var consolePrompt bool
+	loader.SetOpenCallbacksProvider(func(canConsolePrompt bool) *waddrmgr.OpenCallbacks {
+		calls++
+		consolePrompt = canConsolePrompt
+		return UpgradeSecrets(nil, nil)(canConsolePrompt)
+	})
+	if _, err := loader.OpenExistingWallet(pubPass, false); err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+	defer loader.UnloadWallet()
+
+	if calls != 1 || consolePrompt {
+		t.Fatalf("provider called %d times with console prompt %v",
+			calls, consolePrompt)
+	}
+}
+
+// TestScryptOptions ensures wallets are created with the configured scrypt
+// parameters, which are kept when the wallet is reopened and its passphrase
+// changed, and that unsafe parameters are rejected.
+func TestScryptOptions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loader_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, true, 250)
+	invalid := []waddrmgr.ScryptOptions{
+		{N: 16, R: 8, P: 1},
+		{N: 5000, R: 8, P: 1},
+		{N: 1 << 20, R: 0, P: 1},
+		{N: 1 << 20, R: 16, P: 1},
+		{N: 1 << 14, R: 8, P: 0},
+		{N: 1 << 14, R: 8, P: 17},
+	}
+	for _, opts := range invalid {
+		err := loader.SetScryptOptions(&opts)
+		if !errors.Is(err, ErrInvalidScryptOptions) {
+			t.Fatalf("options %+v: got error %v, want "+
+				"ErrInvalidScryptOptions", opts, err)
+		}
+	}
+
+	opts := waddrmgr.ScryptOptions{N: 1 << 12, R: 8, P: 2}
+	if err := loader.SetScryptOptions(&opts); err != nil {
+		t.Fatalf("unable to set scrypt options: %v", err)
+	}
+	pubPass := []byte("hello")
+	w, err := loader.CreateNewWallet(pubPass, []byte("world"), nil, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	if err := w.ChangePrivatePassphrase([]byte("world"), []byte("new")); err != nil {
+		t.Fatalf("unable to change passphrase: %v", err)
+	}
+	if err := loader.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+
+	w, err = loader.OpenExistingWallet(pubPass, false)
+	if err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+	defer loader.UnloadWallet()
+	if err := w.Unlock([]byte("new"), nil); err != nil {
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+	for _, private := range []bool{false, true} {
+		if got := w.Manager.ScryptOptions(private); got != opts {
+			t.Fatalf("got scrypt options %+v (private %v), want %+v",
+				got, private, opts)
+		}
+	}
+}
+
+// TestUpgradeSecrets ensures the callbacks returned by UpgradeSecrets supply
+// the configured secrets and fail for those not provided.
+func TestUpgradeSecrets(t *testing.T) {
+	t.Parallel()
+
+	privPass := []byte("world")
+	cbs := UpgradeSecrets(nil, privPass)(false)
+
+	pass, err := cbs.ObtainPrivatePass()
+	if err != nil {
+		t.Fatalf("unable to obtain private passphrase: %v", err)
+	}
+	if !bytes.Equal(pass, privPass) {
+		t.Fatalf("got private passphrase %q, want %q", pass, privPass)
+	}
+
+	// Callers may zero the returned passphrase, which must not affect
+	// subsequent calls.
+	pass[0] = 0
+	if pass, _ := cbs.ObtainPrivatePass(); !bytes.Equal(pass, privPass) {
+		t.Fatalf("private passphrase modified by caller")
+	}
+
+	if _, err := cbs.ObtainSeed(); err != errNoConsole {
+		t.Fatalf("got error %v obtaining seed, want %v", err,
+			errNoConsole)
+	}
+}
+
+// recordingPassphrase is a PassphraseProvider keeping the returned slice so
+// tests may check it was zeroed.
+type recordingPassphrase struct {
+	pass []byte
+}
+
+func (r *recordingPassphrase) PrivatePassphrase() ([]byte, error) {
+	r.pass = []byte("world")
+	return r.pass, nil
+}
+
+// TestAutoUnlock ensures wallets are unlocked on open using the configured
+// passphrase provider, that the passphrase is zeroed afterwards, and that
+// insecure passphrase files are refused.
+func TestAutoUnlock(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loader_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	pubPass := []byte("hello")
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, true, 250)
+	_, err = loader.CreateNewWallet(pubPass, []byte("world"), nil, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	if err := loader.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+
+	provider := &recordingPassphrase{}
+	loader.SetAutoUnlock(provider, 0)
+	w, err := loader.OpenExistingWallet(pubPass, false)
+	if err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+	if w.Locked() {
+		t.Fatalf("wallet not unlocked on open")
+	}
+	if !bytes.Equal(provider.pass, make([]byte, len(provider.pass))) {
+		t.Fatalf("passphrase not zeroed after unlocking")
+	}
+	if err := loader.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+
+	// A passphrase file readable by other users is refused and the wallet
+	// stays locked.
+	passFile := filepath.Join(dir, "passphrase")
+	if err := ioutil.WriteFile(passFile, []byte("world\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	loader.SetAutoUnlock(FilePassphrase(passFile), 0)
+	w, err = loader.OpenExistingWallet(pubPass, false)
+	if err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+	if !w.Locked() {
+		t.Fatalf("wallet unlocked with insecure passphrase file")
+	}
+	if err := loader.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+
+	if err := os.Chmod(passFile, 0600); err != nil {
+		t.Fatal(err)
+	}
+	w, err = loader.OpenExistingWallet(pubPass, false)
+	if err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+	defer loader.UnloadWallet()
+	if w.Locked() {
+		t.Fatalf("wallet not unlocked with passphrase file")
+	}
+}
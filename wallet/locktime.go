@@ -0,0 +1,68 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// scriptLocksBucketKey is the key of the bucket of the wallet namespace
+// holding the lock heights of imported P2SH redeem scripts which begin with a
+// height-based CHECKLOCKTIMEVERIFY condition, keyed by script hash.
+var scriptLocksBucketKey = []byte("scriptlocks")
+
+// putScriptLockHeight records the lock height of an imported redeem script if
+// it begins with a height-based CHECKLOCKTIMEVERIFY condition.  Redeem
+// scripts are encrypted by the address manager, so the lock height is kept
+// separately to determine the time-locked balance of a locked wallet.
+func putScriptLockHeight(dbtx walletdb.ReadWriteTx, scriptHash,
+	script []byte) error {
+
+	height, ok := wtxmgr.ScriptLockHeight(script)
+	if !ok {
+		return nil
+	}
+	ns := dbtx.ReadWriteBucket(walletNamespaceKey)
+	if ns == nil {
+		return errors.New("missing wallet namespace")
+	}
+	bucket, err := ns.CreateBucketIfNotExists(scriptLocksBucketKey)
+	if err != nil {
+		return err
+	}
+	var v [4]byte
+	binary.BigEndian.PutUint32(v[:], uint32(height))
+	return bucket.Put(scriptHash, v[:])
+}
+
+// creditLockHeight returns the block height an unspent output is time-locked
+// until.  Besides the lock recorded by the transaction store, outputs paying
+// to an imported P2SH redeem script beginning with a height-based
+// CHECKLOCKTIMEVERIFY condition are locked until that height.
+func creditLockHeight(dbtx walletdb.ReadTx, output *wtxmgr.Credit) int32 {
+	height := output.LockHeight
+	if !txscript.IsPayToScriptHash(output.PkScript) {
+		return height
+	}
+	ns := dbtx.ReadBucket(walletNamespaceKey)
+	if ns == nil {
+		return height
+	}
+	bucket := ns.NestedReadBucket(scriptLocksBucketKey)
+	if bucket == nil {
+		return height
+	}
+
+	// A P2SH output script is OP_HASH160 <20-byte hash> OP_EQUAL.
+	v := bucket.Get(output.PkScript[2:22])
+	if len(v) != 4 {
+		return height
+	}
+	if scriptHeight := int32(binary.BigEndian.Uint32(v)); scriptHeight > height {
+		height = scriptHeight
+	}
+	return height
+}
@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestSpendableAtTimeLockedScript ensures outputs paying to an imported P2SH
+// redeem script with a height-based CHECKLOCKTIMEVERIFY condition are reported
+// as time-locked until that height, even while the wallet is locked.
+func TestSpendableAtTimeLockedScript(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	pubKey, err := w.PubKeyForAddress(addr)
+	if err != nil {
+		t.Fatalf("unable to get public key: %v", err)
+	}
+
+	const lockHeight = 500
+	script, err := txscript.NewScriptBuilder().
+		AddInt64(lockHeight).
+		AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).
+		AddOp(txscript.OP_DROP).
+		AddData(pubKey.SerializeCompressed()).
+		AddOp(txscript.OP_CHECKSIG).
+		Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p2shAddr, err := w.ImportP2SHRedeemScript(script)
+	if err != nil {
+		t.Fatalf("unable to import redeem script: %v", err)
+	}
+	addTestCredits(t, w, 100, 200, []bchutil.Address{p2shAddr},
+		[]int64{1e8})
+
+	account := uint32(waddrmgr.ImportedAddrAccount)
+	for height, want := range map[int32]bchutil.Amount{
+		200:            0,
+		lockHeight - 1: 0,
+		lockHeight:     1e8,
+	} {
+		amt, err := w.SpendableAt(height, account)
+		if err != nil {
+			t.Fatalf("unable to get spendable balance: %v", err)
+		}
+		if amt != want {
+			t.Fatalf("spendable at height %d is %v, want %v",
+				height, amt, want)
+		}
+	}
+
+	// The redeem script can not be decrypted while the wallet is locked,
+	// but the output is still reported as time-locked.
+	w.Lock()
+	bals, err := w.CalculateAccountBalances(account, 1)
+	if err != nil {
+		t.Fatalf("unable to calculate balances: %v", err)
+	}
+	if bals.TimeLocked != 1e8 || bals.Spendable != 0 {
+		t.Fatalf("got time-locked balance %v and spendable balance "+
+			"%v, want %v and 0", bals.TimeLocked, bals.Spendable,
+			bchutil.Amount(1e8))
+	}
+}
@@ -0,0 +1,170 @@
+package wallet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// mempoolClient is implemented by chain clients able to query the mempool of
+// the chain server.
+type mempoolClient interface {
+	GetMempoolEntry(txHash string) (*btcjson.GetMempoolEntryResult, error)
+	GetRawMempoolVerbose() (map[string]btcjson.GetRawMempoolVerboseResult, error)
+}
+
+// MempoolStatus describes the state of a transaction in the mempool of the
+// chain server.
+type MempoolStatus struct {
+	// InMempool is whether the transaction is in the mempool.  The fields
+	// describing the mempool entry are only set when it is.
+	InMempool bool
+
+	// Fee is the fee paid by the transaction and Size its serialized size.
+	Fee  bchutil.Amount
+	Size int32
+
+	// Time and Height are the time and best block height when the
+	// transaction entered the mempool.
+	Time   time.Time
+	Height int32
+
+	// AncestorCount and DescendantCount are the numbers of unconfirmed
+	// transactions in the mempool the transaction depends on and that
+	// depend on it, each including the transaction itself.
+	AncestorCount   int64
+	DescendantCount int64
+
+	// Confirmed is set when the transaction is not in the mempool and the
+	// wallet has recorded it as mined.  A transaction that is neither in
+	// the mempool nor confirmed was dropped or never relayed.
+	Confirmed bool
+}
+
+// MempoolStatus queries the chain server for the mempool entry of the
+// transaction with the given hash.  If it is not in the mempool, the returned
+// status reports whether the wallet knows the transaction to be mined.
+func (w *Wallet) MempoolStatus(txHash *chainhash.Hash) (*MempoolStatus, error) {
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+	client, ok := chainClient.(mempoolClient)
+	if !ok {
+		return nil, fmt.Errorf("chain backend %s does not support mempool "+
+			"queries", chainClient.BackEnd())
+	}
+
+	status, err := mempoolEntry(client, txHash)
+	if err != nil || status.InMempool {
+		return status, err
+	}
+
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		details, err := w.TxStore.TxDetails(txmgrNs, txHash)
+		if err != nil {
+			return err
+		}
+		status.Confirmed = details != nil && details.Block.Height != -1
+		return nil
+	})
+	return status, err
+}
+
+// mempoolEntry returns the mempool status of a transaction using the
+// getmempoolentry RPC.  Chain servers which do not implement it are queried
+// using the verbose getrawmempool RPC instead.
+func mempoolEntry(client mempoolClient, txHash *chainhash.Hash) (*MempoolStatus, error) {
+	entry, err := client.GetMempoolEntry(txHash.String())
+	if rpcErr, ok := err.(*btcjson.RPCError); ok {
+		switch rpcErr.Code {
+		case btcjson.ErrRPCNoTxInfo:
+			return &MempoolStatus{}, nil
+		case btcjson.ErrRPCUnimplemented:
+			return rawMempoolEntry(client, txHash)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := bchutil.NewAmount(entry.Fee)
+	if err != nil {
+		return nil, err
+	}
+	return &MempoolStatus{
+		InMempool:       true,
+		Fee:             fee,
+		Size:            entry.Size,
+		Time:            time.Unix(entry.Time, 0),
+		Height:          int32(entry.Height),
+		AncestorCount:   entry.AncestorCount,
+		DescendantCount: entry.DescendantCount,
+	}, nil
+}
+
+// rawMempoolEntry returns the mempool status of a transaction from the
+// verbose contents of the mempool, counting its ancestors and descendants by
+// following the dependencies between mempool transactions.
+func rawMempoolEntry(client mempoolClient, txHash *chainhash.Hash) (*MempoolStatus, error) {
+	mempool, err := client.GetRawMempoolVerbose()
+	if err != nil {
+		return nil, err
+	}
+	txid := txHash.String()
+	entry, ok := mempool[txid]
+	if !ok {
+		return &MempoolStatus{}, nil
+	}
+
+	spenders := make(map[string][]string)
+	for spender, e := range mempool {
+		for _, dep := range e.Depends {
+			spenders[dep] = append(spenders[dep], spender)
+		}
+	}
+	ancestors := countReachable(txid, func(id string) []string {
+		return mempool[id].Depends
+	})
+	descendants := countReachable(txid, func(id string) []string {
+		return spenders[id]
+	})
+
+	fee, err := bchutil.NewAmount(entry.Fee)
+	if err != nil {
+		return nil, err
+	}
+	return &MempoolStatus{
+		InMempool:       true,
+		Fee:             fee,
+		Size:            entry.Size,
+		Time:            time.Unix(entry.Time, 0),
+		Height:          int32(entry.Height),
+		AncestorCount:   ancestors,
+		DescendantCount: descendants,
+	}, nil
+}
+
+// countReachable returns the number of transactions reachable from txid,
+// including itself, by repeatedly following the edges returned by next.
+func countReachable(txid string, next func(string) []string) int64 {
+	seen := map[string]struct{}{txid: {}}
+	queue := []string{txid}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		for _, n := range next(id) {
+			if _, ok := seen[n]; ok {
+				continue
+			}
+			seen[n] = struct{}{}
+			queue = append(queue, n)
+		}
+	}
+	return int64(len(seen))
+}
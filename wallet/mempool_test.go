@@ -0,0 +1,113 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// mempoolChainClient is a mock chain client serving mempool queries.  If
+// entry is nil, getmempoolentry fails with entryErr.
+type mempoolChainClient struct {
+	mockChainClient
+	entry    *btcjson.GetMempoolEntryResult
+	entryErr error
+	mempool  map[string]btcjson.GetRawMempoolVerboseResult
+}
+
+func (c *mempoolChainClient) GetMempoolEntry(string) (
+	*btcjson.GetMempoolEntryResult, error) {
+
+	if c.entry == nil {
+		return nil, c.entryErr
+	}
+	return c.entry, nil
+}
+
+func (c *mempoolChainClient) GetRawMempoolVerbose() (
+	map[string]btcjson.GetRawMempoolVerboseResult, error) {
+
+	return c.mempool, nil
+}
+
+// TestMempoolStatus ensures the mempool status of transactions is reported
+// from either mempool RPC and that mined transactions are reported as
+// confirmed when absent from the mempool.
+func TestMempoolStatus(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	txHash := chainhash.Hash{1}
+	w.chainClient = &mempoolChainClient{
+		entry: &btcjson.GetMempoolEntryResult{
+			Size: 225, Fee: 0.00000226, Time: 1600000000, Height: 100,
+			AncestorCount: 2, DescendantCount: 1,
+		},
+	}
+	status, err := w.MempoolStatus(&txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := MempoolStatus{
+		InMempool: true, Fee: 226, Size: 225, Height: 100,
+		AncestorCount: 2, DescendantCount: 1,
+	}
+	want.Time = status.Time
+	if *status != want || status.Time.Unix() != 1600000000 {
+		t.Fatalf("got status %+v, want %+v", status, want)
+	}
+
+	// Chain servers without getmempoolentry are queried with
+	// getrawmempool.  The transaction spends one mempool transaction,
+	// which spends another, and is spent by a fourth.
+	parent := chainhash.Hash{2}
+	grandparent := chainhash.Hash{3}
+	child := chainhash.Hash{4}
+	w.chainClient = &mempoolChainClient{
+		entryErr: &btcjson.RPCError{Code: btcjson.ErrRPCUnimplemented},
+		mempool: map[string]btcjson.GetRawMempoolVerboseResult{
+			txHash.String():      {Size: 225, Fee: 0.00000226, Depends: []string{parent.String()}},
+			parent.String():      {Depends: []string{grandparent.String()}},
+			grandparent.String(): {},
+			child.String():       {Depends: []string{txHash.String()}},
+		},
+	}
+	status, err = w.MempoolStatus(&txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !status.InMempool || status.Fee != 226 || status.AncestorCount != 3 ||
+		status.DescendantCount != 2 {
+
+		t.Fatalf("unexpected status from getrawmempool: %+v", status)
+	}
+
+	// A transaction missing from the mempool is confirmed only if the
+	// wallet has it recorded as mined.
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := addTestCredits(t, w, 100, 101, []bchutil.Address{addr},
+		[]int64{1e8})
+	w.chainClient = &mempoolChainClient{
+		entryErr: &btcjson.RPCError{Code: btcjson.ErrRPCNoTxInfo},
+	}
+	status, err = w.MempoolStatus(&rec.Hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.InMempool || !status.Confirmed {
+		t.Fatalf("mined transaction not reported as confirmed: %+v", status)
+	}
+	status, err = w.MempoolStatus(&txHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.InMempool || status.Confirmed {
+		t.Fatalf("dropped transaction reported as present: %+v", status)
+	}
+}
@@ -0,0 +1,125 @@
+package wallet
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/walletdb/migration"
+)
+
+// walletVersions is a list of the different wallet-level database versions,
+// covering state kept by the wallet itself rather than by the address or
+// transaction managers, which version their own namespaces.  The last entry
+// should reflect the latest database state.  If the database happens to be at
+// a version number lower than the latest, migrations will be performed in
+// order to catch it up.
+var walletVersions = []migration.Version{
+	{
+		Number:    1,
+		Migration: nil,
+	},
+	{
+		Number:    2,
+		Migration: createLabelsNamespace,
+	},
+}
+
+// walletVersionKey is the key of the wallet-level database version in the
+// wallet namespace.
+var walletVersionKey = []byte("ver")
+
+// latestWalletVersion returns the version number of the latest wallet-level
+// database version.
+func latestWalletVersion() uint32 {
+	return walletVersions[len(walletVersions)-1].Number
+}
+
+// walletMigrationManager is an implementation of the migration.Manager
+// interface that is used to handle wallet-level migrations.
+type walletMigrationManager struct {
+	ns walletdb.ReadWriteBucket
+}
+
+// A compile-time assertion to ensure that walletMigrationManager implements
+// the migration.Manager interface.
+var _ migration.Manager = (*walletMigrationManager)(nil)
+
+// Name returns the name of the service we'll be attempting to upgrade.
+//
+// NOTE: This method is part of the migration.Manager interface.
+func (m *walletMigrationManager) Name() string {
+	return "wallet"
+}
+
+// Namespace returns the top-level bucket of the service.
+//
+// NOTE: This method is part of the migration.Manager interface.
+func (m *walletMigrationManager) Namespace() walletdb.ReadWriteBucket {
+	return m.ns
+}
+
+// CurrentVersion returns the current version of the service's database.
+// Wallets created before the wallet-level version was introduced have no
+// version stored and are at version zero.
+//
+// NOTE: This method is part of the migration.Manager interface.
+func (m *walletMigrationManager) CurrentVersion(ns walletdb.ReadBucket) (uint32, error) {
+	return readWalletVersion(m.ns)
+}
+
+// readWalletVersion reads the wallet-level database version from the wallet
+// namespace.
+func readWalletVersion(ns walletdb.ReadBucket) (uint32, error) {
+	v := ns.Get(walletVersionKey)
+	switch len(v) {
+	case 0:
+		return 0, nil
+	case 4:
+		return binary.BigEndian.Uint32(v), nil
+	default:
+		return 0, fmt.Errorf("malformed wallet database version %x", v)
+	}
+}
+
+// SetVersion sets the version of the service's database.
+//
+// NOTE: This method is part of the migration.Manager interface.
+func (m *walletMigrationManager) SetVersion(ns walletdb.ReadWriteBucket,
+	version uint32) error {
+
+	var v [4]byte
+	binary.BigEndian.PutUint32(v[:], version)
+	return m.ns.Put(walletVersionKey, v[:])
+}
+
+// Versions returns all of the available database versions of the service.
+//
+// NOTE: This method is part of the migration.Manager interface.
+func (m *walletMigrationManager) Versions() []migration.Version {
+	return walletVersions
+}
+
+// checkWalletVersion returns ErrWalletVersion if the wallet-level database
+// version is newer than the latest version known to this software, in which
+// case opening the wallet could corrupt state it does not understand.
+func checkWalletVersion(m *walletMigrationManager) error {
+	version, err := m.CurrentVersion(nil)
+	if err != nil {
+		return err
+	}
+	if latest := latestWalletVersion(); version > latest {
+		return fmt.Errorf("%w: database version %d, latest supported "+
+			"version %d", ErrWalletVersion, version, latest)
+	}
+	return nil
+}
+
+// createLabelsNamespace is the migration to wallet version 2, which creates the
+// labels namespace holding transaction and address labels.  It is a top-level
+// namespace of its own, so it is created through the transaction of the wallet
+// namespace.
+func createLabelsNamespace(ns walletdb.ReadWriteBucket) error {
+	_, err := ns.Tx().CreateTopLevelBucket(labelsNamespaceKey)
+	return err
+}
@@ -0,0 +1,150 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchwallet/walletdb"
+	_ "github.com/gcash/bchwallet/walletdb/bdb"
+)
+
+// TestWalletVersion ensures wallets record the wallet-level database version,
+// that wallets created before it existed are upgraded on open, and that
+// wallets written by newer software are refused.
+func TestWalletVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrations_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := walletdb.Create("bdb", filepath.Join(dir, "wallet.db"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	pubPass := []byte("hello")
+	params := &chaincfg.TestNet3Params
+	err = Create(db, pubPass, []byte("world"), nil, params, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+
+	version := func() uint32 {
+		t.Helper()
+		var v uint32
+		err := walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(walletNamespaceKey)
+			if ns == nil {
+				return errors.New("missing wallet namespace")
+			}
+			var err error
+			v, err = (&walletMigrationManager{ns: ns}).CurrentVersion(nil)
+			return err
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	if v := version(); v != latestWalletVersion() {
+		t.Fatalf("created wallet at version %d, want %d", v,
+			latestWalletVersion())
+	}
+
+	// A wallet without a wallet namespace is upgraded to the latest
+	// version.
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		return tx.DeleteTopLevelBucket(walletNamespaceKey)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(db, pubPass, nil, params, 0); err != nil {
+		t.Fatalf("unable to open wallet without version: %v", err)
+	}
+	if v := version(); v != latestWalletVersion() {
+		t.Fatalf("opened wallet at version %d, want %d", v,
+			latestWalletVersion())
+	}
+
+	// A wallet written by newer software is refused and left untouched.
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(walletNamespaceKey)
+		return (&walletMigrationManager{ns: ns}).SetVersion(ns,
+			latestWalletVersion()+1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = Open(db, pubPass, nil, params, 0)
+	if !errors.Is(err, ErrWalletVersion) {
+		t.Fatalf("got error %v, want ErrWalletVersion", err)
+	}
+	if v := version(); v != latestWalletVersion()+1 {
+		t.Fatalf("refused wallet changed to version %d", v)
+	}
+}
+
+// TestLabelsNamespaceMigration ensures that wallets at a version predating
+// labels have the labels namespace created when they are opened.
+func TestLabelsNamespaceMigration(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migrations_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	db, err := walletdb.Create("bdb", filepath.Join(dir, "wallet.db"), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	pubPass := []byte("hello")
+	params := &chaincfg.TestNet3Params
+	err = Create(db, pubPass, []byte("world"), nil, params, time.Now())
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+
+	// Revert the wallet to version 1, before the labels namespace.
+	err = walletdb.Update(db, func(tx walletdb.ReadWriteTx) error {
+		if err := tx.DeleteTopLevelBucket(labelsNamespaceKey); err != nil {
+			return err
+		}
+		ns := tx.ReadWriteBucket(walletNamespaceKey)
+		return (&walletMigrationManager{ns: ns}).SetVersion(ns, 1)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Open(db, pubPass, nil, params, 0); err != nil {
+		t.Fatalf("unable to open wallet: %v", err)
+	}
+	err = walletdb.View(db, func(tx walletdb.ReadTx) error {
+		if tx.ReadBucket(labelsNamespaceKey) == nil {
+			return errors.New("missing labels namespace")
+		}
+		version, err := readWalletVersion(tx.ReadBucket(walletNamespaceKey))
+		if err != nil {
+			return err
+		}
+		if version != latestWalletVersion() {
+			return fmt.Errorf("opened wallet at version %d, want %d",
+				version, latestWalletVersion())
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -71,7 +71,11 @@ func (w *Wallet) MakeMultiSigScript(addrs []bchutil.Address, nRequired int) ([]b
 	return txscript.MultiSigScript(pubKeys, nRequired)
 }
 
-// ImportP2SHRedeemScript adds a P2SH redeem script to the wallet.
+// ImportP2SHRedeemScript adds a P2SH redeem script to the wallet.  Outputs
+// paying to a redeem script beginning with a height-based
+// CHECKLOCKTIMEVERIFY condition are reported as time-locked until that
+// height.  Importing a script already known to the wallet records its lock
+// height if it was imported before lock heights were recorded.
 func (w *Wallet) ImportP2SHRedeemScript(script []byte) (*bchutil.AddressScriptHash, error) {
 	var p2shAddr *bchutil.AddressScriptHash
 	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
@@ -92,6 +96,11 @@ func (w *Wallet) ImportP2SHRedeemScript(script []byte) (*bchutil.AddressScriptHa
 			return err
 		}
 
+		err = putScriptLockHeight(tx, bchutil.Hash160(script), script)
+		if err != nil {
+			return err
+		}
+
 		addrInfo, err := bip44Mgr.ImportScript(addrmgrNs, script, bs)
 		if err != nil {
 			// Don't care if it's already there, but still have to
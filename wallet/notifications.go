@@ -37,6 +37,8 @@ type NotificationServer struct {
 	spentness      map[uint32][]chan *SpentnessNotifications
 	accountClients []chan *AccountNotification
 	rescanClients  []chan *RescanNotification
+	balanceClients []chan *BalanceMismatchError
+	resyncClients  []chan *ResyncNotification
 	mu             sync.Mutex // Only protects registered client channels
 	wallet         *Wallet    // smells like hacks
 }
@@ -64,8 +66,10 @@ func lookupInputAccount(dbtx walletdb.ReadTx, w *Wallet, details *wtxmgr.TxDetai
 		log.Errorf("Missing previous transaction %v", prevOP.Hash)
 		return 0
 	}
-	prevOut := prev.MsgTx.TxOut[prevOP.Index]
-	_, addrs, _, err := txscript.ExtractPkScriptAddrs(prevOut.PkScript, w.chainParams)
+	// The script of the spent credit remains recorded if the previous
+	// transaction was pruned.
+	pkScript := prev.CreditPkScript(prevOP.Index)
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, w.chainParams)
 	var inputAcct uint32
 	if err == nil && len(addrs) > 0 {
 		_, inputAcct, err = w.Manager.AddrAccount(addrmgrNs, addrs[0])
@@ -97,6 +101,25 @@ func lookupOutputChain(dbtx walletdb.ReadTx, w *Wallet, details *wtxmgr.TxDetail
 	return
 }
 
+// txFee returns the fee paid by a transaction if every input spends a wallet
+// output, and zero otherwise since the fee of transactions with foreign inputs
+// can not be calculated and is not paid (solely) by the wallet.
+func txFee(details *wtxmgr.TxDetails) bchutil.Amount {
+	if details.Pruned {
+		return details.PrunedFee
+	}
+	var fee bchutil.Amount
+	if len(details.Debits) == len(details.MsgTx.TxIn) {
+		for _, deb := range details.Debits {
+			fee += deb.Amount
+		}
+		for _, txOut := range details.MsgTx.TxOut {
+			fee -= bchutil.Amount(txOut.Value)
+		}
+	}
+	return fee
+}
+
 func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *wtxmgr.TxDetails) TransactionSummary {
 	serializedTx := details.SerializedTx
 	if serializedTx == nil {
@@ -107,14 +130,12 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *wtxmgr.TxDetails) T
 		}
 		serializedTx = buf.Bytes()
 	}
-	var fee bchutil.Amount
-	if len(details.Debits) == len(details.MsgTx.TxIn) {
-		for _, deb := range details.Debits {
-			fee += deb.Amount
-		}
-		for _, txOut := range details.MsgTx.TxOut {
-			fee -= bchutil.Amount(txOut.Value)
-		}
+	fee := txFee(details)
+	label := fetchLabel(dbtx, txLabelsBucketKey, details.Hash[:])
+	if details.Pruned {
+		summary := makePrunedTxSummary(details, fee)
+		summary.Label = label
+		return summary
 	}
 	var inputs []TransactionSummaryInput
 	if len(details.Debits) != 0 {
@@ -158,6 +179,35 @@ func makeTxSummary(dbtx walletdb.ReadTx, w *Wallet, details *wtxmgr.TxDetails) T
 		MyOutputs:   outputs,
 		Fee:         fee,
 		Timestamp:   details.Received.Unix(),
+		Label:       label,
+	}
+}
+
+// makePrunedTxSummary creates the summary of a transaction pruned from the
+// transaction store.  Only the amounts of the wallet's inputs and outputs are
+// known, so the serialized transaction, accounts, and addresses are omitted.
+func makePrunedTxSummary(details *wtxmgr.TxDetails, fee bchutil.Amount) TransactionSummary {
+	var inputs []TransactionSummaryInput
+	for _, d := range details.Debits {
+		inputs = append(inputs, TransactionSummaryInput{
+			Index:          d.Index,
+			PreviousAmount: d.Amount,
+		})
+	}
+	var outputs []TransactionSummaryOutput
+	for _, c := range details.Credits {
+		outputs = append(outputs, TransactionSummaryOutput{
+			Index:    c.Index,
+			Internal: c.Change,
+			Amount:   c.Amount,
+		})
+	}
+	return TransactionSummary{
+		Hash:      &details.Hash,
+		MyInputs:  inputs,
+		MyOutputs: outputs,
+		Fee:       fee,
+		Timestamp: details.Received.Unix(),
 	}
 }
 
@@ -375,6 +425,9 @@ type TransactionSummary struct {
 	MyOutputs   []TransactionSummaryOutput
 	Fee         bchutil.Amount
 	Timestamp   int64
+
+	// Label is the label of the transaction, or empty if it has none.
+	Label string
 }
 
 // TransactionSummaryInput describes a transaction input that is relevant to the
@@ -715,3 +768,122 @@ func (c *RescanNotificationsClient) Done() {
 		s.mu.Unlock()
 	}()
 }
+
+func (s *NotificationServer) notifyBalanceMismatch(n *BalanceMismatchError) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.balanceClients {
+		c <- n
+	}
+}
+
+// BalanceMismatchNotificationsClient receives the errors of failed periodic
+// balance self-checks over the channel C.
+type BalanceMismatchNotificationsClient struct {
+	C      chan *BalanceMismatchError
+	server *NotificationServer
+}
+
+// BalanceMismatchNotifications returns a client for receiving the errors of
+// failed periodic balance self-checks over a channel.  The channel is
+// unbuffered.  When finished, the client's Done method should be called to
+// disassociate the client from the server.
+func (s *NotificationServer) BalanceMismatchNotifications() BalanceMismatchNotificationsClient {
+	c := make(chan *BalanceMismatchError)
+	s.mu.Lock()
+	s.balanceClients = append(s.balanceClients, c)
+	s.mu.Unlock()
+	return BalanceMismatchNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *BalanceMismatchNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.balanceClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.balanceClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
+
+// ResyncNotification describes the wallet discarding its transaction history
+// and resyncing from its birthday block because a chain reorganization was
+// deeper than the maximum depth it rolls back block by block, or reached
+// transactions pruned from the transaction store.
+type ResyncNotification struct {
+	// SyncedTo is the block the wallet was synced to when the reorg was
+	// detected, and Birthday the block the wallet resyncs from.
+	SyncedTo waddrmgr.BlockStamp
+	Birthday waddrmgr.BlockStamp
+
+	MaxRollbackDepth int32
+}
+
+func (s *NotificationServer) notifyResync(n *ResyncNotification) {
+	defer s.mu.Unlock()
+	s.mu.Lock()
+	for _, c := range s.resyncClients {
+		c <- n
+	}
+}
+
+// ResyncNotificationsClient receives ResyncNotifications over the channel C.
+type ResyncNotificationsClient struct {
+	C      chan *ResyncNotification
+	server *NotificationServer
+}
+
+// ResyncNotifications returns a client for receiving ResyncNotifications over
+// a channel.  The channel is unbuffered.  When finished, the client's Done
+// method should be called to disassociate the client from the server.
+func (s *NotificationServer) ResyncNotifications() ResyncNotificationsClient {
+	c := make(chan *ResyncNotification)
+	s.mu.Lock()
+	s.resyncClients = append(s.resyncClients, c)
+	s.mu.Unlock()
+	return ResyncNotificationsClient{
+		C:      c,
+		server: s,
+	}
+}
+
+// Done deregisters the client from the server and drains any remaining
+// messages.  It must be called exactly once when the client is finished
+// receiving notifications.
+func (c *ResyncNotificationsClient) Done() {
+	go func() {
+		for range c.C {
+		}
+	}()
+	go func() {
+		s := c.server
+		s.mu.Lock()
+		clients := s.resyncClients
+		for i, ch := range clients {
+			if c.C == ch {
+				clients[i] = clients[len(clients)-1]
+				s.resyncClients = clients[:len(clients)-1]
+				close(ch)
+				break
+			}
+		}
+		s.mu.Unlock()
+	}()
+}
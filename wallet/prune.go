@@ -0,0 +1,66 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/chain"
+)
+
+// pruneInterval is the number of blocks between passes pruning spent
+// transactions from the transaction store when pruning is enabled.
+const pruneInterval = 144
+
+// ErrTxNotFound describes an error where a transaction is not recorded by the
+// wallet.
+var ErrTxNotFound = errors.New("transaction not found")
+
+// rawTransactionClient is implemented by chain clients able to look up
+// transactions by their hash.
+type rawTransactionClient interface {
+	GetRawTransaction(*chainhash.Hash) (*bchutil.Tx, error)
+}
+
+// GetRawTransaction returns a transaction recorded by the wallet.  When the
+// serialized transaction has been pruned from the transaction store, it is
+// fetched from the chain server instead, which for bchd requires the
+// transaction index to be enabled.  ErrTxNotFound is returned if the wallet
+// has no record of the transaction.
+func (w *Wallet) GetRawTransaction(txHash *chainhash.Hash) (*wire.MsgTx, error) {
+	details, err := UnstableAPI(w).TxDetails(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if details == nil {
+		return nil, ErrTxNotFound
+	}
+	if !details.Pruned {
+		return &details.MsgTx, nil
+	}
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+	return fetchRawTransaction(chainClient, txHash)
+}
+
+// fetchRawTransaction looks up a transaction using the chain client.
+func fetchRawTransaction(chainClient chain.Interface,
+	txHash *chainhash.Hash) (*wire.MsgTx, error) {
+
+	client, ok := chainClient.(rawTransactionClient)
+	if !ok {
+		return nil, fmt.Errorf("transaction %v is pruned and chain "+
+			"backend %s does not support transaction lookups", txHash,
+			chainClient.BackEnd())
+	}
+	tx, err := client.GetRawTransaction(txHash)
+	if err != nil {
+		return nil, err
+	}
+	return tx.MsgTx(), nil
+}
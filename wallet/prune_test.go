@@ -0,0 +1,231 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// rawTxChainClient is a mock chain client serving a single transaction.
+type rawTxChainClient struct {
+	mockChainClient
+	tx *wire.MsgTx
+}
+
+func (c *rawTxChainClient) GetRawTransaction(*chainhash.Hash) (*bchutil.Tx,
+	error) {
+
+	return bchutil.NewTx(c.tx), nil
+}
+
+// spendTestCredit records a transaction mined at height spending the first
+// output of rec, which pays 9e7 to a script not controlled by the wallet.
+func spendTestCredit(t *testing.T, w *Wallet, rec *wtxmgr.TxRecord,
+	height int32) {
+
+	t.Helper()
+	spend := wire.NewMsgTx(1)
+	spend.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: rec.Hash}, nil))
+	spend.AddTxOut(wire.NewTxOut(9e7, []byte{0x51}, wire.TokenData{}))
+	spendRec, err := wtxmgr.NewTxRecordFromMsgTx(spend, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		block := &wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{
+				Hash:   chainhash.Hash{byte(height)},
+				Height: height,
+			},
+			Time: time.Unix(1387737310, 0),
+		}
+		return w.TxStore.InsertTx(ns, spendRec, block)
+	})
+	if err != nil {
+		t.Fatalf("unable to insert spending transaction: %v", err)
+	}
+}
+
+// pruneTestTransactions prunes the spent transactions mined at or below
+// maxHeight and checks the number pruned.
+func pruneTestTransactions(t *testing.T, w *Wallet, maxHeight int32, want int) {
+	t.Helper()
+	err := walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		n, err := w.TxStore.PruneSpentTransactions(ns, maxHeight)
+		if err != nil {
+			return err
+		}
+		if n != want {
+			t.Fatalf("pruned %d transactions, want %d", n, want)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to prune transactions: %v", err)
+	}
+}
+
+// TestGetRawTransactionPruned ensures transactions pruned from the store are
+// fetched from the chain client.
+func TestGetRawTransactionPruned(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 101, []bchutil.Address{addr},
+		[]int64{1e8})
+
+	spendTestCredit(t, w, rec, 101)
+	pruneTestTransactions(t, w, 101, 2)
+
+	// The default mock chain client can not look up transactions.
+	if _, err := w.GetRawTransaction(&rec.Hash); err == nil {
+		t.Fatal("expected pruned transaction lookup to fail")
+	}
+
+	w.chainClient = &rawTxChainClient{tx: &rec.MsgTx}
+	tx, err := w.GetRawTransaction(&rec.Hash)
+	if err != nil {
+		t.Fatalf("unable to get pruned transaction: %v", err)
+	}
+	var want, got bytes.Buffer
+	rec.MsgTx.Serialize(&want)
+	tx.Serialize(&got)
+	if !bytes.Equal(want.Bytes(), got.Bytes()) {
+		t.Fatal("pruned transaction does not match")
+	}
+
+	if _, err := w.GetRawTransaction(&chainhash.Hash{1}); err != ErrTxNotFound {
+		t.Fatalf("expected ErrTxNotFound, got %v", err)
+	}
+}
+
+// TestPrunedTransactionTotals ensures the amounts received and the transaction
+// lists reported by the wallet are unchanged by pruning.
+func TestPrunedTransactionTotals(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	addr, err := w.NewAddress(0, scope)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 101, []bchutil.Address{addr},
+		[]int64{1e8})
+	spendTestCredit(t, w, rec, 101)
+
+	type totals struct {
+		account  bchutil.Amount
+		addr     bchutil.Amount
+		received float64
+		sent     float64
+		fees     float64
+		results  int
+	}
+	getTotals := func() totals {
+		t.Helper()
+		var tot totals
+		accounts, err := w.TotalReceivedForAccounts(scope, 1)
+		if err != nil {
+			t.Fatalf("unable to get account totals: %v", err)
+		}
+		tot.account = accounts[0].TotalReceived
+		tot.addr, err = w.TotalReceivedForAddr(addr, 1)
+		if err != nil {
+			t.Fatalf("unable to get address total: %v", err)
+		}
+		txs, err := w.ListAllTransactions()
+		if err != nil {
+			t.Fatalf("unable to list transactions: %v", err)
+		}
+		for _, tx := range txs {
+			switch tx.Category {
+			case "send":
+				tot.sent -= tx.Amount
+				if tx.Fee != nil {
+					tot.fees += *tx.Fee
+				}
+			default:
+				if tx.Address != addr.EncodeAddress() {
+					t.Fatalf("received by %v, want %v",
+						tx.Address, addr)
+				}
+				tot.received += tx.Amount
+			}
+		}
+		tot.results = len(txs)
+		return tot
+	}
+
+	before := getTotals()
+	if before.account != 1e8 || before.addr != 1e8 {
+		t.Fatalf("received %v by account and %v by address, want %v",
+			before.account, before.addr, bchutil.Amount(1e8))
+	}
+	pruneTestTransactions(t, w, 101, 2)
+	if after := getTotals(); after != before {
+		t.Fatalf("got totals %+v after pruning, want %+v", after,
+			before)
+	}
+}
+
+// TestLookupInputAccountPruned ensures the account of a wallet input remains
+// known after the transaction of its previous output is pruned.
+func TestLookupInputAccountPruned(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	account, err := w.NextAccount(waddrmgr.KeyScopeBIP0044, "pruned")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	addr, err := w.NewAddress(account, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 101, []bchutil.Address{addr},
+		[]int64{1e8})
+	spendTestCredit(t, w, rec, 101)
+
+	var spend *wtxmgr.TxDetails
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		return w.TxStore.RangeTransactions(ns, 101, 101,
+			func(details []wtxmgr.TxDetails) (bool, error) {
+				spend = &details[0]
+				return true, nil
+			})
+	})
+	if err != nil || spend == nil {
+		t.Fatalf("unable to fetch spending transaction: %v", err)
+	}
+	if len(spend.Debits) != 1 {
+		t.Fatalf("got %d debits, want 1", len(spend.Debits))
+	}
+
+	pruneTestTransactions(t, w, 101, 2)
+
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		got := lookupInputAccount(dbtx, w, spend, spend.Debits[0])
+		if got != account {
+			t.Fatalf("input account is %d, want %d", got, account)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
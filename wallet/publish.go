@@ -0,0 +1,148 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/rpcclient"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchwallet/chain"
+)
+
+const (
+	// DefaultPublishAttempts is the default number of times a transaction
+	// is sent to the chain backend before a connection error is returned
+	// to the caller.
+	DefaultPublishAttempts = 3
+
+	// DefaultPublishRetryDelay is the default delay before the first retry
+	// of a transaction that could not be sent to the chain backend.  The
+	// delay doubles with each subsequent retry.
+	DefaultPublishRetryDelay = time.Second
+)
+
+// TxBroadcaster is the interface implemented by the chain backend and any
+// additional endpoints transactions are broadcast to, such as RPC clients of
+// other bchd nodes.
+type TxBroadcaster interface {
+	SendRawTransaction(tx *wire.MsgTx, allowHighFees bool) (*chainhash.Hash, error)
+}
+
+// TransientPublishError describes a failure to send a transaction to the
+// chain backend because of connection errors that persisted through every
+// attempt.  The transaction was not rejected and is kept by the wallet to be
+// rebroadcast, so publishing it may simply be retried later without
+// rebuilding it.
+type TransientPublishError struct {
+	// Attempts is the number of times the transaction was sent.
+	Attempts int
+
+	// Err is the error returned by the final attempt.
+	Err error
+}
+
+// Error satisfies the error interface.
+func (e *TransientPublishError) Error() string {
+	return fmt.Sprintf("unable to reach chain backend after %d attempts, "+
+		"transaction will be rebroadcast: %v", e.Attempts, e.Err)
+}
+
+// Unwrap returns the error of the final attempt.
+func (e *TransientPublishError) Unwrap() error {
+	return e.Err
+}
+
+// isTransientPublishError returns whether an error returned by the chain
+// backend when sending a transaction is caused by the connection to the
+// backend rather than a rejection of the transaction, in which case sending
+// it again may succeed.
+func isTransientPublishError(err error) bool {
+	if err == rpcclient.ErrClientNotConnected ||
+		err == rpcclient.ErrClientDisconnect {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// sendRawTransaction sends tx to the chain backend and to each additional
+// broadcast endpoint of the wallet concurrently.  The transaction is published
+// if any endpoint accepts it.  Otherwise, if any endpoint could not be reached,
+// its *TransientPublishError is returned so the transaction is kept to be
+// rebroadcast.  Only when every endpoint rejects the transaction is the
+// rejection of the chain backend returned.
+func (w *Wallet) sendRawTransaction(chainClient chain.Interface, tx *wire.MsgTx) error {
+	if len(w.broadcasters) == 0 {
+		return w.sendRawTransactionTo(chainClient, tx)
+	}
+
+	errs := make([]error, len(w.broadcasters)+1)
+	var wg sync.WaitGroup
+	wg.Add(len(errs))
+	send := func(i int, b TxBroadcaster) {
+		defer wg.Done()
+		errs[i] = w.sendRawTransactionTo(b, tx)
+	}
+	go send(0, chainClient)
+	for i, b := range w.broadcasters {
+		go send(i+1, b)
+	}
+	wg.Wait()
+
+	published := false
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			published = true
+		case i > 0:
+			log.Debugf("Unable to send transaction %v to broadcast "+
+				"endpoint %d: %v", tx.TxHash(), i, err)
+		}
+	}
+	if published {
+		return nil
+	}
+	for _, err := range errs {
+		if _, ok := err.(*TransientPublishError); ok {
+			return err
+		}
+	}
+	return errs[0]
+}
+
+// sendRawTransactionTo sends tx to a single endpoint, retrying with an
+// exponential backoff when the send fails because of a connection error.
+// Rejections of the transaction are returned immediately.  If every attempt
+// fails because of a connection error, a *TransientPublishError is returned.
+func (w *Wallet) sendRawTransactionTo(b TxBroadcaster, tx *wire.MsgTx) error {
+	attempts := int(w.publishAttempts)
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := w.publishRetryDelay
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		_, err = b.SendRawTransaction(tx, false)
+		if err == nil || !isTransientPublishError(err) {
+			return err
+		}
+		if attempt == attempts {
+			return &TransientPublishError{Attempts: attempt, Err: err}
+		}
+
+		log.Debugf("Unable to send transaction %v (attempt %d/%d), "+
+			"retrying in %v: %v", tx.TxHash(), attempt, attempts,
+			delay, err)
+		select {
+		case <-time.After(delay):
+		case <-w.quitChan():
+			return &TransientPublishError{Attempts: attempt, Err: err}
+		}
+		delay *= 2
+	}
+}
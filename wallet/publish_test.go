@@ -0,0 +1,256 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/btcjson"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/rpcclient"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// publishChainClient is a mock chain client returning the queued errors from
+// successive SendRawTransaction calls.
+type publishChainClient struct {
+	mockChainClient
+	errs  []error
+	sends int
+}
+
+func (c *publishChainClient) SendRawTransaction(tx *wire.MsgTx, _ bool) (
+	*chainhash.Hash, error) {
+
+	c.sends++
+	if len(c.errs) == 0 {
+		hash := tx.TxHash()
+		return &hash, nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return nil, err
+}
+
+// TestSendRawTransactionRetry ensures connection errors are retried while
+// rejections are returned immediately.
+func TestSendRawTransactionRetry(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.publishAttempts = 3
+	w.publishRetryDelay = time.Millisecond
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{0x51}, wire.TokenData{}))
+
+	// A send succeeding after transient errors is not an error.
+	client := &publishChainClient{errs: []error{
+		rpcclient.ErrClientDisconnect, rpcclient.ErrClientNotConnected,
+	}}
+	if err := w.sendRawTransaction(client, tx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.sends != 3 {
+		t.Fatalf("got %d sends, want 3", client.sends)
+	}
+
+	// Rejections are not retried.
+	rejection := &btcjson.RPCError{
+		Code:    btcjson.ErrRPCVerify,
+		Message: "bad-txns-inputs-missingorspent",
+	}
+	client = &publishChainClient{errs: []error{rejection}}
+	if err := w.sendRawTransaction(client, tx); err != rejection {
+		t.Fatalf("got error %v, want %v", err, rejection)
+	}
+	if client.sends != 1 {
+		t.Fatalf("got %d sends, want 1", client.sends)
+	}
+
+	// Transient errors persisting through every attempt are reported as
+	// such.
+	client = &publishChainClient{errs: []error{
+		rpcclient.ErrClientDisconnect, rpcclient.ErrClientDisconnect,
+		rpcclient.ErrClientDisconnect,
+	}}
+	err := w.sendRawTransaction(client, tx)
+	var transientErr *TransientPublishError
+	if !errors.As(err, &transientErr) {
+		t.Fatalf("got error %v, want TransientPublishError", err)
+	}
+	if transientErr.Attempts != 3 ||
+		transientErr.Err != rpcclient.ErrClientDisconnect {
+
+		t.Fatalf("unexpected transient error: %v", transientErr)
+	}
+}
+
+// TestPublishTransactionTransientKeepsTx ensures a transaction that could not
+// be sent because of connection errors is kept to be rebroadcast.
+func TestPublishTransactionTransientKeepsTx(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.publishAttempts = 2
+	w.publishRetryDelay = time.Millisecond
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{0x51}, wire.TokenData{}))
+	rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.TxStore.InsertTx(ns, rec, nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w.chainClient = &publishChainClient{errs: []error{
+		rpcclient.ErrClientDisconnect, rpcclient.ErrClientDisconnect,
+	}}
+	_, err = w.publishTransaction(tx)
+	if _, ok := err.(*TransientPublishError); !ok {
+		t.Fatalf("got error %v, want TransientPublishError", err)
+	}
+
+	var unmined []*wire.MsgTx
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		var err error
+		unmined, err = w.TxStore.UnminedTxs(ns)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unmined) != 1 || unmined[0].TxHash() != tx.TxHash() {
+		t.Fatalf("transaction was not kept after transient error")
+	}
+}
+
+// TestPublishTransactionRejectionRemovesTx ensures a transaction rejected by
+// the chain server is not left in the store after it was recorded for
+// publishing, and that the outputs it spent are spendable again.
+func TestPublishTransactionRejectionRemovesTx(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	// The recovery interrupt handler is not running for test wallets.
+	go func() { <-w.recoveryInterruptChan }()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 101, []bchutil.Address{addr},
+		[]int64{1e8})
+	changeAddr, err := w.NewChangeAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create change address: %v", err)
+	}
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: rec.Hash}, nil))
+	tx.AddTxOut(wire.NewTxOut(9e7, changeScript, wire.TokenData{}))
+
+	rejection := &btcjson.RPCError{
+		Code:    btcjson.ErrRPCVerify,
+		Message: "bad-txns-inputs-missingorspent",
+	}
+	w.chainClient = &publishChainClient{errs: []error{rejection}}
+	if err := w.PublishTransaction(tx); err != rejection {
+		t.Fatalf("got error %v, want %v", err, rejection)
+	}
+
+	txHash := tx.TxHash()
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		details, err := w.TxStore.TxDetails(ns, &txHash)
+		if err != nil {
+			return err
+		}
+		if details != nil {
+			t.Fatalf("rejected transaction was kept")
+		}
+		credits, err := w.TxStore.UnspentOutputs(ns)
+		if err != nil {
+			return err
+		}
+		if len(credits) != 1 || credits[0].Hash != rec.Hash {
+			t.Fatalf("got unspent outputs %v, want the spent "+
+				"credit of %v", credits, rec.Hash)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestSendRawTransactionBroadcasters ensures a transaction sent to additional
+// broadcast endpoints is published when any endpoint accepts it, is kept when
+// any endpoint can not be reached, and is only rejected when every endpoint
+// rejects it.
+func TestSendRawTransactionBroadcasters(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.publishAttempts = 1
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, nil))
+	tx.AddTxOut(wire.NewTxOut(1e8, []byte{0x51}, wire.TokenData{}))
+
+	rejection := &btcjson.RPCError{
+		Code:    btcjson.ErrRPCVerify,
+		Message: "bad-txns-inputs-missingorspent",
+	}
+	otherRejection := &btcjson.RPCError{
+		Code:    btcjson.ErrRPCVerify,
+		Message: "insufficient priority",
+	}
+
+	// An endpoint accepting the transaction publishes it.
+	extra := &publishChainClient{}
+	w.broadcasters = []TxBroadcaster{extra}
+	client := &publishChainClient{errs: []error{rejection}}
+	if err := w.sendRawTransaction(client, tx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.sends != 1 || extra.sends != 1 {
+		t.Fatalf("got %d and %d sends, want 1 each", client.sends,
+			extra.sends)
+	}
+
+	// An unreachable endpoint keeps the transaction.
+	w.broadcasters = []TxBroadcaster{&publishChainClient{
+		errs: []error{rpcclient.ErrClientDisconnect},
+	}}
+	client = &publishChainClient{errs: []error{rejection}}
+	err := w.sendRawTransaction(client, tx)
+	if _, ok := err.(*TransientPublishError); !ok {
+		t.Fatalf("got error %v, want TransientPublishError", err)
+	}
+
+	// The chain backend's rejection is returned when every endpoint
+	// rejects the transaction.
+	w.broadcasters = []TxBroadcaster{&publishChainClient{
+		errs: []error{otherRejection},
+	}}
+	client = &publishChainClient{errs: []error{rejection}}
+	if err := w.sendRawTransaction(client, tx); err != rejection {
+		t.Fatalf("got error %v, want %v", err, rejection)
+	}
+}
@@ -0,0 +1,129 @@
+package wallet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestTotalReceived ensures the totals received by addresses and accounts
+// only count outputs at the required depth and exclude change.
+func TestTotalReceived(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	account, err := w.NextAccount(scope, "second")
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	recv0, err := w.NewAddress(0, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recv1, err := w.NewAddress(account, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	change, err := w.NewChangeAddress(0, scope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := &wire.MsgTx{TxIn: []*wire.TxIn{{}}}
+	for i, addr := range []bchutil.Address{recv0, recv1, change} {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx.TxOut = append(tx.TxOut, wire.NewTxOut(int64(i+1)*1000,
+			pkScript, wire.TokenData{}))
+	}
+	var b bytes.Buffer
+	if err := tx.Serialize(&b); err != nil {
+		t.Fatal(err)
+	}
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: chainhash.Hash{100}, Height: 100},
+		Time:  time.Unix(1387737310, 0),
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+			return err
+		}
+		for i := range tx.TxOut {
+			err := w.TxStore.AddCredit(ns, rec, block, uint32(i), i == 2)
+			if err != nil {
+				return err
+			}
+		}
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.SetSyncedTo(addrmgrNs, &waddrmgr.BlockStamp{
+			Hash:   chainhash.Hash{100},
+			Height: 100,
+		})
+	})
+	if err != nil {
+		t.Fatalf("unable to insert tx: %v", err)
+	}
+
+	addrTests := []struct {
+		addr    bchutil.Address
+		minConf int32
+		want    bchutil.Amount
+	}{
+		{recv0, 1, 1000},
+		{recv1, 1, 2000},
+		{change, 1, 0},
+		{recv0, 2, 0},
+	}
+	for _, test := range addrTests {
+		got, err := w.TotalReceivedForAddr(test.addr, test.minConf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("received by %v with %d confs: got %v, want %v",
+				test.addr, test.minConf, got, test.want)
+		}
+	}
+
+	acctTests := []struct {
+		account uint32
+		minConf int32
+		want    bchutil.Amount
+	}{
+		{0, 1, 1000},
+		{account, 1, 2000},
+		{account, 2, 0},
+	}
+	for _, test := range acctTests {
+		got, err := w.TotalReceivedForAccount(scope, test.account,
+			test.minConf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("received by account %d with %d confs: got %v, "+
+				"want %v", test.account, test.minConf, got, test.want)
+		}
+	}
+
+	_, err = w.TotalReceivedForAccount(scope, account+1, 1)
+	if !waddrmgr.IsError(err, waddrmgr.ErrAccountNotFound) {
+		t.Fatalf("unexpected error for unknown account: %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/rpcclient"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/chain"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// reconnectChainClient is a mock chain client backed by a mock chain which
+// counts the notification requests made by the wallet.  Rescans succeed
+// immediately, queueing a rescan finished notification for the chain tip,
+// unless rescanErr is set.
+type reconnectChainClient struct {
+	mockChainConnClient
+	ntfns chan interface{}
+
+	notifyBlocks int
+	rescans      int
+	rescanErr    error
+}
+
+func newReconnectChainClient(n uint32) *reconnectChainClient {
+	c := &reconnectChainClient{
+		ntfns: make(chan interface{}, 10),
+	}
+	c.conn = createMockChainConn(
+		chaincfg.TestNet3Params.GenesisBlock, n, defaultBlockInterval,
+	)
+	return c
+}
+
+// extend mines a block on the mock chain, returning its notification.
+func (c *reconnectChainClient) extend() chain.BlockConnected {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prev := c.conn.blocks[c.conn.blockHashes[c.conn.chainTip]]
+	block := &wire.MsgBlock{
+		Header: wire.BlockHeader{
+			PrevBlock: prev.BlockHash(),
+			Timestamp: prev.Header.Timestamp.Add(defaultBlockInterval),
+		},
+	}
+	hash := block.BlockHash()
+	c.conn.chainTip++
+	c.conn.blockHashes[c.conn.chainTip] = hash
+	c.conn.blocks[hash] = block
+
+	return chain.BlockConnected{
+		Block: wtxmgr.Block{Hash: hash, Height: int32(c.conn.chainTip)},
+		Time:  block.Header.Timestamp,
+	}
+}
+
+func (c *reconnectChainClient) counts() (notifyBlocks, rescans int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.notifyBlocks, c.rescans
+}
+
+func (c *reconnectChainClient) setRescanErr(err error) {
+	c.mu.Lock()
+	c.rescanErr = err
+	c.mu.Unlock()
+}
+
+func (c *reconnectChainClient) NotifyBlocks() error {
+	c.mu.Lock()
+	c.notifyBlocks++
+	c.mu.Unlock()
+	return nil
+}
+
+func (c *reconnectChainClient) Rescan(*chainhash.Hash, []bchutil.Address,
+	map[wire.OutPoint]bchutil.Address) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rescans++
+	if c.rescanErr != nil {
+		return c.rescanErr
+	}
+	hash := c.conn.blockHashes[c.conn.chainTip]
+	c.ntfns <- &chain.RescanFinished{
+		Hash:   &hash,
+		Height: int32(c.conn.chainTip),
+		Time:   c.conn.blocks[hash].Header.Timestamp,
+	}
+	return nil
+}
+
+func (c *reconnectChainClient) Notifications() <-chan interface{} {
+	return c.ntfns
+}
+
+// TestChainClientReconnect ensures the wallet resynchronizes with the chain
+// backend and requests its notifications again each time the backend
+// reconnects, including after the backend disconnects during a rescan, and
+// that block notifications are processed after the reconnect.
+func TestChainClientReconnect(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	c := newReconnectChainClient(10)
+	w.chainClientLock.Lock()
+	w.chainClient = nil
+	w.chainClientLock.Unlock()
+	w.SynchronizeRPC(c)
+
+	waitFor := func(desc string, cond func() bool) {
+		t.Helper()
+		deadline := time.Now().Add(30 * time.Second)
+		for !cond() {
+			if time.Now().After(deadline) {
+				t.Fatalf("timed out waiting for %s", desc)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	checkCounts := func(wantNotifyBlocks, wantRescans int) {
+		t.Helper()
+		notifyBlocks, rescans := c.counts()
+		if notifyBlocks != wantNotifyBlocks || rescans != wantRescans {
+			t.Fatalf("got %d block notification requests and %d "+
+				"rescans, want %d and %d", notifyBlocks, rescans,
+				wantNotifyBlocks, wantRescans)
+		}
+	}
+	checkSyncedTo := func(height int32) {
+		t.Helper()
+		if got := w.Manager.SyncedTo().Height; got != height {
+			t.Fatalf("wallet synced to height %d, want %d", got,
+				height)
+		}
+	}
+
+	// The initial connection synchronizes the wallet to the chain tip.
+	c.ntfns <- chain.ClientConnected{}
+	waitFor("initial sync", w.ChainSynced)
+	checkCounts(1, 1)
+	checkSyncedTo(10)
+
+	// A reconnect during which the backend disconnects again before the
+	// rescan completes leaves the wallet out of sync.
+	c.setRescanErr(rpcclient.ErrClientDisconnect)
+	c.ntfns <- chain.ClientConnected{}
+	waitFor("failed rescan", func() bool {
+		_, rescans := c.counts()
+		return rescans == 2
+	})
+	if w.ChainSynced() {
+		t.Fatal("wallet synced after an interrupted rescan")
+	}
+
+	// Blocks mined while the backend was disconnected are synchronized
+	// once it reconnects, and notifications are requested again.
+	c.setRescanErr(nil)
+	c.extend()
+	c.extend()
+	c.ntfns <- chain.ClientConnected{}
+	waitFor("resync", w.ChainSynced)
+	checkCounts(3, 3)
+	checkSyncedTo(12)
+
+	// Block notifications are processed after the reconnect.
+	c.ntfns <- c.extend()
+	waitFor("block connected", func() bool {
+		return w.Manager.SyncedTo().Height == 13
+	})
+}
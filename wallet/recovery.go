@@ -18,9 +18,13 @@ import (
 // addresses, and coordinates batched processing of the blocks to search.
 type RecoveryManager struct {
 	// recoveryWindow defines the key-derivation lookahead used when
-	// attempting to recover the set of used addresses.
+	// attempting to recover the set of used external addresses.
 	recoveryWindow uint32
 
+	// internalRecoveryWindow defines the key-derivation lookahead used
+	// when attempting to recover the set of used change addresses.
+	internalRecoveryWindow uint32
+
 	// started is true after the first block has been added to the batch.
 	started bool
 
@@ -38,16 +42,20 @@ type RecoveryManager struct {
 }
 
 // NewRecoveryManager initializes a new RecoveryManager with a derivation
-// look-ahead of `recoveryWindow` child indexes, and pre-allocates a backing
-// array for `batchSize` blocks to scan at once.
-func NewRecoveryManager(recoveryWindow, batchSize uint32,
-	chainParams *chaincfg.Params) *RecoveryManager {
+// look-ahead of `recoveryWindow` child indexes for external branches and
+// `internalRecoveryWindow` child indexes for internal branches, and
+// pre-allocates a backing array for `batchSize` blocks to scan at once.
+func NewRecoveryManager(recoveryWindow, internalRecoveryWindow,
+	batchSize uint32, chainParams *chaincfg.Params) *RecoveryManager {
 
 	return &RecoveryManager{
-		recoveryWindow: recoveryWindow,
-		blockBatch:     make([]wtxmgr.BlockMeta, 0, batchSize),
-		chainParams:    chainParams,
-		state:          NewRecoveryState(recoveryWindow),
+		recoveryWindow:         recoveryWindow,
+		internalRecoveryWindow: internalRecoveryWindow,
+		blockBatch:             make([]wtxmgr.BlockMeta, 0, batchSize),
+		chainParams:            chainParams,
+		state: NewRecoveryState(
+			recoveryWindow, internalRecoveryWindow,
+		),
 	}
 }
 
@@ -64,12 +72,12 @@ func (rm *RecoveryManager) Resurrect(ns walletdb.ReadBucket,
 	// addresses up to the last found address known to each branch.
 	for keyScope, scopedMgr := range scopedMgrs {
 		// Load the current account properties for this scope, using the
-		// the default account number.
+		// account number of the scope's recovery state.
 		// TODO(conner): rescan for all created accounts if we allow
 		// users to use non-default address
 		scopeState := rm.state.StateForScope(keyScope)
 		acctProperties, err := scopedMgr.AccountProperties(
-			ns, waddrmgr.DefaultAccountNum,
+			ns, scopeState.Account,
 		)
 		if err != nil {
 			return err
@@ -83,7 +91,7 @@ func (rm *RecoveryManager) Resurrect(ns walletdb.ReadBucket,
 		// deriving each address and adding it to the external branch
 		// recovery state's set of addresses to look for.
 		for i := uint32(0); i < externalCount; i++ {
-			keyPath := externalKeyPath(i)
+			keyPath := externalKeyPath(scopeState.Account, i)
 			addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 			if err != nil && err != hdkeychain.ErrInvalidChild {
 				return err
@@ -103,7 +111,7 @@ func (rm *RecoveryManager) Resurrect(ns walletdb.ReadBucket,
 		// deriving each address and adding it to the internal branch
 		// recovery state's set of addresses to look for.
 		for i := uint32(0); i < internalCount; i++ {
-			keyPath := internalKeyPath(i)
+			keyPath := internalKeyPath(scopeState.Account, i)
 			addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 			if err != nil && err != hdkeychain.ErrInvalidChild {
 				return err
@@ -151,7 +159,9 @@ func (rm *RecoveryManager) AddToBlockBatch(hash *chainhash.Hash, height int32,
 	if !rm.started {
 		log.Infof("Seed birthday surpassed, starting recovery "+
 			"of wallet from height=%d hash=%v with "+
-			"recovery-window=%d", height, *hash, rm.recoveryWindow)
+			"recovery-window=%d internal-recovery-window=%d",
+			height, *hash, rm.recoveryWindow,
+			rm.internalRecoveryWindow)
 		rm.started = true
 	}
 
@@ -196,10 +206,17 @@ func (rm *RecoveryManager) State() *RecoveryState {
 //     same block.
 type RecoveryState struct {
 	// recoveryWindow defines the key-derivation lookahead used when
-	// attempting to recover the set of used addresses. This value will be
-	// used to instantiate a new RecoveryState for each requested scope.
+	// attempting to recover the set of used external addresses. This value
+	// will be used to instantiate a new RecoveryState for each requested
+	// scope.
 	recoveryWindow uint32
 
+	// internalRecoveryWindow defines the key-derivation lookahead used
+	// when attempting to recover the set of used change addresses. Change
+	// addresses are generated at a different rate than external addresses,
+	// so they may require a different lookahead.
+	internalRecoveryWindow uint32
+
 	// scopes maintains a map of each requested key scope to its active
 	// RecoveryState.
 	scopes map[waddrmgr.KeyScope]*ScopeRecoveryState
@@ -210,22 +227,25 @@ type RecoveryState struct {
 	watchedOutPoints map[wire.OutPoint]bchutil.Address
 }
 
-// NewRecoveryState creates a new RecoveryState using the provided
-// recoveryWindow. Each RecoveryState that is subsequently initialized for a
-// particular key scope will receive the same recoveryWindow.
-func NewRecoveryState(recoveryWindow uint32) *RecoveryState {
+// NewRecoveryState creates a new RecoveryState using the provided external
+// and internal recovery windows. Each RecoveryState that is subsequently
+// initialized for a particular key scope will receive the same windows.
+func NewRecoveryState(recoveryWindow,
+	internalRecoveryWindow uint32) *RecoveryState {
+
 	scopes := make(map[waddrmgr.KeyScope]*ScopeRecoveryState)
 
 	return &RecoveryState{
-		recoveryWindow:   recoveryWindow,
-		scopes:           scopes,
-		watchedOutPoints: make(map[wire.OutPoint]bchutil.Address),
+		recoveryWindow:         recoveryWindow,
+		internalRecoveryWindow: internalRecoveryWindow,
+		scopes:                 scopes,
+		watchedOutPoints:       make(map[wire.OutPoint]bchutil.Address),
 	}
 }
 
 // StateForScope returns a ScopeRecoveryState for the provided key scope. If one
 // does not already exist, a new one will be generated with the RecoveryState's
-// recoveryWindow.
+// recovery windows.
 func (rs *RecoveryState) StateForScope(
 	keyScope waddrmgr.KeyScope) *ScopeRecoveryState {
 
@@ -235,8 +255,10 @@ func (rs *RecoveryState) StateForScope(
 	}
 
 	// Otherwise, initialize the recovery state for this scope with the
-	// chosen recovery window.
-	rs.scopes[keyScope] = NewScopeRecoveryState(rs.recoveryWindow)
+	// chosen recovery windows.
+	rs.scopes[keyScope] = NewScopeRecoveryState(
+		rs.recoveryWindow, rs.internalRecoveryWindow,
+	)
 
 	return rs.scopes[keyScope]
 }
@@ -257,8 +279,12 @@ func (rs *RecoveryState) AddWatchedOutPoint(outPoint *wire.OutPoint,
 
 // ScopeRecoveryState is used to manage the recovery of addresses generated
 // under a particular BIP32 account. Each account tracks both an external and
-// internal branch recovery state, both of which use the same recovery window.
+// internal branch recovery state, each of which uses its own recovery window.
 type ScopeRecoveryState struct {
+	// Account is the number of the account being recovered, which is the
+	// default account unless set otherwise.
+	Account uint32
+
 	// ExternalBranch is the recovery state of addresses generated for
 	// external use, i.e. receiving addresses.
 	ExternalBranch *BranchRecoveryState
@@ -269,11 +295,13 @@ type ScopeRecoveryState struct {
 }
 
 // NewScopeRecoveryState initializes an ScopeRecoveryState with the chosen
-// recovery window.
-func NewScopeRecoveryState(recoveryWindow uint32) *ScopeRecoveryState {
+// external and internal recovery windows.
+func NewScopeRecoveryState(recoveryWindow,
+	internalRecoveryWindow uint32) *ScopeRecoveryState {
+
 	return &ScopeRecoveryState{
 		ExternalBranch: NewBranchRecoveryState(recoveryWindow),
-		InternalBranch: NewBranchRecoveryState(recoveryWindow),
+		InternalBranch: NewBranchRecoveryState(internalRecoveryWindow),
 	}
 }
 
@@ -344,6 +372,15 @@ func (brs *BranchRecoveryState) ExtendHorizon() (uint32, uint32) {
 	return curHorizon, delta
 }
 
+// HorizonExhausted returns true if an address has been found so close to the
+// branch's horizon that the horizon no longer extends the recovery window past
+// it.  Addresses beyond the horizon were not watched, so any blocks already
+// filtered may have used them.
+func (brs *BranchRecoveryState) HorizonExhausted() bool {
+	nInvalid := brs.NumInvalidInHorizon()
+	return brs.horizon < brs.nextUnfound+brs.recoveryWindow+nInvalid
+}
+
 // AddAddr adds a freshly derived address from our lookahead into the map of
 // known addresses for this branch.
 func (brs *BranchRecoveryState) AddAddr(index uint32, addr bchutil.Address) {
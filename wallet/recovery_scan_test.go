@@ -0,0 +1,117 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// TestRecoveryWidelySeparatedAddresses ensures that recovery discovers
+// addresses used at widely separated heights, including addresses used in the
+// same block past the horizon watched when the block was first filtered, and
+// that a recovery resumed after the chain grows continues from the progress
+// persisted by the previous one.
+func TestRecoveryWidelySeparatedAddresses(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	const window = 10
+	w.recoveryWindow = window
+	w.internalRecoveryWindow = window
+
+	payment := func(index uint32) *wire.MsgTx {
+		return testPaymentTx(t, w, 0, waddrmgr.ExternalBranch, index, 1e8)
+	}
+	c := &mockChainConnClient{
+		conn: createMockChainConn(
+			chaincfg.TestNet3Params.GenesisBlock, 4500,
+			defaultBlockInterval,
+		),
+		// The test wallet begins with ten keys on each branch, so
+		// the horizons first extend the window past index nine.
+		payments: map[int32][]*wire.MsgTx{
+			10: {payment(5)},
+			// Index 25 is beyond the horizon watched until index 19
+			// is found in the same block.
+			2500: {payment(19), payment(25)},
+			3000: {testPaymentTx(t, w, 0, waddrmgr.InternalBranch,
+				12, 1e8)},
+			4200: {payment(34)},
+		},
+	}
+	c.conn.chainTip = 3000
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-w.rescanNotifications:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	keyCounts := func() (uint32, uint32) {
+		t.Helper()
+
+		var props *waddrmgr.AccountProperties
+		err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+			ns := tx.ReadBucket(waddrmgrNamespaceKey)
+			scopedMgr, err := w.Manager.FetchScopedKeyManager(
+				waddrmgr.KeyScopeBIP0044,
+			)
+			if err != nil {
+				return err
+			}
+			props, err = scopedMgr.AccountProperties(ns, 0)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("unable to fetch account properties: %v", err)
+		}
+		return props.ExternalKeyCount, props.InternalKeyCount
+	}
+
+	// The first recovery spans two batches and finds all addresses used
+	// up to the chain tip.
+	err := w.recovery(context.Background(), c, &waddrmgr.BlockStamp{})
+	if err != nil {
+		t.Fatalf("unable to recover: %v", err)
+	}
+	if synced := w.Manager.SyncedTo().Height; synced != 3000 {
+		t.Fatalf("expected wallet synced to 3000, got %d", synced)
+	}
+	external, internal := keyCounts()
+	if external != 26 || internal != 13 {
+		t.Fatalf("expected 26 external and 13 internal keys, got %d "+
+			"and %d", external, internal)
+	}
+
+	// Once the chain grows, recovery resumes from the last block synced
+	// and only filters the new blocks.  The address used at 4200 is
+	// within the window of the addresses found by the first recovery.
+	c.conn.chainTip = 4500
+	c.filtered = 0
+	err = w.recovery(context.Background(), c, &waddrmgr.BlockStamp{})
+	if err != nil {
+		t.Fatalf("unable to resume recovery: %v", err)
+	}
+	if synced := w.Manager.SyncedTo().Height; synced != 4500 {
+		t.Fatalf("expected wallet synced to 4500, got %d", synced)
+	}
+	if c.filtered > 1500+1 {
+		t.Fatalf("resumed recovery filtered %d blocks, expected at "+
+			"most %d", c.filtered, 1500+1)
+	}
+	external, internal = keyCounts()
+	if external != 35 || internal != 13 {
+		t.Fatalf("expected 35 external and 13 internal keys, got %d "+
+			"and %d", external, internal)
+	}
+}
@@ -0,0 +1,178 @@
+package wallet
+
+import (
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestRecoveryInternalWindow ensures that resuming recovery of a wallet with
+// many more change addresses than receiving addresses watches each branch up
+// to its own recovery window past the last known address.
+func TestRecoveryInternalWindow(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	const (
+		numChange      = 60
+		externalWindow = 5
+		internalWindow = 40
+	)
+	for i := 0; i < numChange; i++ {
+		_, err := w.NewChangeAddress(0, waddrmgr.KeyScopeBIP0044)
+		if err != nil {
+			t.Fatalf("unable to create change address: %v", err)
+		}
+	}
+
+	scopedMgr, err := w.Manager.FetchScopedKeyManager(
+		waddrmgr.KeyScopeBIP0044,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch scoped manager: %v", err)
+	}
+
+	recoveryMgr := NewRecoveryManager(
+		externalWindow, internalWindow, recoveryBatchSize, w.chainParams,
+	)
+	var props *waddrmgr.AccountProperties
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		scopedMgrs := map[waddrmgr.KeyScope]*waddrmgr.ScopedKeyManager{
+			waddrmgr.KeyScopeBIP0044: scopedMgr,
+		}
+		if err := recoveryMgr.Resurrect(ns, scopedMgrs, nil); err != nil {
+			return err
+		}
+
+		// Extend the horizons of the branches as the recovery of the
+		// next batch of blocks would.
+		scopeState := recoveryMgr.State().StateForScope(
+			waddrmgr.KeyScopeBIP0044,
+		)
+		if err := expandScopeHorizons(ns, scopedMgr, scopeState); err != nil {
+			return err
+		}
+
+		var err error
+		props, err = scopedMgr.AccountProperties(ns, 0)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to resurrect recovery state: %v", err)
+	}
+
+	scopeState := recoveryMgr.State().StateForScope(waddrmgr.KeyScopeBIP0044)
+	tests := []struct {
+		name   string
+		branch *BranchRecoveryState
+		count  uint32
+		window uint32
+	}{
+		{"external", scopeState.ExternalBranch, props.ExternalKeyCount,
+			externalWindow},
+		{"internal", scopeState.InternalBranch, props.InternalKeyCount,
+			internalWindow},
+	}
+	for _, test := range tests {
+		want := int(test.count + test.window)
+		if got := len(test.branch.Addrs()); got != want {
+			t.Fatalf("%s branch watches %d addresses, want %d",
+				test.name, got, want)
+		}
+		if test.branch.NextUnfound() != test.count {
+			t.Fatalf("%s branch next unfound is %d, want %d",
+				test.name, test.branch.NextUnfound(), test.count)
+		}
+	}
+}
+
+// TestRecoveryFindsChangePastExternalWindow ensures that recovering the
+// addresses of a wallet finds a payment to a change address past the external
+// recovery window only when the internal recovery window reaches it.
+func TestRecoveryFindsChangePastExternalWindow(t *testing.T) {
+	const (
+		externalWindow = 5
+		changeIndex    = 12
+	)
+	tests := []struct {
+		name           string
+		internalWindow uint32
+		want           bchutil.Amount
+	}{
+		{"external window", externalWindow, 0},
+		{"internal window", 20, 1e8},
+	}
+	for _, test := range tests {
+		w, cleanup := testWallet(t)
+
+		w.recoveryWindow = externalWindow
+		w.internalRecoveryWindow = test.internalWindow
+
+		scope := waddrmgr.KeyScopeBIP0044
+		manager, err := w.Manager.FetchScopedKeyManager(scope)
+		if err != nil {
+			t.Fatal(err)
+		}
+		payment := testPaymentTx(t, w, 0, waddrmgr.InternalBranch,
+			changeIndex, 1e8)
+
+		c := &mockChainConnClient{
+			conn: createMockChainConn(
+				chaincfg.TestNet3Params.GenesisBlock, 20,
+				defaultBlockInterval,
+			),
+			payments: map[int32][]*wire.MsgTx{10: {payment}},
+		}
+		w.chainClient = c
+
+		var blocks []wtxmgr.BlockMeta
+		for height := int32(1); height <= 20; height++ {
+			hash, err := c.GetBlockHash(int64(height))
+			if err != nil {
+				t.Fatal(err)
+			}
+			header, err := c.GetBlockHeader(hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			blocks = append(blocks, wtxmgr.BlockMeta{
+				Block: wtxmgr.Block{Hash: *hash, Height: height},
+				Time:  header.Timestamp,
+			})
+		}
+
+		recoveryState := NewRecoveryState(
+			w.recoveryWindow, w.internalRecoveryWindow,
+		)
+		scopedMgrs := map[waddrmgr.KeyScope]*waddrmgr.ScopedKeyManager{
+			scope: manager,
+		}
+		err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+			ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+			_, err := w.recoverScopedAddresses(
+				c, tx, ns, blocks, recoveryState, scopedMgrs,
+			)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("%s: unable to recover addresses: %v", test.name,
+				err)
+		}
+
+		bals, err := w.CalculateAccountBalances(0, 0)
+		if err != nil {
+			t.Fatalf("unable to calculate balance: %v", err)
+		}
+		if bals.Total != test.want {
+			t.Fatalf("%s: recovered balance %v, want %v", test.name,
+				bals.Total, test.want)
+		}
+		cleanup()
+	}
+}
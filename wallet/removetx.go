@@ -0,0 +1,38 @@
+package wallet
+
+import (
+	"errors"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// ErrTxMined describes an error where a transaction can not be removed from
+// the wallet because it has been mined in a block.
+var ErrTxMined = errors.New("transaction is mined")
+
+// RemoveUnminedTransaction removes an unmined transaction, such as one that
+// was rejected by the network or is stuck with too low a fee, from the
+// wallet.  Every unmined transaction spending its outputs is removed as well,
+// and the outputs it spent become spendable again.  ErrTxNotFound is returned
+// if the wallet has no record of the transaction, and ErrTxMined if it has
+// been mined.
+func (w *Wallet) RemoveUnminedTransaction(txHash *chainhash.Hash) error {
+	return walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		txmgrNs := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+
+		details, err := w.TxStore.TxDetails(txmgrNs, txHash)
+		if err != nil {
+			return err
+		}
+		if details == nil {
+			return ErrTxNotFound
+		}
+		if details.Block.Height != -1 {
+			return ErrTxMined
+		}
+
+		log.Infof("Removing unmined transaction %v", txHash)
+		return w.TxStore.RemoveUnminedTx(txmgrNs, &details.TxRecord)
+	})
+}
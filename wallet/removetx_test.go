@@ -0,0 +1,109 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestRemoveUnminedTransaction ensures removing an unmined transaction also
+// removes its unmined descendants and makes the outputs it spent spendable
+// again, while mined and unknown transactions are refused.
+func TestRemoveUnminedTransaction(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 101, []bchutil.Address{addr},
+		[]int64{1e8})
+
+	// Spend the credit in an unmined transaction, which is in turn spent
+	// by another unmined transaction.
+	spend := wire.NewMsgTx(1)
+	spend.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: rec.Hash}, nil))
+	spend.AddTxOut(wire.NewTxOut(9e7, []byte{0x51}, wire.TokenData{}))
+	spendHash := spend.TxHash()
+	child := wire.NewMsgTx(1)
+	child.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: spendHash}, nil))
+	child.AddTxOut(wire.NewTxOut(8e7, []byte{0x51}, wire.TokenData{}))
+	childHash := child.TxHash()
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		for _, tx := range []*wire.MsgTx{spend, child} {
+			rec, err := wtxmgr.NewTxRecordFromMsgTx(tx, time.Now())
+			if err != nil {
+				return err
+			}
+			if err := w.TxStore.InsertTx(ns, rec, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to insert unmined transactions: %v", err)
+	}
+
+	unspent := func() bool {
+		var found bool
+		err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+			ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+			credits, err := w.TxStore.UnspentOutputs(ns)
+			for _, c := range credits {
+				if c.OutPoint.Hash == rec.Hash {
+					found = true
+				}
+			}
+			return err
+		})
+		if err != nil {
+			t.Fatalf("unable to fetch unspent outputs: %v", err)
+		}
+		return found
+	}
+	if unspent() {
+		t.Fatal("credit spent by an unmined transaction is unspent")
+	}
+
+	if err := w.RemoveUnminedTransaction(&rec.Hash); err != ErrTxMined {
+		t.Fatalf("got error %v removing mined transaction, want %v",
+			err, ErrTxMined)
+	}
+	err = w.RemoveUnminedTransaction(&chainhash.Hash{1})
+	if err != ErrTxNotFound {
+		t.Fatalf("got error %v removing unknown transaction, want %v",
+			err, ErrTxNotFound)
+	}
+
+	if err := w.RemoveUnminedTransaction(&spendHash); err != nil {
+		t.Fatalf("unable to remove transaction: %v", err)
+	}
+	if !unspent() {
+		t.Fatal("credit is still spent after removing its spender")
+	}
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		ns := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for _, hash := range []*chainhash.Hash{&spendHash, &childHash} {
+			details, err := w.TxStore.TxDetails(ns, hash)
+			if err != nil {
+				return err
+			}
+			if details != nil {
+				t.Errorf("transaction %v was not removed", hash)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to look up transactions: %v", err)
+	}
+}
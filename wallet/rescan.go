@@ -5,6 +5,9 @@
 package wallet
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
@@ -14,6 +17,10 @@ import (
 	"github.com/gcash/bchwallet/wtxmgr"
 )
 
+// ErrRescanHeight describes an error where a rescan is requested to begin at a
+// height outside of the chain the wallet is synced to.
+var ErrRescanHeight = errors.New("rescan height is not within the synced chain")
+
 // RescanProgressMsg reports the current progress made by a rescan for a
 // set of wallet addresses.
 type RescanProgressMsg struct {
@@ -56,22 +63,39 @@ type rescanBatch struct {
 	errChans    []chan error
 }
 
+// rescanFailed is sent to the rescan batch handler when the rescan of a batch
+// could not be performed, such as when the chain backend disconnected, so no
+// RescanFinished notification will be received for it.
+type rescanFailed struct {
+	batch *rescanBatch
+}
+
 // NewRescanJob creates a new RescanJob using the active data
 // in the wallet. This can then be passed into SubmitRescan
 // to do a rescan from the wallet's birthday.
 func (w *Wallet) NewRescanJob() (*RescanJob, error) {
-	var (
-		addrs         []bchutil.Address
-		unspent       []wtxmgr.Credit
-		birthdayBlock waddrmgr.BlockStamp
-		err           error
-	)
-	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+	var birthdayBlock waddrmgr.BlockStamp
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
 		addrmgrNs := dbtx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
 		birthdayBlock, _, err = w.Manager.BirthdayBlock(addrmgrNs)
-		if err != nil {
-			return err
-		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return w.newRescanJob(birthdayBlock)
+}
+
+// newRescanJob creates a RescanJob for the active addresses and unspent
+// outputs of the wallet beginning at the given block.
+func (w *Wallet) newRescanJob(bs waddrmgr.BlockStamp) (*RescanJob, error) {
+	var (
+		addrs   []bchutil.Address
+		unspent []wtxmgr.Credit
+	)
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		var err error
 		addrs, unspent, err = w.activeData(dbtx)
 		return err
 	})
@@ -95,11 +119,42 @@ func (w *Wallet) NewRescanJob() (*RescanJob, error) {
 		InitialSync: true,
 		Addrs:       addrs,
 		OutPoints:   outpoints,
-		BlockStamp:  birthdayBlock,
+		BlockStamp:  bs,
 	}
 	return job, nil
 }
 
+// NewRescanJobFromHeight creates a new RescanJob like NewRescanJob, except that
+// the rescan begins at the block of the given height rather than at the
+// wallet's birthday.  The height must be within the chain the wallet is synced
+// to, otherwise an error wrapping ErrRescanHeight is returned.
+func (w *Wallet) NewRescanJobFromHeight(height int32) (*RescanJob, error) {
+	syncedTo := w.Manager.SyncedTo()
+	if height < 0 || height > syncedTo.Height {
+		return nil, fmt.Errorf("%w: height %d, synced to height %d",
+			ErrRescanHeight, height, syncedTo.Height)
+	}
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := chainClient.GetBlockHash(int64(height))
+	if err != nil {
+		return nil, err
+	}
+	header, err := chainClient.GetBlockHeader(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.newRescanJob(waddrmgr.BlockStamp{
+		Hash:      *hash,
+		Height:    height,
+		Timestamp: header.Timestamp,
+	})
+}
+
 // SubmitRescan submits a RescanJob to the RescanManager.  A channel is
 // returned with the final error of the rescan.  The channel is buffered
 // and does not need to be read to prevent a deadlock.
@@ -237,6 +292,26 @@ func (w *Wallet) rescanBatchHandler() {
 						return
 					}
 				}
+			case *rescanFailed:
+				if curBatch == nil || n.batch != curBatch {
+					continue
+				}
+
+				// Start the next batch, if any, as the failed
+				// batch will never finish.
+				curBatch, nextBatch = nextBatch, nil
+
+				if curBatch != nil {
+					select {
+					case w.rescanBatch <- curBatch:
+					case <-quit:
+						for _, errChan := range curBatch.errChans {
+							errChan <- ErrWalletShuttingDown
+						}
+						return
+					}
+				}
+
 			case *chain.RecoveryProgress:
 				select {
 				case w.recoveryProgess <- &RecoveryProgessMsg{
@@ -327,6 +402,21 @@ out:
 					noun, err)
 			}
 			batch.done(err)
+
+			// Let the batch handler start the next batch, as no
+			// rescan finished notification will be received for
+			// this one.  This is sent asynchronously since the
+			// batch handler may be sending the next batch here.
+			if err != nil {
+				w.wg.Add(1)
+				go func(batch *rescanBatch) {
+					defer w.wg.Done()
+					select {
+					case w.rescanNotifications <- &rescanFailed{batch}:
+					case <-quit:
+					}
+				}(batch)
+			}
 		case <-quit:
 			break out
 		}
@@ -0,0 +1,71 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// heightChainClient is a mock chain client whose block hashes encode their
+// heights.
+type heightChainClient struct {
+	mockChainClient
+}
+
+func (c *heightChainClient) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	return &chainhash.Hash{byte(height)}, nil
+}
+
+func (c *heightChainClient) GetBlockHeader(hash *chainhash.Hash) (
+	*wire.BlockHeader, error) {
+
+	return &wire.BlockHeader{Timestamp: time.Unix(int64(hash[0]), 0)}, nil
+}
+
+// TestNewRescanJobFromHeight ensures rescan jobs begin at the block of the
+// requested height, and that heights outside of the synced chain are
+// rejected.
+func TestNewRescanJobFromHeight(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+	w.chainClient = &heightChainClient{}
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 120, []bchutil.Address{addr},
+		[]int64{1e8})
+
+	for _, height := range []int32{-1, 121} {
+		_, err := w.NewRescanJobFromHeight(height)
+		if !errors.Is(err, ErrRescanHeight) {
+			t.Fatalf("height %d: got error %v, want ErrRescanHeight",
+				height, err)
+		}
+	}
+
+	job, err := w.NewRescanJobFromHeight(50)
+	if err != nil {
+		t.Fatalf("unable to create rescan job: %v", err)
+	}
+	want := waddrmgr.BlockStamp{
+		Hash:      chainhash.Hash{50},
+		Height:    50,
+		Timestamp: time.Unix(50, 0),
+	}
+	if job.BlockStamp != want {
+		t.Fatalf("rescan begins at %v, want %v", job.BlockStamp, want)
+	}
+	if _, ok := job.OutPoints[wire.OutPoint{Hash: rec.Hash}]; !ok {
+		t.Fatal("rescan does not watch the wallet's unspent output")
+	}
+	if len(job.Addrs) == 0 {
+		t.Fatal("rescan does not watch the wallet's addresses")
+	}
+}
@@ -0,0 +1,339 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// TestResyncFromBirthday ensures resyncing drops the transaction history and
+// consistently rewinds the sync state to the birthday block.
+func TestResyncFromBirthday(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	key, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := bchutil.NewAddressPubKeyHash(
+		bchutil.Hash160(key.PubKey().SerializeCompressed()),
+		w.chainParams,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addTestCredits(t, w, 100, 110, []bchutil.Address{addr}, []int64{1e8})
+
+	birthday := &waddrmgr.BlockStamp{
+		Hash:      chainhash.Hash{0x50},
+		Height:    50,
+		Timestamp: time.Unix(1387737310, 0),
+	}
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		return w.resyncFromBirthday(tx, birthday)
+	})
+	if err != nil {
+		t.Fatalf("unable to resync: %v", err)
+	}
+
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		if len(unspent) != 0 {
+			t.Fatalf("expected no unspent outputs after resync, "+
+				"got %d", len(unspent))
+		}
+
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		stored, _, err := w.Manager.BirthdayBlock(addrmgrNs)
+		if err != nil {
+			return err
+		}
+		if stored.Hash != birthday.Hash || stored.Height != birthday.Height {
+			t.Fatalf("birthday block is %v, want %v", stored, birthday)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	syncedTo := w.Manager.SyncedTo()
+	if syncedTo.Hash != birthday.Hash || syncedTo.Height != birthday.Height {
+		t.Fatalf("synced to %v, want %v", syncedTo, birthday)
+	}
+}
+
+// TestSyncWithChainDeepReorg ensures syncing with a chain which has reorged
+// deeper than the maximum rollback depth resyncs the wallet from its birthday
+// block and notifies clients of the resync.
+func TestSyncWithChainDeepReorg(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	const (
+		syncedHeight     = 30
+		birthdayHeight   = 10
+		maxRollbackDepth = 5
+	)
+	w.recoveryWindow = 0
+	w.maxRollbackDepth = maxRollbackDepth
+
+	key, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := bchutil.NewAddressPubKeyHash(
+		bchutil.Hash160(key.PubKey().SerializeCompressed()),
+		w.chainParams,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addTestCredits(t, w, 20, 20, []bchutil.Address{addr}, []int64{1e8})
+
+	// Every block the wallet is synced to has since been reorged out of
+	// the chain.
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for height := int32(1); height <= syncedHeight; height++ {
+			err := w.Manager.SetSyncedTo(ns, &waddrmgr.BlockStamp{
+				Hash:   chainhash.Hash{0xff, byte(height)},
+				Height: height,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &mockChainConnClient{
+		conn: createMockChainConn(
+			chaincfg.TestNet3Params.GenesisBlock, syncedHeight,
+			defaultBlockInterval,
+		),
+	}
+	birthdayHash, err := c.GetBlockHash(birthdayHeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	birthday := &waddrmgr.BlockStamp{
+		Hash:   *birthdayHash,
+		Height: birthdayHeight,
+	}
+
+	w.chainClient = c
+	w.wg.Add(3)
+	go w.rescanBatchHandler()
+	go w.rescanProgressHandler()
+	go w.rescanRPCHandler()
+
+	ntfns := w.NtfnServer.ResyncNotifications()
+	defer ntfns.Done()
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- w.syncWithChain(birthday)
+	}()
+
+	select {
+	case n := <-ntfns.C:
+		if n.SyncedTo.Height != syncedHeight {
+			t.Fatalf("notified resync from height %d, want %d",
+				n.SyncedTo.Height, syncedHeight)
+		}
+		if n.Birthday.Hash != *birthdayHash ||
+			n.Birthday.Height != birthdayHeight {
+
+			t.Fatalf("notified resync to %v, want %v", n.Birthday,
+				birthday)
+		}
+		if n.MaxRollbackDepth != maxRollbackDepth {
+			t.Fatalf("notified maximum rollback depth %d, want %d",
+				n.MaxRollbackDepth, maxRollbackDepth)
+		}
+	case err := <-errChan:
+		t.Fatalf("sync finished without a resync notification: %v", err)
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for resync notification")
+	}
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unable to sync with chain: %v", err)
+		}
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for sync")
+	}
+
+	syncedTo := w.Manager.SyncedTo()
+	if syncedTo.Hash != *birthdayHash || syncedTo.Height != birthdayHeight {
+		t.Fatalf("synced to %v, want %v", syncedTo, birthday)
+	}
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		unspent, err := w.TxStore.UnspentOutputs(
+			tx.ReadBucket(wtxmgrNamespaceKey),
+		)
+		if err != nil {
+			return err
+		}
+		if len(unspent) != 0 {
+			t.Fatalf("got %d unspent outputs after resync, want "+
+				"none", len(unspent))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestResyncPrunedHistory ensures a disconnected block which can not be
+// rolled back because of pruned transactions resyncs the wallet from its
+// birthday block.
+func TestResyncPrunedHistory(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	const (
+		syncedHeight   = 30
+		birthdayHeight = 10
+	)
+	w.recoveryWindow = 0
+
+	c := &mockChainConnClient{
+		conn: createMockChainConn(
+			chaincfg.TestNet3Params.GenesisBlock, syncedHeight,
+			defaultBlockInterval,
+		),
+	}
+	var birthday waddrmgr.BlockStamp
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		for height := int32(1); height <= syncedHeight; height++ {
+			hash, err := c.GetBlockHash(int64(height))
+			if err != nil {
+				return err
+			}
+			header, err := c.GetBlockHeader(hash)
+			if err != nil {
+				return err
+			}
+			stamp := waddrmgr.BlockStamp{
+				Hash:      *hash,
+				Height:    height,
+				Timestamp: header.Timestamp,
+			}
+			if err := w.Manager.SetSyncedTo(ns, &stamp); err != nil {
+				return err
+			}
+			if height == birthdayHeight {
+				birthday = stamp
+			}
+		}
+		return w.Manager.SetBirthdayBlock(ns, birthday, true)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 20, syncedHeight, []bchutil.Address{addr},
+		[]int64{1e8})
+	spendTestCredit(t, w, rec, 21)
+	pruneTestTransactions(t, w, 21, 2)
+
+	// Disconnecting the block of the pruned spending transaction fails.
+	w.chainClient = c
+	w.SetChainSynced(true)
+	hash, err := c.GetBlockHash(21)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		return w.disconnectBlock(tx, wtxmgr.BlockMeta{
+			Block: wtxmgr.Block{Hash: *hash, Height: 21},
+		})
+	})
+	var txErr wtxmgr.Error
+	if !errors.As(err, &txErr) || txErr.Code != wtxmgr.ErrPruned {
+		t.Fatalf("disconnecting a pruned block returned %v, want an "+
+			"error with code %v", err, wtxmgr.ErrPruned)
+	}
+
+	w.wg.Add(3)
+	go w.rescanBatchHandler()
+	go w.rescanProgressHandler()
+	go w.rescanRPCHandler()
+
+	ntfns := w.NtfnServer.ResyncNotifications()
+	defer ntfns.Done()
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- w.resyncPrunedHistory()
+	}()
+
+	select {
+	case n := <-ntfns.C:
+		if n.SyncedTo.Height != syncedHeight {
+			t.Fatalf("notified resync from height %d, want %d",
+				n.SyncedTo.Height, syncedHeight)
+		}
+		if n.Birthday.Hash != birthday.Hash ||
+			n.Birthday.Height != birthdayHeight {
+
+			t.Fatalf("notified resync to %v, want %v", n.Birthday,
+				birthday)
+		}
+	case err := <-errChan:
+		t.Fatalf("resync finished without a notification: %v", err)
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for resync notification")
+	}
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unable to resync: %v", err)
+		}
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for resync")
+	}
+
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		details, err := w.TxStore.TxDetails(
+			tx.ReadBucket(wtxmgrNamespaceKey), &rec.Hash,
+		)
+		if err != nil {
+			return err
+		}
+		if details != nil {
+			t.Fatal("expected the pruned history to be discarded")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
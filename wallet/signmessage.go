@@ -0,0 +1,91 @@
+package wallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// signedMessageMagic is prefixed to messages before they are hashed for
+// signing.  It is the same prefix used by bchd and other Bitcoin Cash wallets,
+// so that signatures created by any of them can be verified by the others.
+const signedMessageMagic = "Bitcoin Signed Message:\n"
+
+// signedMessageHash returns the hash of a message that is signed.
+func signedMessageHash(message string) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarString(&buf, 0, signedMessageMagic)
+	wire.WriteVarString(&buf, 0, message)
+	return chainhash.DoubleHashB(buf.Bytes())
+}
+
+// SignMessage signs a message with the private key of a P2PKH or P2PK address
+// of the wallet, returning the compact signature from which the public key
+// can be recovered.  The wallet must be unlocked.  An error wrapping
+// ErrNotPubKeyAddress is returned for addresses that are not backed by a
+// single key, and one with the waddrmgr.ErrWatchingOnly code if the private
+// key is not available.
+func (w *Wallet) SignMessage(addr bchutil.Address, message string) ([]byte, error) {
+	var maddr waddrmgr.ManagedAddress
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		maddr, err = w.Manager.Address(addrmgrNs, addr)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pka, ok := maddr.(waddrmgr.ManagedPubKeyAddress)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotPubKeyAddress, addr)
+	}
+	privKey, err := pka.PrivKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return bchec.SignCompact(bchec.S256(), privKey,
+		signedMessageHash(message), pka.Compressed())
+}
+
+// VerifyMessage returns whether a compact signature of a message was created
+// by the key of a P2PKH or P2PK address.  Signatures from which no public key
+// can be recovered are reported as invalid.  An error wrapping
+// ErrNotPubKeyAddress is returned for other address types.
+func VerifyMessage(addr bchutil.Address, message string,
+	signature []byte) (bool, error) {
+
+	switch addr.(type) {
+	case *bchutil.AddressPubKeyHash, *bchutil.AddressPubKey:
+	default:
+		return false, fmt.Errorf("%w: %s", ErrNotPubKeyAddress, addr)
+	}
+
+	pubKey, wasCompressed, err := bchec.RecoverCompact(bchec.S256(),
+		signature, signedMessageHash(message))
+	if err != nil {
+		return false, nil
+	}
+	var serializedPubKey []byte
+	if wasCompressed {
+		serializedPubKey = pubKey.SerializeCompressed()
+	} else {
+		serializedPubKey = pubKey.SerializeUncompressed()
+	}
+
+	switch addr := addr.(type) {
+	case *bchutil.AddressPubKeyHash:
+		return bytes.Equal(bchutil.Hash160(serializedPubKey),
+			addr.Hash160()[:]), nil
+	default:
+		return bytes.Equal(serializedPubKey, addr.ScriptAddress()), nil
+	}
+}
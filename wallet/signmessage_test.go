@@ -0,0 +1,90 @@
+package wallet
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestSignMessage ensures messages signed by the key of a wallet address are
+// verified against that address only, and that script addresses can neither
+// sign nor verify messages.
+func TestSignMessage(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	other, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+
+	const message = "hello world"
+	sig, err := w.SignMessage(addr, message)
+	if err != nil {
+		t.Fatalf("unable to sign message: %v", err)
+	}
+
+	tests := []struct {
+		addr    bchutil.Address
+		message string
+		sig     []byte
+		valid   bool
+	}{
+		{addr, message, sig, true},
+		{addr, "goodbye world", sig, false},
+		{other, message, sig, false},
+		{addr, message, sig[1:], false},
+	}
+	for i, test := range tests {
+		valid, err := VerifyMessage(test.addr, test.message, test.sig)
+		if err != nil {
+			t.Fatalf("test %d: unable to verify message: %v", i, err)
+		}
+		if valid != test.valid {
+			t.Fatalf("test %d: got valid %v, want %v", i, valid,
+				test.valid)
+		}
+	}
+
+	// The signature also verifies against the pay-to-pubkey address of
+	// the key.
+	pubKey, err := w.PubKeyForAddress(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkAddr, err := bchutil.NewAddressPubKey(pubKey.SerializeCompressed(),
+		w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if valid, err := VerifyMessage(pkAddr, message, sig); err != nil || !valid {
+		t.Fatalf("signature not valid for pay-to-pubkey address: %v",
+			err)
+	}
+
+	scriptAddr, err := bchutil.NewAddressScriptHash([]byte{0x51},
+		w.ChainParams())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = VerifyMessage(scriptAddr, message, sig)
+	if !errors.Is(err, ErrNotPubKeyAddress) {
+		t.Fatalf("got error %v, want ErrNotPubKeyAddress", err)
+	}
+
+	// Signing requires the wallet to be unlocked.
+	w.Lock()
+	if !w.Locked() {
+		t.Fatal("wallet was not locked")
+	}
+	_, err = w.SignMessage(addr, message)
+	if !waddrmgr.IsError(err, waddrmgr.ErrLocked) {
+		t.Fatalf("got error %v, want ErrLocked", err)
+	}
+}
@@ -52,6 +52,10 @@ type AuthoredTx struct {
 	PrevInputValues []bchutil.Amount
 	TotalInput      bchutil.Amount
 	ChangeIndex     int // negative if no change
+
+	// AbsorbedChange is the remaining output value added to the fee
+	// because it was too small to return to the wallet in a change output.
+	AbsorbedChange bchutil.Amount
 }
 
 // ChangeSource provides P2PKH change output scripts for transaction creation.
@@ -80,6 +84,20 @@ type ChangeSource func() ([]byte, error)
 func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb bchutil.Amount,
 	fetchInputs InputSource, fetchChange ChangeSource) (*AuthoredTx, error) {
 
+	return NewUnsignedTransactionMinChange(outputs, relayFeePerKb, 0,
+		fetchInputs, fetchChange)
+}
+
+// NewUnsignedTransactionMinChange creates an unsigned transaction like
+// NewUnsignedTransaction, except that no change output is created when the
+// remaining output value is less than minChange.  The remaining value is
+// instead added to the fee and reported by the AbsorbedChange field of the
+// result.  This avoids creating change outputs which cost nearly as much to
+// spend as they are worth.
+func NewUnsignedTransactionMinChange(outputs []*wire.TxOut, relayFeePerKb,
+	minChange bchutil.Amount, fetchInputs InputSource,
+	fetchChange ChangeSource) (*AuthoredTx, error) {
+
 	targetAmount := h.SumOutputValues(outputs)
 	estimatedSize := txsizes.EstimateSerializeSize(0, outputs, true)
 	targetFee := txrules.FeeForSerializeSize(relayFeePerKb, estimatedSize)
@@ -108,9 +126,13 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb bchutil.Amount,
 			LockTime: 0,
 		}
 		changeIndex := -1
+		var absorbedChange bchutil.Amount
 		changeAmount := inputAmount - targetAmount - maxRequiredFee
-		if changeAmount != 0 && !txrules.IsDustAmount(changeAmount,
+		if changeAmount < minChange || txrules.IsDustAmount(changeAmount,
 			txsizes.P2PKHPkScriptSize, relayFeePerKb) {
+
+			absorbedChange = changeAmount
+		} else if changeAmount != 0 {
 			changeScript, err := fetchChange()
 			if err != nil {
 				return nil, err
@@ -131,6 +153,7 @@ func NewUnsignedTransaction(outputs []*wire.TxOut, relayFeePerKb bchutil.Amount,
 			PrevInputValues: inputValues,
 			TotalInput:      inputAmount,
 			ChangeIndex:     changeIndex,
+			AbsorbedChange:  absorbedChange,
 		}, nil
 	}
 }
@@ -219,3 +219,52 @@ func TestNewUnsignedTransaction(t *testing.T) {
 		}
 	}
 }
+
+func TestNewUnsignedTransactionMinChange(t *testing.T) {
+	const relayFee = 1e3
+	fee := txrules.FeeForSerializeSize(relayFee,
+		txsizes.EstimateSerializeSize(1, p2pkhOutputs(0), true))
+
+	changeSource := func() ([]byte, error) {
+		return make([]byte, txsizes.P2PKHPkScriptSize), nil
+	}
+
+	tests := []struct {
+		leftover  bchutil.Amount
+		minChange bchutil.Amount
+		change    bool
+	}{
+		// Leftover at or above the minimum is returned as change.
+		{leftover: 2000, minChange: 2000, change: true},
+		{leftover: 2000, minChange: 0, change: true},
+
+		// Leftover below the minimum is added to the fee.
+		{leftover: 1999, minChange: 2000, change: false},
+
+		// Dust is always added to the fee.
+		{leftover: 545, minChange: 0, change: false},
+	}
+	for i, test := range tests {
+		outputs := p2pkhOutputs(1e8 - test.leftover - fee)
+		inputSource := makeInputSource(p2pkhOutputs(1e8))
+		tx, err := NewUnsignedTransactionMinChange(outputs, relayFee,
+			test.minChange, inputSource, changeSource)
+		if err != nil {
+			t.Fatalf("Test %d: Unexpected error: %v", i, err)
+		}
+		if test.change {
+			if tx.ChangeIndex < 0 || tx.AbsorbedChange != 0 {
+				t.Errorf("Test %d: Expected change output, absorbed %v",
+					i, tx.AbsorbedChange)
+			}
+			continue
+		}
+		if tx.ChangeIndex >= 0 {
+			t.Errorf("Test %d: Included change output but expected none", i)
+		}
+		if tx.AbsorbedChange != test.leftover {
+			t.Errorf("Test %d: Absorbed %v, expected %v", i,
+				tx.AbsorbedChange, test.leftover)
+		}
+	}
+}
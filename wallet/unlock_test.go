@@ -0,0 +1,121 @@
+package wallet
+
+import (
+	"testing"
+	"time"
+)
+
+// TestUnlockFor ensures the timeout of the latest unlock replaces the timeouts
+// of earlier ones, that it is reported by UnlockedUntil, and that locking the
+// wallet cancels it.
+func TestUnlockFor(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	privPass := []byte("world")
+	waitLocked := func() {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for !w.Locked() {
+			if time.Now().After(deadline) {
+				t.Fatal("wallet was not relocked after its timeout")
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	// An unlock without a time limit reports no relock time.
+	if err := w.UnlockFor(privPass, 0); err != nil {
+		t.Fatal(err)
+	}
+	if w.Locked() || !w.UnlockedUntil().IsZero() {
+		t.Fatalf("locked %v, unlocked until %v after unlock without "+
+			"timeout", w.Locked(), w.UnlockedUntil())
+	}
+
+	// A short unlock extended by a long one must not relock when the short
+	// timeout would have expired.
+	if err := w.UnlockFor(privPass, 50*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	before := time.Now()
+	if err := w.UnlockFor(privPass, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	until := w.UnlockedUntil()
+	if until.Before(before.Add(time.Hour)) ||
+		until.After(time.Now().Add(time.Hour)) {
+
+		t.Fatalf("unlocked until %v, want an hour from %v", until, before)
+	}
+	time.Sleep(200 * time.Millisecond)
+	if w.Locked() {
+		t.Fatal("wallet relocked by the timeout of an earlier unlock")
+	}
+
+	// Shortening the timeout relocks the wallet once it expires.
+	if err := w.UnlockFor(privPass, 50*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	waitLocked()
+	if !w.UnlockedUntil().IsZero() {
+		t.Fatalf("locked wallet reports unlocked until %v",
+			w.UnlockedUntil())
+	}
+
+	// Locking the wallet cancels a pending timeout.
+	if err := w.UnlockFor(privPass, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	w.Lock()
+	if !w.Locked() || !w.UnlockedUntil().IsZero() {
+		t.Fatalf("locked %v, unlocked until %v after lock", w.Locked(),
+			w.UnlockedUntil())
+	}
+
+	// An unlock with the wrong passphrase locks the wallet and also
+	// cancels a pending timeout.
+	if err := w.UnlockFor(privPass, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.UnlockFor([]byte("wrong"), time.Minute); err == nil {
+		t.Fatal("unlocked with the wrong passphrase")
+	}
+	if !w.Locked() || !w.UnlockedUntil().IsZero() {
+		t.Fatalf("locked %v, unlocked until %v after failed unlock",
+			w.Locked(), w.UnlockedUntil())
+	}
+}
+
+// TestUnlockForConcurrent ensures concurrent unlocks leave the wallet with the
+// timeout of one of them and that it is cancelled by locking the wallet.
+func TestUnlockForConcurrent(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	privPass := []byte("world")
+	errs := make(chan error)
+	for i := 1; i <= 10; i++ {
+		timeout := time.Duration(i) * time.Hour
+		go func() {
+			errs <- w.UnlockFor(privPass, timeout)
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	until := time.Until(w.UnlockedUntil())
+	if w.Locked() || until <= 0 || until > 10*time.Hour {
+		t.Fatalf("locked %v, unlocked for %v after concurrent unlocks",
+			w.Locked(), until)
+	}
+
+	w.Lock()
+	if !w.Locked() || !w.UnlockedUntil().IsZero() {
+		t.Fatalf("locked %v, unlocked until %v after lock", w.Locked(),
+			w.UnlockedUntil())
+	}
+}
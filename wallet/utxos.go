@@ -6,8 +6,13 @@
 package wallet
 
 import (
+	"bytes"
+	"context"
+	"sort"
+
 	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
 	"github.com/gcash/bchwallet/walletdb"
 )
 
@@ -23,8 +28,11 @@ func (p *OutputSelectionPolicy) meetsRequiredConfs(txHeight, curHeight int32) bo
 }
 
 // UnspentOutputs fetches all unspent outputs from the wallet that match rules
-// described in the passed policy.
-func (w *Wallet) UnspentOutputs(policy OutputSelectionPolicy) ([]*TransactionOutput, error) {
+// described in the passed policy.  Matching the outputs of large wallets may
+// take some time, so the context's error is returned if it is canceled first.
+func (w *Wallet) UnspentOutputs(ctx context.Context,
+	policy OutputSelectionPolicy) ([]*TransactionOutput, error) {
+
 	var outputResults []*TransactionOutput
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
@@ -40,6 +48,10 @@ func (w *Wallet) UnspentOutputs(policy OutputSelectionPolicy) ([]*TransactionOut
 		}
 
 		for _, output := range outputs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
 			// Ignore outputs that haven't reached the required
 			// number of confirmations.
 			if !policy.meetsRequiredConfs(output.Height, syncBlock.Height) {
@@ -88,3 +100,59 @@ func (w *Wallet) UnspentOutputs(policy OutputSelectionPolicy) ([]*TransactionOut
 	})
 	return outputResults, err
 }
+
+// SelectUnspentOutputs selects unspent outputs matching the policy until their
+// total exceeds target, or returns every matching output if target is zero.
+// Outputs are considered largest first, breaking ties by the earliest receive
+// time and then by outpoint, so identical wallet state always produces the
+// same selection.  Locked outpoints are never selected.
+//
+// If lock is set, the selected outpoints are locked before returning, as if by
+// LockOutpoint, so that concurrent selections do not return the same outputs.
+// The caller is responsible for unlocking them if they are not spent.
+func (w *Wallet) SelectUnspentOutputs(ctx context.Context,
+	policy OutputSelectionPolicy, target bchutil.Amount,
+	lock bool) ([]*TransactionOutput, bchutil.Amount, error) {
+
+	outputs, err := w.UnspentOutputs(ctx, policy)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.Slice(outputs, func(i, j int) bool {
+		a, b := outputs[i], outputs[j]
+		if a.Output.Value != b.Output.Value {
+			return a.Output.Value > b.Output.Value
+		}
+		if !a.ReceiveTime.Equal(b.ReceiveTime) {
+			return a.ReceiveTime.Before(b.ReceiveTime)
+		}
+		if c := bytes.Compare(a.OutPoint.Hash[:], b.OutPoint.Hash[:]); c != 0 {
+			return c < 0
+		}
+		return a.OutPoint.Index < b.OutPoint.Index
+	})
+
+	// Checking and locking the outpoints under a single acquisition of the
+	// mutex prevents a concurrent selection from choosing any of them.
+	w.lockedOutpointsMtx.Lock()
+	defer w.lockedOutpointsMtx.Unlock()
+
+	var selected []*TransactionOutput
+	var total bchutil.Amount
+	for _, output := range outputs {
+		if _, locked := w.lockedOutpoints[output.OutPoint]; locked {
+			continue
+		}
+		selected = append(selected, output)
+		total += bchutil.Amount(output.Output.Value)
+		if target != 0 && total > target {
+			break
+		}
+	}
+	if lock {
+		for _, output := range selected {
+			w.lockedOutpoints[output.OutPoint] = struct{}{}
+		}
+	}
+	return selected, total, nil
+}
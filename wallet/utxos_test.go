@@ -0,0 +1,161 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/waddrmgr"
+)
+
+// TestUnspentOutputsCanceled ensures UnspentOutputs stops and returns the
+// context's error once the context is canceled.
+func TestUnspentOutputsCanceled(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	addTestCredits(t, w, 100, 100, []bchutil.Address{addr, addr},
+		[]int64{1e8, 2e8})
+
+	policy := OutputSelectionPolicy{Account: 0, RequiredConfirmations: 1}
+	outputs, err := w.UnspentOutputs(context.Background(), policy)
+	if err != nil {
+		t.Fatalf("unable to fetch unspent outputs: %v", err)
+	}
+	if len(outputs) != 2 {
+		t.Fatalf("expected 2 unspent outputs, got %d", len(outputs))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = w.UnspentOutputs(ctx, policy)
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+// TestSelectUnspentOutputs ensures outputs are selected in a deterministic
+// order, that locked outputs are skipped, and that locking selections keeps
+// concurrent selections from returning the same outputs.
+func TestSelectUnspentOutputs(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	addTestCredits(t, w, 100, 100, []bchutil.Address{addr, addr, addr, addr},
+		[]int64{1e8, 3e8, 2e8, 3e8})
+
+	policy := OutputSelectionPolicy{Account: 0, RequiredConfirmations: 1}
+	ctx := context.Background()
+
+	selected, total, err := w.SelectUnspentOutputs(ctx, policy, 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 4 || total != 9e8 {
+		t.Fatalf("selected %d outputs totaling %v, want 4 totaling 9 BCH",
+			len(selected), total)
+	}
+	for i, want := range []uint32{1, 3, 2, 0} {
+		if selected[i].OutPoint.Index != want {
+			t.Fatalf("output %d has index %d, want %d", i,
+				selected[i].OutPoint.Index, want)
+		}
+	}
+
+	// Locked outputs are skipped.
+	w.LockOutpoint(selected[0].OutPoint)
+	selected, total, err = w.SelectUnspentOutputs(ctx, policy, 4e8, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(selected) != 2 || total != 5e8 || selected[0].OutPoint.Index != 3 {
+		t.Fatalf("unexpected selection with a locked output: %d outputs "+
+			"totaling %v", len(selected), total)
+	}
+	w.ResetLockedOutpoints()
+
+	// Concurrent locking selections never share an output.
+	results := make(chan []*TransactionOutput, 4)
+	for i := 0; i < 4; i++ {
+		go func() {
+			selected, _, err := w.SelectUnspentOutputs(ctx, policy, 1, true)
+			if err != nil {
+				t.Error(err)
+			}
+			results <- selected
+		}()
+	}
+	seen := make(map[wire.OutPoint]struct{})
+	for i := 0; i < 4; i++ {
+		selected := <-results
+		if len(selected) != 1 {
+			t.Fatalf("selected %d outputs, want 1", len(selected))
+		}
+		op := selected[0].OutPoint
+		if _, ok := seen[op]; ok {
+			t.Fatalf("outpoint %v selected twice", op)
+		}
+		seen[op] = struct{}{}
+	}
+	if len(w.LockedOutpoints()) != 4 {
+		t.Fatalf("got %d locked outpoints, want 4", len(w.LockedOutpoints()))
+	}
+}
+
+// TestLockedOutputs ensures locked outpoints are listed in order with the
+// amounts of the wallet outputs they refer to.
+func TestLockedOutputs(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	rec := addTestCredits(t, w, 100, 100, []bchutil.Address{addr, addr},
+		[]int64{1e8, 3e8})
+
+	// Outpoints which are not outputs of the wallet may be locked too.
+	unknown := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 5}
+	w.LockOutpoint(unknown)
+	w.LockOutpoint(wire.OutPoint{Hash: rec.Hash, Index: 1})
+	w.LockOutpoint(wire.OutPoint{Hash: rec.Hash, Index: 0})
+
+	locked, err := w.LockedOutputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []LockedOutput{
+		{OutPoint: wire.OutPoint{Hash: rec.Hash, Index: 0}, Amount: 1e8},
+		{OutPoint: wire.OutPoint{Hash: rec.Hash, Index: 1}, Amount: 3e8},
+		{OutPoint: unknown},
+	}
+	if bytes.Compare(unknown.Hash[:], rec.Hash[:]) < 0 {
+		want = []LockedOutput{want[2], want[0], want[1]}
+	}
+	if !reflect.DeepEqual(locked, want) {
+		t.Fatalf("got locked outputs %v, want %v", locked, want)
+	}
+
+	w.UnlockOutpoint(unknown)
+	locked, err = w.LockedOutputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(locked) != 2 {
+		t.Fatalf("got %d locked outputs after unlocking, want 2",
+			len(locked))
+	}
+}
@@ -7,9 +7,11 @@ package wallet
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"runtime"
 	"sort"
 	"strings"
 	"sync"
@@ -67,9 +69,61 @@ var (
 	// down.
 	ErrWalletShuttingDown = errors.New("wallet shutting down")
 
+	// ErrInvalidBlockRange describes an error where a block range begins
+	// after it ends or includes negative heights.
+	ErrInvalidBlockRange = errors.New("invalid block range")
+
+	// ErrChangeAddressNotOwned describes an error where a change address
+	// requested for a new transaction is not controlled by the wallet.
+	ErrChangeAddressNotOwned = errors.New("change address does not belong " +
+		"to the wallet")
+
+	// ErrWalletVersion describes an error where the wallet database was
+	// written by a newer version of the software and can not be opened
+	// without risking its corruption.
+	ErrWalletVersion = errors.New("wallet database version is newer than " +
+		"supported by this software")
+
+	// ErrImmatureSpendNotAcknowledged describes an error where a
+	// transaction spending immature coinbase outputs was requested without
+	// acknowledging that it can not be mined before they mature.
+	ErrImmatureSpendNotAcknowledged = errors.New("spending immature " +
+		"coinbase outputs requires acknowledging the risk")
+
+	// ErrImmatureSpendLockTime describes an error where the lock time of a
+	// transaction spending immature coinbase outputs is not a block height
+	// at or after which all of its coinbase inputs are mature.
+	ErrImmatureSpendLockTime = errors.New("invalid lock time for spending " +
+		"immature coinbase outputs")
+
+	// ErrHeightNotSynced describes an error where a query for the state of
+	// the wallet at a block height was made for a height above the block
+	// the wallet is synced to.
+	ErrHeightNotSynced = errors.New("block height is above the wallet's " +
+		"synced height")
+
+	// ErrHistoryUnavailable describes an error where the state of the
+	// wallet at a block height can not be reconstructed, because the height
+	// is before the wallet's birthday block or transactions needed have
+	// been pruned.
+	ErrHistoryUnavailable = errors.New("wallet history is unavailable at " +
+		"block height")
+
+	// ErrNotPubKeyAddress describes an error where the private key of a
+	// wallet address was requested, but the address is not backed by a
+	// single key, such as a script address.
+	ErrNotPubKeyAddress = errors.New("address is not a public key address")
+
+	// ErrInputNotEligible describes an error where an input selected for
+	// a new transaction is not a spendable output of the account.
+	ErrInputNotEligible = errors.New("selected input is not a spendable " +
+		"output of the account")
+
 	// Namespace bucket keys.
 	waddrmgrNamespaceKey = []byte("waddrmgr")
 	wtxmgrNamespaceKey   = []byte("wtxmgr")
+	walletNamespaceKey   = []byte("wallet")
+	labelsNamespaceKey   = []byte("labels")
 )
 
 // Wallet is a structure containing all the components for a
@@ -78,6 +132,11 @@ var (
 type Wallet struct {
 	publicPassphrase []byte
 
+	// publicEncrypted is whether the public data of the wallet is
+	// encrypted with a passphrase other than InsecurePubPassphrase.
+	publicEncrypted    bool
+	publicEncryptedMtx sync.Mutex
+
 	// Data stores
 	db      walletdb.DB
 	Manager *waddrmgr.Manager
@@ -88,9 +147,52 @@ type Wallet struct {
 	chainClientSynced  bool
 	chainClientSyncMtx sync.Mutex
 
-	lockedOutpoints map[wire.OutPoint]struct{}
+	lockedOutpoints    map[wire.OutPoint]struct{}
+	lockedOutpointsMtx sync.Mutex
+
+	recoveryWindow         uint32
+	internalRecoveryWindow uint32
+
+	// maxRollbackDepth is the deepest reorg, in blocks, that is handled
+	// by rolling back the wallet's state block by block when syncing.
+	// Deeper reorgs cause the wallet to resync from its birthday block.
+	maxRollbackDepth int32
+
+	// pruneSpentTxs enables pruning the serialized transactions of
+	// fully-spent transactions mined deeper than maxRollbackDepth.
+	pruneSpentTxs bool
+
+	// publishAttempts is the number of times a transaction is sent to the
+	// chain backend when sending fails because of a connection error, and
+	// publishRetryDelay the delay before the first retry.
+	publishAttempts   uint32
+	publishRetryDelay time.Duration
+
+	// broadcasters are the endpoints transactions are broadcast to in
+	// addition to the chain backend.
+	broadcasters []TxBroadcaster
+
+	// minChangeAmount is the smallest change output created by the
+	// wallet.  Smaller change is added to the transaction fee instead.
+	minChangeAmount bchutil.Amount
 
-	recoveryWindow uint32
+	// balanceCheckInterval is the interval between periodic balance
+	// self-checks, or zero to disable them.
+	balanceCheckInterval time.Duration
+
+	// gapConfirmations is the number of confirmations a deposit to an
+	// external address needs before the address closes the gap counted
+	// against the gap limit.  Zero counts addresses as soon as a deposit
+	// is seen, as the address manager does.
+	gapConfirmations int32
+
+	// gapDeposits caches the addresses paid by confirmed deposits for
+	// the confirmed gap check.
+	gapDeposits confirmedDeposits
+
+	// filterWorkers is the maximum number of blocks the chain backend
+	// fetches and filters concurrently when recovering addresses.
+	filterWorkers int
 
 	// Channels for rescan processing.  Requests are added and merged with
 	// any waiting requests, before being sent to another goroutine to
@@ -112,6 +214,7 @@ type Wallet struct {
 	lockRequests       chan struct{}
 	holdUnlockRequests chan chan heldUnlock
 	lockState          chan bool
+	unlockedUntil      chan time.Time
 	changePassphrase   chan changePassphraseRequest
 	changePassphrases  chan changePassphrasesRequest
 
@@ -158,6 +261,11 @@ func (w *Wallet) Start() {
 	go w.txCreator()
 	go w.walletLocker()
 	go w.recoveryInterruptHandler()
+
+	if w.balanceCheckInterval > 0 {
+		w.wg.Add(1)
+		go w.balanceChecker(w.balanceCheckInterval)
+	}
 }
 
 // recoveryInterruptHandler handles the recovery interrupt and closes
@@ -250,6 +358,21 @@ func (w *Wallet) ChainClient() chain.Interface {
 	return chainClient
 }
 
+// quitContext returns a context that is canceled when the wallet is stopped or
+// the returned cancel function is called.
+func (w *Wallet) quitContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	quit := w.quitChan()
+	go func() {
+		select {
+		case <-quit:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
 // quitChan atomically reads the quit channel.
 func (w *Wallet) quitChan() <-chan struct{} {
 	w.quitMu.Lock()
@@ -325,7 +448,7 @@ func (w *Wallet) ChainSynced() bool {
 //
 // NOTE: Due to an API limitation with rpcclient, this may return true after
 // the client disconnected (and is attempting a reconnect).  This will be unknown
-// until the reconnect notification is received, at which point the wallet can be
+// until the reconnect notification is received, at which point the wallet is
 // marked out of sync again until after the next rescan completes.
 func (w *Wallet) SetChainSynced(synced bool) {
 	w.chainClientSyncMtx.Lock()
@@ -391,7 +514,7 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) error {
 			chainClient, w.Manager.Birthday(),
 		)
 		if err != nil {
-			return fmt.Errorf("unable to locate birthday block: %v",
+			return fmt.Errorf("unable to locate birthday block: %w",
 				err)
 		}
 
@@ -437,16 +560,19 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) error {
 		})
 		if err != nil {
 			return fmt.Errorf("unable to persist initial sync "+
-				"data: %v", err)
+				"data: %w", err)
 		}
 	}
 
 	// If the wallet requested an on-chain recovery of its funds, we'll do
 	// so now.
 	if w.recoveryWindow > 0 {
-		if err := w.recovery(chainClient, birthdayStamp); err != nil {
+		ctx, cancel := w.quitContext()
+		err := w.recovery(ctx, chainClient, birthdayStamp)
+		cancel()
+		if err != nil {
 			return fmt.Errorf("unable to perform wallet recovery: "+
-				"%v", err)
+				"%w", err)
 		}
 	}
 
@@ -455,11 +581,45 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) error {
 	// before catching up with the rescan.
 	rollback := false
 	rollbackStamp := w.Manager.SyncedTo()
+	var resync *ResyncNotification
 	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		txmgrNs := tx.ReadWriteBucket(wtxmgrNamespaceKey)
 
+		syncedHeight := rollbackStamp.Height
 		for height := rollbackStamp.Height; true; height-- {
+			// If the reorg is deeper than we're willing to roll
+			// back incrementally, resync from our birthday instead.
+			if syncedHeight-height > w.maxRollbackDepth {
+				log.Warnf("Chain reorganization from height %d "+
+					"exceeds the maximum rollback depth of %d "+
+					"blocks, resyncing from birthday block %v "+
+					"(height %d)", syncedHeight,
+					w.maxRollbackDepth, birthdayStamp.Hash,
+					birthdayStamp.Height)
+
+				chainHash, err := chainClient.GetBlockHash(
+					int64(birthdayStamp.Height),
+				)
+				if err != nil {
+					return err
+				}
+				header, err := chainClient.GetBlockHeader(chainHash)
+				if err != nil {
+					return err
+				}
+				resync = &ResyncNotification{
+					SyncedTo: w.Manager.SyncedTo(),
+					Birthday: waddrmgr.BlockStamp{
+						Hash:      *chainHash,
+						Height:    birthdayStamp.Height,
+						Timestamp: header.Timestamp,
+					},
+					MaxRollbackDepth: w.maxRollbackDepth,
+				}
+				return w.resyncFromBirthday(tx, &resync.Birthday)
+			}
+
 			hash, err := w.Manager.BlockHash(addrmgrNs, height)
 			if err != nil {
 				return err
@@ -512,12 +672,19 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) error {
 		// stale state. `Rollback` unconfirms transactions at and beyond
 		// the passed height, so add one to the new synced-to height to
 		// prevent unconfirming transactions in the synced-to block.
+		w.gapDeposits.invalidate(rollbackStamp.Height + 1)
 		return w.TxStore.Rollback(txmgrNs, rollbackStamp.Height+1)
 	})
 	if err != nil {
 		return err
 	}
 
+	// Let clients know the transaction history was discarded, as balances
+	// and transactions are missing until the rescan rebuilds them.
+	if resync != nil {
+		w.NtfnServer.notifyResync(resync)
+	}
+
 	// Request notifications for connected and disconnected blocks.
 	//
 	// TODO(jrick): Either request this notification only once, or when
@@ -548,6 +715,68 @@ func (w *Wallet) syncWithChain(birthdayStamp *waddrmgr.BlockStamp) error {
 	return w.rescanWithTarget(addrs, unspent, nil)
 }
 
+// resyncFromBirthday discards the wallet's transaction history and rewinds its
+// sync state to the birthday block, so that the following rescan rebuilds the
+// history from the birthday onwards.  This is used instead of rolling back
+// block by block when a reorg is deeper than the wallet's maximum rollback
+// depth, as the block hashes needed to do so reliably may no longer be stored.
+func (w *Wallet) resyncFromBirthday(tx walletdb.ReadWriteTx,
+	birthdayStamp *waddrmgr.BlockStamp) error {
+
+	w.gapDeposits.invalidate(0)
+	err := tx.DeleteTopLevelBucket(wtxmgrNamespaceKey)
+	if err != nil && err != walletdb.ErrBucketNotFound {
+		return err
+	}
+	txmgrNs, err := tx.CreateTopLevelBucket(wtxmgrNamespaceKey)
+	if err != nil {
+		return err
+	}
+	if err := wtxmgr.Create(txmgrNs); err != nil {
+		return err
+	}
+
+	// The birthday block serves as a barrier requiring the previous block
+	// hash to be stored when updating our sync state, so it must be removed
+	// before rewinding to it.
+	addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+	if err := waddrmgr.DeleteBirthdayBlock(addrmgrNs); err != nil {
+		return err
+	}
+	if err := w.Manager.SetSyncedTo(addrmgrNs, birthdayStamp); err != nil {
+		return err
+	}
+	return w.Manager.SetBirthdayBlock(addrmgrNs, *birthdayStamp, true)
+}
+
+// resyncPrunedHistory resyncs the wallet from its birthday block after a chain
+// reorganization reached transactions pruned from the transaction store, which
+// can not be rolled back.  Clients are notified of the resync, and the rescan
+// rebuilding the history blocks until finished.
+func (w *Wallet) resyncPrunedHistory() error {
+	var resync *ResyncNotification
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		birthday, _, err := w.Manager.BirthdayBlock(addrmgrNs)
+		if err != nil {
+			return err
+		}
+		resync = &ResyncNotification{
+			SyncedTo:         w.Manager.SyncedTo(),
+			Birthday:         birthday,
+			MaxRollbackDepth: w.maxRollbackDepth,
+		}
+		return w.resyncFromBirthday(tx, &birthday)
+	})
+	if err != nil {
+		return err
+	}
+	w.NtfnServer.notifyResync(resync)
+
+	w.SetChainSynced(false)
+	return w.syncWithChain(&resync.Birthday)
+}
+
 // isDevEnv determines whether the wallet is currently under a local developer
 // environment, e.g. simnet or regtest.
 func (w *Wallet) isDevEnv() bool {
@@ -662,16 +891,18 @@ func locateBirthdayBlock(chainClient chainConn,
 // recovery attempts to recover any unspent outputs that pay to any of our
 // addresses starting from our birthday, or the wallet's tip (if higher), which
 // would indicate resuming a recovery after a restart.
-func (w *Wallet) recovery(chainClient chain.Interface,
+func (w *Wallet) recovery(ctx context.Context, chainClient chain.Interface,
 	birthdayBlock *waddrmgr.BlockStamp) error {
 
 	log.Infof("RECOVERY MODE ENABLED -- rescanning for used addresses "+
-		"with recovery_window=%d", w.recoveryWindow)
+		"with recovery_window=%d internal_recovery_window=%d",
+		w.recoveryWindow, w.internalRecoveryWindow)
 
 	// We'll initialize the recovery manager with a default batch size of
 	// 2000.
 	recoveryMgr := NewRecoveryManager(
-		w.recoveryWindow, recoveryBatchSize, w.chainParams,
+		w.recoveryWindow, w.internalRecoveryWindow, recoveryBatchSize,
+		w.chainParams,
 	)
 
 	// In the event that this recovery is being resumed, we will need to
@@ -714,6 +945,12 @@ func (w *Wallet) recovery(chainClient chain.Interface,
 	var blocks []*waddrmgr.BlockStamp
 	startHeight := w.Manager.SyncedTo().Height + 1
 	for height := startHeight; height <= bestHeight; height++ {
+		// Stop deriving and scanning if the recovery is canceled.  Any
+		// progress committed so far is resumed from on restart.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		hash, err := chainClient.GetBlockHash(int64(height))
 		if err != nil {
 			return err
@@ -846,7 +1083,9 @@ func (w *Wallet) recoverDefaultScopes(
 //     address are contained in a particular block.
 //  3. Record all internal and external addresses found in the block.
 //  4. Record any outpoints found in the block that should be watched for spends
-//  5. Trim the range of blocks up to and including the one reporting the addrs.
+//  5. Trim the range of blocks up to and including the one reporting the addrs,
+//     or up to it if the addrs found exhausted a horizon, since addresses past
+//     the horizon may also have been used in that block.
 //  6. Repeat from (1) if there are still more blocks in the range.
 func (w *Wallet) recoverScopedAddresses(
 	chainClient chain.Interface,
@@ -879,6 +1118,7 @@ expandHorizons:
 	// of blocks we intend to scan, in addition to the scope-index -> addr
 	// map for all internal and external branches.
 	filterReq := newFilterBlocksRequest(batch, scopedMgrs, recoveryState)
+	filterReq.Workers = w.filterWorkers
 	w.addRecoveryInterruptChan(filterReq.Interrupt)
 
 	// Initiate the filter blocks request using our chain backend. If an
@@ -941,8 +1181,15 @@ expandHorizons:
 	}
 
 	// Update the batch to indicate that we've processed all block through
-	// the one that returned found addresses.
-	batch = batch[filterResp.BatchIndex+1:]
+	// the one that returned found addresses.  If the addresses found were
+	// near the edge of a branch's horizon, addresses past the horizon may
+	// have been used in the same block without being watched, so that
+	// block is filtered again once the horizons are expanded.
+	if horizonsExhausted(scopedMgrs, recoveryState) {
+		batch = batch[filterResp.BatchIndex:]
+	} else {
+		batch = batch[filterResp.BatchIndex+1:]
+	}
 
 	// If this was not the last block in the batch, we will repeat the
 	// filtering process again after expanding our horizons.
@@ -973,6 +1220,24 @@ func (w *Wallet) removeRecoveryInterruptChan(c chan struct{}) {
 	w.recoveryLock.Unlock()
 }
 
+// horizonsExhausted returns true if the horizon of any branch of the given
+// scopes must be expanded to keep watching a full recovery window past the
+// last address found.
+func horizonsExhausted(
+	scopedMgrs map[waddrmgr.KeyScope]*waddrmgr.ScopedKeyManager,
+	recoveryState *RecoveryState) bool {
+
+	for scope := range scopedMgrs {
+		scopeState := recoveryState.StateForScope(scope)
+		if scopeState.ExternalBranch.HorizonExhausted() ||
+			scopeState.InternalBranch.HorizonExhausted() {
+
+			return true
+		}
+	}
+	return false
+}
+
 // expandScopeHorizons ensures that the ScopeRecoveryState has an adequately
 // sized look ahead for both its internal and external branches. The keys
 // derived here are added to the scope's recovery state, but do not affect the
@@ -989,7 +1254,7 @@ func expandScopeHorizons(ns walletdb.ReadWriteBucket,
 	exHorizon, exWindow := scopeState.ExternalBranch.ExtendHorizon()
 	count, childIndex := uint32(0), exHorizon
 	for count < exWindow {
-		keyPath := externalKeyPath(childIndex)
+		keyPath := externalKeyPath(scopeState.Account, childIndex)
 		addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 		switch {
 		case err == hdkeychain.ErrInvalidChild:
@@ -1019,7 +1284,7 @@ func expandScopeHorizons(ns walletdb.ReadWriteBucket,
 	inHorizon, inWindow := scopeState.InternalBranch.ExtendHorizon()
 	count, childIndex = 0, inHorizon
 	for count < inWindow {
-		keyPath := internalKeyPath(childIndex)
+		keyPath := internalKeyPath(scopeState.Account, childIndex)
 		addr, err := scopedMgr.DeriveFromKeyPath(ns, keyPath)
 		switch {
 		case err == hdkeychain.ErrInvalidChild:
@@ -1046,19 +1311,21 @@ func expandScopeHorizons(ns walletdb.ReadWriteBucket,
 	return nil
 }
 
-// externalKeyPath returns the relative external derivation path /0/0/index.
-func externalKeyPath(index uint32) waddrmgr.DerivationPath {
+// externalKeyPath returns the relative external derivation path
+// /account/0/index.
+func externalKeyPath(account, index uint32) waddrmgr.DerivationPath {
 	return waddrmgr.DerivationPath{
-		Account: waddrmgr.DefaultAccountNum,
+		Account: account,
 		Branch:  waddrmgr.ExternalBranch,
 		Index:   index,
 	}
 }
 
-// internalKeyPath returns the relative internal derivation path /0/1/index.
-func internalKeyPath(index uint32) waddrmgr.DerivationPath {
+// internalKeyPath returns the relative internal derivation path
+// /account/1/index.
+func internalKeyPath(account, index uint32) waddrmgr.DerivationPath {
 	return waddrmgr.DerivationPath{
-		Account: waddrmgr.DefaultAccountNum,
+		Account: account,
 		Branch:  waddrmgr.InternalBranch,
 		Index:   index,
 	}
@@ -1134,7 +1401,7 @@ func extendFoundAddresses(ns walletdb.ReadWriteBucket,
 		}
 
 		err := scopedMgr.ExtendExternalAddresses(
-			ns, waddrmgr.DefaultAccountNum, exLastFound,
+			ns, scopeState.Account, exLastFound,
 		)
 		if err != nil {
 			return err
@@ -1176,7 +1443,7 @@ func extendFoundAddresses(ns walletdb.ReadWriteBucket,
 			inLastFound--
 		}
 		err := scopedMgr.ExtendInternalAddresses(
-			ns, waddrmgr.DefaultAccountNum, inLastFound,
+			ns, scopeState.Account, inLastFound,
 		)
 		if err != nil {
 			return err
@@ -1233,6 +1500,7 @@ func logFilterBlocksResp(block wtxmgr.BlockMeta,
 
 type (
 	createTxRequest struct {
+		keyScope    *waddrmgr.KeyScope
 		account     uint32
 		outputs     []*wire.TxOut
 		minconf     int32
@@ -1267,8 +1535,8 @@ out:
 				txr.resp <- createTxResponse{nil, err}
 				continue
 			}
-			tx, err := w.txToOutputs(txr.outputs, txr.account,
-				txr.minconf, txr.feeSatPerKB, txr.dryRun)
+			tx, err := w.txToOutputs(txr.outputs, txr.keyScope,
+				txr.account, txr.minconf, txr.feeSatPerKB, txr.dryRun)
 			heldUnlock.release()
 			txr.resp <- createTxResponse{tx, err}
 		case <-quit:
@@ -1285,13 +1553,19 @@ out:
 // function is serialized to prevent the creation of many transactions which
 // spend the same outputs.
 //
+// If keyScope is non-nil, only outputs of the account under that key scope are
+// spent and change is derived under the same scope.  Otherwise outputs of the
+// account under any scope may be spent and change is derived under the default
+// pay-to-pubkey-hash scope.
+//
 // NOTE: The dryRun argument can be set true to create a tx that doesn't alter
 // the database. A tx created with this set to true SHOULD NOT be broadcasted.
-func (w *Wallet) CreateSimpleTx(account uint32, outputs []*wire.TxOut,
-	minconf int32, satPerKb bchutil.Amount, dryRun bool) (
-	*txauthor.AuthoredTx, error) {
+func (w *Wallet) CreateSimpleTx(keyScope *waddrmgr.KeyScope, account uint32,
+	outputs []*wire.TxOut, minconf int32, satPerKb bchutil.Amount,
+	dryRun bool) (*txauthor.AuthoredTx, error) {
 
 	req := createTxRequest{
+		keyScope:    keyScope,
 		account:     account,
 		outputs:     outputs,
 		minconf:     minconf,
@@ -1309,17 +1583,51 @@ func (w *Wallet) CreateSimpleTx(account uint32, outputs []*wire.TxOut,
 // address/amount pairs.  Change and an appropriate transaction fee are
 // automatically included, if necessary.  All transaction creation through this
 // function is serialized to prevent the creation of many transactions which
-// spend the same outputs.
-func (w *Wallet) CreateUnsignedTx(account uint32, outputs []*wire.TxOut,
-	minconf int32, satPerKb bchutil.Amount) (*txauthor.AuthoredTx, error) {
+// spend the same outputs.  The strategy of the options determines how inputs
+// are selected; CoinSelectionSingleAddress may be used to avoid combining
+// outputs paying to unrelated addresses.
+//
+// The key scope of the account is interpreted as by CreateSimpleTx.  Change is
+// paid to the change address of the options if it is non-nil, and to a change
+// address of the account otherwise.  ErrChangeAddressNotOwned is returned if
+// that address is not controlled by the wallet.
+//
+// If the options permit spending immature coinbase outputs, they may also be
+// selected and the transaction is locked until they mature.  See
+// ImmatureCoinbaseSpend.
+func (w *Wallet) CreateUnsignedTx(keyScope *waddrmgr.KeyScope, account uint32,
+	outputs []*wire.TxOut, minconf int32, satPerKb bchutil.Amount,
+	opts TxCreateOptions) (*txauthor.AuthoredTx, error) {
+
+	return w.createUnsigned(outputs, keyScope, account, minconf, satPerKb,
+		opts.Strategy, opts.ChangeAddr, opts.Immature, nil)
+}
 
-	return w.createUnsigned(outputs, account, minconf, satPerKb)
+// CreateUnsignedTxWithInputs creates a new unsigned transaction like
+// CreateUnsignedTx, except that inputs are not selected automatically and
+// every given outpoint is spent instead.  Each must be an unspent output of
+// the account that is not locked and can be signed for, otherwise an error
+// wrapping ErrInputNotEligible is returned.  Unconfirmed outputs may be
+// selected, but immature coinbase outputs may not.  Change is paid to a change
+// address of the account, and an error is returned if the inputs do not cover
+// the outputs and fee.
+func (w *Wallet) CreateUnsignedTxWithInputs(account uint32,
+	outputs []*wire.TxOut, inputs []wire.OutPoint,
+	feeRate bchutil.Amount) (*txauthor.AuthoredTx, error) {
+
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("%w: no inputs selected",
+			ErrInputNotEligible)
+	}
+	return w.createUnsigned(outputs, nil, account, 0, feeRate,
+		CoinSelectionLargest, nil, nil, inputs)
 }
 
 type (
 	unlockRequest struct {
 		passphrase []byte
 		lockAfter  <-chan time.Time // nil prevents the timeout.
+		timeout    time.Duration    // used instead of lockAfter if positive
 		err        chan error
 	}
 
@@ -1348,6 +1656,20 @@ func (w *Wallet) walletLocker() {
 	var timeout <-chan time.Time
 	holdChan := make(heldUnlock)
 	quit := w.quitChan()
+
+	// timer is the relock timer of an unlock with a known duration, which
+	// expires at unlockedUntil.  It is stopped whenever the timeout is
+	// replaced so superseded unlocks do not leave timers running.
+	var timer *time.Timer
+	var unlockedUntil time.Time
+	stopTimer := func() {
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+		unlockedUntil = time.Time{}
+	}
+	defer stopTimer()
 out:
 	for {
 		select {
@@ -1357,10 +1679,24 @@ out:
 				return w.Manager.Unlock(addrmgrNs, req.passphrase)
 			})
 			if err != nil {
+				// A wrong passphrase locks the manager, which
+				// leaves no timeout pending.
+				if w.Manager.IsLocked() {
+					stopTimer()
+					timeout = nil
+				}
 				req.err <- err
 				continue
 			}
+
+			// The latest unlock replaces any earlier timeout.
+			stopTimer()
 			timeout = req.lockAfter
+			if req.timeout > 0 {
+				timer = time.NewTimer(req.timeout)
+				timeout = timer.C
+				unlockedUntil = time.Now().Add(req.timeout)
+			}
 			if timeout == nil {
 				log.Info("The wallet has been unlocked without a time limit")
 			} else {
@@ -1372,30 +1708,39 @@ out:
 		case req := <-w.changePassphrase:
 			err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 				addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+				scryptOpts := w.Manager.ScryptOptions(req.private)
 				return w.Manager.ChangePassphrase(
 					addrmgrNs, req.old, req.new, req.private,
-					&waddrmgr.DefaultScryptOptions,
+					&scryptOpts,
 				)
 			})
+			if err == nil && !req.private {
+				w.setPublicEncrypted(req.new)
+			}
 			req.err <- err
 			continue
 
 		case req := <-w.changePassphrases:
 			err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 				addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+				pubScryptOpts := w.Manager.ScryptOptions(false)
 				err := w.Manager.ChangePassphrase(
 					addrmgrNs, req.publicOld, req.publicNew,
-					false, &waddrmgr.DefaultScryptOptions,
+					false, &pubScryptOpts,
 				)
 				if err != nil {
 					return err
 				}
 
+				privScryptOpts := w.Manager.ScryptOptions(true)
 				return w.Manager.ChangePassphrase(
 					addrmgrNs, req.privateOld, req.privateNew,
-					true, &waddrmgr.DefaultScryptOptions,
+					true, &privScryptOpts,
 				)
 			})
+			if err == nil {
+				w.setPublicEncrypted(req.publicNew)
+			}
 			req.err <- err
 			continue
 
@@ -1423,6 +1768,9 @@ out:
 		case w.lockState <- w.Manager.IsLocked():
 			continue
 
+		case w.unlockedUntil <- unlockedUntil:
+			continue
+
 		case <-quit:
 			break out
 
@@ -1432,6 +1780,7 @@ out:
 
 		// Select statement fell through by an explicit lock or the
 		// timer expiring.  Lock the manager here.
+		stopTimer()
 		timeout = nil
 		err := w.Manager.Lock()
 		if err != nil && !waddrmgr.IsError(err, waddrmgr.ErrLocked) {
@@ -1458,6 +1807,22 @@ func (w *Wallet) Unlock(passphrase []byte, lock <-chan time.Time) error {
 	return <-err
 }
 
+// UnlockFor unlocks the wallet's address manager and relocks it once timeout
+// has elapsed, or never if timeout is not positive.  Like Unlock, an unlock of
+// an already unlocked wallet replaces its current timeout, so the latest call
+// wins: the timeout may be extended, shortened or removed by calling UnlockFor
+// again.  Unlike Unlock, the time the wallet will be relocked is known and
+// reported by UnlockedUntil.
+func (w *Wallet) UnlockFor(passphrase []byte, timeout time.Duration) error {
+	err := make(chan error, 1)
+	w.unlockRequests <- unlockRequest{
+		passphrase: passphrase,
+		timeout:    timeout,
+		err:        err,
+	}
+	return <-err
+}
+
 // Lock locks the wallet's address manager.
 func (w *Wallet) Lock() {
 	w.lockRequests <- struct{}{}
@@ -1468,6 +1833,14 @@ func (w *Wallet) Locked() bool {
 	return <-w.lockState
 }
 
+// UnlockedUntil returns the time the wallet will be relocked after being
+// unlocked by UnlockFor.  The zero time is returned when the wallet is locked,
+// or is unlocked without a time limit or by Unlock with a caller-provided lock
+// channel; Locked distinguishes these cases.
+func (w *Wallet) UnlockedUntil() time.Time {
+	return <-w.unlockedUntil
+}
+
 // holdUnlock prevents the wallet from being locked.  The heldUnlock object
 // *must* be released, or the wallet will forever remain unlocked.
 //
@@ -1591,12 +1964,15 @@ func (w *Wallet) CalculateBalance(confirms int32) (bchutil.Amount, error) {
 	return balance, err
 }
 
-// Balances records total, spendable (by policy), and immature coinbase
-// reward balance amounts.
+// Balances records total, spendable (by policy), immature coinbase reward,
+// time-locked, and watch-only balance amounts.  Watch-only outputs are
+// included in the total but are never considered spendable.
 type Balances struct {
 	Total          bchutil.Amount
 	Spendable      bchutil.Amount
 	ImmatureReward bchutil.Amount
+	TimeLocked     bchutil.Amount
+	WatchOnly      bchutil.Amount
 }
 
 // CalculateAccountBalances sums the amounts of all unspent transaction
@@ -1622,20 +1998,68 @@ func (w *Wallet) CalculateAccountBalances(account uint32, confirms int32) (Balan
 		for i := range unspent {
 			output := &unspent[i]
 
-			var outputAcct uint32
-			_, addrs, _, err := txscript.ExtractPkScriptAddrs(
-				output.PkScript, w.chainParams)
-			if err == nil && len(addrs) > 0 {
-				_, outputAcct, err = w.Manager.AddrAccount(addrmgrNs, addrs[0])
+			ma, err := w.outputAddress(addrmgrNs, output.PkScript)
+			if err != nil || ma.Account() != account {
+				continue
+			}
+
+			bals.Total += output.Amount
+			if ma.WatchOnly() {
+				bals.WatchOnly += output.Amount
+			} else if output.FromCoinBase && !confirmed(int32(w.chainParams.CoinbaseMaturity),
+				output.Height, syncBlock.Height) {
+				bals.ImmatureReward += output.Amount
+			} else if creditLockHeight(tx, output) > syncBlock.Height {
+				bals.TimeLocked += output.Amount
+			} else if confirmed(confirms, output.Height, syncBlock.Height) {
+				bals.Spendable += output.Amount
 			}
-			if err != nil || outputAcct != account {
+		}
+		return nil
+	})
+	return bals, err
+}
+
+// AddressBalance sums the amounts of all unspent transaction outputs paying
+// to an address of the wallet, whether derived or imported, and returns the
+// balance the same way as CalculateAccountBalances.  Outputs paying to the
+// address's public key are included.  An address of the wallet which has never
+// received any outputs has zero balances, while an address the wallet does not
+// know about returns an error.
+func (w *Wallet) AddressBalance(a bchutil.Address, confirms int32) (Balances, error) {
+	var bals Balances
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		addr, err := w.Manager.Address(addrmgrNs, a)
+		if err != nil {
+			return err
+		}
+		encoded := addr.Address().EncodeAddress()
+
+		syncBlock := w.Manager.SyncedTo()
+
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		for i := range unspent {
+			output := &unspent[i]
+
+			ma, err := w.outputAddress(addrmgrNs, output.PkScript)
+			if err != nil || ma.Address().EncodeAddress() != encoded {
 				continue
 			}
 
 			bals.Total += output.Amount
-			if output.FromCoinBase && !confirmed(int32(w.chainParams.CoinbaseMaturity),
+			if ma.WatchOnly() {
+				bals.WatchOnly += output.Amount
+			} else if output.FromCoinBase && !confirmed(int32(w.chainParams.CoinbaseMaturity),
 				output.Height, syncBlock.Height) {
 				bals.ImmatureReward += output.Amount
+			} else if creditLockHeight(tx, output) > syncBlock.Height {
+				bals.TimeLocked += output.Amount
 			} else if confirmed(confirms, output.Height, syncBlock.Height) {
 				bals.Spendable += output.Amount
 			}
@@ -1645,19 +2069,150 @@ func (w *Wallet) CalculateAccountBalances(account uint32, confirms int32) (Balan
 	return bals, err
 }
 
-// CurrentAddress gets the most recently requested Bitcoin payment address
-// from a wallet for a particular key-chain scope. This should never return
-// a used address because we maintain a buffer of unused addresses.
+// SpendableAt returns the balance of the given account that will be spendable
+// once the main chain reaches the given block height, assuming no further
+// transactions are sent or received.  Outputs are only included if they will
+// have at least one confirmation, will have reached coinbase maturity, and
+// will no longer be encumbered by a time-lock at that height.
+//
+// This can be used along with CalculateAccountBalances to report both the
+// amount available now and the amount that becomes available at a future
+// height.
+func (w *Wallet) SpendableAt(height int32, account uint32) (bchutil.Amount, error) {
+	var balance bchutil.Amount
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		unspent, err := w.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		for i := range unspent {
+			output := &unspent[i]
+
+			ma, err := w.outputAddress(addrmgrNs, output.PkScript)
+			if err != nil || ma.Account() != account || ma.WatchOnly() {
+				continue
+			}
+
+			if !confirmed(1, output.Height, height) {
+				continue
+			}
+			if output.FromCoinBase && !confirmed(int32(w.chainParams.CoinbaseMaturity),
+				output.Height, height) {
+				continue
+			}
+			if creditLockHeight(tx, output) > height {
+				continue
+			}
+			balance += output.Amount
+		}
+		return nil
+	})
+	return balance, err
+}
+
+// BalanceAtHeight returns the spendable balance of the given account as of a
+// past block height, replaying the wallet's transaction history up to and
+// including the block at that height.  Like SpendableAt, immature coinbase,
+// time-locked and watch-only outputs are not included.
+//
+// ErrHeightNotSynced is returned for heights above the block the wallet is
+// synced to.  ErrHistoryUnavailable is returned if the balance can't be
+// reconstructed, either because the height is before the wallet's birthday
+// block or because transactions needed have been pruned.
+func (w *Wallet) BalanceAtHeight(account uint32, height int32) (bchutil.Amount, error) {
+	var balance bchutil.Amount
+	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		if height > w.Manager.SyncedTo().Height {
+			return ErrHeightNotSynced
+		}
+		birthdayBlock, _, err := w.Manager.BirthdayBlock(addrmgrNs)
+		switch {
+		case waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet):
+		case err != nil:
+			return err
+		case height < birthdayBlock.Height:
+			return fmt.Errorf("%w: height %d is before the "+
+				"birthday block at height %d",
+				ErrHistoryUnavailable, height,
+				birthdayBlock.Height)
+		}
+
+		unspent, err := w.TxStore.UnspentOutputsAt(txmgrNs, height)
+		if err != nil {
+			var txErr wtxmgr.Error
+			if errors.As(err, &txErr) && txErr.Code == wtxmgr.ErrPruned {
+				return fmt.Errorf("%w: %v", ErrHistoryUnavailable,
+					err)
+			}
+			return err
+		}
+		for i := range unspent {
+			output := &unspent[i]
+
+			ma, err := w.outputAddress(addrmgrNs, output.PkScript)
+			if err != nil || ma.Account() != account || ma.WatchOnly() {
+				continue
+			}
+			if output.FromCoinBase && !confirmed(int32(w.chainParams.CoinbaseMaturity),
+				output.Height, height) {
+				continue
+			}
+			if creditLockHeight(tx, output) > height {
+				continue
+			}
+			balance += output.Amount
+		}
+		return nil
+	})
+	return balance, err
+}
+
+// outputAddress returns the managed address of the first address an output
+// script pays to.  An error is returned if the script does not pay to any
+// address or the address is not managed by the wallet.
+func (w *Wallet) outputAddress(addrmgrNs walletdb.ReadBucket,
+	pkScript []byte) (waddrmgr.ManagedAddress, error) {
+
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, w.chainParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, errors.New("output script does not pay to any address")
+	}
+	return w.Manager.Address(addrmgrNs, addrs[0])
+}
+
+// CurrentAddress gets the current receiving address of an account for a
+// particular key-chain scope.  This is the earliest derived address that has
+// not been used, so the same address is returned, regardless of calls to
+// NewAddress, until it receives funds.  If every address has been used, a new
+// address is derived, unless the addresses whose deposits lack the
+// confirmations required to close the gap already fill the gap limit, in which
+// case an ErrGapLimitExceeded error is returned.
 func (w *Wallet) CurrentAddress(account uint32, scope waddrmgr.KeyScope) (bchutil.Address, error) {
 	manager, err := w.Manager.FetchScopedKeyManager(scope)
 	if err != nil {
 		return nil, err
 	}
 
-	var addr bchutil.Address
+	var (
+		addr   bchutil.Address
+		derive bool
+	)
 	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
 		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
 		maddr, err := manager.FirstUnusedAddress(addrmgrNs, account, false)
+		if waddrmgr.IsError(err, waddrmgr.ErrAddressNotFound) {
+			derive = true
+			return w.checkConfirmedGap(tx, scope, account, 1)
+		}
 		if err != nil {
 			return err
 		}
@@ -1668,6 +2223,9 @@ func (w *Wallet) CurrentAddress(account uint32, scope waddrmgr.KeyScope) (bchuti
 	if err != nil {
 		return nil, err
 	}
+	if derive {
+		return w.NewAddress(account, scope)
+	}
 
 	return addr, nil
 }
@@ -1832,6 +2390,28 @@ func (w *Wallet) AccountProperties(scope waddrmgr.KeyScope, acct uint32) (*waddr
 	return props, err
 }
 
+// AccountExtendedPubKey returns the extended public key of an account, from
+// which all of its addresses are derived.  It may be used to set up a
+// watching-only wallet for the account, and is available when this wallet is
+// itself watching-only.
+func (w *Wallet) AccountExtendedPubKey(scope waddrmgr.KeyScope,
+	account uint32) (*hdkeychain.ExtendedKey, error) {
+
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var key *hdkeychain.ExtendedKey
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		var err error
+		key, err = manager.AccountExtendedPubKey(addrmgrNs, account)
+		return err
+	})
+	return key, err
+}
+
 // RenameAccount sets the name for an account number to newName.
 func (w *Wallet) RenameAccount(scope waddrmgr.KeyScope, account uint32, newName string) error {
 	manager, err := w.Manager.FetchScopedKeyManager(scope)
@@ -1958,6 +2538,11 @@ func listTransactions(tx walletdb.ReadTx, details *wtxmgr.TxDetails, addrMgr *wa
 		confirmations = int64(confirms(details.Block.Height, syncHeight))
 	}
 
+	if details.Pruned {
+		return listPrunedTransactions(tx, details, addrMgr,
+			confirmations, blockHashStr, blockTime, net)
+	}
+
 	results := []btcjson.ListTransactionsResult{}
 	txHashStr := details.Hash.String()
 	received := details.Received.Unix()
@@ -2067,6 +2652,102 @@ outputs:
 	return results
 }
 
+// listPrunedTransactions creates the listtransactions results of a transaction
+// pruned from the transaction store.  Only the wallet's credits and debits and
+// the fee paid by the wallet are recorded, so the outputs paying other wallets
+// are reported as a single send result without an address or output index.
+// Whether the transaction was a coinbase is not recorded either, so credits
+// are always reported as received.
+func listPrunedTransactions(tx walletdb.ReadTx, details *wtxmgr.TxDetails,
+	addrMgr *waddrmgr.Manager, confirmations int64, blockHashStr string,
+	blockTime int64, net *chaincfg.Params) []btcjson.ListTransactionsResult {
+
+	addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+	results := []btcjson.ListTransactionsResult{}
+	txHashStr := details.Hash.String()
+	received := details.Received.Unix()
+	send := len(details.Debits) != 0
+
+	// Note: This RPC reports negative numbers for fees.
+	feeF64 := (-details.PrunedFee).ToBCH()
+
+	// The amount paid to other wallets is what the debits spent that was
+	// neither returned to the wallet nor paid as the fee.
+	var sent bchutil.Amount
+	for _, deb := range details.Debits {
+		sent += deb.Amount
+	}
+	sent -= details.PrunedFee
+
+	for _, cred := range details.Credits {
+		sent -= cred.Amount
+
+		// Change outputs are ignored.
+		if cred.Change {
+			continue
+		}
+
+		var address string
+		var accountName string
+		_, addrs, _, _ := txscript.ExtractPkScriptAddrs(
+			details.CreditPkScript(cred.Index), net)
+		if len(addrs) == 1 {
+			address = addrs[0].EncodeAddress()
+			mgr, account, err := addrMgr.AddrAccount(addrmgrNs, addrs[0])
+			if err == nil {
+				accountName, err = mgr.AccountName(addrmgrNs, account)
+				if err != nil {
+					accountName = ""
+				}
+			}
+		}
+
+		amountF64 := cred.Amount.ToBCH()
+		result := btcjson.ListTransactionsResult{
+			Address:         address,
+			Vout:            cred.Index,
+			Confirmations:   confirmations,
+			BlockHash:       blockHashStr,
+			BlockTime:       blockTime,
+			TxID:            txHashStr,
+			WalletConflicts: []string{},
+			Time:            received,
+			TimeReceived:    received,
+		}
+
+		// Pruned transactions are always spent, so every credit is
+		// also reported under the send category, as done for spent
+		// credits by listTransactions.
+		result.Category = "send"
+		result.Amount = -amountF64
+		result.Fee = &feeF64
+		results = append(results, result)
+
+		result.Account = accountName
+		result.Category = CreditReceive.String()
+		result.Amount = amountF64
+		result.Fee = nil
+		results = append(results, result)
+	}
+
+	if send && sent > 0 {
+		results = append(results, btcjson.ListTransactionsResult{
+			Category:        "send",
+			Amount:          -sent.ToBCH(),
+			Fee:             &feeF64,
+			Confirmations:   confirmations,
+			BlockHash:       blockHashStr,
+			BlockTime:       blockTime,
+			TxID:            txHashStr,
+			WalletConflicts: []string{},
+			Time:            received,
+			TimeReceived:    received,
+		})
+	}
+	return results
+}
+
 // ListSinceBlock returns a slice of objects with details about transactions
 // since the given block. If the block is -1 then all transactions are included.
 // This is intended to be used for listsinceblock RPC replies.
@@ -2157,9 +2838,8 @@ func (w *Wallet) ListAddressTransactions(pkHashes map[string]struct{}) ([]btcjso
 		loopDetails:
 			for i := range details {
 				detail := &details[i]
-
 				for _, cred := range detail.Credits {
-					pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
+					pkScript := detail.CreditPkScript(cred.Index)
 					_, addrs, _, err := txscript.ExtractPkScriptAddrs(
 						pkScript, w.chainParams)
 					if err != nil || len(addrs) != 1 {
@@ -2247,14 +2927,16 @@ type GetTransactionsResult struct {
 // block.  Blocks in the block range may be specified by either a height or a
 // hash.
 //
-// Because this is a possibly lenghtly operation, a cancel channel is provided
-// to cancel the task.  If this channel unblocks, the results created thus far
+// Because this is a possibly lenghtly operation, a context is provided to
+// cancel the task.  If the context is canceled, the results created thus far
 // will be returned.
 //
 // Transaction results are organized by blocks in ascending order and unmined
 // transactions in an unspecified order.  Mined transactions are saved in a
 // Block structure which records properties about the block.
-func (w *Wallet) GetTransactions(startBlock, endBlock *BlockIdentifier, cancel <-chan struct{}) (*GetTransactionsResult, error) {
+func (w *Wallet) GetTransactions(ctx context.Context, startBlock,
+	endBlock *BlockIdentifier) (*GetTransactionsResult, error) {
+
 	var start, end int32 = 0, -1
 
 	w.chainClientLock.Lock()
@@ -2356,7 +3038,7 @@ func (w *Wallet) GetTransactions(startBlock, endBlock *BlockIdentifier, cancel <
 			}
 
 			select {
-			case <-cancel:
+			case <-ctx.Done():
 				return true, nil
 			default:
 				return false, nil
@@ -2368,6 +3050,62 @@ func (w *Wallet) GetTransactions(startBlock, endBlock *BlockIdentifier, cancel <
 	return &res, err
 }
 
+// TotalFeesPaid returns the sum of the fees paid by the wallet's own
+// transactions mined in the inclusive block range [startBlock, endBlock].
+// Only transactions whose inputs all spend wallet outputs are counted, as
+// incoming transactions are funded, and their fees paid, by others.  A nil
+// startBlock begins the range at the genesis block and a nil endBlock ends it
+// at the block the wallet is synced to, so the fees paid over the wallet's
+// entire history are returned when both are nil.
+func (w *Wallet) TotalFeesPaid(startBlock, endBlock *BlockIdentifier) (bchutil.Amount, error) {
+	start, end := int32(0), w.Manager.SyncedTo().Height
+	var err error
+	if startBlock != nil {
+		start, err = w.blockIdentifierHeight(startBlock)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if endBlock != nil {
+		end, err = w.blockIdentifierHeight(endBlock)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if start < 0 || end < 0 || start > end {
+		return 0, ErrInvalidBlockRange
+	}
+
+	var total bchutil.Amount
+	err = walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		rangeFn := func(details []wtxmgr.TxDetails) (bool, error) {
+			for i := range details {
+				total += txFee(&details[i])
+			}
+			return false, nil
+		}
+		return w.TxStore.RangeTransactions(txmgrNs, start, end, rangeFn)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+// blockIdentifierHeight returns the height of the identified block, looking
+// up the height of blocks identified by their hash using the chain client.
+func (w *Wallet) blockIdentifierHeight(id *BlockIdentifier) (int32, error) {
+	if id.hash == nil {
+		return id.height, nil
+	}
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return 0, err
+	}
+	return blockHeight(chainClient, id.hash)
+}
+
 // AccountResult is a single account result for the AccountsResult type.
 type AccountResult struct {
 	waddrmgr.AccountProperties
@@ -2758,7 +3496,7 @@ func (w *Wallet) DumpWIFPrivateKey(addr bchutil.Address) (string, error) {
 
 	pka, ok := maddr.(waddrmgr.ManagedPubKeyAddress)
 	if !ok {
-		return "", fmt.Errorf("address %s is not a key type", addr)
+		return "", fmt.Errorf("%w: %s", ErrNotPubKeyAddress, addr)
 	}
 
 	wif, err := pka.ExportPrivKey()
@@ -2875,6 +3613,9 @@ func (w *Wallet) ImportPrivateKey(scope waddrmgr.KeyScope, wif *bchutil.WIF,
 // LockedOutpoint returns whether an outpoint has been marked as locked and
 // should not be used as an input for created transactions.
 func (w *Wallet) LockedOutpoint(op wire.OutPoint) bool {
+	w.lockedOutpointsMtx.Lock()
+	defer w.lockedOutpointsMtx.Unlock()
+
 	_, locked := w.lockedOutpoints[op]
 	return locked
 }
@@ -2882,18 +3623,27 @@ func (w *Wallet) LockedOutpoint(op wire.OutPoint) bool {
 // LockOutpoint marks an outpoint as locked, that is, it should not be used as
 // an input for newly created transactions.
 func (w *Wallet) LockOutpoint(op wire.OutPoint) {
+	w.lockedOutpointsMtx.Lock()
+	defer w.lockedOutpointsMtx.Unlock()
+
 	w.lockedOutpoints[op] = struct{}{}
 }
 
 // UnlockOutpoint marks an outpoint as unlocked, that is, it may be used as an
 // input for newly created transactions.
 func (w *Wallet) UnlockOutpoint(op wire.OutPoint) {
+	w.lockedOutpointsMtx.Lock()
+	defer w.lockedOutpointsMtx.Unlock()
+
 	delete(w.lockedOutpoints, op)
 }
 
 // ResetLockedOutpoints resets the set of locked outpoints so all may be used
 // as inputs for new transactions.
 func (w *Wallet) ResetLockedOutpoints() {
+	w.lockedOutpointsMtx.Lock()
+	defer w.lockedOutpointsMtx.Unlock()
+
 	w.lockedOutpoints = map[wire.OutPoint]struct{}{}
 }
 
@@ -2901,6 +3651,9 @@ func (w *Wallet) ResetLockedOutpoints() {
 // intended to be used by marshaling the result as a JSON array for
 // listlockunspent RPC results.
 func (w *Wallet) LockedOutpoints() []btcjson.TransactionInput {
+	w.lockedOutpointsMtx.Lock()
+	defer w.lockedOutpointsMtx.Unlock()
+
 	locked := make([]btcjson.TransactionInput, len(w.lockedOutpoints))
 	i := 0
 	for op := range w.lockedOutpoints {
@@ -2913,6 +3666,57 @@ func (w *Wallet) LockedOutpoints() []btcjson.TransactionInput {
 	return locked
 }
 
+// LockedOutput describes a locked outpoint and the amount of the wallet
+// output it refers to.
+type LockedOutput struct {
+	OutPoint wire.OutPoint
+
+	// Amount is zero when the outpoint is not an output of the wallet.
+	Amount bchutil.Amount
+}
+
+// LockedOutputs returns the currently locked outpoints along with their
+// amounts, sorted by outpoint.  This allows outputs left locked by an
+// interrupted operation to be found and released with UnlockOutpoint.
+func (w *Wallet) LockedOutputs() ([]LockedOutput, error) {
+	w.lockedOutpointsMtx.Lock()
+	locked := make([]LockedOutput, 0, len(w.lockedOutpoints))
+	for op := range w.lockedOutpoints {
+		locked = append(locked, LockedOutput{OutPoint: op})
+	}
+	w.lockedOutpointsMtx.Unlock()
+
+	sort.Slice(locked, func(i, j int) bool {
+		a, b := &locked[i].OutPoint, &locked[j].OutPoint
+		if a.Hash != b.Hash {
+			return bytes.Compare(a.Hash[:], b.Hash[:]) < 0
+		}
+		return a.Index < b.Index
+	})
+
+	err := walletdb.View(w.db, func(dbtx walletdb.ReadTx) error {
+		txmgrNs := dbtx.ReadBucket(wtxmgrNamespaceKey)
+		for i := range locked {
+			op := &locked[i].OutPoint
+			details, err := w.TxStore.TxDetails(txmgrNs, &op.Hash)
+			if err != nil {
+				return err
+			}
+			if details == nil {
+				continue
+			}
+			for _, cred := range details.Credits {
+				if cred.Index == op.Index {
+					locked[i].Amount = cred.Amount
+					break
+				}
+			}
+		}
+		return nil
+	})
+	return locked, err
+}
+
 // resendUnminedTxs iterates through all transactions that spend from wallet
 // credits that are not known to have been mined into a block, and attempts
 // to send each to the chain server for relay.
@@ -2977,7 +3781,10 @@ func (w *Wallet) NewAddress(account uint32,
 	)
 	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
-		var err error
+		err := w.checkConfirmedGap(tx, scope, account, 1)
+		if err != nil {
+			return err
+		}
 		addr, props, err = w.newAddress(addrmgrNs, account, scope)
 		return err
 	})
@@ -3033,7 +3840,7 @@ func (w *Wallet) NewChangeAddress(account uint32,
 	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
 		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
 		var err error
-		addr, err = w.newChangeAddress(addrmgrNs, account)
+		addr, err = w.newChangeAddress(addrmgrNs, account, scope)
 		return err
 	})
 	if err != nil {
@@ -3049,20 +3856,16 @@ func (w *Wallet) NewChangeAddress(account uint32,
 	return addr, nil
 }
 
-// newChangeAddress returns a new change address for the wallet.
+// newChangeAddress returns a new change address for the account under the
+// given key scope.
 //
 // NOTE: This method requires the caller to use the backend's NotifyReceived
 // method in order to detect when an on-chain transaction pays to the address
 // being created.
 func (w *Wallet) newChangeAddress(addrmgrNs walletdb.ReadWriteBucket,
-	account uint32) (bchutil.Address, error) {
+	account uint32, scope waddrmgr.KeyScope) (bchutil.Address, error) {
 
-	// As we're making a change address, we'll fetch the type of manager
-	// that is able to make p2kh output as they're the most efficient.
-	scopes := w.Manager.ScopesForExternalAddrType(
-		waddrmgr.PubKeyHash,
-	)
-	manager, err := w.Manager.FetchScopedKeyManager(scopes[0])
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
 	if err != nil {
 		return nil, err
 	}
@@ -3076,6 +3879,73 @@ func (w *Wallet) newChangeAddress(addrmgrNs walletdb.ReadWriteBucket,
 	return addrs[0].Address(), nil
 }
 
+// NewAddresses returns the next count external, or internal if internal is
+// set, chained addresses of an account in a single database transaction,
+// together with the branch index of the first address.  The addresses are
+// returned in derivation order, so that the address at position i was derived
+// at the index firstIndex+i unless an invalid child key was skipped.
+func (w *Wallet) NewAddresses(account uint32, scope waddrmgr.KeyScope,
+	internal bool, count uint32) ([]bchutil.Address, uint32, error) {
+
+	chainClient, err := w.requireChainClient()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var (
+		maddrs []waddrmgr.ManagedAddress
+		props  *waddrmgr.AccountProperties
+	)
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		manager, err := w.Manager.FetchScopedKeyManager(scope)
+		if err != nil {
+			return err
+		}
+		if internal {
+			maddrs, err = manager.NextInternalAddresses(addrmgrNs,
+				account, count)
+		} else {
+			err = w.checkConfirmedGap(tx, scope, account, count)
+			if err != nil {
+				return err
+			}
+			maddrs, err = manager.NextExternalAddresses(addrmgrNs,
+				account, count)
+		}
+		if err != nil {
+			return err
+		}
+		props, err = manager.AccountProperties(addrmgrNs, account)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	addrs := make([]bchutil.Address, 0, len(maddrs))
+	for _, maddr := range maddrs {
+		addrs = append(addrs, maddr.Address())
+	}
+	var firstIndex uint32
+	if len(maddrs) > 0 {
+		if pka, ok := maddrs[0].(waddrmgr.ManagedPubKeyAddress); ok {
+			_, path, _ := pka.DerivationInfo()
+			firstIndex = path.Index
+		}
+	}
+
+	// Notify the rpc server about the newly created addresses.
+	err = chainClient.NotifyReceived(addrs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	w.NtfnServer.notifyAccountProperties(props)
+
+	return addrs, firstIndex, nil
+}
+
 // confirmed checks whether a transaction at height txHeight has met minconf
 // confirmations for a blockchain at height curHeight.
 func confirmed(minconf, txHeight, curHeight int32) bool {
@@ -3104,7 +3974,8 @@ type AccountTotalReceivedResult struct {
 }
 
 // TotalReceivedForAccounts iterates through a wallet's transaction history,
-// returning the total amount of Bitcoin received for all accounts.
+// returning the total amount of Bitcoin received for all accounts.  Change
+// returning to the wallet is not counted as received.
 func (w *Wallet) TotalReceivedForAccounts(scope waddrmgr.KeyScope,
 	minConf int32) ([]AccountTotalReceivedResult, error) {
 
@@ -3147,7 +4018,10 @@ func (w *Wallet) TotalReceivedForAccounts(scope waddrmgr.KeyScope,
 			for i := range details {
 				detail := &details[i]
 				for _, cred := range detail.Credits {
-					pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
+					if cred.Change {
+						continue
+					}
+					pkScript := detail.CreditPkScript(cred.Index)
 					var outputAcct uint32
 					_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, w.chainParams)
 					if err == nil && len(addrs) > 0 {
@@ -3172,9 +4046,69 @@ func (w *Wallet) TotalReceivedForAccounts(scope waddrmgr.KeyScope,
 	return results, err
 }
 
+// TotalReceivedForAccount iterates through a wallet's transaction history,
+// returning the total amount of bitcoins received by addresses of a single
+// account with at least minConf confirmations.  Change returning to the wallet
+// is not counted as received.
+func (w *Wallet) TotalReceivedForAccount(scope waddrmgr.KeyScope, account uint32,
+	minConf int32) (bchutil.Amount, error) {
+
+	manager, err := w.Manager.FetchScopedKeyManager(scope)
+	if err != nil {
+		return 0, err
+	}
+
+	var amount bchutil.Amount
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+
+		// Ensure the account exists.
+		if _, err := manager.AccountName(addrmgrNs, account); err != nil {
+			return err
+		}
+
+		syncBlock := w.Manager.SyncedTo()
+
+		var stopHeight int32
+		if minConf > 0 {
+			stopHeight = syncBlock.Height - minConf + 1
+		} else {
+			stopHeight = -1
+		}
+		rangeFn := func(details []wtxmgr.TxDetails) (bool, error) {
+			for i := range details {
+				detail := &details[i]
+				for _, cred := range detail.Credits {
+					if cred.Change {
+						continue
+					}
+					pkScript := detail.CreditPkScript(cred.Index)
+					_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript,
+						w.chainParams)
+					if err != nil || len(addrs) == 0 {
+						continue
+					}
+					addrScope, outputAcct, err := w.Manager.AddrAccount(
+						addrmgrNs, addrs[0])
+					if err != nil {
+						continue
+					}
+					if addrScope.Scope() == scope && outputAcct == account {
+						amount += cred.Amount
+					}
+				}
+			}
+			return false, nil
+		}
+		return w.TxStore.RangeTransactions(txmgrNs, 0, stopHeight, rangeFn)
+	})
+	return amount, err
+}
+
 // TotalReceivedForAddr iterates through a wallet's transaction history,
 // returning the total amount of bitcoins received for a single wallet
-// address.
+// address.  Change returning to the wallet is not counted as received.
 func (w *Wallet) TotalReceivedForAddr(addr bchutil.Address, minConf int32) (bchutil.Amount, error) {
 	var amount bchutil.Amount
 	err := walletdb.View(w.db, func(tx walletdb.ReadTx) error {
@@ -3196,7 +4130,10 @@ func (w *Wallet) TotalReceivedForAddr(addr bchutil.Address, minConf int32) (bchu
 			for i := range details {
 				detail := &details[i]
 				for _, cred := range detail.Credits {
-					pkScript := detail.MsgTx.TxOut[cred.Index].PkScript
+					if cred.Change {
+						continue
+					}
+					pkScript := detail.CreditPkScript(cred.Index)
 					_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript,
 						w.chainParams)
 					// An error creating addresses from the output script only
@@ -3220,9 +4157,10 @@ func (w *Wallet) TotalReceivedForAddr(addr bchutil.Address, minConf int32) (bchu
 }
 
 // SendOutputs creates and sends payment transactions. It returns the
-// transaction upon success.
-func (w *Wallet) SendOutputs(outputs []*wire.TxOut, account uint32,
-	minconf int32, satPerKb bchutil.Amount) (*wire.MsgTx, error) {
+// transaction upon success.  The key scope of the account is interpreted as by
+// CreateSimpleTx.
+func (w *Wallet) SendOutputs(outputs []*wire.TxOut, keyScope *waddrmgr.KeyScope,
+	account uint32, minconf int32, satPerKb bchutil.Amount) (*wire.MsgTx, error) {
 
 	// Ensure the outputs to be created adhere to the network's consensus
 	// rules.
@@ -3237,7 +4175,7 @@ func (w *Wallet) SendOutputs(outputs []*wire.TxOut, account uint32,
 	// continue to re-broadcast the transaction upon restarts until it has
 	// been confirmed.
 	createdTx, err := w.CreateSimpleTx(
-		account, outputs, minconf, satPerKb, false,
+		keyScope, account, outputs, minconf, satPerKb, false,
 	)
 	if err != nil {
 		return nil, err
@@ -3311,6 +4249,10 @@ func (w *Wallet) SignTransaction(tx *wire.MsgTx, inputValues []int64, hashType t
 					return fmt.Errorf("%v not found",
 						txIn.PreviousOutPoint)
 				}
+				if txDetails.Pruned {
+					return fmt.Errorf("%v is pruned",
+						txIn.PreviousOutPoint)
+				}
 
 				// Only set the prevOutScript if it wasn't passed in explicitly.
 				if !ok {
@@ -3493,7 +4435,7 @@ func (w *Wallet) publishTransaction(tx *wire.MsgTx) (*chainhash.Hash, error) {
 		return nil, err
 	}
 
-	_, err = chainClient.SendRawTransaction(tx, false)
+	err = w.sendRawTransaction(chainClient, tx)
 
 	// Determine if this was an RPC error thrown due to the transaction
 	// already confirming.
@@ -3503,10 +4445,19 @@ func (w *Wallet) publishTransaction(tx *wire.MsgTx) (*chainhash.Hash, error) {
 	}
 
 	txid := tx.TxHash()
-	switch {
-	case err == nil:
+	switch err.(type) {
+	case nil:
 		return &txid, nil
 
+	// If the backend could not be reached, the transaction was not
+	// rejected, so it is kept in the store to be rebroadcast once the
+	// connection is restored.
+	case *TransientPublishError:
+		return nil, err
+	}
+
+	switch {
+
 	// Since we have different backends that can be used with the wallet,
 	// we'll need to check specific errors for each one.
 	//
@@ -3617,6 +4568,19 @@ func (w *Wallet) GetProxyDialer() proxy.Dialer {
 func Create(db walletdb.DB, pubPass, privPass, seed []byte, params *chaincfg.Params,
 	birthday time.Time) error {
 
+	return CreateWithScryptOptions(db, pubPass, privPass, seed, params,
+		birthday, nil)
+}
+
+// CreateWithScryptOptions is like Create, but derives the keys protecting the
+// passphrases using the given scrypt parameters rather than
+// waddrmgr.DefaultScryptOptions when non-nil.  The parameters are stored with
+// the wallet, so they are used again when it is opened or its passphrases are
+// changed.
+func CreateWithScryptOptions(db walletdb.DB, pubPass, privPass, seed []byte,
+	params *chaincfg.Params, birthday time.Time,
+	scryptOpts *waddrmgr.ScryptOptions) error {
+
 	// If a seed was provided, ensure that it is of valid length. Otherwise,
 	// we generate a random seed for the wallet with the recommended seed
 	// length.
@@ -3642,9 +4606,21 @@ func Create(db walletdb.DB, pubPass, privPass, seed []byte, params *chaincfg.Par
 		if err != nil {
 			return err
 		}
+		walletNs, err := tx.CreateTopLevelBucket(walletNamespaceKey)
+		if err != nil {
+			return err
+		}
+		walletMgr := &walletMigrationManager{ns: walletNs}
+		err = walletMgr.SetVersion(walletNs, latestWalletVersion())
+		if err != nil {
+			return err
+		}
+		if _, err := tx.CreateTopLevelBucket(labelsNamespaceKey); err != nil {
+			return err
+		}
 
 		err = waddrmgr.Create(
-			addrmgrNs, seed, pubPass, privPass, params, nil,
+			addrmgrNs, seed, pubPass, privPass, params, scryptOpts,
 			birthday,
 		)
 		if err != nil {
@@ -3675,6 +4651,8 @@ func Create(db walletdb.DB, pubPass, privPass, seed []byte, params *chaincfg.Par
 }
 
 // Open loads an already-created wallet from the passed database and namespaces.
+// The recovery window is the key-derivation lookahead used when recovering
+// both external and change addresses, and a zero window disables recovery.
 func Open(db walletdb.DB, pubPass []byte, cbs *waddrmgr.OpenCallbacks,
 	params *chaincfg.Params, recoveryWindow uint32) (*Wallet, error) {
 
@@ -3697,9 +4675,25 @@ func Open(db walletdb.DB, pubPass []byte, cbs *waddrmgr.OpenCallbacks,
 			return errors.New("missing transaction manager namespace")
 		}
 
+		// Wallets created before the wallet-level version was
+		// introduced have no wallet namespace, so it is created here
+		// and migrated from version zero.  A wallet written by a newer
+		// version of the software is refused before any of its
+		// namespaces are upgraded.
+		walletBucket, err := tx.CreateTopLevelBucket(walletNamespaceKey)
+		if err != nil {
+			return err
+		}
+		walletUpgrader := &walletMigrationManager{ns: walletBucket}
+		if err := checkWalletVersion(walletUpgrader); err != nil {
+			return err
+		}
+
 		addrMgrUpgrader := waddrmgr.NewMigrationManager(addrMgrBucket)
 		txMgrUpgrader := wtxmgr.NewMigrationManager(txMgrBucket)
-		err := migration.Upgrade(txMgrUpgrader, addrMgrUpgrader)
+		err = migration.Upgrade(
+			walletUpgrader, txMgrUpgrader, addrMgrUpgrader,
+		)
 		if err != nil {
 			return err
 		}
@@ -3718,28 +4712,36 @@ func Open(db walletdb.DB, pubPass []byte, cbs *waddrmgr.OpenCallbacks,
 	log.Infof("Opened wallet") // TODO: log balance? last sync height?
 
 	w := &Wallet{
-		publicPassphrase:      pubPass,
-		db:                    db,
-		Manager:               addrMgr,
-		TxStore:               txMgr,
-		lockedOutpoints:       map[wire.OutPoint]struct{}{},
-		recoveryWindow:        recoveryWindow,
-		rescanAddJob:          make(chan *RescanJob),
-		rescanBatch:           make(chan *rescanBatch),
-		rescanNotifications:   make(chan interface{}),
-		rescanProgress:        make(chan *RescanProgressMsg),
-		rescanFinished:        make(chan *RescanFinishedMsg),
-		recoveryProgess:       make(chan *RecoveryProgessMsg),
-		createTxRequests:      make(chan createTxRequest),
-		unlockRequests:        make(chan unlockRequest),
-		lockRequests:          make(chan struct{}),
-		holdUnlockRequests:    make(chan chan heldUnlock),
-		lockState:             make(chan bool),
-		changePassphrase:      make(chan changePassphraseRequest),
-		changePassphrases:     make(chan changePassphrasesRequest),
-		chainParams:           params,
-		quit:                  make(chan struct{}),
-		recoveryInterruptChan: make(chan struct{}),
+		publicPassphrase:       pubPass,
+		publicEncrypted:        !isInsecurePubPassphrase(pubPass),
+		db:                     db,
+		Manager:                addrMgr,
+		TxStore:                txMgr,
+		lockedOutpoints:        map[wire.OutPoint]struct{}{},
+		recoveryWindow:         recoveryWindow,
+		internalRecoveryWindow: recoveryWindow,
+		maxRollbackDepth:       waddrmgr.MaxReorgDepth,
+		publishAttempts:        DefaultPublishAttempts,
+		publishRetryDelay:      DefaultPublishRetryDelay,
+		minChangeAmount:        DefaultMinChangeAmount,
+		filterWorkers:          runtime.NumCPU(),
+		rescanAddJob:           make(chan *RescanJob),
+		rescanBatch:            make(chan *rescanBatch),
+		rescanNotifications:    make(chan interface{}),
+		rescanProgress:         make(chan *RescanProgressMsg),
+		rescanFinished:         make(chan *RescanFinishedMsg),
+		recoveryProgess:        make(chan *RecoveryProgessMsg),
+		createTxRequests:       make(chan createTxRequest),
+		unlockRequests:         make(chan unlockRequest),
+		lockRequests:           make(chan struct{}),
+		holdUnlockRequests:     make(chan chan heldUnlock),
+		lockState:              make(chan bool),
+		unlockedUntil:          make(chan time.Time),
+		changePassphrase:       make(chan changePassphraseRequest),
+		changePassphrases:      make(chan changePassphrasesRequest),
+		chainParams:            params,
+		quit:                   make(chan struct{}),
+		recoveryInterruptChan:  make(chan struct{}),
 	}
 
 	w.NtfnServer = newNotificationServer(w)
@@ -3,6 +3,8 @@ package wallet
 import (
 	"testing"
 	"time"
+
+	"github.com/gcash/bchwallet/waddrmgr"
 )
 
 // TestLocateBirthdayBlock ensures we can properly map a block in the chain to a
@@ -83,3 +85,51 @@ func TestLocateBirthdayBlock(t *testing.T) {
 		}
 	}
 }
+
+// TestNewAddresses ensures batches of addresses are derived in order from the
+// reported index, and that no addresses are derived when a batch would exceed
+// the gap limit.
+func TestNewAddresses(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	scope := waddrmgr.KeyScopeBIP0044
+	w.Manager.SetGapLimit(waddrmgr.NumInitialAddrs + 3)
+
+	_, _, err := w.NewAddresses(0, scope, false, 4)
+	if !waddrmgr.IsError(err, waddrmgr.ErrGapLimitExceeded) {
+		t.Fatalf("expected ErrGapLimitExceeded, got %v", err)
+	}
+
+	for _, internal := range []bool{false, true} {
+		addrs, firstIndex, err := w.NewAddresses(0, scope, internal, 3)
+		if err != nil {
+			t.Fatalf("unable to create addresses: %v", err)
+		}
+		if len(addrs) != 3 {
+			t.Fatalf("got %d addresses, want 3", len(addrs))
+		}
+		if firstIndex != waddrmgr.NumInitialAddrs {
+			t.Fatalf("got first index %d, want %d", firstIndex,
+				waddrmgr.NumInitialAddrs)
+		}
+
+		details, err := w.AccountAddressDetails(scope, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		indexes := make(map[string]uint32)
+		for _, d := range details {
+			if d.Internal == internal {
+				indexes[d.Address.EncodeAddress()] = d.Index
+			}
+		}
+		for i, addr := range addrs {
+			index, ok := indexes[addr.EncodeAddress()]
+			if !ok || index != firstIndex+uint32(i) {
+				t.Fatalf("address %d (%v) has index %d, want %d", i,
+					addr, index, firstIndex+uint32(i))
+			}
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package wallet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/bchec"
+	"github.com/gcash/bchd/chaincfg"
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchutil/hdkeychain"
+	"github.com/gcash/bchwallet/waddrmgr"
+	"github.com/gcash/bchwallet/walletdb"
+	_ "github.com/gcash/bchwallet/walletdb/bdb"
+	"github.com/gcash/bchwallet/wtxmgr"
+)
+
+// testWallet creates a new unlocked test wallet backed by a mock chain client.
+// The returned function must be called to clean up the wallet's files.
+func testWallet(t *testing.T) (*Wallet, func()) {
+	t.Helper()
+
+	seed, err := hdkeychain.GenerateSeed(hdkeychain.MinSeedBytes)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	return testWalletFromSeed(t, seed)
+}
+
+// testWalletFromSeed is like testWallet but creates the wallet from the given
+// seed.
+func testWalletFromSeed(t *testing.T, seed []byte) (*Wallet, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "wallet_test")
+	if err != nil {
+		t.Fatalf("Failed to create db dir: %v", err)
+	}
+
+	pubPass := []byte("hello")
+	privPass := []byte("world")
+
+	loader := NewLoader(&chaincfg.TestNet3Params, dir, true, 250)
+	w, err := loader.CreateNewWallet(pubPass, privPass, seed, time.Now())
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	w.chainClient = &mockChainClient{}
+	if err := w.Unlock(privPass, time.After(10*time.Minute)); err != nil {
+		loader.UnloadWallet()
+		os.RemoveAll(dir)
+		t.Fatalf("unable to unlock wallet: %v", err)
+	}
+
+	return w, func() {
+		loader.UnloadWallet()
+		os.RemoveAll(dir)
+	}
+}
+
+// addTestCredits inserts a transaction mined at the given height paying the
+// given amounts to each address, marks every output as a wallet credit, and
+// syncs the wallet to syncHeight.
+func addTestCredits(t *testing.T, w *Wallet, height, syncHeight int32,
+	addrs []bchutil.Address, amounts []int64) *wtxmgr.TxRecord {
+
+	t.Helper()
+
+	tx := &wire.MsgTx{TxIn: []*wire.TxIn{{}}}
+	for i, addr := range addrs {
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			t.Fatalf("unable to create pkScript: %v", err)
+		}
+		tx.TxOut = append(tx.TxOut, wire.NewTxOut(amounts[i], pkScript,
+			wire.TokenData{}))
+	}
+
+	var b bytes.Buffer
+	if err := tx.Serialize(&b); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	rec, err := wtxmgr.NewTxRecord(b.Bytes(), time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+
+	block := &wtxmgr.BlockMeta{
+		Block: wtxmgr.Block{Hash: chainhash.Hash{byte(height)}, Height: height},
+		Time:  time.Unix(1387737310, 0),
+	}
+	err = walletdb.Update(w.db, func(dbtx walletdb.ReadWriteTx) error {
+		ns := dbtx.ReadWriteBucket(wtxmgrNamespaceKey)
+		if err := w.TxStore.InsertTx(ns, rec, block); err != nil {
+			return err
+		}
+		for i := range tx.TxOut {
+			err := w.TxStore.AddCredit(ns, rec, block, uint32(i), false)
+			if err != nil {
+				return err
+			}
+		}
+
+		addrmgrNs := dbtx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.SetSyncedTo(addrmgrNs, &waddrmgr.BlockStamp{
+			Hash:   chainhash.Hash{byte(syncHeight)},
+			Height: syncHeight,
+		})
+	})
+	if err != nil {
+		t.Fatalf("failed inserting tx: %v", err)
+	}
+	return rec
+}
+
+// TestWatchOnlyBalanceAndSelection ensures outputs paying to watch-only
+// addresses are reported separately in account balances and are never chosen
+// as inputs by automatic coin selection, while spendable outputs of the same
+// account are.
+func TestWatchOnlyBalanceAndSelection(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	manager, err := w.Manager.FetchScopedKeyManager(waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to fetch scoped manager: %v", err)
+	}
+
+	spendableKey, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+	wif, err := bchutil.NewWIF(spendableKey, w.chainParams, true)
+	if err != nil {
+		t.Fatalf("unable to create wif: %v", err)
+	}
+	watchKey, err := bchec.NewPrivateKey(bchec.S256())
+	if err != nil {
+		t.Fatalf("unable to create key: %v", err)
+	}
+
+	// Import both a private key and a bare public key, so the imported
+	// account contains a spendable and a watch-only address.
+	var spendableAddr, watchAddr waddrmgr.ManagedPubKeyAddress
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		bs := &waddrmgr.BlockStamp{}
+		var err error
+		spendableAddr, err = manager.ImportPrivateKey(ns, wif, bs)
+		if err != nil {
+			return err
+		}
+		watchAddr, err = manager.ImportPublicKey(
+			ns, watchKey.PubKey(), true, bs,
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to import keys: %v", err)
+	}
+	if spendableAddr.WatchOnly() {
+		t.Fatalf("imported private key address reported as watch-only")
+	}
+	if !watchAddr.WatchOnly() {
+		t.Fatalf("imported public key address not reported as watch-only")
+	}
+
+	const spendableAmt, watchAmt = 100000, 250000
+	addTestCredits(t, w, 100, 110,
+		[]bchutil.Address{spendableAddr.Address(), watchAddr.Address()},
+		[]int64{spendableAmt, watchAmt})
+
+	bals, err := w.CalculateAccountBalances(waddrmgr.ImportedAddrAccount, 1)
+	if err != nil {
+		t.Fatalf("unable to calculate balances: %v", err)
+	}
+	if bals.Total != spendableAmt+watchAmt {
+		t.Fatalf("expected total %v, got %v", spendableAmt+watchAmt,
+			int64(bals.Total))
+	}
+	if bals.Spendable != spendableAmt {
+		t.Fatalf("expected spendable %v, got %v", spendableAmt,
+			int64(bals.Spendable))
+	}
+	if bals.WatchOnly != watchAmt {
+		t.Fatalf("expected watch-only %v, got %v", watchAmt,
+			int64(bals.WatchOnly))
+	}
+
+	var eligible []wtxmgr.Credit
+	err = walletdb.View(w.db, func(tx walletdb.ReadTx) error {
+		bs, err := w.chainClient.BlockStamp()
+		if err != nil {
+			return err
+		}
+		eligible, err = w.findEligibleOutputs(
+			tx, nil, waddrmgr.ImportedAddrAccount, 1, bs,
+		)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("unable to find eligible outputs: %v", err)
+	}
+	if len(eligible) != 1 || eligible[0].Amount != spendableAmt {
+		t.Fatalf("expected only the spendable output to be eligible, "+
+			"got %v", eligible)
+	}
+}
+
+// TestWatchingOnlyWalletUnsignedTx ensures the outputs of a watching-only
+// wallet are spendable and selected when creating unsigned transactions.
+func TestWatchingOnlyWalletUnsignedTx(t *testing.T) {
+	w, cleanup := testWallet(t)
+	defer cleanup()
+
+	addr, err := w.NewAddress(0, waddrmgr.KeyScopeBIP0044)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	addTestCredits(t, w, 100, 200, []bchutil.Address{addr}, []int64{1e8})
+
+	err = walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		ns := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		return w.Manager.ConvertToWatchingOnly(ns)
+	})
+	if err != nil {
+		t.Fatalf("unable to convert to watching-only: %v", err)
+	}
+
+	bals, err := w.CalculateAccountBalances(0, 1)
+	if err != nil {
+		t.Fatalf("unable to calculate balances: %v", err)
+	}
+	if bals.Spendable != 1e8 || bals.WatchOnly != 0 {
+		t.Fatalf("got spendable balance %v and watch-only balance %v, "+
+			"want %v and 0", bals.Spendable, bals.WatchOnly,
+			bchutil.Amount(1e8))
+	}
+
+	outputs := []*wire.TxOut{
+		wire.NewTxOut(5e7, []byte{txscript.OP_TRUE}, wire.TokenData{}),
+	}
+	tx, err := w.CreateUnsignedTx(nil, 0, outputs, 1, 1000,
+		TxCreateOptions{})
+	if err != nil {
+		t.Fatalf("unable to create unsigned transaction: %v", err)
+	}
+	if len(tx.Tx.TxIn) != 1 || tx.TotalInput != 1e8 {
+		t.Fatalf("got %d inputs totaling %v, want the watching-only "+
+			"wallet's output", len(tx.Tx.TxIn), tx.TotalInput)
+	}
+}
@@ -68,6 +68,7 @@ var (
 	bucketUnmined        = []byte("m")
 	bucketUnminedCredits = []byte("mc")
 	bucketUnminedInputs  = []byte("mi")
+	bucketPrunedScripts  = []byte("ps")
 )
 
 // Root (namespace) bucket keys
@@ -75,6 +76,7 @@ var (
 	rootCreateDate   = []byte("date")
 	rootVersion      = []byte("vers")
 	rootMinedBalance = []byte("bal")
+	rootPrunedHeight = []byte("prune")
 )
 
 // The root bucket's mined balance k/v pair records the total balance for all
@@ -103,6 +105,53 @@ func putMinedBalance(ns walletdb.ReadWriteBucket, amt bchutil.Amount) error {
 	return nil
 }
 
+// The root bucket's pruned height k/v pair records the height up to which
+// spent transactions have been pruned, so that pruning only needs to consider
+// transactions mined or spent above it.  The value is the height serialized as
+// a uint32.  A store which has never been pruned has no pruned height.
+func fetchPrunedHeight(ns walletdb.ReadBucket) (int32, error) {
+	v := ns.Get(rootPrunedHeight)
+	if v == nil {
+		return -1, nil
+	}
+	if len(v) != 4 {
+		str := fmt.Sprintf("pruned height: short read (expected 4 "+
+			"bytes, read %v)", len(v))
+		return 0, storeError(ErrData, str, nil)
+	}
+	return int32(byteOrder.Uint32(v)), nil
+}
+
+func putPrunedHeight(ns walletdb.ReadWriteBucket, height int32) error {
+	var err error
+	if height < 0 {
+		err = ns.Delete(rootPrunedHeight)
+	} else {
+		v := make([]byte, 4)
+		byteOrder.PutUint32(v, uint32(height))
+		err = ns.Put(rootPrunedHeight, v)
+	}
+	if err != nil {
+		str := "failed to put pruned height"
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+// lowerPrunedHeight lowers the pruned height below height, if it is not
+// already, so that transactions inserted or rolled back at height are
+// considered by the next prune.
+func lowerPrunedHeight(ns walletdb.ReadWriteBucket, height int32) error {
+	prunedHeight, err := fetchPrunedHeight(ns)
+	if err != nil {
+		return err
+	}
+	if prunedHeight < height {
+		return nil
+	}
+	return putPrunedHeight(ns, height-1)
+}
+
 // Several data structures are given canonical serialization formats as either
 // keys or values.  These common formats allow keys and values to be reused
 // across different buckets.
@@ -367,6 +416,15 @@ func deleteBlockRecord(ns walletdb.ReadWriteBucket, height int32) error {
 //
 //   [0:8]   Received time (8 bytes)
 //   [8:]    Serialized transaction (varies)
+//
+// Records pruned by PruneSpentTransactions no longer include the serialized
+// transaction and are instead serialized as such:
+//
+//   [0:8]   Received time (8 bytes)
+//   [8:16]  Fee paid by the wallet (8 bytes)
+//
+// A serialized transaction is never shorter than 10 bytes, so the length of
+// the value distinguishes pruned records from full records.
 
 func keyTxRecord(txHash *chainhash.Hash, block *Block) []byte {
 	k := make([]byte, 68)
@@ -395,6 +453,54 @@ func valueTxRecord(rec *TxRecord) ([]byte, error) {
 	return v, nil
 }
 
+// prunedTxRecordSize is the size of a pruned transaction record value.
+const prunedTxRecordSize = 16
+
+func valuePrunedTxRecord(received time.Time, fee bchutil.Amount) []byte {
+	v := make([]byte, prunedTxRecordSize)
+	byteOrder.PutUint64(v, uint64(received.Unix()))
+	byteOrder.PutUint64(v[8:16], uint64(fee))
+	return v
+}
+
+func isPrunedTxRecord(v []byte) bool {
+	return len(v) == prunedTxRecordSize
+}
+
+// The pruned scripts bucket records the output scripts of the credits of
+// pruned transaction records, which are otherwise lost with the serialized
+// transaction.  It is only created once a transaction is first pruned.
+//
+// The key is the credit key, and the value is the output script.
+
+func putPrunedScript(ns walletdb.ReadWriteBucket, credKey, pkScript []byte) error {
+	bucket, err := ns.CreateBucketIfNotExists(bucketPrunedScripts)
+	if err != nil {
+		str := "failed to create pruned scripts bucket"
+		return storeError(ErrDatabase, str, err)
+	}
+	err = bucket.Put(credKey, pkScript)
+	if err != nil {
+		str := fmt.Sprintf("%s: put failed", bucketPrunedScripts)
+		return storeError(ErrDatabase, str, err)
+	}
+	return nil
+}
+
+func fetchPrunedScript(ns walletdb.ReadBucket, credKey []byte) []byte {
+	bucket := ns.NestedReadBucket(bucketPrunedScripts)
+	if bucket == nil {
+		return nil
+	}
+	v := bucket.Get(credKey)
+	if v == nil {
+		return nil
+	}
+	pkScript := make([]byte, len(v))
+	copy(pkScript, v)
+	return pkScript
+}
+
 func putTxRecord(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Block) error {
 	k := keyTxRecord(&rec.Hash, block)
 	v, err := valueTxRecord(rec)
@@ -426,6 +532,11 @@ func readRawTxRecord(txHash *chainhash.Hash, v []byte, rec *TxRecord) error {
 	}
 	rec.Hash = *txHash
 	rec.Received = time.Unix(int64(byteOrder.Uint64(v)), 0)
+	if isPrunedTxRecord(v) {
+		rec.Pruned = true
+		rec.PrunedFee = bchutil.Amount(byteOrder.Uint64(v[8:16]))
+		return nil
+	}
 	err := rec.MsgTx.Deserialize(bytes.NewReader(v[8:]))
 	if err != nil {
 		str := fmt.Sprintf("%s: failed to deserialize transaction %v",
@@ -464,6 +575,10 @@ func fetchRawTxRecordPkScript(k, v []byte, index uint32) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
+	if rec.Pruned {
+		str := fmt.Sprintf("transaction %v is pruned", rec.Hash)
+		return nil, storeError(ErrData, str, nil)
+	}
 	if int(index) >= len(rec.MsgTx.TxOut) {
 		str := "missing transaction output for credit index"
 		return nil, storeError(ErrData, str, nil)
@@ -1421,6 +1536,16 @@ func deleteBuckets(ns walletdb.ReadWriteBucket) error {
 		return storeError(ErrDatabase, str, err)
 	}
 
+	// The pruned scripts bucket only exists once a transaction has been
+	// pruned.
+	if ns.NestedReadBucket(bucketPrunedScripts) != nil {
+		err := ns.DeleteNestedBucket(bucketPrunedScripts)
+		if err != nil {
+			str := "failed to delete pruned scripts bucket"
+			return storeError(ErrDatabase, str, err)
+		}
+	}
+
 	return nil
 }
 
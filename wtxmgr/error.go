@@ -49,6 +49,10 @@ const (
 	// but the database version is newer than latest version known to this
 	// software.  This likely indicates an outdated binary.
 	ErrUnknownVersion
+
+	// ErrPruned describes an error where a query or rollback requires the
+	// serialized transaction of a record dropped by PruneSpentTransactions.
+	ErrPruned
 )
 
 var errStrs = [...]string{
@@ -59,6 +63,7 @@ var errStrs = [...]string{
 	ErrNoExists:       "ErrNoExists",
 	ErrNeedsUpgrade:   "ErrNeedsUpgrade",
 	ErrUnknownVersion: "ErrUnknownVersion",
+	ErrPruned:         "ErrPruned",
 }
 
 // String returns the ErrorCode as a human-readable name.
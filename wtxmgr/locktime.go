@@ -0,0 +1,98 @@
+package wtxmgr
+
+import (
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+)
+
+// maxScriptNumLen is the maximum number of bytes a locktime pushed by a
+// CHECKLOCKTIMEVERIFY script may be encoded with.
+const maxScriptNumLen = 5
+
+// lockHeight returns the block height that the output at index of the
+// transaction record is time-locked until, or zero if the output is not
+// encumbered by a height-based locktime.
+//
+// Outputs whose script begins with a height-based CHECKLOCKTIMEVERIFY
+// condition are locked until that height.  Outputs of unmined transactions
+// are additionally locked by the transaction's own locktime, since the
+// transaction itself can not be mined until then.
+func lockHeight(rec *TxRecord, index uint32, mined bool) int32 {
+	height, _ := ScriptLockHeight(rec.MsgTx.TxOut[index].PkScript)
+	if mined {
+		return height
+	}
+	if txHeight := txLockHeight(&rec.MsgTx); txHeight > height {
+		height = txHeight
+	}
+	return height
+}
+
+// txLockHeight returns the height-based locktime of a transaction, or zero if
+// the transaction is final regardless of the current block height.
+func txLockHeight(tx *wire.MsgTx) int32 {
+	if tx.LockTime == 0 || tx.LockTime >= txscript.LockTimeThreshold {
+		return 0
+	}
+	for _, txIn := range tx.TxIn {
+		if txIn.Sequence != wire.MaxTxInSequenceNum {
+			return int32(tx.LockTime)
+		}
+	}
+	return 0
+}
+
+// ScriptLockHeight parses a script of the form
+// <locktime> OP_CHECKLOCKTIMEVERIFY ... and returns the locktime if it is a
+// block height.  The boolean return is false if the script does not begin
+// with a height-based CHECKLOCKTIMEVERIFY condition.  The script may be an
+// output script or the redeem script of a P2SH output.
+func ScriptLockHeight(script []byte) (int32, bool) {
+	if len(script) < 2 {
+		return 0, false
+	}
+
+	var lockTime int64
+	var rest []byte
+	switch op := script[0]; {
+	case op >= txscript.OP_1 && op <= txscript.OP_16:
+		lockTime = int64(op - (txscript.OP_1 - 1))
+		rest = script[1:]
+
+	case op >= txscript.OP_DATA_1 && op <= maxScriptNumLen:
+		if len(script) < int(op)+2 {
+			return 0, false
+		}
+		lockTime = decodeScriptNum(script[1 : 1+op])
+		rest = script[1+op:]
+
+	default:
+		return 0, false
+	}
+
+	if rest[0] != txscript.OP_CHECKLOCKTIMEVERIFY {
+		return 0, false
+	}
+	if lockTime <= 0 || lockTime >= txscript.LockTimeThreshold {
+		return 0, false
+	}
+	return int32(lockTime), true
+}
+
+// decodeScriptNum decodes a little-endian, sign-magnitude script number.
+func decodeScriptNum(v []byte) int64 {
+	if len(v) == 0 {
+		return 0
+	}
+	var result int64
+	for i, b := range v {
+		result |= int64(b) << uint8(8*i)
+	}
+	// A set high bit of the most significant byte indicates a negative
+	// number.
+	if v[len(v)-1]&0x80 != 0 {
+		result &= ^(int64(0x80) << uint8(8*(len(v)-1)))
+		return -result
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package wtxmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+)
+
+func TestScriptLockHeight(t *testing.T) {
+	t.Parallel()
+
+	p2pkh := []byte{
+		txscript.OP_DUP, txscript.OP_HASH160, txscript.OP_DATA_20,
+		0x47, 0x9e, 0xd3, 0x07, 0x83, 0x1d, 0x0a, 0xc1, 0x9e, 0xbc,
+		0x5f, 0x63, 0xde, 0x7d, 0x5f, 0x1a, 0x43, 0x0d, 0xdb, 0x9d,
+		txscript.OP_EQUALVERIFY, txscript.OP_CHECKSIG,
+	}
+	cltv := func(lockTime int64) []byte {
+		script, err := txscript.NewScriptBuilder().
+			AddInt64(lockTime).
+			AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).
+			AddOp(txscript.OP_DROP).
+			Script()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return append(script, p2pkh...)
+	}
+
+	tests := []struct {
+		name   string
+		script []byte
+		height int32
+		locked bool
+	}{
+		{"p2pkh", p2pkh, 0, false},
+		{"small int", cltv(16), 16, true},
+		{"one byte", cltv(100), 100, true},
+		{"three bytes", cltv(600000), 600000, true},
+		{"four bytes", cltv(txscript.LockTimeThreshold - 1), txscript.LockTimeThreshold - 1, true},
+		{"timestamp", cltv(txscript.LockTimeThreshold), 0, false},
+		{"negative", cltv(-1), 0, false},
+		{"csv", []byte{txscript.OP_1, txscript.OP_CHECKSEQUENCEVERIFY}, 0, false},
+		{"truncated", []byte{txscript.OP_DATA_3, 0x01}, 0, false},
+	}
+	for _, test := range tests {
+		height, locked := ScriptLockHeight(test.script)
+		if height != test.height || locked != test.locked {
+			t.Errorf("%s: got (%d, %v), want (%d, %v)", test.name,
+				height, locked, test.height, test.locked)
+		}
+	}
+}
+
+func TestLockHeightUnmined(t *testing.T) {
+	t.Parallel()
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(&wire.TxIn{Sequence: wire.MaxTxInSequenceNum})
+	tx.AddTxOut(&wire.TxOut{Value: 1e8, PkScript: []byte{txscript.OP_TRUE}})
+	tx.LockTime = 1000
+	rec, err := NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A transaction with only final sequence numbers ignores its locktime.
+	if h := lockHeight(rec, 0, false); h != 0 {
+		t.Fatalf("final transaction locked until %d", h)
+	}
+
+	rec.MsgTx.TxIn[0].Sequence = 0
+	if h := lockHeight(rec, 0, false); h != 1000 {
+		t.Fatalf("unmined transaction locked until %d, want 1000", h)
+	}
+
+	// Once mined, the transaction locktime no longer encumbers outputs.
+	if h := lockHeight(rec, 0, true); h != 0 {
+		t.Fatalf("mined transaction locked until %d", h)
+	}
+}
@@ -0,0 +1,214 @@
+package wtxmgr
+
+import (
+	"fmt"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// PruneSpentTransactions drops the serialized transactions of mined records
+// at or below maxHeight whose credits have all been spent by transactions also
+// mined at or below maxHeight.  A compact record holding the transaction hash,
+// block, received time, and fee paid by the wallet is kept in place of each
+// pruned transaction, and its credit and debit records, which determine its
+// net effect on the wallet's balance, are left untouched.  The output scripts
+// of the credits are kept so the addresses they paid remain known.
+//
+// The height pruned up to is recorded, so only the transactions mined above
+// it, and those whose credits they spend, are considered by the next prune.
+//
+// Pruned transactions can not be rolled back, so maxHeight must be below the
+// deepest reorg the caller handles by rolling back the store.  The number of
+// transactions pruned is returned.
+func (s *Store) PruneSpentTransactions(ns walletdb.ReadWriteBucket,
+	maxHeight int32) (int, error) {
+
+	prunedHeight, err := fetchPrunedHeight(ns)
+	if err != nil {
+		return 0, err
+	}
+	if maxHeight <= prunedHeight {
+		return 0, nil
+	}
+
+	// A transaction mined at or below the pruned height can only have
+	// become prunable by the last of its credits being spent by a
+	// transaction mined above it, so the candidates are the transactions
+	// mined above the pruned height and those they spend from.
+	var candidates [][]byte
+	seen := make(map[string]struct{})
+	addCandidate := func(k []byte) {
+		if _, ok := seen[string(k)]; ok {
+			return
+		}
+		seen[string(k)] = struct{}{}
+		candidates = append(candidates, k)
+	}
+	blockIter := makeReadBlockIterator(ns, prunedHeight+1)
+	for blockIter.next() {
+		block := &blockIter.elem
+		if block.Height > maxHeight {
+			break
+		}
+
+		for i := range block.transactions {
+			k := keyTxRecord(&block.transactions[i], &block.Block)
+			addCandidate(k)
+
+			debIter := makeReadDebitIterator(ns, k)
+			for debIter.next() {
+				credKey := extractRawDebitCreditKey(debIter.cv)
+				addCandidate(credKey[:68])
+			}
+			if debIter.err != nil {
+				return 0, debIter.err
+			}
+		}
+	}
+	if blockIter.err != nil {
+		return 0, blockIter.err
+	}
+
+	type prunedRecord struct {
+		k, v    []byte
+		scripts map[string][]byte // Output scripts keyed by credit key
+	}
+	var pruned []prunedRecord
+
+	for _, k := range candidates {
+		var txHash chainhash.Hash
+		copy(txHash[:], k[:32])
+		v := existsRawTxRecord(ns, k)
+		if v == nil {
+			str := fmt.Sprintf("missing transaction %v for "+
+				"block %v", txHash, byteOrder.Uint32(k[32:36]))
+			return 0, storeError(ErrData, str, nil)
+		}
+		if isPrunedTxRecord(v) {
+			continue
+		}
+
+		spent, err := creditsSpentBy(ns, k, maxHeight)
+		if err != nil {
+			return 0, err
+		}
+		if !spent {
+			continue
+		}
+
+		var rec TxRecord
+		if err := readRawTxRecord(&txHash, v, &rec); err != nil {
+			return 0, err
+		}
+		fee, err := recordFee(ns, k, &rec)
+		if err != nil {
+			return 0, err
+		}
+		scripts := make(map[string][]byte)
+		credIter := makeReadCreditIterator(ns, k)
+		for credIter.next() {
+			index := credIter.elem.Index
+			if int(index) >= len(rec.MsgTx.TxOut) {
+				str := "saved credit index exceeds number of " +
+					"outputs"
+				return 0, storeError(ErrData, str, nil)
+			}
+			scripts[string(credIter.ck)] = rec.MsgTx.TxOut[index].PkScript
+		}
+		if credIter.err != nil {
+			return 0, credIter.err
+		}
+		pruned = append(pruned, prunedRecord{
+			k:       k,
+			v:       valuePrunedTxRecord(rec.Received, fee),
+			scripts: scripts,
+		})
+	}
+
+	for _, r := range pruned {
+		for credKey, pkScript := range r.scripts {
+			err := putPrunedScript(ns, []byte(credKey), pkScript)
+			if err != nil {
+				return 0, err
+			}
+		}
+		if err := putRawTxRecord(ns, r.k, r.v); err != nil {
+			return 0, err
+		}
+	}
+	if err := putPrunedHeight(ns, maxHeight); err != nil {
+		return 0, err
+	}
+	if len(pruned) > 0 {
+		log.Debugf("Pruned %d spent transactions mined at or below "+
+			"height %d", len(pruned), maxHeight)
+	}
+	return len(pruned), nil
+}
+
+// creditsSpentBy returns whether every credit of the mined transaction record
+// with key recKey is spent by a transaction mined at or below maxHeight.
+func creditsSpentBy(ns walletdb.ReadBucket, recKey []byte,
+	maxHeight int32) (bool, error) {
+
+	credIter := makeReadCreditIterator(ns, recKey)
+	for credIter.next() {
+		if !credIter.elem.Spent {
+			return false, nil
+		}
+
+		spender, err := readCreditSpenderBlock(credIter.cv)
+		if err != nil {
+			return false, err
+		}
+		if spender.Height > maxHeight {
+			return false, nil
+		}
+	}
+	return credIter.err == nil, credIter.err
+}
+
+// readCreditSpenderBlock reads the block of the transaction spending a spent
+// credit from the credit's value.
+func readCreditSpenderBlock(cv []byte) (Block, error) {
+	// The debit key of the spending input begins with the key of the
+	// spending transaction's record, which records the height it was mined
+	// at.
+	var spender Block
+	if len(cv) < 81 {
+		str := fmt.Sprintf("%s: short read (expected %d bytes, "+
+			"read %d)", bucketCredits, 81, len(cv))
+		return spender, storeError(ErrData, str, nil)
+	}
+	err := readRawTxRecordBlock(cv[9:81], &spender)
+	return spender, err
+}
+
+// recordFee returns the fee paid by the wallet for the mined transaction
+// record rec with key recKey.  Zero is returned unless every input is a wallet
+// debit.
+func recordFee(ns walletdb.ReadBucket, recKey []byte,
+	rec *TxRecord) (bchutil.Amount, error) {
+
+	var debitTotal bchutil.Amount
+	var numDebits int
+	debIter := makeReadDebitIterator(ns, recKey)
+	for debIter.next() {
+		debitTotal += debIter.elem.Amount
+		numDebits++
+	}
+	if debIter.err != nil {
+		return 0, debIter.err
+	}
+	if numDebits != len(rec.MsgTx.TxIn) {
+		return 0, nil
+	}
+
+	var outputTotal bchutil.Amount
+	for _, output := range rec.MsgTx.TxOut {
+		outputTotal += bchutil.Amount(output.Value)
+	}
+	return debitTotal - outputTotal, nil
+}
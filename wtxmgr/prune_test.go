@@ -0,0 +1,338 @@
+package wtxmgr
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
+	"github.com/gcash/bchd/wire"
+	"github.com/gcash/bchutil"
+	"github.com/gcash/bchwallet/walletdb"
+)
+
+// TestPruneSpentTransactions ensures only transactions whose credits are all
+// spent by transactions mined at or below the prune height are pruned, that
+// pruned records keep their fee, credits, and debits, and that balances are
+// unaffected.
+func TestPruneSpentTransactions(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	insert := func(tx *wire.MsgTx, height int32, credits ...uint32) *TxRecord {
+		t.Helper()
+		rec, err := NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		block := &BlockMeta{
+			Block: Block{Height: height},
+			Time:  time.Now(),
+		}
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			if err := store.InsertTx(ns, rec, block); err != nil {
+				t.Fatal(err)
+			}
+			for _, i := range credits {
+				err := store.AddCredit(ns, rec, block, i, i != 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+		})
+		return rec
+	}
+	prune := func(maxHeight int32, want int) {
+		t.Helper()
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			n, err := store.PruneSpentTransactions(ns, maxHeight)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != want {
+				t.Fatalf("pruned %d transactions at height %d, "+
+					"want %d", n, maxHeight, want)
+			}
+		})
+	}
+	balance := func() bchutil.Amount {
+		t.Helper()
+		var bal bchutil.Amount
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			var err error
+			bal, err = store.Balance(ns, 1, 300)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+		return bal
+	}
+
+	// The coinbase is spent by a transaction paying 6e7 away with 3e7 in
+	// change, whose change is in turn spent paying 2e7 away with 9e6 in
+	// change.  The last change output remains unspent.
+	cb := insert(newCoinBase(1e8), 100, 0)
+	send1Tx := spendOutput(&cb.Hash, 0, 6e7, 3e7)
+	send1Tx.TxOut[1].PkScript = []byte{txscript.OP_TRUE}
+	send1 := insert(send1Tx, 101, 1)
+	send2 := insert(spendOutput(&send1.Hash, 1, 2e7, 9e6), 102, 1)
+
+	wantBalance := bchutil.Amount(9e6)
+	if bal := balance(); bal != wantBalance {
+		t.Fatalf("balance is %v, want %v", bal, wantBalance)
+	}
+
+	// The coinbase may not be pruned until its spender is also below the
+	// prune height.
+	prune(100, 0)
+	prune(101, 1)
+	prune(101, 0)
+	prune(102, 1)
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		details, err := store.TxDetails(ns, &send1.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !details.Pruned {
+			t.Fatalf("expected %v to be pruned", send1.Hash)
+		}
+		if details.PrunedFee != 1e7 {
+			t.Fatalf("pruned fee is %v, want %v", details.PrunedFee,
+				bchutil.Amount(1e7))
+		}
+		if len(details.Credits) != 1 || len(details.Debits) != 1 {
+			t.Fatalf("expected 1 credit and 1 debit, got %d and %d",
+				len(details.Credits), len(details.Debits))
+		}
+		wantScript := []byte{txscript.OP_TRUE}
+		if got := details.CreditPkScript(1); !bytes.Equal(got, wantScript) {
+			t.Fatalf("pruned credit script is %x, want %x", got,
+				wantScript)
+		}
+
+		details, err = store.TxDetails(ns, &send2.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if details.Pruned {
+			t.Fatalf("expected %v with an unspent credit to not be "+
+				"pruned", send2.Hash)
+		}
+
+		var n int
+		err = store.RangeTransactions(ns, 0, -1, func(d []TxDetails) (bool, error) {
+			n += len(d)
+			return false, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 3 {
+			t.Fatalf("ranged over %d transactions, want 3", n)
+		}
+	})
+
+	if bal := balance(); bal != wantBalance {
+		t.Fatalf("balance after pruning is %v, want %v", bal,
+			wantBalance)
+	}
+
+	// Unpruned transactions may still be rolled back, but pruned ones may
+	// not.
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		if err := store.Rollback(ns, 102); err != nil {
+			t.Fatal(err)
+		}
+		err := store.Rollback(ns, 101)
+		if serr, ok := err.(Error); !ok || serr.Code != ErrPruned {
+			t.Fatalf("rolling back a pruned transaction returned "+
+				"%v, want an error with code %v", err, ErrPruned)
+		}
+	})
+}
+
+// TestPruneSpentTransactionsBelowPrunedHeight ensures transactions inserted at
+// or below the height already pruned up to, as found by a rescan, are pruned
+// by the next prune.
+func TestPruneSpentTransactionsBelowPrunedHeight(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	prune := func(maxHeight int32, want int) {
+		t.Helper()
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			n, err := store.PruneSpentTransactions(ns, maxHeight)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != want {
+				t.Fatalf("pruned %d transactions at height %d, "+
+					"want %d", n, maxHeight, want)
+			}
+		})
+	}
+
+	prune(200, 0)
+
+	// The coinbase and its spender are found by a rescan after the store
+	// was pruned up to a greater height.
+	cb, err := NewTxRecordFromMsgTx(newCoinBase(1e8), time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	send, err := NewTxRecordFromMsgTx(
+		spendOutput(&cb.Hash, 0, 6e7, 3e7), time.Now(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		block := &BlockMeta{Block: Block{Height: 100}, Time: time.Now()}
+		if err := store.InsertTx(ns, cb, block); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.AddCredit(ns, cb, block, 0, false); err != nil {
+			t.Fatal(err)
+		}
+		block = &BlockMeta{Block: Block{Height: 101}, Time: time.Now()}
+		if err := store.InsertTx(ns, send, block); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	// Both are pruned, as the spender has no credits.
+	prune(200, 2)
+	prune(201, 0)
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		details, err := store.TxDetails(ns, &cb.Hash)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !details.Pruned {
+			t.Fatalf("expected %v to be pruned", cb.Hash)
+		}
+	})
+}
+
+// TestPruneSpentTransactionsUnminedSpender ensures a mined transaction whose
+// credits are spent by an unmined transaction is not pruned, and that pruning
+// leaves the unspent outputs and balances of the store unchanged.
+func TestPruneSpentTransactionsUnminedSpender(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	insert := func(tx *wire.MsgTx, block *BlockMeta, credits ...uint32) *TxRecord {
+		t.Helper()
+		rec, err := NewTxRecordFromMsgTx(tx, time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			if err := store.InsertTx(ns, rec, block); err != nil {
+				t.Fatal(err)
+			}
+			for _, i := range credits {
+				err := store.AddCredit(ns, rec, block, i, i != 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+		})
+		return rec
+	}
+	mined := func(height int32) *BlockMeta {
+		return &BlockMeta{Block: Block{Height: height}, Time: time.Now()}
+	}
+	type state struct {
+		unspent     map[wire.OutPoint]bchutil.Amount
+		bal, minBal bchutil.Amount
+	}
+	snapshot := func() state {
+		t.Helper()
+		s := state{unspent: make(map[wire.OutPoint]bchutil.Amount)}
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			credits, err := store.UnspentOutputs(ns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, c := range credits {
+				s.unspent[c.OutPoint] = c.Amount
+			}
+			s.bal, err = store.Balance(ns, 0, 300)
+			if err != nil {
+				t.Fatal(err)
+			}
+			s.minBal, err = store.Balance(ns, 1, 300)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+		return s
+	}
+
+	// The coinbase is spent by a mined transaction with 3e7 in change,
+	// which is in turn spent by an unmined transaction with 9e6 in change.
+	cb := insert(newCoinBase(1e8), mined(100), 0)
+	send1 := insert(spendOutput(&cb.Hash, 0, 6e7, 3e7), mined(101), 1)
+	send2 := insert(spendOutput(&send1.Hash, 1, 2e7, 9e6), nil, 1)
+
+	before := snapshot()
+	if len(before.unspent) != 1 {
+		t.Fatalf("got %d unspent outputs, want 1", len(before.unspent))
+	}
+
+	commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+		n, err := store.PruneSpentTransactions(ns, 200)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != 1 {
+			t.Fatalf("pruned %d transactions, want 1", n)
+		}
+
+		for _, hash := range []*chainhash.Hash{&send1.Hash, &send2.Hash} {
+			details, err := store.TxDetails(ns, hash)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if details.Pruned {
+				t.Fatalf("expected %v with an unmined spender to "+
+					"not be pruned", hash)
+			}
+		}
+	})
+
+	after := snapshot()
+	if after.bal != before.bal || after.minBal != before.minBal {
+		t.Fatalf("balances after pruning are %v and %v, want %v and %v",
+			after.bal, after.minBal, before.bal, before.minBal)
+	}
+	if len(after.unspent) != len(before.unspent) {
+		t.Fatalf("got %d unspent outputs after pruning, want %d",
+			len(after.unspent), len(before.unspent))
+	}
+	for op, amt := range before.unspent {
+		if after.unspent[op] != amt {
+			t.Fatalf("unspent output %v changed from %v to %v", op,
+				amt, after.unspent[op])
+		}
+	}
+}
@@ -39,6 +39,21 @@ type TxDetails struct {
 	Block   BlockMeta
 	Credits []CreditRecord
 	Debits  []DebitRecord
+
+	// prunedScripts holds the output scripts of the credits of a pruned
+	// record keyed by output index.
+	prunedScripts map[uint32][]byte
+}
+
+// CreditPkScript returns the output script of the credited output with the
+// given index.  The scripts of credits remain available after the transaction
+// is pruned, but nil is returned for outputs which are not credits of a pruned
+// record.
+func (d *TxDetails) CreditPkScript(index uint32) []byte {
+	if d.Pruned {
+		return d.prunedScripts[index]
+	}
+	return d.MsgTx.TxOut[index].PkScript
 }
 
 // minedTxDetails fetches the TxDetails for the mined transaction with hash
@@ -63,10 +78,15 @@ func (s *Store) minedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash, r
 
 	credIter := makeReadCreditIterator(ns, recKey)
 	for credIter.next() {
-		if int(credIter.elem.Index) >= len(details.MsgTx.TxOut) {
+		if !details.Pruned &&
+			int(credIter.elem.Index) >= len(details.MsgTx.TxOut) {
 			str := "saved credit index exceeds number of outputs"
 			return nil, storeError(ErrData, str, nil)
 		}
+		if details.Pruned {
+			details.addPrunedScript(ns, credIter.ck,
+				credIter.elem.Index)
+		}
 
 		// The credit iterator does not record whether this credit was
 		// spent by an unmined transaction, so check that here.
@@ -83,7 +103,8 @@ func (s *Store) minedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash, r
 
 	debIter := makeReadDebitIterator(ns, recKey)
 	for debIter.next() {
-		if int(debIter.elem.Index) >= len(details.MsgTx.TxIn) {
+		if !details.Pruned &&
+			int(debIter.elem.Index) >= len(details.MsgTx.TxIn) {
 			str := "saved debit index exceeds number of inputs"
 			return nil, storeError(ErrData, str, nil)
 		}
@@ -93,6 +114,21 @@ func (s *Store) minedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash, r
 	return &details, debIter.err
 }
 
+// addPrunedScript reads the output script of the credit with key credKey and
+// output index index of a pruned record into the details.
+func (d *TxDetails) addPrunedScript(ns walletdb.ReadBucket, credKey []byte,
+	index uint32) {
+
+	pkScript := fetchPrunedScript(ns, credKey)
+	if pkScript == nil {
+		return
+	}
+	if d.prunedScripts == nil {
+		d.prunedScripts = make(map[uint32][]byte)
+	}
+	d.prunedScripts[index] = pkScript
+}
+
 // unminedTxDetails fetches the TxDetails for the unmined transaction with the
 // hash txHash and the passed unmined record value.
 func (s *Store) unminedTxDetails(ns walletdb.ReadBucket, txHash *chainhash.Hash, v []byte) (*TxDetails, error) {
@@ -309,10 +345,15 @@ func (s *Store) rangeBlockTransactions(ns walletdb.ReadBucket, begin, end int32,
 
 			credIter := makeReadCreditIterator(ns, k)
 			for credIter.next() {
-				if int(credIter.elem.Index) >= len(detail.MsgTx.TxOut) {
+				if !detail.Pruned &&
+					int(credIter.elem.Index) >= len(detail.MsgTx.TxOut) {
 					str := "saved credit index exceeds number of outputs"
 					return false, storeError(ErrData, str, nil)
 				}
+				if detail.Pruned {
+					detail.addPrunedScript(ns, credIter.ck,
+						credIter.elem.Index)
+				}
 
 				// The credit iterator does not record whether
 				// this credit was spent by an unmined
@@ -330,7 +371,8 @@ func (s *Store) rangeBlockTransactions(ns walletdb.ReadBucket, begin, end int32,
 
 			debIter := makeReadDebitIterator(ns, k)
 			for debIter.next() {
-				if int(debIter.elem.Index) >= len(detail.MsgTx.TxIn) {
+				if !detail.Pruned &&
+					int(debIter.elem.Index) >= len(detail.MsgTx.TxIn) {
 					str := "saved debit index exceeds number of inputs"
 					return false, storeError(ErrData, str, nil)
 				}
@@ -7,11 +7,13 @@ package wtxmgr
 
 import (
 	"bytes"
+	"fmt"
 	"time"
 
 	"github.com/gcash/bchd/blockchain"
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
 	"github.com/gcash/bchwallet/walletdb"
@@ -80,6 +82,13 @@ type TxRecord struct {
 	Hash         chainhash.Hash
 	Received     time.Time
 	SerializedTx []byte // Optional: may be nil
+
+	// Pruned is set for mined records whose serialized transaction was
+	// dropped by PruneSpentTransactions.  MsgTx is empty for pruned
+	// records and PrunedFee records the fee paid by the wallet, or zero
+	// if not every input was a wallet debit.
+	Pruned    bool
+	PrunedFee bchutil.Amount
 }
 
 // NewTxRecord creates a new transaction record that may be inserted into the
@@ -128,6 +137,12 @@ type Credit struct {
 	PkScript     []byte
 	Received     time.Time
 	FromCoinBase bool
+
+	// LockHeight is the block height the output is time-locked until, or
+	// zero if the output is not encumbered by a height-based locktime.
+	// Such an output may only be spent by a transaction mined in a block
+	// with a height greater than LockHeight.
+	LockHeight int32
 }
 
 // Store implements a transaction store for storing and managing wallet
@@ -333,6 +348,12 @@ func (s *Store) insertMinedTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
 		return nil
 	}
 
+	// A transaction mined at or below the pruned height, as found by a
+	// rescan, must be considered by the next prune.
+	if err := lowerPrunedHeight(ns, block.Height); err != nil {
+		return err
+	}
+
 	// If a block record does not yet exist for any transactions from this
 	// block, insert a block record first. Otherwise, update it by adding
 	// the transaction hash to the set of transactions from this block.
@@ -382,6 +403,7 @@ func (s *Store) insertMinedTx(ns walletdb.ReadWriteBucket, rec *TxRecord,
 // AddCredit marks a transaction record as containing a transaction output
 // spendable by wallet.  The output is added unspent, and is marked spent
 // when a new transaction spending the output is inserted into the store.
+// Zero-value and provably unspendable outputs are ignored.
 //
 // TODO(jrick): This should not be necessary.  Instead, pass the indexes
 // that are known to contain credits when a transaction or merkleblock is
@@ -392,6 +414,15 @@ func (s *Store) AddCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 		return storeError(ErrInput, str, nil)
 	}
 
+	// Outputs that carry no value or can never be spent, such as OP_RETURN
+	// data carriers, are never credits, so they can't contribute to the
+	// balance or be selected as inputs.
+	if !isCreditable(rec.MsgTx.TxOut[index]) {
+		log.Debugf("Ignoring unspendable transaction %v output %d",
+			rec.Hash, index)
+		return nil
+	}
+
 	isNew, err := s.addCredit(ns, rec, block, index, change)
 	if err == nil && isNew && s.NotifyUnspent != nil {
 		s.NotifyUnspent(&rec.Hash, index)
@@ -399,6 +430,12 @@ func (s *Store) AddCredit(ns walletdb.ReadWriteBucket, rec *TxRecord, block *Blo
 	return err
 }
 
+// isCreditable returns whether an output can be recorded as a credit.  Outputs
+// with a zero value or a provably unspendable script are not.
+func isCreditable(txOut *wire.TxOut) bool {
+	return txOut.Value > 0 && !txscript.IsUnspendable(txOut.PkScript)
+}
+
 // addCredit is an AddCredit helper that runs in an update transaction.  The
 // bool return specifies whether the unspent output is newly added (true) or a
 // duplicate (false).
@@ -479,6 +516,12 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, height int32) error {
 	var coinBaseCredits []wire.OutPoint
 	var heightsToRemove []int32
 
+	// Transactions mined again after the rollback must be considered by
+	// the next prune.
+	if err := lowerPrunedHeight(ns, height); err != nil {
+		return err
+	}
+
 	it := makeReverseBlockIterator(ns)
 	for it.prev() {
 		b := &it.elem
@@ -501,6 +544,11 @@ func (s *Store) rollback(ns walletdb.ReadWriteBucket, height int32) error {
 			if err != nil {
 				return err
 			}
+			if rec.Pruned {
+				str := fmt.Sprintf("unable to roll back pruned "+
+					"transaction %v", txHash)
+				return storeError(ErrPruned, str, nil)
+			}
 
 			err = deleteTxRecord(ns, txHash, &b.Block)
 			if err != nil {
@@ -747,6 +795,7 @@ func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]Credit, error) {
 			PkScript:     txOut.PkScript,
 			Received:     rec.Received,
 			FromCoinBase: blockchain.IsCoinBaseTx(&rec.MsgTx),
+			LockHeight:   lockHeight(rec, op.Index, true),
 		}
 		unspent = append(unspent, cred)
 		return nil
@@ -790,6 +839,7 @@ func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]Credit, error) {
 			PkScript:     txOut.PkScript,
 			Received:     rec.Received,
 			FromCoinBase: blockchain.IsCoinBaseTx(&rec.MsgTx),
+			LockHeight:   lockHeight(&rec, op.Index, false),
 		}
 		unspent = append(unspent, cred)
 		return nil
@@ -805,6 +855,89 @@ func (s *Store) UnspentOutputs(ns walletdb.ReadBucket) ([]Credit, error) {
 	return unspent, nil
 }
 
+// UnspentOutputsAt returns the mined credits which were unspent at the given
+// block height: those mined at or below the height and not spent by another
+// transaction also mined at or below it.  The serialized transactions of
+// records pruned by PruneSpentTransactions are needed to create the credits, so
+// an error with code ErrPruned is returned when one of them was still unspent
+// at the height.
+func (s *Store) UnspentOutputsAt(ns walletdb.ReadBucket, height int32) ([]Credit, error) {
+	var unspent []Credit
+
+	blockIter := makeReadBlockIterator(ns, 0)
+	for blockIter.next() {
+		block := &blockIter.elem
+		if block.Height > height {
+			break
+		}
+
+		for i := range block.transactions {
+			txHash := &block.transactions[i]
+			k, v := existsTxRecord(ns, txHash, &block.Block)
+			if v == nil {
+				str := fmt.Sprintf("missing transaction %v for "+
+					"block %v", txHash, block.Height)
+				return nil, storeError(ErrData, str, nil)
+			}
+
+			// The transaction is only read once one of its credits
+			// is found unspent.
+			var rec *TxRecord
+			credIter := makeReadCreditIterator(ns, k)
+			for credIter.next() {
+				if credIter.elem.Spent {
+					spender, err := readCreditSpenderBlock(credIter.cv)
+					if err != nil {
+						return nil, err
+					}
+					if spender.Height <= height {
+						continue
+					}
+				}
+
+				if rec == nil {
+					rec = new(TxRecord)
+					err := readRawTxRecord(txHash, v, rec)
+					if err != nil {
+						return nil, err
+					}
+					if rec.Pruned {
+						str := fmt.Sprintf("transaction %v "+
+							"unspent at height %d is pruned",
+							txHash, height)
+						return nil, storeError(ErrPruned, str, nil)
+					}
+				}
+
+				index := credIter.elem.Index
+				txOut := rec.MsgTx.TxOut[index]
+				unspent = append(unspent, Credit{
+					OutPoint: wire.OutPoint{
+						Hash:  *txHash,
+						Index: index,
+					},
+					BlockMeta: BlockMeta{
+						Block: block.Block,
+						Time:  block.Time,
+					},
+					Amount:       credIter.elem.Amount,
+					PkScript:     txOut.PkScript,
+					Received:     rec.Received,
+					FromCoinBase: blockchain.IsCoinBaseTx(&rec.MsgTx),
+					LockHeight:   lockHeight(rec, index, true),
+				})
+			}
+			if credIter.err != nil {
+				return nil, credIter.err
+			}
+		}
+	}
+	if blockIter.err != nil {
+		return nil, blockIter.err
+	}
+	return unspent, nil
+}
+
 // Balance returns the spendable wallet balance (total value of all unspent
 // transaction outputs) given a minimum of minConf confirmations, calculated
 // at a current chain height of curHeight.  Coinbase outputs are only included
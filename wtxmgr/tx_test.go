@@ -15,6 +15,7 @@ import (
 
 	"github.com/gcash/bchd/chaincfg"
 	"github.com/gcash/bchd/chaincfg/chainhash"
+	"github.com/gcash/bchd/txscript"
 	"github.com/gcash/bchd/wire"
 	"github.com/gcash/bchutil"
 	"github.com/gcash/bchwallet/walletdb"
@@ -2263,3 +2264,61 @@ func TestInsertMempoolTxAndConfirm(t *testing.T) {
 		}
 	})
 }
+
+// TestUnspendableOutputsNotCredited ensures that zero-value and OP_RETURN
+// outputs are never recorded as credits, so they neither contribute to the
+// balance nor are returned as unspent outputs available for selection.
+func TestUnspendableOutputsNotCredited(t *testing.T) {
+	t.Parallel()
+
+	store, db, teardown, err := testStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	nullData, err := txscript.NullDataScript([]byte("memo"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx := spendOutput(&chainhash.Hash{}, 0, 1e8, 0, 0)
+	tx.TxOut[1].PkScript = nullData
+	tx.TxOut[2].PkScript = []byte{txscript.OP_TRUE}
+	rec, err := NewTxRecordFromMsgTx(tx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, block := range []*BlockMeta{nil, {Block: Block{Height: 100}}} {
+		commitDBTx(t, store, db, func(ns walletdb.ReadWriteBucket) {
+			if err := store.InsertTx(ns, rec, block); err != nil {
+				t.Fatal(err)
+			}
+			for i := range tx.TxOut {
+				err := store.AddCredit(ns, rec, block, uint32(i), false)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			unspent, err := store.UnspentOutputs(ns)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(unspent) != 1 || unspent[0].Index != 0 {
+				t.Fatalf("expected only output 0 to be unspent, "+
+					"got %v", unspent)
+			}
+
+			bal, err := store.Balance(ns, 0, 100)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bal != 1e8 {
+				t.Fatalf("expected balance %v, got %v",
+					bchutil.Amount(1e8), bal)
+			}
+		})
+	}
+}